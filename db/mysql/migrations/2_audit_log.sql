@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE `audit_log_actor_types` (
+  `id`                       VARCHAR(32)    NOT NULL COMMENT "id",
+  CONSTRAINT `audit_log_actor_types_pkey` PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+COMMENT "audit_log_actor_type";
+
+CREATE TABLE `audit_log_target_types` (
+  `id`                       VARCHAR(32)    NOT NULL COMMENT "id",
+  CONSTRAINT `audit_log_target_types_pkey` PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+COMMENT "audit_log_target_type";
+
+CREATE TABLE `audit_logs` (
+  `id`                       VARCHAR(64)    NOT NULL COMMENT "id",
+  `tenant_id`                VARCHAR(64)    NULL     COMMENT "tenant_id",
+  `actor_type`               VARCHAR(32)    NOT NULL COMMENT "actor_type",
+  `actor_id`                 VARCHAR(64)    NOT NULL COMMENT "actor_id",
+  `method`                   VARCHAR(256)   NOT NULL COMMENT "rpc method",
+  `target_type`              VARCHAR(32)    NOT NULL COMMENT "target_type",
+  `target_id`                VARCHAR(64)    NOT NULL COMMENT "target_id",
+  `diff`                     TEXT           NOT NULL COMMENT "before/after diff",
+  `created_at`               DATETIME       NOT NULL COMMENT "created date",
+  CONSTRAINT `audit_logs_pkey` PRIMARY KEY (`id`),
+  INDEX `audit_logs_idx_tenant_id_created_at` (`tenant_id`, `created_at`),
+  INDEX `audit_logs_idx_target_type_target_id` (`target_type`, `target_id`),
+  CONSTRAINT `audit_logs_fkey_actor_type` FOREIGN KEY (`actor_type`) REFERENCES `audit_log_actor_types` (`id`),
+  CONSTRAINT `audit_logs_fkey_target_type` FOREIGN KEY (`target_type`) REFERENCES `audit_log_target_types` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+COMMENT "audit_log";
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_logs;
+DROP TABLE IF EXISTS audit_log_target_types;
+DROP TABLE IF EXISTS audit_log_actor_types;
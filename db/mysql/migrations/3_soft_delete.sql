@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE `tenants`
+  ADD COLUMN `deleted_at`    DATETIME       NULL     COMMENT "deleted date" AFTER `updated_at`,
+  ADD INDEX `tenants_idx_deleted_at` (`deleted_at`);
+
+ALTER TABLE `staffs`
+  ADD COLUMN `deleted_at`    DATETIME       NULL     COMMENT "deleted date" AFTER `updated_at`,
+  ADD INDEX `staffs_idx_tenant_id_deleted_at` (`tenant_id`, `deleted_at`),
+  ADD INDEX `staffs_idx_deleted_at` (`deleted_at`);
+
+-- +goose Down
+ALTER TABLE `staffs`
+  DROP INDEX `staffs_idx_deleted_at`,
+  DROP INDEX `staffs_idx_tenant_id_deleted_at`,
+  DROP COLUMN `deleted_at`;
+
+ALTER TABLE `tenants`
+  DROP INDEX `tenants_idx_deleted_at`,
+  DROP COLUMN `deleted_at`;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE `invitations` (
+  `id`                       VARCHAR(64)    NOT NULL COMMENT "id",
+  `tenant_id`                VARCHAR(64)    NOT NULL COMMENT "tenant_id",
+  `email`                    VARCHAR(512)   NOT NULL COMMENT "email",
+  `role`                     VARCHAR(32)    NOT NULL COMMENT "role",
+  `token_hash`               VARCHAR(64)    NOT NULL COMMENT "sha256 hash of the invitation token",
+  `invited_by`               VARCHAR(64)    NOT NULL COMMENT "staff id of the inviter",
+  `expires_at`               DATETIME       NOT NULL COMMENT "expiration date",
+  `accepted_at`              DATETIME       NULL     COMMENT "accepted date",
+  `accepted_staff_id`        VARCHAR(64)    NULL     COMMENT "staff id created on acceptance",
+  `revoked_at`               DATETIME       NULL     COMMENT "revoked date",
+  `created_at`               DATETIME       NOT NULL COMMENT "created date",
+  `updated_at`               DATETIME       NOT NULL COMMENT "update date",
+  CONSTRAINT `invitations_pkey` PRIMARY KEY (`id`),
+  UNIQUE `invitations_unique_token_hash` (`token_hash`),
+  INDEX `invitations_idx_tenant_id_created_at` (`tenant_id`, `created_at`),
+  CONSTRAINT `invitations_fkey_tenant_id` FOREIGN KEY (`tenant_id`) REFERENCES `tenants` (`id`),
+  CONSTRAINT `invitations_fkey_role` FOREIGN KEY (`role`) REFERENCES `staff_roles` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+COMMENT "invitation";
+
+-- +goose Down
+DROP TABLE IF EXISTS invitations;
@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE `outbox_event_statuses` (
+  `id`                       VARCHAR(32)    NOT NULL COMMENT "id",
+  CONSTRAINT `outbox_event_statuses_pkey` PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+COMMENT "outbox_event_status";
+
+CREATE TABLE `outbox_events` (
+  `id`                       VARCHAR(64)    NOT NULL COMMENT "id",
+  `event_type`               VARCHAR(64)    NOT NULL COMMENT "domain event type",
+  `tenant_id`                VARCHAR(64)    NULL     COMMENT "tenant_id",
+  `aggregate_id`             VARCHAR(64)    NOT NULL COMMENT "id of the entity the event is about",
+  `payload`                  TEXT           NOT NULL COMMENT "event payload as json",
+  `occurred_at`              DATETIME       NOT NULL COMMENT "occurred date",
+  `status`                   VARCHAR(32)    NOT NULL COMMENT "delivery status",
+  `attempts`                 INT UNSIGNED   NOT NULL COMMENT "number of delivery attempts",
+  `next_attempt_at`          DATETIME       NOT NULL COMMENT "next delivery date",
+  `last_error`               TEXT           NULL     COMMENT "last delivery error",
+  `delivered_at`             DATETIME       NULL     COMMENT "delivered date",
+  `created_at`               DATETIME       NOT NULL COMMENT "created date",
+  `updated_at`               DATETIME       NOT NULL COMMENT "update date",
+  CONSTRAINT `outbox_events_pkey` PRIMARY KEY (`id`),
+  INDEX `outbox_events_idx_status_next_attempt_at` (`status`, `next_attempt_at`),
+  CONSTRAINT `outbox_events_fkey_status` FOREIGN KEY (`status`) REFERENCES `outbox_event_statuses` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+COMMENT "outbox_event";
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox_events;
+DROP TABLE IF EXISTS outbox_event_statuses;
@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE `job_statuses` (
+  `id`                       VARCHAR(32)    NOT NULL COMMENT "id",
+  CONSTRAINT `job_statuses_pkey` PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+COMMENT "job_status";
+
+CREATE TABLE `jobs` (
+  `id`                       VARCHAR(64)    NOT NULL COMMENT "id",
+  `type`                     VARCHAR(64)    NOT NULL COMMENT "job type",
+  `payload`                  TEXT           NOT NULL COMMENT "job payload as json",
+  `status`                   VARCHAR(32)    NOT NULL COMMENT "status",
+  `attempts`                 INT UNSIGNED   NOT NULL COMMENT "number of attempts",
+  `max_attempts`             INT UNSIGNED   NOT NULL COMMENT "number of attempts before dead lettering",
+  `run_at`                   DATETIME       NOT NULL COMMENT "scheduled date",
+  `locked_until`             DATETIME       NULL     COMMENT "lease expiry date of a running job",
+  `last_error`               TEXT           NULL     COMMENT "last error",
+  `finished_at`              DATETIME       NULL     COMMENT "finished date",
+  `created_at`               DATETIME       NOT NULL COMMENT "created date",
+  `updated_at`               DATETIME       NOT NULL COMMENT "update date",
+  CONSTRAINT `jobs_pkey` PRIMARY KEY (`id`),
+  INDEX `jobs_idx_status_run_at` (`status`, `run_at`),
+  CONSTRAINT `jobs_fkey_status` FOREIGN KEY (`status`) REFERENCES `job_statuses` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+COMMENT "job";
+
+-- +goose Down
+DROP TABLE IF EXISTS jobs;
+DROP TABLE IF EXISTS job_statuses;
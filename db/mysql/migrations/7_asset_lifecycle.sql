@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE `asset_statuses` (
+  `id`                       VARCHAR(32)    NOT NULL COMMENT "id",
+  CONSTRAINT `asset_statuses_pkey` PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+COMMENT "asset_status";
+
+-- the existing assets are pending, so the status they reference is needed before sync constants runs
+INSERT INTO `asset_statuses` (`id`) VALUES ('pending');
+
+ALTER TABLE `assets`
+  ADD COLUMN `status`        VARCHAR(32)    NOT NULL DEFAULT 'pending' COMMENT "status" AFTER `path`,
+  ADD COLUMN `size`          BIGINT         NULL     COMMENT "object size in bytes" AFTER `status`,
+  ADD COLUMN `uploaded_at`   DATETIME       NULL     COMMENT "upload confirmed date" AFTER `expires_at`,
+  ADD INDEX `assets_idx_status_expires_at` (`status`, `expires_at`),
+  ADD CONSTRAINT `assets_fkey_status` FOREIGN KEY (`status`) REFERENCES `asset_statuses` (`id`);
+
+-- +goose Down
+ALTER TABLE `assets`
+  DROP FOREIGN KEY `assets_fkey_status`,
+  DROP INDEX `assets_idx_status_expires_at`,
+  DROP COLUMN `uploaded_at`,
+  DROP COLUMN `size`,
+  DROP COLUMN `status`;
+
+DROP TABLE IF EXISTS asset_statuses;
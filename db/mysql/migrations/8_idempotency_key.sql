@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE `idempotency_keys` (
+  `id`                       VARCHAR(64)    NOT NULL COMMENT "id",
+  `fingerprint`              VARCHAR(64)    NOT NULL COMMENT "fingerprint of the request",
+  `response`                 MEDIUMBLOB     NULL     COMMENT "serialized response",
+  `expires_at`               DATETIME       NOT NULL COMMENT "expiry date",
+  `created_at`               DATETIME       NOT NULL COMMENT "created date",
+  `updated_at`               DATETIME       NOT NULL COMMENT "update date",
+  CONSTRAINT `idempotency_keys_pkey` PRIMARY KEY (`id`),
+  INDEX `idempotency_keys_idx_expires_at` (`expires_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+COMMENT "idempotency_key";
+
+-- +goose Down
+DROP TABLE IF EXISTS idempotency_keys;
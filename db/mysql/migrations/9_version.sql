@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE `tenants`
+  ADD COLUMN `version`       BIGINT         NOT NULL DEFAULT 1 COMMENT "version incremented on every update" AFTER `deleted_at`;
+
+ALTER TABLE `staffs`
+  ADD COLUMN `version`       BIGINT         NOT NULL DEFAULT 1 COMMENT "version incremented on every update" AFTER `deleted_at`;
+
+-- +goose Down
+ALTER TABLE `staffs`
+  DROP COLUMN `version`;
+
+ALTER TABLE `tenants`
+  DROP COLUMN `version`;
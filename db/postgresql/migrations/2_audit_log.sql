@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE audit_log_actor_types (
+    "id" VARCHAR(32) PRIMARY KEY
+);
+
+CREATE TABLE audit_log_target_types (
+    "id" VARCHAR(32) PRIMARY KEY
+);
+
+CREATE TABLE audit_logs (
+    "id"          VARCHAR(64)   PRIMARY KEY,
+    "tenant_id"   VARCHAR(64),
+    "actor_type"  VARCHAR(32)   NOT NULL,
+    "actor_id"    VARCHAR(64)   NOT NULL,
+    "method"      VARCHAR(256)  NOT NULL,
+    "target_type" VARCHAR(32)   NOT NULL,
+    "target_id"   VARCHAR(64)   NOT NULL,
+    "diff"        TEXT          NOT NULL,
+    "created_at"  TIMESTAMPTZ   NOT NULL,
+    CONSTRAINT "audit_logs_fkey_actor_type" FOREIGN KEY ("actor_type") REFERENCES "audit_log_actor_types" ("id"),
+    CONSTRAINT "audit_logs_fkey_target_type" FOREIGN KEY ("target_type") REFERENCES "audit_log_target_types" ("id")
+);
+
+CREATE INDEX "audit_logs_idx_tenant_id_created_at" ON "audit_logs" ("tenant_id", "created_at");
+CREATE INDEX "audit_logs_idx_target_type_target_id" ON "audit_logs" ("target_type", "target_id");
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_logs;
+DROP TABLE IF EXISTS audit_log_target_types;
+DROP TABLE IF EXISTS audit_log_actor_types;
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE "tenants" ADD COLUMN "deleted_at" TIMESTAMPTZ NULL;
+ALTER TABLE "staffs" ADD COLUMN "deleted_at" TIMESTAMPTZ NULL;
+
+CREATE INDEX "tenants_idx_deleted_at" ON "tenants" ("deleted_at");
+CREATE INDEX "staffs_idx_tenant_id_deleted_at" ON "staffs" ("tenant_id", "deleted_at");
+CREATE INDEX "staffs_idx_deleted_at" ON "staffs" ("deleted_at");
+
+-- +goose Down
+DROP INDEX IF EXISTS "staffs_idx_deleted_at";
+DROP INDEX IF EXISTS "staffs_idx_tenant_id_deleted_at";
+DROP INDEX IF EXISTS "tenants_idx_deleted_at";
+
+ALTER TABLE "staffs" DROP COLUMN "deleted_at";
+ALTER TABLE "tenants" DROP COLUMN "deleted_at";
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE invitations (
+    "id"                VARCHAR(64)   PRIMARY KEY,
+    "tenant_id"         VARCHAR(64)   NOT NULL,
+    "email"             VARCHAR(512)  NOT NULL,
+    "role"              VARCHAR(32)   NOT NULL,
+    "token_hash"        VARCHAR(64)   NOT NULL,
+    "invited_by"        VARCHAR(64)   NOT NULL,
+    "expires_at"        TIMESTAMPTZ   NOT NULL,
+    "accepted_at"       TIMESTAMPTZ,
+    "accepted_staff_id" VARCHAR(64),
+    "revoked_at"        TIMESTAMPTZ,
+    "created_at"        TIMESTAMPTZ   NOT NULL,
+    "updated_at"        TIMESTAMPTZ   NOT NULL,
+    CONSTRAINT "invitations_unique_token_hash" UNIQUE ("token_hash"),
+    CONSTRAINT "invitations_fkey_tenant_id" FOREIGN KEY ("tenant_id") REFERENCES "tenants" ("id"),
+    CONSTRAINT "invitations_fkey_role" FOREIGN KEY ("role") REFERENCES "staff_roles" ("id")
+);
+
+CREATE INDEX "invitations_idx_tenant_id_created_at" ON "invitations" ("tenant_id", "created_at");
+
+-- +goose Down
+DROP TABLE IF EXISTS invitations;
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE outbox_event_statuses (
+    "id" VARCHAR(32) PRIMARY KEY
+);
+
+CREATE TABLE outbox_events (
+    "id"              VARCHAR(64)   PRIMARY KEY,
+    "event_type"      VARCHAR(64)   NOT NULL,
+    "tenant_id"       VARCHAR(64),
+    "aggregate_id"    VARCHAR(64)   NOT NULL,
+    "payload"         TEXT          NOT NULL,
+    "occurred_at"     TIMESTAMPTZ   NOT NULL,
+    "status"          VARCHAR(32)   NOT NULL,
+    "attempts"        INTEGER       NOT NULL,
+    "next_attempt_at" TIMESTAMPTZ   NOT NULL,
+    "last_error"      TEXT,
+    "delivered_at"    TIMESTAMPTZ,
+    "created_at"      TIMESTAMPTZ   NOT NULL,
+    "updated_at"      TIMESTAMPTZ   NOT NULL,
+    CONSTRAINT "outbox_events_fkey_status" FOREIGN KEY ("status") REFERENCES "outbox_event_statuses" ("id")
+);
+
+CREATE INDEX "outbox_events_idx_status_next_attempt_at" ON "outbox_events" ("status", "next_attempt_at");
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox_events;
+DROP TABLE IF EXISTS outbox_event_statuses;
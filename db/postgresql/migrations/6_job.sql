@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE job_statuses (
+    "id" VARCHAR(32) PRIMARY KEY
+);
+
+CREATE TABLE jobs (
+    "id"           VARCHAR(64)   PRIMARY KEY,
+    "type"         VARCHAR(64)   NOT NULL,
+    "payload"      TEXT          NOT NULL,
+    "status"       VARCHAR(32)   NOT NULL,
+    "attempts"     INTEGER       NOT NULL,
+    "max_attempts" INTEGER       NOT NULL,
+    "run_at"       TIMESTAMPTZ   NOT NULL,
+    "locked_until" TIMESTAMPTZ,
+    "last_error"   TEXT,
+    "finished_at"  TIMESTAMPTZ,
+    "created_at"   TIMESTAMPTZ   NOT NULL,
+    "updated_at"   TIMESTAMPTZ   NOT NULL,
+    CONSTRAINT "jobs_fkey_status" FOREIGN KEY ("status") REFERENCES "job_statuses" ("id")
+);
+
+CREATE INDEX "jobs_idx_status_run_at" ON "jobs" ("status", "run_at");
+
+-- +goose Down
+DROP TABLE IF EXISTS jobs;
+DROP TABLE IF EXISTS job_statuses;
@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE asset_statuses (
+    "id" VARCHAR(32) PRIMARY KEY
+);
+
+-- the existing assets are pending, so the status they reference is needed before sync constants runs
+INSERT INTO asset_statuses ("id") VALUES ('pending');
+
+ALTER TABLE assets
+    ADD COLUMN "status"      VARCHAR(32)   NOT NULL DEFAULT 'pending',
+    ADD COLUMN "size"        BIGINT,
+    ADD COLUMN "uploaded_at" TIMESTAMPTZ,
+    ADD CONSTRAINT "assets_fkey_status" FOREIGN KEY ("status") REFERENCES "asset_statuses" ("id");
+
+CREATE INDEX "assets_idx_status_expires_at" ON "assets" ("status", "expires_at");
+
+-- +goose Down
+DROP INDEX IF EXISTS "assets_idx_status_expires_at";
+
+ALTER TABLE assets
+    DROP CONSTRAINT "assets_fkey_status",
+    DROP COLUMN "uploaded_at",
+    DROP COLUMN "size",
+    DROP COLUMN "status";
+
+DROP TABLE IF EXISTS asset_statuses;
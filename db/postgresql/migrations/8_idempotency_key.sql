@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE idempotency_keys (
+    "id"          VARCHAR(64)   PRIMARY KEY,
+    "fingerprint" VARCHAR(64)   NOT NULL,
+    "response"    BYTEA,
+    "expires_at"  TIMESTAMPTZ   NOT NULL,
+    "created_at"  TIMESTAMPTZ   NOT NULL,
+    "updated_at"  TIMESTAMPTZ   NOT NULL
+);
+
+CREATE INDEX "idempotency_keys_idx_expires_at" ON "idempotency_keys" ("expires_at");
+
+-- +goose Down
+DROP TABLE IF EXISTS idempotency_keys;
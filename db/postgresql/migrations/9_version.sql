@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE "tenants" ADD COLUMN "version" BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE "staffs" ADD COLUMN "version" BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE "staffs" DROP COLUMN "version";
+ALTER TABLE "tenants" DROP COLUMN "version";
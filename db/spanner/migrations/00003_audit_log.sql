@@ -0,0 +1,13 @@
+CREATE TABLE `AuditLogs` (
+  `AuditLogID`               STRING(36)     NOT NULL, -- audit log id
+  `TenantID`                 STRING(36),              -- tenant id
+  `ActorType`                STRING(32)     NOT NULL, -- actor type
+  `ActorID`                  STRING(36)     NOT NULL, -- actor id
+  `Method`                   STRING(256)    NOT NULL, -- rpc method
+  `TargetType`               STRING(32)     NOT NULL, -- target type
+  `TargetID`                 STRING(36)     NOT NULL, -- target id
+  `Diff`                     STRING(MAX)    NOT NULL, -- before/after diff
+  `CreatedAt`                TIMESTAMP      NOT NULL, -- creation date
+) PRIMARY KEY(`AuditLogID`);
+
+CREATE INDEX `AuditLogs_IDX_TenantID_CreatedAt` ON `AuditLogs` (`TenantID`, `CreatedAt` DESC);
@@ -0,0 +1,9 @@
+ALTER TABLE `Tenants` ADD COLUMN `DeletedAt` TIMESTAMP; -- deletion date
+
+ALTER TABLE `Staffs` ADD COLUMN `DeletedAt` TIMESTAMP; -- deletion date
+
+CREATE INDEX `Tenants_IDX_DeletedAt` ON `Tenants` (`DeletedAt`);
+
+CREATE INDEX `Staffs_IDX_TenantID_DeletedAt` ON `Staffs` (`TenantID`, `DeletedAt`);
+
+CREATE INDEX `Staffs_IDX_DeletedAt` ON `Staffs` (`DeletedAt`);
@@ -0,0 +1,15 @@
+CREATE TABLE `TenantTagTypes` (
+  `TenantTagTypeID`          STRING(256)    NOT NULL -- tenant tag type id
+) PRIMARY KEY(`TenantTagTypeID`);
+
+CREATE TABLE `TenantTags` (
+  `TenantTagID`              STRING(36)     NOT NULL, -- tenant tag id
+  `TenantID`                 STRING(36)     NOT NULL, -- tenant id
+  `Type`                     STRING(256)    NOT NULL, -- type
+  `CreatedAt`                TIMESTAMP      NOT NULL, -- creation date
+  `UpdatedAt`                TIMESTAMP      NOT NULL, -- updation date
+  CONSTRAINT `TenantTags_FK_TenantID` FOREIGN KEY (`TenantID`) REFERENCES `Tenants` (`TenantID`),
+  CONSTRAINT `TenantTags_FK_Type` FOREIGN KEY (`Type`) REFERENCES `TenantTagTypes` (`TenantTagTypeID`)
+) PRIMARY KEY(`TenantTagID`);
+
+CREATE UNIQUE INDEX `TenantTags_UQ_TenantID_Type` ON `TenantTags` (`TenantID`, `Type`);
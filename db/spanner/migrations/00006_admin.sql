@@ -0,0 +1,18 @@
+CREATE TABLE `AdminRoles` (
+  `AdminRoleID`              STRING(32)     NOT NULL -- admin role id
+) PRIMARY KEY(`AdminRoleID`);
+
+CREATE TABLE `Admins` (
+  `AdminID`                  STRING(36)     NOT NULL, -- admin id
+  `Role`                     STRING(32)     NOT NULL, -- role
+  `AuthUID`                  STRING(256)    NOT NULL, -- auth uid
+  `Email`                    STRING(512)    NOT NULL, -- email
+  `DisplayName`              STRING(256)    NOT NULL, -- display name
+  `CreatedAt`                TIMESTAMP      NOT NULL, -- creation date
+  `UpdatedAt`                TIMESTAMP      NOT NULL, -- updation date
+  CONSTRAINT `Admins_FK_Role` FOREIGN KEY (`Role`) REFERENCES `AdminRoles` (`AdminRoleID`)
+) PRIMARY KEY(`AdminID`);
+
+CREATE UNIQUE INDEX `Admins_UQ_AuthUID` ON `Admins` (`AuthUID`);
+
+CREATE UNIQUE INDEX `Admins_UQ_Email` ON `Admins` (`Email`);
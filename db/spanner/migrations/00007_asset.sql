@@ -0,0 +1,22 @@
+CREATE TABLE `ContentTypes` (
+  `ContentTypeID`            STRING(256)    NOT NULL -- content type id
+) PRIMARY KEY(`ContentTypeID`);
+
+CREATE TABLE `AssetTypes` (
+  `AssetTypeID`              STRING(256)    NOT NULL -- asset type id
+) PRIMARY KEY(`AssetTypeID`);
+
+CREATE TABLE `Assets` (
+  `AssetID`                  STRING(36)     NOT NULL, -- asset id
+  `AuthContext`              STRING(256)    NOT NULL, -- auth context
+  `ContentType`              STRING(256)    NOT NULL, -- content type
+  `Type`                     STRING(256)    NOT NULL, -- type
+  `Path`                     STRING(MAX)    NOT NULL, -- path
+  `ExpiresAt`                TIMESTAMP      NOT NULL, -- expiration date
+  `CreatedAt`                TIMESTAMP      NOT NULL, -- creation date
+  `UpdatedAt`                TIMESTAMP      NOT NULL, -- updation date
+  CONSTRAINT `Assets_FK_ContentType` FOREIGN KEY (`ContentType`) REFERENCES `ContentTypes` (`ContentTypeID`),
+  CONSTRAINT `Assets_FK_Type` FOREIGN KEY (`Type`) REFERENCES `AssetTypes` (`AssetTypeID`)
+) PRIMARY KEY(`AssetID`);
+
+CREATE INDEX `Assets_IDX_AuthContext` ON `Assets` (`AuthContext`);
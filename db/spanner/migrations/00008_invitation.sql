@@ -0,0 +1,20 @@
+CREATE TABLE `Invitations` (
+  `InvitationID`             STRING(36)     NOT NULL, -- invitation id
+  `TenantID`                 STRING(36)     NOT NULL, -- tenant id
+  `Email`                    STRING(512)    NOT NULL, -- email
+  `Role`                     STRING(32)     NOT NULL, -- role
+  `TokenHash`                STRING(64)     NOT NULL, -- sha256 hash of the invitation token
+  `InvitedBy`                STRING(36)     NOT NULL, -- staff id of the inviter
+  `ExpiresAt`                TIMESTAMP      NOT NULL, -- expiration date
+  `AcceptedAt`               TIMESTAMP,               -- accepted date
+  `AcceptedStaffID`          STRING(36),              -- staff id created on acceptance
+  `RevokedAt`                TIMESTAMP,               -- revoked date
+  `CreatedAt`                TIMESTAMP      NOT NULL, -- creation date
+  `UpdatedAt`                TIMESTAMP      NOT NULL, -- updation date
+  CONSTRAINT `Invitations_FK_TenantID` FOREIGN KEY (`TenantID`) REFERENCES `Tenants` (`TenantID`),
+  CONSTRAINT `Invitations_FK_Role` FOREIGN KEY (`Role`) REFERENCES `StaffRoles` (`StaffRoleID`)
+) PRIMARY KEY(`InvitationID`);
+
+CREATE UNIQUE INDEX `Invitations_UQ_TokenHash` ON `Invitations` (`TokenHash`);
+
+CREATE INDEX `Invitations_IDX_TenantID_CreatedAt` ON `Invitations` (`TenantID`, `CreatedAt` DESC);
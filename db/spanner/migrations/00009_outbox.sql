@@ -0,0 +1,22 @@
+CREATE TABLE `OutboxEventStatuses` (
+  `OutboxEventStatusID`      STRING(32)     NOT NULL -- outbox event status id
+) PRIMARY KEY(`OutboxEventStatusID`);
+
+CREATE TABLE `OutboxEvents` (
+  `OutboxEventID`            STRING(36)     NOT NULL, -- outbox event id
+  `EventType`                STRING(64)     NOT NULL, -- domain event type
+  `TenantID`                 STRING(36),              -- tenant id
+  `AggregateID`              STRING(36)     NOT NULL, -- id of the entity the event is about
+  `Payload`                  STRING(MAX)    NOT NULL, -- event payload as json
+  `OccurredAt`               TIMESTAMP      NOT NULL, -- occurred date
+  `Status`                   STRING(32)     NOT NULL, -- delivery status
+  `Attempts`                 INT64          NOT NULL, -- number of delivery attempts
+  `NextAttemptAt`            TIMESTAMP      NOT NULL, -- next delivery date
+  `LastError`                STRING(MAX),             -- last delivery error
+  `DeliveredAt`              TIMESTAMP,               -- delivered date
+  `CreatedAt`                TIMESTAMP      NOT NULL, -- creation date
+  `UpdatedAt`                TIMESTAMP      NOT NULL, -- updation date
+  CONSTRAINT `OutboxEvents_FK_Status` FOREIGN KEY (`Status`) REFERENCES `OutboxEventStatuses` (`OutboxEventStatusID`)
+) PRIMARY KEY(`OutboxEventID`);
+
+CREATE INDEX `OutboxEvents_IDX_Status_NextAttemptAt` ON `OutboxEvents` (`Status`, `NextAttemptAt`);
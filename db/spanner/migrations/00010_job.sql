@@ -0,0 +1,21 @@
+CREATE TABLE `JobStatuses` (
+  `JobStatusID`              STRING(32)     NOT NULL -- job status id
+) PRIMARY KEY(`JobStatusID`);
+
+CREATE TABLE `Jobs` (
+  `JobID`                    STRING(36)     NOT NULL, -- job id
+  `Type`                     STRING(64)     NOT NULL, -- job type
+  `Payload`                  STRING(MAX)    NOT NULL, -- job payload as json
+  `Status`                   STRING(32)     NOT NULL, -- status
+  `Attempts`                 INT64          NOT NULL, -- number of attempts
+  `MaxAttempts`              INT64          NOT NULL, -- number of attempts before dead lettering
+  `RunAt`                    TIMESTAMP      NOT NULL, -- scheduled date
+  `LockedUntil`              TIMESTAMP,               -- lease expiry date of a running job
+  `LastError`                STRING(MAX),             -- last error
+  `FinishedAt`               TIMESTAMP,               -- finished date
+  `CreatedAt`                TIMESTAMP      NOT NULL, -- creation date
+  `UpdatedAt`                TIMESTAMP      NOT NULL, -- updation date
+  CONSTRAINT `Jobs_FK_Status` FOREIGN KEY (`Status`) REFERENCES `JobStatuses` (`JobStatusID`)
+) PRIMARY KEY(`JobID`);
+
+CREATE INDEX `Jobs_IDX_Status_RunAt` ON `Jobs` (`Status`, `RunAt`);
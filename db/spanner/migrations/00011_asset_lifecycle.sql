@@ -0,0 +1,13 @@
+CREATE TABLE `AssetStatuses` (
+  `AssetStatusID`            STRING(32)     NOT NULL -- asset status id
+) PRIMARY KEY(`AssetStatusID`);
+
+ALTER TABLE `Assets` ADD COLUMN `Status` STRING(32) NOT NULL DEFAULT ("pending"); -- status
+
+ALTER TABLE `Assets` ADD COLUMN `Size` INT64; -- object size in bytes
+
+ALTER TABLE `Assets` ADD COLUMN `UploadedAt` TIMESTAMP; -- upload confirmed date
+
+ALTER TABLE `Assets` ADD CONSTRAINT `Assets_FK_Status` FOREIGN KEY (`Status`) REFERENCES `AssetStatuses` (`AssetStatusID`);
+
+CREATE INDEX `Assets_IDX_Status_ExpiresAt` ON `Assets` (`Status`, `ExpiresAt`);
@@ -0,0 +1,9 @@
+CREATE TABLE `IdempotencyKeys` (
+  `IdempotencyKeyID`         STRING(64)     NOT NULL, -- idempotency key id
+  `Fingerprint`              STRING(64)     NOT NULL, -- fingerprint of the request
+  `Response`                 BYTES(MAX),              -- serialized response
+  `ExpiresAt`                TIMESTAMP      NOT NULL, -- expiry date
+  `CreatedAt`                TIMESTAMP      NOT NULL, -- creation date
+  `UpdatedAt`                TIMESTAMP      NOT NULL  -- updation date
+) PRIMARY KEY(`IdempotencyKeyID`),
+  ROW DELETION POLICY (OLDER_THAN(`ExpiresAt`, INTERVAL 0 DAY));
@@ -0,0 +1,3 @@
+ALTER TABLE `Tenants` ADD COLUMN `Version` INT64 NOT NULL DEFAULT (1); -- version incremented on every update
+
+ALTER TABLE `Staffs` ADD COLUMN `Version` INT64 NOT NULL DEFAULT (1); -- version incremented on every update
@@ -8,6 +8,8 @@ CREATE TABLE Tenants (
   Name STRING(256) NOT NULL,
   CreatedAt TIMESTAMP NOT NULL,
   UpdatedAt TIMESTAMP NOT NULL,
+  DeletedAt TIMESTAMP,
+  Version INT64 NOT NULL DEFAULT (1),
 ) PRIMARY KEY(TenantID);
 
 CREATE TABLE StaffRoles (
@@ -24,6 +26,173 @@ CREATE TABLE Staffs (
   Email STRING(512) NOT NULL,
   CreatedAt TIMESTAMP NOT NULL,
   UpdatedAt TIMESTAMP NOT NULL,
+  DeletedAt TIMESTAMP,
+  Version INT64 NOT NULL DEFAULT (1),
   CONSTRAINT Staffs_FK_TenantID FOREIGN KEY(TenantID) REFERENCES Tenants(TenantID) ON DELETE NO ACTION,
   CONSTRAINT Staffs_FK_Role FOREIGN KEY(Role) REFERENCES StaffRoles(StaffRoleID) ON DELETE NO ACTION,
 ) PRIMARY KEY(StaffID);
+
+CREATE TABLE AuditLogs (
+  AuditLogID STRING(36) NOT NULL,
+  TenantID STRING(36),
+  ActorType STRING(32) NOT NULL,
+  ActorID STRING(36) NOT NULL,
+  Method STRING(256) NOT NULL,
+  TargetType STRING(32) NOT NULL,
+  TargetID STRING(36) NOT NULL,
+  Diff STRING(MAX) NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+) PRIMARY KEY(AuditLogID);
+
+CREATE INDEX AuditLogs_IDX_TenantID_CreatedAt ON AuditLogs(TenantID, CreatedAt DESC);
+
+CREATE INDEX Tenants_IDX_DeletedAt ON Tenants(DeletedAt);
+
+CREATE INDEX Staffs_IDX_TenantID_DeletedAt ON Staffs(TenantID, DeletedAt);
+
+CREATE INDEX Staffs_IDX_DeletedAt ON Staffs(DeletedAt);
+
+CREATE TABLE TenantTagTypes (
+  TenantTagTypeID STRING(256) NOT NULL,
+) PRIMARY KEY(TenantTagTypeID);
+
+CREATE TABLE TenantTags (
+  TenantTagID STRING(36) NOT NULL,
+  TenantID STRING(36) NOT NULL,
+  Type STRING(256) NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+  UpdatedAt TIMESTAMP NOT NULL,
+  CONSTRAINT TenantTags_FK_TenantID FOREIGN KEY(TenantID) REFERENCES Tenants(TenantID) ON DELETE NO ACTION,
+  CONSTRAINT TenantTags_FK_Type FOREIGN KEY(Type) REFERENCES TenantTagTypes(TenantTagTypeID) ON DELETE NO ACTION,
+) PRIMARY KEY(TenantTagID);
+
+CREATE UNIQUE INDEX TenantTags_UQ_TenantID_Type ON TenantTags(TenantID, Type);
+
+CREATE TABLE AdminRoles (
+  AdminRoleID STRING(32) NOT NULL,
+) PRIMARY KEY(AdminRoleID);
+
+CREATE TABLE Admins (
+  AdminID STRING(36) NOT NULL,
+  Role STRING(32) NOT NULL,
+  AuthUID STRING(256) NOT NULL,
+  Email STRING(512) NOT NULL,
+  DisplayName STRING(256) NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+  UpdatedAt TIMESTAMP NOT NULL,
+  CONSTRAINT Admins_FK_Role FOREIGN KEY(Role) REFERENCES AdminRoles(AdminRoleID) ON DELETE NO ACTION,
+) PRIMARY KEY(AdminID);
+
+CREATE UNIQUE INDEX Admins_UQ_AuthUID ON Admins(AuthUID);
+
+CREATE UNIQUE INDEX Admins_UQ_Email ON Admins(Email);
+
+CREATE TABLE ContentTypes (
+  ContentTypeID STRING(256) NOT NULL,
+) PRIMARY KEY(ContentTypeID);
+
+CREATE TABLE AssetTypes (
+  AssetTypeID STRING(256) NOT NULL,
+) PRIMARY KEY(AssetTypeID);
+
+CREATE TABLE Assets (
+  AssetID STRING(36) NOT NULL,
+  AuthContext STRING(256) NOT NULL,
+  ContentType STRING(256) NOT NULL,
+  Type STRING(256) NOT NULL,
+  Path STRING(MAX) NOT NULL,
+  ExpiresAt TIMESTAMP NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+  UpdatedAt TIMESTAMP NOT NULL,
+  Status STRING(32) NOT NULL DEFAULT ("pending"),
+  Size INT64,
+  UploadedAt TIMESTAMP,
+  CONSTRAINT Assets_FK_ContentType FOREIGN KEY(ContentType) REFERENCES ContentTypes(ContentTypeID) ON DELETE NO ACTION,
+  CONSTRAINT Assets_FK_Type FOREIGN KEY(Type) REFERENCES AssetTypes(AssetTypeID) ON DELETE NO ACTION,
+) PRIMARY KEY(AssetID);
+
+CREATE INDEX Assets_IDX_AuthContext ON Assets(AuthContext);
+
+CREATE TABLE Invitations (
+  InvitationID STRING(36) NOT NULL,
+  TenantID STRING(36) NOT NULL,
+  Email STRING(512) NOT NULL,
+  Role STRING(32) NOT NULL,
+  TokenHash STRING(64) NOT NULL,
+  InvitedBy STRING(36) NOT NULL,
+  ExpiresAt TIMESTAMP NOT NULL,
+  AcceptedAt TIMESTAMP,
+  AcceptedStaffID STRING(36),
+  RevokedAt TIMESTAMP,
+  CreatedAt TIMESTAMP NOT NULL,
+  UpdatedAt TIMESTAMP NOT NULL,
+  CONSTRAINT Invitations_FK_TenantID FOREIGN KEY(TenantID) REFERENCES Tenants(TenantID) ON DELETE NO ACTION,
+  CONSTRAINT Invitations_FK_Role FOREIGN KEY(Role) REFERENCES StaffRoles(StaffRoleID) ON DELETE NO ACTION,
+) PRIMARY KEY(InvitationID);
+
+CREATE UNIQUE INDEX Invitations_UQ_TokenHash ON Invitations(TokenHash);
+
+CREATE INDEX Invitations_IDX_TenantID_CreatedAt ON Invitations(TenantID, CreatedAt DESC);
+
+CREATE TABLE OutboxEventStatuses (
+  OutboxEventStatusID STRING(32) NOT NULL,
+) PRIMARY KEY(OutboxEventStatusID);
+
+CREATE TABLE OutboxEvents (
+  OutboxEventID STRING(36) NOT NULL,
+  EventType STRING(64) NOT NULL,
+  TenantID STRING(36),
+  AggregateID STRING(36) NOT NULL,
+  Payload STRING(MAX) NOT NULL,
+  OccurredAt TIMESTAMP NOT NULL,
+  Status STRING(32) NOT NULL,
+  Attempts INT64 NOT NULL,
+  NextAttemptAt TIMESTAMP NOT NULL,
+  LastError STRING(MAX),
+  DeliveredAt TIMESTAMP,
+  CreatedAt TIMESTAMP NOT NULL,
+  UpdatedAt TIMESTAMP NOT NULL,
+  CONSTRAINT OutboxEvents_FK_Status FOREIGN KEY(Status) REFERENCES OutboxEventStatuses(OutboxEventStatusID) ON DELETE NO ACTION,
+) PRIMARY KEY(OutboxEventID);
+
+CREATE INDEX OutboxEvents_IDX_Status_NextAttemptAt ON OutboxEvents(Status, NextAttemptAt);
+
+CREATE TABLE JobStatuses (
+  JobStatusID STRING(32) NOT NULL,
+) PRIMARY KEY(JobStatusID);
+
+CREATE TABLE Jobs (
+  JobID STRING(36) NOT NULL,
+  Type STRING(64) NOT NULL,
+  Payload STRING(MAX) NOT NULL,
+  Status STRING(32) NOT NULL,
+  Attempts INT64 NOT NULL,
+  MaxAttempts INT64 NOT NULL,
+  RunAt TIMESTAMP NOT NULL,
+  LockedUntil TIMESTAMP,
+  LastError STRING(MAX),
+  FinishedAt TIMESTAMP,
+  CreatedAt TIMESTAMP NOT NULL,
+  UpdatedAt TIMESTAMP NOT NULL,
+  CONSTRAINT Jobs_FK_Status FOREIGN KEY(Status) REFERENCES JobStatuses(JobStatusID) ON DELETE NO ACTION,
+) PRIMARY KEY(JobID);
+
+CREATE INDEX Jobs_IDX_Status_RunAt ON Jobs(Status, RunAt);
+
+CREATE TABLE AssetStatuses (
+  AssetStatusID STRING(32) NOT NULL,
+) PRIMARY KEY(AssetStatusID);
+
+ALTER TABLE Assets ADD CONSTRAINT Assets_FK_Status FOREIGN KEY(Status) REFERENCES AssetStatuses(AssetStatusID) ON DELETE NO ACTION;
+
+CREATE INDEX Assets_IDX_Status_ExpiresAt ON Assets(Status, ExpiresAt);
+
+CREATE TABLE IdempotencyKeys (
+  IdempotencyKeyID STRING(64) NOT NULL,
+  Fingerprint STRING(64) NOT NULL,
+  Response BYTES(MAX),
+  ExpiresAt TIMESTAMP NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+  UpdatedAt TIMESTAMP NOT NULL,
+) PRIMARY KEY(IdempotencyKeyID),
+  ROW DELETION POLICY (OLDER_THAN(ExpiresAt, INTERVAL 0 DAY));
@@ -66,12 +66,20 @@ func (e yoError) NotFound() bool { return e.code == codes.NotFound }
 
 var (
 	ctxTKey = struct{}{}
+
+	// defaultDB runs the queries issued outside of a transaction.
+	defaultDB *spanner.Client
 )
 
+// GetSpannerTransaction returns the transaction of the context.
+// Outside of a transaction, every query and statement runs in its own single-use transaction.
 func GetSpannerTransaction(ctx context.Context) *SpannerTransaction {
 	if txn, ok := ctx.Value(&ctxTKey).(*SpannerTransaction); ok {
 		return txn
 	}
+	if defaultDB != nil {
+		return &SpannerTransaction{db: defaultDB}
+	}
 	panic("error GetSpannerTransaction: transaction not found")
 }
 
@@ -123,12 +131,14 @@ func (t *SpannerTransactable) RWTx(ctx context.Context, fn func(ctx context.Cont
 func NewTransactable(
 	db *spanner.Client,
 ) *SpannerTransactable {
+	defaultDB = db
 	return &SpannerTransactable{db}
 }
 
 type SpannerTransaction struct {
 	ro *spanner.ReadOnlyTransaction
 	rw *spanner.ReadWriteTransaction
+	db *spanner.Client
 }
 
 // isRo はReadOnlyTransactionかどうかを返す
@@ -141,6 +151,11 @@ func (t *SpannerTransaction) isRw() bool {
 	return t.rw != nil
 }
 
+// isSingle はトランザクション外で単発実行するかどうかを返す
+func (t *SpannerTransaction) isSingle() bool {
+	return t.db != nil
+}
+
 func (t *SpannerTransaction) QueryContext(ctx context.Context, query string, params map[string]interface{}) (*SpannerRows, error) {
 	if t.isRo() {
 		iter := t.ro.Query(ctx, spanner.Statement{
@@ -156,10 +171,27 @@ func (t *SpannerTransaction) QueryContext(ctx context.Context, query string, par
 		})
 		return &SpannerRows{iter: iter, query: query}, nil
 	}
+	if t.isSingle() {
+		iter := t.db.Single().Query(ctx, spanner.Statement{
+			SQL:    query,
+			Params: params,
+		})
+		return &SpannerRows{iter: iter, query: query}, nil
+	}
 	return nil, fmt.Errorf("error QueryContext: empty transaction")
 }
 
 func (t *SpannerTransaction) ExecContext(ctx context.Context, query string, params map[string]interface{}) error {
+	if t.isSingle() {
+		_, err := t.db.ReadWriteTransaction(ctx, func(ctx context.Context, rw *spanner.ReadWriteTransaction) error {
+			_, err := rw.Update(ctx, spanner.Statement{
+				SQL:    query,
+				Params: params,
+			})
+			return err
+		})
+		return err
+	}
 	if t.isRo() || !t.isRw() {
 		return fmt.Errorf("error ExecContext is executed in read only transaction")
 	}
@@ -24,6 +24,8 @@ SERVER_LOG_OFFSET=0
 TEST_ID="${TEST_ID:-$(date +%s)-${RANDOM}}"
 ADMIN_EMAIL="${ADMIN_EMAIL:-e2e-admin-${TEST_ID}@example.com}"
 ADMIN_DISPLAY_NAME="${ADMIN_DISPLAY_NAME:-E2E Admin ${TEST_ID}}"
+SUB_ADMIN_EMAIL="${SUB_ADMIN_EMAIL:-e2e-sub-admin-${TEST_ID}@example.com}"
+SUB_ADMIN_DISPLAY_NAME="${SUB_ADMIN_DISPLAY_NAME:-E2E Sub Admin ${TEST_ID}}"
 STAFF_EMAIL="${STAFF_EMAIL:-e2e-staff-${TEST_ID}@example.com}"
 STAFF_DISPLAY_NAME="${STAFF_DISPLAY_NAME:-E2E Staff ${TEST_ID}}"
 TENANT_NAME="${TENANT_NAME:-E2E Test Tenant ${TEST_ID}}"
@@ -38,6 +40,7 @@ ADMIN_ID=""
 ADMIN_AUTH_UID=""
 ADMIN_PASSWORD=""
 ADMIN_TOKEN=""
+SUB_ADMIN_ID=""
 TENANT_ID=""
 STAFF_ID=""
 STAFF_AUTH_UID=""
@@ -66,6 +69,51 @@ run_mysql() {
     fi
 }
 
+# A 1x1 PNG uploaded as the image of the assets
+TEST_PNG_BASE64="iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNk+M9QDwADhgGAWjR9awAAAABJRU5ErkJggg=="
+
+# Upload a 1x1 PNG with the presigned upload of a CreateAssetPresignedURL response,
+# so that the asset upload can be confirmed and the image processed by the worker
+upload_asset() {
+    local url method
+    url=$(echo "$1" | jq -r '.presigned_url')
+    method=$(echo "$1" | jq -r '.upload_method')
+    local args=()
+    if [ "$method" = "POST" ]; then
+        while IFS= read -r field; do
+            args+=(-F "$field")
+        done < <(echo "$1" | jq -r '.upload_fields // {} | to_entries[] | "\(.key)=\(.value)"')
+        args+=(-F "file=@-;type=image/png")
+    else
+        while IFS= read -r header; do
+            args+=(-H "$header")
+        done < <(echo "$1" | jq -r '.upload_headers // {} | to_entries[] | "\(.key): \(.value)"')
+        args+=(--data-binary @-)
+    fi
+    echo "$TEST_PNG_BASE64" | base64 -d | curl -s -o /dev/null -w "%{http_code}" -X "$method" "$url" "${args[@]}"
+}
+
+# Build the NDJSON body of an UploadAsset stream sending the 1x1 PNG in a single chunk
+upload_asset_stream_body() {
+    local sha256
+    sha256=$(echo "$TEST_PNG_BASE64" | base64 -d | sha256sum | cut -d' ' -f1)
+    printf '{"header":{"asset_type":"ASSET_TYPE_USER_IMAGE","content_type":"CONTENT_TYPE_IMAGE_PNG","sha256":"%s"}}\n' "$sha256"
+    printf '{"chunk":"%s"}\n' "$TEST_PNG_BASE64"
+}
+
+# Verify the NDJSON response of a DownloadAsset stream, printing the asset id
+# when the trailing sha256 matches the chunks received
+verify_downloaded_asset() {
+    local sent received
+    sent=$(echo "$1" | jq -r 'select(.result.sha256 != null) | .result.sha256')
+    received=$(echo "$1" | jq -r 'select(.result.chunk != null) | .result.chunk' | while IFS= read -r chunk; do
+        echo "$chunk" | base64 -d
+    done | sha256sum | cut -d' ' -f1)
+    if [ -n "$sent" ] && [ "$sent" = "$received" ]; then
+        echo "$1" | jq -r 'select(.result.asset != null) | .result.asset.id'
+    fi
+}
+
 # Reset the database by dropping and recreating the public schema
 reset_database() {
     print_step "Resetting Database"
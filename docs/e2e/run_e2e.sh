@@ -95,28 +95,52 @@ main() {
     test_admin_list_tenants
     create_tenant
     test_admin_get_tenant
+    test_admin_list_tenants_by_cursor
     test_admin_update_tenant
+    test_admin_list_tenants_by_tag_types
+    test_admin_search_tenants
     test_admin_delete_tenant
+    test_admin_restore_tenant
 
     # Phase 4: Admin Staff CRUD
     create_staff
     test_admin_list_staffs
+    test_admin_search_staffs
     test_admin_get_staff
     test_admin_update_staff
+    test_admin_upload_download_asset
 
-    # Phase 5: Staff APIs (requires staff token)
+    # Phase 5: Admin Admin CRUD
+    create_sub_admin
+    test_admin_list_admins
+    test_admin_get_admin
+    test_admin_update_admin
+    test_admin_update_admin_role
+    test_admin_delete_admin
+
+    # Phase 6: Admin Audit Logs
+    test_admin_list_audit_logs
+
+    # Phase 7: Staff APIs (requires staff token)
     get_staff_token
     test_staff_get_me
     test_staff_update_me
     test_staff_get_tenant
     test_staff_update_tenant
     test_staff_create_asset
+    test_staff_upload_download_asset
 
-    # Phase 6: Staff List/Get
+    # Phase 8: Staff List/Get
     test_staff_list_staffs
     test_staff_get_staff
 
-    # Phase 7: Staff Signup Flow
+    # Phase 9: Staff Invitations
+    test_staff_create_invitation
+    test_staff_list_invitations
+    test_staff_revoke_invitation
+    test_staff_accept_invitation
+
+    # Phase 10: Staff Signup Flow
     staff_signup
 
     # Results
@@ -0,0 +1,138 @@
+#!/bin/bash
+
+# E2E Tests: Admin Admin CRUD
+
+create_sub_admin() {
+    print_step "Admin API - Create Admin"
+
+    # @e2e POST /admin/v1/admins
+    response=$(curl -s -X POST "$BASE_URL/admin/v1/admins" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d "{
+            \"email\":\"$SUB_ADMIN_EMAIL\",
+            \"display_name\":\"$SUB_ADMIN_DISPLAY_NAME\",
+            \"role\":\"ADMIN_ROLE_NORMAL\"
+        }")
+
+    SUB_ADMIN_ID=$(echo "$response" | jq -r '.admin.id // empty')
+    sub_admin_password=$(echo "$response" | jq -r '.password // empty')
+
+    if [ -n "$SUB_ADMIN_ID" ] && [ -n "$sub_admin_password" ]; then
+        print_success "Admin created successfully"
+        print_info "  AdminID: $SUB_ADMIN_ID"
+        print_info "  Email: $SUB_ADMIN_EMAIL"
+    else
+        print_error "Failed to create admin"
+        echo "$response"
+        exit 1
+    fi
+
+    echo ""
+}
+
+test_admin_list_admins() {
+    print_step "Admin API - List Admins"
+
+    # @e2e GET /admin/v1/admins
+    response=$(curl -s "$BASE_URL/admin/v1/admins" \
+        -H "Authorization: Bearer $ADMIN_TOKEN")
+
+    if echo "$response" | jq -e '.admins' > /dev/null 2>&1; then
+        admin_count=$(echo "$response" | jq '.admins | length')
+        print_success "Admin list admins successful (found $admin_count admins)"
+    else
+        print_error "Failed to list admins"
+        echo "$response"
+        exit 1
+    fi
+
+    echo ""
+}
+
+test_admin_get_admin() {
+    print_step "Admin API - Get Admin"
+
+    # @e2e GET /admin/v1/admins/{admin_id}
+    response=$(curl -s "$BASE_URL/admin/v1/admins/$SUB_ADMIN_ID" \
+        -H "Authorization: Bearer $ADMIN_TOKEN")
+
+    admin_id=$(echo "$response" | jq -r '.admin.id // empty')
+
+    if [ -n "$admin_id" ] && [ "$admin_id" = "$SUB_ADMIN_ID" ]; then
+        print_success "Get admin successful (ID matches)"
+        print_info "  AdminID: $admin_id"
+    else
+        print_error "Failed to get admin"
+        echo "$response"
+        exit 1
+    fi
+
+    echo ""
+}
+
+test_admin_update_admin() {
+    print_step "Admin API - Update Admin"
+
+    UPDATED_SUB_ADMIN_NAME="Updated ${SUB_ADMIN_DISPLAY_NAME}"
+
+    # @e2e PATCH /admin/v1/admins/{admin_id}
+    response=$(curl -s -X PATCH "$BASE_URL/admin/v1/admins/$SUB_ADMIN_ID" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d "{\"display_name\":\"$UPDATED_SUB_ADMIN_NAME\"}")
+
+    updated_name=$(echo "$response" | jq -r '.admin.display_name // empty')
+
+    if [ "$updated_name" = "$UPDATED_SUB_ADMIN_NAME" ]; then
+        print_success "Admin display name updated successfully"
+        print_info "  Display name: $updated_name"
+    else
+        print_error "Failed to update admin"
+        echo "$response"
+        exit 1
+    fi
+
+    echo ""
+}
+
+test_admin_update_admin_role() {
+    print_step "Admin API - Update Admin Role"
+
+    # @e2e PATCH /admin/v1/admins/{admin_id}/role
+    response=$(curl -s -X PATCH "$BASE_URL/admin/v1/admins/$SUB_ADMIN_ID/role" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d "{\"role\":\"ADMIN_ROLE_ROOT\"}")
+
+    updated_role=$(echo "$response" | jq -r '.admin.role // empty')
+
+    if [ "$updated_role" = "ADMIN_ROLE_ROOT" ]; then
+        print_success "Admin role updated successfully"
+        print_info "  Role: $updated_role"
+    else
+        print_error "Failed to update admin role"
+        echo "$response"
+        exit 1
+    fi
+
+    echo ""
+}
+
+test_admin_delete_admin() {
+    print_step "Admin API - Delete Admin"
+
+    # @e2e DELETE /admin/v1/admins/{admin_id}
+    delete_response=$(curl -s -o /dev/null -w "%{http_code}" -X DELETE \
+        "$BASE_URL/admin/v1/admins/$SUB_ADMIN_ID" \
+        -H "Authorization: Bearer $ADMIN_TOKEN")
+
+    if [ "$delete_response" = "200" ]; then
+        print_success "Admin deleted successfully (HTTP 200)"
+    else
+        print_error "Failed to delete admin (HTTP $delete_response)"
+        exit 1
+    fi
+
+    echo ""
+}
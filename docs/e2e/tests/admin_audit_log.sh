@@ -0,0 +1,23 @@
+#!/bin/bash
+
+# E2E Tests: Admin Audit Logs
+
+test_admin_list_audit_logs() {
+    print_step "Admin API - List Audit Logs"
+
+    # @e2e GET /admin/v1/audit_logs
+    response=$(curl -s "$BASE_URL/admin/v1/audit_logs?tenant_id=$TENANT_ID&target_type=AUDIT_LOG_TARGET_TYPE_TENANT" \
+        -H "Authorization: Bearer $ADMIN_TOKEN")
+
+    # Creating and updating the tenant in Phase 3 must have been recorded.
+    audit_log_count=$(echo "$response" | jq '.audit_logs | length' 2>/dev/null)
+    if [ -n "$audit_log_count" ] && [ "$audit_log_count" -ge 2 ]; then
+        print_success "Admin list audit logs successful (found $audit_log_count audit logs)"
+    else
+        print_error "Failed to list audit logs"
+        echo "$response"
+        exit 1
+    fi
+
+    echo ""
+}
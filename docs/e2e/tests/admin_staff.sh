@@ -26,6 +26,28 @@ create_staff() {
 
     print_info "  AssetID: $ASSET_ID"
 
+    upload_status=$(upload_asset "$asset_response")
+    if [ "$upload_status" != "200" ] && [ "$upload_status" != "204" ]; then
+        print_error "Failed to upload asset (HTTP $upload_status)"
+        exit 1
+    fi
+
+    # @e2e POST /admin/v1/assets/{asset_id}/confirm
+    confirm_response=$(curl -s -X POST "$BASE_URL/admin/v1/assets/$ASSET_ID/confirm" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d "{}")
+
+    asset_status=$(echo "$confirm_response" | jq -r '.asset.status // empty')
+
+    if [ "$asset_status" != "ASSET_STATUS_UPLOADED" ]; then
+        print_error "Failed to confirm asset upload"
+        echo "$confirm_response"
+        exit 1
+    fi
+
+    print_info "  Asset upload confirmed"
+
     # Create staff with asset_id
     # @e2e POST /admin/v1/staffs
     response=$(curl -s -X POST "$BASE_URL/admin/v1/staffs" \
@@ -77,6 +99,28 @@ test_admin_list_staffs() {
     echo ""
 }
 
+test_admin_search_staffs() {
+    print_step "Admin API - Search Staffs"
+
+    response=$(curl -s -G "$BASE_URL/admin/v1/staffs" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        --data-urlencode "tenant_id=$TENANT_ID" \
+        --data-urlencode "display_name=e2e staff" \
+        --data-urlencode "email=$STAFF_EMAIL" \
+        --data-urlencode "role=STAFF_ROLE_ADMIN")
+
+    if echo "$response" | jq -e --arg id "$STAFF_ID" \
+        '(.staffs | length) == 1 and .staffs[0].id == $id' > /dev/null 2>&1; then
+        print_success "Display name, email and role filters returned the test staff"
+    else
+        print_error "Failed to search staffs"
+        echo "$response"
+        exit 1
+    fi
+
+    echo ""
+}
+
 test_admin_get_staff() {
     print_step "Admin API - Get Staff"
 
@@ -122,3 +166,42 @@ test_admin_update_staff() {
 
     echo ""
 }
+
+test_admin_upload_download_asset() {
+    print_step "Admin API - Upload & Download Asset Stream"
+
+    # @e2e POST /admin/v1/assets/-/upload
+    response=$(upload_asset_stream_body | curl -s -X POST "$BASE_URL/admin/v1/assets/-/upload" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        --data-binary @-)
+
+    asset_id=$(echo "$response" | jq -r '.asset.id // empty')
+    asset_status=$(echo "$response" | jq -r '.asset.status // empty')
+
+    if [ -n "$asset_id" ] && [ "$asset_status" = "ASSET_STATUS_UPLOADED" ]; then
+        print_success "Admin upload asset stream successful"
+        print_info "  AssetID: $asset_id"
+    else
+        print_error "Failed to upload asset stream via admin API"
+        echo "$response"
+        exit 1
+    fi
+
+    # @e2e GET /admin/v1/assets/{asset_id}/download
+    response=$(curl -s "$BASE_URL/admin/v1/assets/$asset_id/download" \
+        -H "Authorization: Bearer $ADMIN_TOKEN")
+
+    downloaded_asset_id=$(verify_downloaded_asset "$response")
+
+    if [ "$downloaded_asset_id" = "$asset_id" ]; then
+        print_success "Admin download asset stream successful"
+        print_info "  Checksum verified"
+    else
+        print_error "Failed to download asset stream via admin API"
+        echo "$response"
+        exit 1
+    fi
+
+    echo ""
+}
@@ -24,24 +24,63 @@ test_admin_list_tenants() {
 create_tenant() {
     print_step "Creating Tenant"
 
+    idempotency_key="create-tenant-$TEST_ID"
+
     # @e2e POST /admin/v1/tenants
     response=$(curl -s -X POST "$BASE_URL/admin/v1/tenants" \
         -H "Authorization: Bearer $ADMIN_TOKEN" \
         -H "Content-Type: application/json" \
-        -d "{\"name\":\"$TENANT_NAME\"}")
+        -H "Idempotency-Key: $idempotency_key" \
+        -d "{\"name\":\"$TENANT_NAME\",\"tags\":[\"TENANT_TAG_TYPE_EDUCATION\"]}")
 
     TENANT_ID=$(echo "$response" | jq -r '.tenant.id // empty')
+    tags=$(echo "$response" | jq -c '.tenant.tags // empty')
 
-    if [ -n "$TENANT_ID" ]; then
+    if [ -n "$TENANT_ID" ] && [ "$tags" = '["TENANT_TAG_TYPE_EDUCATION"]' ]; then
         print_success "Tenant created successfully"
         print_info "  TenantID: $TENANT_ID"
         print_info "  Name: $TENANT_NAME"
+        print_info "  Tags: $tags"
     else
         print_error "Failed to create tenant"
         echo "$response"
         exit 1
     fi
 
+    # the retry with the same idempotency key gets the tenant created first
+    headers=$(mktemp)
+    response=$(curl -s -D "$headers" -X POST "$BASE_URL/admin/v1/tenants" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -H "Idempotency-Key: $idempotency_key" \
+        -d "{\"name\":\"$TENANT_NAME\",\"tags\":[\"TENANT_TAG_TYPE_EDUCATION\"]}")
+    replayed_tenant_id=$(echo "$response" | jq -r '.tenant.id // empty')
+
+    if [ "$replayed_tenant_id" = "$TENANT_ID" ] && grep -qi '^idempotent-replayed: true' "$headers"; then
+        print_success "Retried tenant creation replayed the first response"
+    else
+        print_error "Retried tenant creation was not replayed"
+        cat "$headers"
+        echo "$response"
+        rm -f "$headers"
+        exit 1
+    fi
+    rm -f "$headers"
+
+    # the idempotency key reused for another request is rejected
+    http_code=$(curl -s -o /dev/null -w "%{http_code}" -X POST "$BASE_URL/admin/v1/tenants" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -H "Idempotency-Key: $idempotency_key" \
+        -d "{\"name\":\"$TENANT_NAME-other\",\"tags\":[\"TENANT_TAG_TYPE_EDUCATION\"]}")
+
+    if [ "$http_code" = "409" ]; then
+        print_success "Idempotency key reused for another tenant was rejected"
+    else
+        print_error "Expected 409 for the reused idempotency key, got $http_code"
+        exit 1
+    fi
+
     echo ""
 }
 
@@ -66,6 +105,43 @@ test_admin_get_tenant() {
     echo ""
 }
 
+test_admin_list_tenants_by_cursor() {
+    print_step "Admin API - List Tenants by Cursor"
+
+    # @e2e GET /admin/v1/tenants
+    response=$(curl -s "$BASE_URL/admin/v1/tenants?limit=1&cursor=" \
+        -H "Authorization: Bearer $ADMIN_TOKEN")
+
+    tenant_count=$(echo "$response" | jq '.tenants | length')
+    next_cursor=$(echo "$response" | jq -r '.next_cursor // empty')
+
+    if [ "$tenant_count" != "1" ]; then
+        print_error "Failed to list tenants by cursor"
+        echo "$response"
+        exit 1
+    fi
+    print_success "First cursor page returned 1 tenant"
+
+    if [ -n "$next_cursor" ]; then
+        # @e2e GET /admin/v1/tenants
+        response=$(curl -s -G "$BASE_URL/admin/v1/tenants" \
+            --data-urlencode "limit=1" \
+            --data-urlencode "cursor=$next_cursor" \
+            --data-urlencode "include_total_count=true" \
+            -H "Authorization: Bearer $ADMIN_TOKEN")
+
+        if echo "$response" | jq -e '.tenants and .pagination.total_count' > /dev/null 2>&1; then
+            print_success "Next cursor page returned with total count"
+        else
+            print_error "Failed to list tenants with next cursor"
+            echo "$response"
+            exit 1
+        fi
+    fi
+
+    echo ""
+}
+
 test_admin_update_tenant() {
     print_step "Admin API - Update Tenant"
 
@@ -75,19 +151,88 @@ test_admin_update_tenant() {
     response=$(curl -s -X PATCH "$BASE_URL/admin/v1/tenants/$TENANT_ID" \
         -H "Authorization: Bearer $ADMIN_TOKEN" \
         -H "Content-Type: application/json" \
-        -d "{\"name\":\"$UPDATED_TENANT_NAME\"}")
+        -d "{\"name\":\"$UPDATED_TENANT_NAME\",\"tags\":{\"values\":[\"TENANT_TAG_TYPE_EDUCATION\",\"TENANT_TAG_TYPE_BUSINESS\"]}}")
 
     updated_name=$(echo "$response" | jq -r '.tenant.name // empty')
+    updated_tags=$(echo "$response" | jq -c '.tenant.tags | sort')
 
-    if [ "$updated_name" = "$UPDATED_TENANT_NAME" ]; then
-        print_success "Tenant name updated successfully"
+    if [ "$updated_name" = "$UPDATED_TENANT_NAME" ] && [ "$updated_tags" = '["TENANT_TAG_TYPE_BUSINESS","TENANT_TAG_TYPE_EDUCATION"]' ]; then
+        print_success "Tenant name and tags updated successfully"
         print_info "  Name: $updated_name"
+        print_info "  Tags: $updated_tags"
     else
         print_error "Failed to update tenant"
         echo "$response"
         exit 1
     fi
 
+    etag=$(echo "$response" | jq -r '.tenant.etag // empty')
+    http_code=$(curl -s -o /dev/null -w "%{http_code}" -X PATCH "$BASE_URL/admin/v1/tenants/$TENANT_ID" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -H "If-Match: \"0\"" \
+        -d "{\"name\":\"$UPDATED_TENANT_NAME\"}")
+
+    if [ "$http_code" = "400" ]; then
+        print_success "Update with a stale etag was rejected"
+    else
+        print_error "Expected 400 for the stale etag, got $http_code"
+        exit 1
+    fi
+
+    http_code=$(curl -s -o /dev/null -w "%{http_code}" -X PATCH "$BASE_URL/admin/v1/tenants/$TENANT_ID" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -H "If-Match: $etag" \
+        -d "{\"name\":\"$UPDATED_TENANT_NAME\"}")
+
+    if [ -n "$etag" ] && [ "$http_code" = "200" ]; then
+        print_success "Update with the current etag was applied"
+    else
+        print_error "Expected 200 for the current etag $etag, got $http_code"
+        exit 1
+    fi
+
+    echo ""
+}
+
+test_admin_list_tenants_by_tag_types() {
+    print_step "Admin API - List Tenants by Tag Types"
+
+    # @e2e GET /admin/v1/tenants
+    response=$(curl -s "$BASE_URL/admin/v1/tenants?tag_types=TENANT_TAG_TYPE_BUSINESS&limit=100" \
+        -H "Authorization: Bearer $ADMIN_TOKEN")
+
+    if echo "$response" | jq -e --arg id "$TENANT_ID" \
+        '(.tenants | map(.id) | index($id)) != null and all(.tenants[]; .tags | index("TENANT_TAG_TYPE_BUSINESS") != null)' > /dev/null 2>&1; then
+        print_success "Tag type filter returned only tagged tenants"
+    else
+        print_error "Failed to list tenants by tag types"
+        echo "$response"
+        exit 1
+    fi
+
+    echo ""
+}
+
+test_admin_search_tenants() {
+    print_step "Admin API - Search Tenants"
+
+    # Name matching is a case-insensitive substring search
+    response=$(curl -s -G "$BASE_URL/admin/v1/tenants" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        --data-urlencode "name=test tenant ${TEST_ID}" \
+        --data-urlencode "created_at_from=2000-01-01T00:00:00Z")
+
+    if echo "$response" | jq -e --arg id "$TENANT_ID" \
+        '(.tenants | length) == 1 and .tenants[0].id == $id' > /dev/null 2>&1; then
+        print_success "Name and created_at filters returned the test tenant"
+    else
+        print_error "Failed to search tenants"
+        echo "$response"
+        exit 1
+    fi
+
     echo ""
 }
 
@@ -136,3 +281,50 @@ test_admin_delete_tenant() {
 
     echo ""
 }
+
+test_admin_restore_tenant() {
+    print_step "Admin API - Restore Tenant"
+
+    # The deleted tenant is still readable when deleted rows are included
+    # @e2e GET /admin/v1/tenants/{tenant_id}
+    response=$(curl -s "$BASE_URL/admin/v1/tenants/$TEMP_TENANT_ID?include_deleted=true" \
+        -H "Authorization: Bearer $ADMIN_TOKEN")
+
+    if echo "$response" | jq -e '.tenant.deleted_at' > /dev/null 2>&1; then
+        print_success "Deleted tenant is returned with deleted_at"
+    else
+        print_error "Failed to get deleted tenant"
+        echo "$response"
+        exit 1
+    fi
+
+    # @e2e POST /admin/v1/tenants/{tenant_id}/restore
+    response=$(curl -s -X POST "$BASE_URL/admin/v1/tenants/$TEMP_TENANT_ID/restore" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d "{}")
+
+    if echo "$response" | jq -e '.tenant.id and (.tenant.deleted_at == null)' > /dev/null 2>&1; then
+        print_success "Tenant restored successfully"
+    else
+        print_error "Failed to restore tenant"
+        echo "$response"
+        exit 1
+    fi
+
+    # Restoring a tenant that is not deleted is rejected
+    restore_again=$(curl -s -o /dev/null -w "%{http_code}" -X POST \
+        "$BASE_URL/admin/v1/tenants/$TEMP_TENANT_ID/restore" \
+        -H "Authorization: Bearer $ADMIN_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d "{}")
+
+    if [ "$restore_again" = "409" ]; then
+        print_success "Restoring a live tenant is rejected (HTTP 409)"
+    else
+        print_error "Unexpected status restoring a live tenant (HTTP $restore_again)"
+        exit 1
+    fi
+
+    echo ""
+}
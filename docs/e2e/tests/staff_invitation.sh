@@ -0,0 +1,112 @@
+#!/bin/bash
+
+# E2E Tests: Staff Invitation APIs
+
+test_staff_create_invitation() {
+    print_step "Staff API - Create Invitation"
+
+    INVITEE_EMAIL="e2e-invitee-${TEST_ID}@example.com"
+
+    # @e2e POST /staff/v1/invitations
+    response=$(curl -s -X POST "$BASE_URL/staff/v1/invitations" \
+        -H "Authorization: Bearer $STAFF_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d "{
+            \"email\":\"$INVITEE_EMAIL\",
+            \"role\":\"STAFF_ROLE_NORMAL\"
+        }")
+
+    INVITATION_ID=$(echo "$response" | jq -r '.invitation.id // empty')
+    status=$(echo "$response" | jq -r '.invitation.status // empty')
+
+    if [ -n "$INVITATION_ID" ] && [ "$status" = "INVITATION_STATUS_PENDING" ]; then
+        print_success "Invitation created successfully"
+        print_info "  InvitationID: $INVITATION_ID"
+        print_info "  Email: $INVITEE_EMAIL"
+    else
+        print_error "Failed to create invitation"
+        echo "$response"
+        exit 1
+    fi
+
+    echo ""
+}
+
+test_staff_list_invitations() {
+    print_step "Staff API - List Invitations"
+
+    # @e2e GET /staff/v1/invitations
+    response=$(curl -s "$BASE_URL/staff/v1/invitations" \
+        -H "Authorization: Bearer $STAFF_TOKEN")
+
+    if echo "$response" | jq -e --arg id "$INVITATION_ID" '.invitations | map(.id) | index($id)' > /dev/null 2>&1; then
+        invitation_count=$(echo "$response" | jq '.invitations | length')
+        print_success "Staff list invitations successful (found $invitation_count invitations)"
+    else
+        print_error "Failed to list invitations"
+        echo "$response"
+        exit 1
+    fi
+
+    echo ""
+}
+
+test_staff_revoke_invitation() {
+    print_step "Staff API - Revoke Invitation"
+
+    # @e2e POST /staff/v1/invitations/{invitation_id}/revoke
+    response=$(curl -s -X POST "$BASE_URL/staff/v1/invitations/$INVITATION_ID/revoke" \
+        -H "Authorization: Bearer $STAFF_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d "{}")
+
+    status=$(echo "$response" | jq -r '.invitation.status // empty')
+
+    if [ "$status" = "INVITATION_STATUS_REVOKED" ]; then
+        print_success "Invitation revoked successfully"
+    else
+        print_error "Failed to revoke invitation"
+        echo "$response"
+        exit 1
+    fi
+
+    # Revoking an invitation that is no longer pending is rejected
+    revoke_again=$(curl -s -o /dev/null -w "%{http_code}" -X POST \
+        "$BASE_URL/staff/v1/invitations/$INVITATION_ID/revoke" \
+        -H "Authorization: Bearer $STAFF_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d "{}")
+
+    if [ "$revoke_again" = "409" ]; then
+        print_success "Revoking a revoked invitation is rejected (HTTP 409)"
+    else
+        print_error "Unexpected status revoking a revoked invitation (HTTP $revoke_again)"
+        exit 1
+    fi
+
+    echo ""
+}
+
+test_staff_accept_invitation() {
+    print_step "Staff API - Accept Invitation"
+
+    # The raw token only reaches the invitee by email, so an unknown token is used here
+    # @e2e POST /staff/v1/invitations:accept
+    accept_status=$(curl -s -o /dev/null -w "%{http_code}" -X POST \
+        "$BASE_URL/staff/v1/invitations:accept" \
+        -H "Authorization: Bearer $STAFF_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d "{
+            \"token\":\"unknown-token-${TEST_ID}\",
+            \"display_name\":\"E2E Invitee\"
+        }")
+
+    if [ "$accept_status" = "404" ]; then
+        print_success "Accepting an unknown token is rejected (HTTP 404)"
+    else
+        print_error "Unexpected status accepting an unknown token (HTTP $accept_status)"
+        exit 1
+    fi
+
+    echo ""
+}
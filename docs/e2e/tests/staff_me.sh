@@ -69,6 +69,28 @@ test_staff_update_me() {
 
     print_info "  Updated AssetID: $UPDATED_ASSET_ID"
 
+    upload_status=$(upload_asset "$asset_response")
+    if [ "$upload_status" != "200" ] && [ "$upload_status" != "204" ]; then
+        print_error "Failed to upload asset (HTTP $upload_status)"
+        exit 1
+    fi
+
+    # @e2e POST /staff/v1/assets/{asset_id}/confirm
+    confirm_response=$(curl -s -X POST "$BASE_URL/staff/v1/assets/$UPDATED_ASSET_ID/confirm" \
+        -H "Authorization: Bearer $STAFF_TOKEN" \
+        -H "Content-Type: application/json" \
+        -d "{}")
+
+    asset_status=$(echo "$confirm_response" | jq -r '.asset.status // empty')
+
+    if [ "$asset_status" != "ASSET_STATUS_UPLOADED" ]; then
+        print_error "Failed to confirm asset upload"
+        echo "$confirm_response"
+        exit 1
+    fi
+
+    print_info "  Asset upload confirmed"
+
     # Update staff profile with new display name and image
     UPDATED_DISPLAY_NAME="${STAFF_DISPLAY_NAME} (Updated)"
     # @e2e PATCH /staff/v1/me
@@ -126,13 +148,15 @@ test_staff_update_tenant() {
     response=$(curl -s -X PATCH "$BASE_URL/staff/v1/me/tenant" \
         -H "Authorization: Bearer $STAFF_TOKEN" \
         -H "Content-Type: application/json" \
-        -d "{\"name\":\"$STAFF_UPDATED_TENANT_NAME\"}")
+        -d "{\"name\":\"$STAFF_UPDATED_TENANT_NAME\",\"tags\":{\"values\":[\"TENANT_TAG_TYPE_OTHER\"]}}")
 
     updated_name=$(echo "$response" | jq -r '.tenant.name // empty')
+    updated_tags=$(echo "$response" | jq -c '.tenant.tags')
 
-    if [ "$updated_name" = "$STAFF_UPDATED_TENANT_NAME" ]; then
-        print_success "Staff updated tenant name successfully"
+    if [ "$updated_name" = "$STAFF_UPDATED_TENANT_NAME" ] && [ "$updated_tags" = '["TENANT_TAG_TYPE_OTHER"]' ]; then
+        print_success "Staff updated tenant name and tags successfully"
         print_info "  Name: $updated_name"
+        print_info "  Tags: $updated_tags"
     else
         print_error "Failed to update tenant via staff API"
         echo "$response"
@@ -168,3 +192,42 @@ test_staff_create_asset() {
 
     echo ""
 }
+
+test_staff_upload_download_asset() {
+    print_step "Staff API - Upload & Download Asset Stream"
+
+    # @e2e POST /staff/v1/assets/-/upload
+    response=$(upload_asset_stream_body | curl -s -X POST "$BASE_URL/staff/v1/assets/-/upload" \
+        -H "Authorization: Bearer $STAFF_TOKEN" \
+        -H "Content-Type: application/json" \
+        --data-binary @-)
+
+    asset_id=$(echo "$response" | jq -r '.asset.id // empty')
+    asset_status=$(echo "$response" | jq -r '.asset.status // empty')
+
+    if [ -n "$asset_id" ] && [ "$asset_status" = "ASSET_STATUS_UPLOADED" ]; then
+        print_success "Staff upload asset stream successful"
+        print_info "  AssetID: $asset_id"
+    else
+        print_error "Failed to upload asset stream via staff API"
+        echo "$response"
+        exit 1
+    fi
+
+    # @e2e GET /staff/v1/assets/{asset_id}/download
+    response=$(curl -s "$BASE_URL/staff/v1/assets/$asset_id/download" \
+        -H "Authorization: Bearer $STAFF_TOKEN")
+
+    downloaded_asset_id=$(verify_downloaded_asset "$response")
+
+    if [ "$downloaded_asset_id" = "$asset_id" ]; then
+        print_success "Staff download asset stream successful"
+        print_info "  Checksum verified"
+    else
+        print_error "Failed to download asset stream via staff API"
+        echo "$response"
+        exit 1
+    fi
+
+    echo ""
+}
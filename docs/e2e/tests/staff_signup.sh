@@ -51,6 +51,7 @@ staff_signup() {
     # Step 3: Create asset for signup via direct DB insert
     # The signup endpoint validates assets with auth_context="staff:<auth_uid>" (the Cognito sub).
     # The auth_uid returned by the debug API is the Cognito sub, so we use it directly.
+    # The asset is inserted as uploaded, so that the signup does not look for its object in the bucket.
     print_info "Creating asset for signup via DB insert..."
 
     SIGNUP_ASSET_ID="e2e-signup-asset-${TEST_ID}"
@@ -60,8 +61,8 @@ staff_signup() {
     EXPIRES=$(date -u -v+15M '+%Y-%m-%d %H:%M:%S' 2>/dev/null || date -u -d '+15 minutes' '+%Y-%m-%d %H:%M:%S')
 
     run_mysql "
-        INSERT INTO assets (id, auth_context, content_type, type, path, expires_at, created_at, updated_at)
-        VALUES ('${SIGNUP_ASSET_ID}', '${SIGNUP_ASSET_AUTH_CONTEXT}', 'image/png', 'private/user_images', '${SIGNUP_ASSET_PATH}', '${EXPIRES}', '${NOW}', '${NOW}');
+        INSERT INTO assets (id, auth_context, content_type, type, path, status, size, expires_at, uploaded_at, created_at, updated_at)
+        VALUES ('${SIGNUP_ASSET_ID}', '${SIGNUP_ASSET_AUTH_CONTEXT}', 'image/png', 'private/user_images', '${SIGNUP_ASSET_PATH}', 'uploaded', 8, '${EXPIRES}', '${NOW}', '${NOW}', '${NOW}');
     "
 
     if [ $? -ne 0 ]; then
@@ -6,8 +6,12 @@ import (
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 )
 
+// AssetPath caches the paths of the uploaded assets not attached yet.
+// Attaching an asset reads its row locked instead, and clears the entry.
+//
 //go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_cache
 type AssetPath interface {
+	// Get returns AssetNotFoundErr on a cache miss.
 	Get(
 		ctx context.Context,
 		id string,
@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// getEntry reads the entity cached at key.
+// A failing store is treated as a miss, so that the database keeps serving reads.
+func getEntry[T any](
+	ctx context.Context,
+	store Store,
+	key string,
+) (*T, bool) {
+	data, ok, err := store.Get(ctx, key)
+	if err != nil {
+		warn(ctx, "failed to get cache entry", key, err)
+		return nil, false
+	}
+	if !ok {
+		return nil, false
+	}
+	entity := new(T)
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(entity); err != nil {
+		warn(ctx, "failed to decode cache entry", key, err)
+		return nil, false
+	}
+	return entity, true
+}
+
+func setEntry[T any](
+	ctx context.Context,
+	store Store,
+	key string,
+	entity *T,
+	ttl time.Duration,
+) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(entity); err != nil {
+		warn(ctx, "failed to encode cache entry", key, err)
+		return
+	}
+	if err := store.Set(ctx, key, buf.Bytes(), ttl); err != nil {
+		warn(ctx, "failed to set cache entry", key, err)
+	}
+}
+
+func warn(ctx context.Context, msg string, key string, err error) {
+	logger.L(ctx).Warn(msg,
+		zap.String("key", key),
+		zap.Error(err),
+	)
+}
@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
+// IdempotencyKey stores the requests made with an Idempotency-Key header and their responses,
+// so that a retried request is answered with the response of the first one.
+//
+//go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_cache
+type IdempotencyKey interface {
+	// Claim stores key for the request in progress and reports true,
+	// or returns the key of the same ID already stored and reports false.
+	// A stored key expired at t is replaced.
+	Claim(
+		ctx context.Context,
+		key *model.IdempotencyKey,
+		t time.Time,
+	) (*model.IdempotencyKey, bool, error)
+	// Complete stores the response of the claimed key.
+	Complete(
+		ctx context.Context,
+		key *model.IdempotencyKey,
+	) error
+	// Release deletes the claimed key, so that the request can be made again.
+	Release(
+		ctx context.Context,
+		id string,
+	) error
+	// DeleteExpired deletes up to limit keys expired at t and returns the number of keys deleted.
+	// The stores expiring the keys by themselves delete nothing.
+	DeleteExpired(
+		ctx context.Context,
+		t time.Time,
+		limit uint64,
+	) (int, error)
+}
@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimit allows Limit requests per Period, which may come at once in a burst.
+// The allowance is replenished evenly over the period (GCRA).
+type RateLimit struct {
+	Limit  int
+	Period time.Duration
+}
+
+// Interval returns the time replenishing the allowance of one request.
+func (l RateLimit) Interval() time.Duration {
+	return l.Period / time.Duration(l.Limit)
+}
+
+type RateLimitResult struct {
+	Allowed bool
+	// Remaining is the number of requests allowed right after this one.
+	Remaining int
+	// RetryAfter is the wait before the next request is allowed, when this one is not.
+	RetryAfter time.Duration
+	// ResetAfter is the wait before the whole allowance is replenished.
+	ResetAfter time.Duration
+}
+
+// RateLimiter counts the requests made under each key against a rate limit.
+//
+//go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_cache
+type RateLimiter interface {
+	// Allow counts a request under key, unless the limit is exceeded.
+	Allow(
+		ctx context.Context,
+		key string,
+		limit RateLimit,
+	) (*RateLimitResult, error)
+}
+
+type fallbackRateLimiter struct {
+	primary  RateLimiter
+	fallback RateLimiter
+}
+
+// NewFallbackRateLimiter returns a RateLimiter counting in primary, or in fallback while primary fails,
+// so that an outage of a shared store neither fails the requests nor lifts the limits.
+// The counts in fallback are only of the requests made to this process during the outage.
+func NewFallbackRateLimiter(
+	primary RateLimiter,
+	fallback RateLimiter,
+) RateLimiter {
+	return &fallbackRateLimiter{
+		primary:  primary,
+		fallback: fallback,
+	}
+}
+
+func (l *fallbackRateLimiter) Allow(
+	ctx context.Context,
+	key string,
+	limit RateLimit,
+) (*RateLimitResult, error) {
+	result, err := l.primary.Allow(ctx, key, limit)
+	if err == nil {
+		return result, nil
+	}
+	warn(ctx, "failed to count the request in the rate limiter", key, err)
+	return l.fallback.Allow(ctx, key, limit)
+}
@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// stubRateLimiter is a RateLimiter returning a fixed result, counting its calls.
+type stubRateLimiter struct {
+	result *RateLimitResult
+	err    error
+	calls  int
+}
+
+func (l *stubRateLimiter) Allow(context.Context, string, RateLimit) (*RateLimitResult, error) {
+	l.calls++
+	return l.result, l.err
+}
+
+func TestFallbackRateLimiter_Allow(t *testing.T) {
+	t.Parallel()
+
+	limit := RateLimit{
+		Limit:  10,
+		Period: time.Minute,
+	}
+	allowed := &RateLimitResult{
+		Allowed:    true,
+		Remaining:  9,
+		RetryAfter: 0,
+		ResetAfter: 6 * time.Second,
+	}
+	rejected := &RateLimitResult{
+		Allowed:    false,
+		Remaining:  0,
+		RetryAfter: 6 * time.Second,
+		ResetAfter: time.Minute,
+	}
+
+	tests := map[string]func(t *testing.T){
+		"the result of primary is returned": func(t *testing.T) {
+			primary := &stubRateLimiter{result: rejected, err: nil, calls: 0}
+			fallback := &stubRateLimiter{result: allowed, err: nil, calls: 0}
+
+			got, err := NewFallbackRateLimiter(primary, fallback).Allow(context.Background(), "key", limit)
+			require.NoError(t, err)
+			require.Equal(t, rejected, got)
+			require.Zero(t, fallback.calls)
+		},
+		"fallback counts while primary fails": func(t *testing.T) {
+			primary := &stubRateLimiter{result: nil, err: errors.InternalErr.Wrap(fmt.Errorf("connection refused")), calls: 0}
+			fallback := &stubRateLimiter{result: allowed, err: nil, calls: 0}
+
+			got, err := NewFallbackRateLimiter(primary, fallback).Allow(context.Background(), "key", limit)
+			require.NoError(t, err)
+			require.Equal(t, allowed, got)
+			require.Equal(t, 1, fallback.calls)
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			tc(t)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+)
+
+type staff struct {
+	staffRepository  repository.Staff
+	tenantRepository repository.Tenant
+	store            Store
+	ttl              time.Duration
+}
+
+// NewStaff caches the staffs got by ID or auth UID in the store.
+// The staff is cached without its tenant, which is preloaded from tenantRepository,
+// so that an update of the tenant does not leave stale copies in the cached staffs.
+func NewStaff(
+	staffRepository repository.Staff,
+	tenantRepository repository.Tenant,
+	store Store,
+	ttl time.Duration,
+) repository.Staff {
+	return &staff{
+		staffRepository:  staffRepository,
+		tenantRepository: tenantRepository,
+		store:            store,
+		ttl:              ttl,
+	}
+}
+
+func (c *staff) buildKey(id string) string {
+	return fmt.Sprintf("staff:%s", id)
+}
+
+// buildAuthUIDKey is the key of the ID of the staff with the auth UID.
+func (c *staff) buildAuthUIDKey(authUID string) string {
+	return fmt.Sprintf("staff_auth_uid:%s", authUID)
+}
+
+func (c *staff) Get(
+	ctx context.Context,
+	query repository.GetStaffQuery,
+) (*model.Staff, error) {
+	if query.ID.Valid == query.AuthUID.Valid || query.ForUpdate || query.SkipLocked || inRWTx(ctx) {
+		return c.staffRepository.Get(ctx, query)
+	}
+
+	var got *model.Staff
+	var err error
+	if query.ID.Valid {
+		got, err = c.getByID(ctx, query.ID.String)
+	} else {
+		got, err = c.getByAuthUID(ctx, query.AuthUID.String)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if got == nil || (got.DeletedAt.Valid && !query.IncludeDeleted) {
+		if query.OrFail {
+			return nil, errors.StaffNotFoundErr.New().
+				WithDetail("staff is not found").
+				WithValue("query", query)
+		}
+		return nil, nil
+	}
+	if query.Preload {
+		tenant, err := c.tenantRepository.Get(ctx, repository.GetTenantQuery{
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail:     false,
+				Preload:    false,
+				ForUpdate:  false,
+				SkipLocked: false,
+			},
+			ID:             null.StringFrom(got.TenantID),
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		got.ReadonlyReference = &struct {
+			Tenant *model.Tenant
+		}{
+			Tenant: tenant,
+		}
+	}
+	return got, nil
+}
+
+func (c *staff) getByID(
+	ctx context.Context,
+	id string,
+) (*model.Staff, error) {
+	key := c.buildKey(id)
+	if got, ok := getEntry[model.Staff](ctx, c.store, key); ok {
+		return got, nil
+	}
+	got, err := c.staffRepository.Get(ctx, repository.GetStaffQuery{
+		BaseGetOptions: repository.BaseGetOptions{
+			OrFail:     false,
+			Preload:    false,
+			ForUpdate:  false,
+			SkipLocked: false,
+		},
+		ID:             null.StringFrom(id),
+		AuthUID:        null.String{},
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if got != nil {
+		setEntry(ctx, c.store, key, got, c.ttl)
+	}
+	return got, nil
+}
+
+func (c *staff) getByAuthUID(
+	ctx context.Context,
+	authUID string,
+) (*model.Staff, error) {
+	authUIDKey := c.buildAuthUIDKey(authUID)
+	if staffID, ok := getEntry[string](ctx, c.store, authUIDKey); ok {
+		got, err := c.getByID(ctx, *staffID)
+		if err != nil {
+			return nil, err
+		}
+		// the staff may have been deleted and its auth UID given to another one
+		if got != nil && got.AuthUID == authUID {
+			return got, nil
+		}
+	}
+	got, err := c.staffRepository.Get(ctx, repository.GetStaffQuery{
+		BaseGetOptions: repository.BaseGetOptions{
+			OrFail:     false,
+			Preload:    false,
+			ForUpdate:  false,
+			SkipLocked: false,
+		},
+		ID:             null.String{},
+		AuthUID:        null.StringFrom(authUID),
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if got != nil {
+		setEntry(ctx, c.store, c.buildKey(got.ID), got, c.ttl)
+		setEntry(ctx, c.store, authUIDKey, &got.ID, c.ttl)
+	}
+	return got, nil
+}
+
+func (c *staff) List(
+	ctx context.Context,
+	query repository.ListStaffQuery,
+) (model.Staffs, error) {
+	return c.staffRepository.List(ctx, query)
+}
+
+func (c *staff) Count(
+	ctx context.Context,
+	query repository.ListStaffQuery,
+) (uint64, error) {
+	return c.staffRepository.Count(ctx, query)
+}
+
+func (c *staff) Create(
+	ctx context.Context,
+	staff *model.Staff,
+) error {
+	if err := c.staffRepository.Create(ctx, staff); err != nil {
+		return err
+	}
+	return invalidate(ctx, c.store, c.buildAuthUIDKey(staff.AuthUID))
+}
+
+func (c *staff) BatchCreate(
+	ctx context.Context,
+	staffs model.Staffs,
+) error {
+	if err := c.staffRepository.BatchCreate(ctx, staffs); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(staffs))
+	for _, staff := range staffs {
+		keys = append(keys, c.buildAuthUIDKey(staff.AuthUID))
+	}
+	return invalidate(ctx, c.store, keys...)
+}
+
+func (c *staff) Update(
+	ctx context.Context,
+	staff *model.Staff,
+) error {
+	if err := c.staffRepository.Update(ctx, staff); err != nil {
+		return err
+	}
+	return invalidate(ctx, c.store, c.buildKey(staff.ID), c.buildAuthUIDKey(staff.AuthUID))
+}
+
+func (c *staff) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	if err := c.staffRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	return invalidate(ctx, c.store, c.buildKey(id))
+}
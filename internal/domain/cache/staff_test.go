@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStaff_Get(t *testing.T) {
+	t.Parallel()
+
+	byAuthUIDQuery := func(authUID string) repository.GetStaffQuery {
+		return repository.GetStaffQuery{
+			AuthUID:        null.StringFrom(authUID),
+			IncludeDeleted: true,
+		}
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller){
+		"get by auth uid caches the staff for gets by id": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			staff.ReadonlyReference = nil
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(), byAuthUIDQuery(staff.AuthUID)).
+				Return(staff, nil).
+				Times(1)
+
+			c := NewStaff(mockStaffRepo, mock_repository.NewMockTenant(ctrl), newMapStore(), time.Minute)
+			for _, query := range []repository.GetStaffQuery{
+				{AuthUID: null.StringFrom(staff.AuthUID)},
+				{AuthUID: null.StringFrom(staff.AuthUID)},
+				{ID: null.StringFrom(staff.ID)},
+			} {
+				got, err := c.Get(ctx, query)
+				require.NoError(t, err)
+				require.Equal(t, staff.ID, got.ID)
+				require.Equal(t, staff.AuthUID, got.AuthUID)
+				require.Nil(t, got.ReadonlyReference)
+			}
+		},
+		"preload attaches the tenant": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			tenant := testdata.Tenant
+			staff.ReadonlyReference = nil
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(), repository.GetStaffQuery{
+					ID:             null.StringFrom(staff.ID),
+					IncludeDeleted: true,
+				}).
+				Return(staff, nil)
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(), repository.GetTenantQuery{
+					ID:             null.StringFrom(tenant.ID),
+					IncludeDeleted: true,
+				}).
+				Return(tenant, nil)
+
+			c := NewStaff(mockStaffRepo, mockTenantRepo, newMapStore(), time.Minute)
+			got, err := c.Get(ctx, repository.GetStaffQuery{
+				BaseGetOptions: repository.BaseGetOptions{
+					OrFail:  true,
+					Preload: true,
+				},
+				ID: null.StringFrom(staff.ID),
+			})
+			require.NoError(t, err)
+			require.Equal(t, tenant, got.ReadonlyReference.Tenant)
+		},
+		"deleted staff is not found without include deleted": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			staff.ReadonlyReference = nil
+			staff.Delete(testdata.RequestTime)
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(), byAuthUIDQuery(staff.AuthUID)).
+				Return(staff, nil)
+
+			c := NewStaff(mockStaffRepo, mock_repository.NewMockTenant(ctrl), newMapStore(), time.Minute)
+			_, err := c.Get(ctx, repository.GetStaffQuery{
+				BaseGetOptions: repository.BaseGetOptions{
+					OrFail: true,
+				},
+				AuthUID: null.StringFrom(staff.AuthUID),
+			})
+			require.ErrorIs(t, err, errors.StaffNotFoundErr)
+		},
+		"auth uid given to another staff reads through again": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			staff.ReadonlyReference = nil
+			newStaff := *staff
+			newStaff.ID = "new-staff-id"
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			gomock.InOrder(
+				mockStaffRepo.EXPECT().
+					Get(gomock.Any(), byAuthUIDQuery(staff.AuthUID)).
+					Return(staff, nil),
+				mockStaffRepo.EXPECT().
+					Delete(gomock.Any(), staff.ID).
+					Return(nil),
+				mockStaffRepo.EXPECT().
+					Get(gomock.Any(), repository.GetStaffQuery{
+						ID:             null.StringFrom(staff.ID),
+						IncludeDeleted: true,
+					}).
+					Return(nil, nil),
+				mockStaffRepo.EXPECT().
+					Get(gomock.Any(), byAuthUIDQuery(staff.AuthUID)).
+					Return(&newStaff, nil),
+			)
+
+			c := NewStaff(mockStaffRepo, mock_repository.NewMockTenant(ctrl), newMapStore(), time.Minute)
+			query := repository.GetStaffQuery{
+				AuthUID: null.StringFrom(staff.AuthUID),
+			}
+			_, err := c.Get(ctx, query)
+			require.NoError(t, err)
+			require.NoError(t, c.Delete(ctx, staff.ID))
+
+			got, err := c.Get(ctx, query)
+			require.NoError(t, err)
+			require.Equal(t, newStaff.ID, got.ID)
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			tc(t, ctrl)
+		})
+	}
+}
+
+func TestStaff_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller){
+		"update invalidates the staff and its auth uid": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Update(gomock.Any(), staff).
+				Return(nil)
+
+			store := newMapStore()
+			require.NoError(t, store.Set(ctx, "staff:"+staff.ID, []byte("cached"), time.Minute))
+			require.NoError(t, store.Set(ctx, "staff_auth_uid:"+staff.AuthUID, []byte("cached"), time.Minute))
+			c := NewStaff(mockStaffRepo, mock_repository.NewMockTenant(ctrl), store, time.Minute)
+			require.NoError(t, c.Update(ctx, staff))
+			require.False(t, store.has("staff:"+staff.ID))
+			require.False(t, store.has("staff_auth_uid:"+staff.AuthUID))
+		},
+		"create invalidates the auth uid": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Create(gomock.Any(), staff).
+				Return(nil)
+
+			store := newMapStore()
+			require.NoError(t, store.Set(ctx, "staff_auth_uid:"+staff.AuthUID, []byte("cached"), time.Minute))
+			c := NewStaff(mockStaffRepo, mock_repository.NewMockTenant(ctrl), store, time.Minute)
+			require.NoError(t, c.Create(ctx, staff))
+			require.False(t, store.has("staff_auth_uid:"+staff.AuthUID))
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			tc(t, ctrl)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_cache
+type Store interface {
+	// Get reports false when the key is missing or expired.
+	Get(
+		ctx context.Context,
+		key string,
+	) ([]byte, bool, error)
+	Set(
+		ctx context.Context,
+		key string,
+		value []byte,
+		ttl time.Duration,
+	) error
+	Delete(
+		ctx context.Context,
+		keys ...string,
+	) error
+}
@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+)
+
+type tenant struct {
+	tenantRepository repository.Tenant
+	store            Store
+	ttl              time.Duration
+}
+
+// NewTenant caches the tenants got by ID in the store.
+// The tenant is always cached with its tags and regardless of its deletion,
+// and the query options are applied to the cached tenant.
+func NewTenant(
+	tenantRepository repository.Tenant,
+	store Store,
+	ttl time.Duration,
+) repository.Tenant {
+	return &tenant{
+		tenantRepository: tenantRepository,
+		store:            store,
+		ttl:              ttl,
+	}
+}
+
+func (c *tenant) buildKey(id string) string {
+	return fmt.Sprintf("tenant:%s", id)
+}
+
+func (c *tenant) Get(
+	ctx context.Context,
+	query repository.GetTenantQuery,
+) (*model.Tenant, error) {
+	if !query.ID.Valid || query.ForUpdate || query.SkipLocked || inRWTx(ctx) {
+		return c.tenantRepository.Get(ctx, query)
+	}
+
+	key := c.buildKey(query.ID.String)
+	got, ok := getEntry[model.Tenant](ctx, c.store, key)
+	if !ok {
+		var err error
+		got, err = c.tenantRepository.Get(ctx, repository.GetTenantQuery{
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail:     false,
+				Preload:    true,
+				ForUpdate:  false,
+				SkipLocked: false,
+			},
+			ID:             query.ID,
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if got != nil {
+			setEntry(ctx, c.store, key, got, c.ttl)
+		}
+	}
+
+	if got == nil || (got.DeletedAt.Valid && !query.IncludeDeleted) {
+		if query.OrFail {
+			return nil, errors.TenantNotFoundErr.New().
+				WithDetail("tenant is not found").
+				WithValue("query", query)
+		}
+		return nil, nil
+	}
+	return got, nil
+}
+
+func (c *tenant) List(
+	ctx context.Context,
+	query repository.ListTenantsQuery,
+) (model.Tenants, error) {
+	return c.tenantRepository.List(ctx, query)
+}
+
+func (c *tenant) Count(
+	ctx context.Context,
+	query repository.ListTenantsQuery,
+) (uint64, error) {
+	return c.tenantRepository.Count(ctx, query)
+}
+
+func (c *tenant) Create(
+	ctx context.Context,
+	tenant *model.Tenant,
+) error {
+	return c.tenantRepository.Create(ctx, tenant)
+}
+
+func (c *tenant) BatchCreate(
+	ctx context.Context,
+	tenants model.Tenants,
+) error {
+	return c.tenantRepository.BatchCreate(ctx, tenants)
+}
+
+func (c *tenant) Update(
+	ctx context.Context,
+	tenant *model.Tenant,
+) error {
+	if err := c.tenantRepository.Update(ctx, tenant); err != nil {
+		return err
+	}
+	return invalidate(ctx, c.store, c.buildKey(tenant.ID))
+}
+
+func (c *tenant) BatchUpdate(
+	ctx context.Context,
+	tenants model.Tenants,
+) error {
+	if err := c.tenantRepository.BatchUpdate(ctx, tenants); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(tenants))
+	for _, tenant := range tenants {
+		keys = append(keys, c.buildKey(tenant.ID))
+	}
+	return invalidate(ctx, c.store, keys...)
+}
+
+func (c *tenant) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	if err := c.tenantRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	return invalidate(ctx, c.store, c.buildKey(id))
+}
@@ -0,0 +1,289 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// mapStore is a Store on a map, ignoring the ttl.
+type mapStore struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+}
+
+func newMapStore() *mapStore {
+	return &mapStore{
+		mu:      sync.Mutex{},
+		entries: map[string][]byte{},
+	}
+}
+
+func (s *mapStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.entries[key]
+	return value, ok, nil
+}
+
+func (s *mapStore) Set(_ context.Context, key string, value []byte, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = value
+	return nil
+}
+
+func (s *mapStore) Delete(_ context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		delete(s.entries, key)
+	}
+	return nil
+}
+
+func (s *mapStore) has(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.entries[key]
+	return ok
+}
+
+// newPassThroughTransactable returns a Transactable running fn in place of a transaction.
+func newPassThroughTransactable(ctrl *gomock.Controller) repository.Transactable {
+	mockTransactable := mock_repository.NewMockTransactable(ctrl)
+	mockTransactable.EXPECT().RWTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+	return mockTransactable
+}
+
+func TestTenant_Get(t *testing.T) {
+	t.Parallel()
+
+	cachedQuery := func(id string) repository.GetTenantQuery {
+		return repository.GetTenantQuery{
+			BaseGetOptions: repository.BaseGetOptions{
+				Preload: true,
+			},
+			ID:             null.StringFrom(id),
+			IncludeDeleted: true,
+		}
+	}
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller){
+		"reads through once and serves the cached tenant": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(), cachedQuery(tenant.ID)).
+				Return(tenant, nil).
+				Times(1)
+
+			c := NewTenant(mockTenantRepo, newMapStore(), time.Minute)
+			for range 2 {
+				got, err := c.Get(ctx, repository.GetTenantQuery{
+					BaseGetOptions: repository.BaseGetOptions{
+						OrFail: true,
+					},
+					ID: null.StringFrom(tenant.ID),
+				})
+				require.NoError(t, err)
+				require.Equal(t, tenant.ID, got.ID)
+				require.Equal(t, tenant.Name, got.Name)
+				require.Len(t, got.Tags, len(tenant.Tags))
+			}
+		},
+		"deleted tenant is only returned with include deleted": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+			tenant.Delete(testdata.RequestTime)
+
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(), cachedQuery(tenant.ID)).
+				Return(tenant, nil)
+
+			c := NewTenant(mockTenantRepo, newMapStore(), time.Minute)
+			_, err := c.Get(ctx, repository.GetTenantQuery{
+				BaseGetOptions: repository.BaseGetOptions{
+					OrFail: true,
+				},
+				ID: null.StringFrom(tenant.ID),
+			})
+			require.ErrorIs(t, err, errors.TenantNotFoundErr)
+
+			got, err := c.Get(ctx, repository.GetTenantQuery{
+				ID: null.StringFrom(tenant.ID),
+			})
+			require.NoError(t, err)
+			require.Nil(t, got)
+
+			got, err = c.Get(ctx, repository.GetTenantQuery{
+				ID:             null.StringFrom(tenant.ID),
+				IncludeDeleted: true,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tenant.ID, got.ID)
+		},
+		"for update bypasses the cache": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+			query := repository.GetTenantQuery{
+				BaseGetOptions: repository.BaseGetOptions{
+					OrFail:    true,
+					ForUpdate: true,
+				},
+				ID: null.StringFrom(tenant.ID),
+			}
+
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(), query).
+				Return(tenant, nil).
+				Times(2)
+
+			store := newMapStore()
+			c := NewTenant(mockTenantRepo, store, time.Minute)
+			for range 2 {
+				_, err := c.Get(ctx, query)
+				require.NoError(t, err)
+			}
+			require.False(t, store.has("tenant:"+tenant.ID))
+		},
+		"read-write transaction bypasses the cache": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+			query := repository.GetTenantQuery{
+				ID: null.StringFrom(tenant.ID),
+			}
+
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(), query).
+				Return(tenant, nil).
+				Times(2)
+
+			store := newMapStore()
+			c := NewTenant(mockTenantRepo, store, time.Minute)
+			err := NewTransactable(newPassThroughTransactable(ctrl)).RWTx(ctx, func(ctx context.Context) error {
+				for range 2 {
+					if _, err := c.Get(ctx, query); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			require.False(t, store.has("tenant:"+tenant.ID))
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			tc(t, ctrl)
+		})
+	}
+}
+
+func TestTenant_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(t *testing.T, ctrl *gomock.Controller){
+		"update invalidates the cached tenant": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				Return(tenant, nil).
+				Times(2)
+			mockTenantRepo.EXPECT().
+				Update(gomock.Any(), tenant).
+				Return(nil)
+
+			store := newMapStore()
+			c := NewTenant(mockTenantRepo, store, time.Minute)
+			query := repository.GetTenantQuery{
+				ID: null.StringFrom(tenant.ID),
+			}
+			_, err := c.Get(ctx, query)
+			require.NoError(t, err)
+			require.True(t, store.has("tenant:"+tenant.ID))
+
+			require.NoError(t, c.Update(ctx, tenant))
+			require.False(t, store.has("tenant:"+tenant.ID))
+
+			_, err = c.Get(ctx, query)
+			require.NoError(t, err)
+		},
+		"update in a transaction invalidates again after commit": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				BatchUpdate(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			store := newMapStore()
+			c := NewTenant(mockTenantRepo, store, time.Minute)
+			err := NewTransactable(newPassThroughTransactable(ctrl)).RWTx(ctx, func(ctx context.Context) error {
+				if err := c.BatchUpdate(ctx, model.Tenants{tenant}); err != nil {
+					return err
+				}
+				// a reader outside the transaction caches the tenant before the commit
+				return store.Set(ctx, "tenant:"+tenant.ID, []byte("stale"), time.Minute)
+			})
+			require.NoError(t, err)
+			require.False(t, store.has("tenant:"+tenant.ID))
+		},
+		"failed update keeps the cache": func(t *testing.T, ctrl *gomock.Controller) {
+			ctx := context.Background()
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Delete(gomock.Any(), tenant.ID).
+				Return(errors.InternalErr.New())
+
+			store := newMapStore()
+			require.NoError(t, store.Set(ctx, "tenant:"+tenant.ID, []byte("cached"), time.Minute))
+			c := NewTenant(mockTenantRepo, store, time.Minute)
+			require.ErrorIs(t, c.Delete(ctx, tenant.ID), errors.InternalErr)
+			require.True(t, store.has("tenant:"+tenant.ID))
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			tc(t, ctrl)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type ctxTxKey struct{}
+
+// txState collects the keys written in a read-write transaction,
+// which are invalidated once more after the transaction has committed.
+type txState struct {
+	mu            sync.Mutex
+	invalidations []invalidation
+}
+
+type invalidation struct {
+	store Store
+	keys  []string
+}
+
+type invalidatingTransactable struct {
+	transactable repository.Transactable
+}
+
+// NewTransactable marks the context of read-write transactions, so that the repository caches
+// read through to the database within them, and invalidates the keys written in them after commit.
+// A reader between the write and the commit may cache the old entity again, which the
+// invalidation after commit removes.
+func NewTransactable(
+	transactable repository.Transactable,
+) repository.Transactable {
+	return &invalidatingTransactable{
+		transactable: transactable,
+	}
+}
+
+func (t *invalidatingTransactable) ROTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return t.transactable.ROTx(ctx, fn)
+}
+
+func (t *invalidatingTransactable) RWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if inRWTx(ctx) {
+		return t.transactable.RWTx(ctx, fn)
+	}
+	state := &txState{
+		mu:            sync.Mutex{},
+		invalidations: nil,
+	}
+	ctxWithTx := context.WithValue(ctx, ctxTxKey{}, state)
+	if err := t.transactable.RWTx(ctxWithTx, fn); err != nil {
+		return err
+	}
+	for _, inv := range state.invalidations {
+		// The transaction has committed, so a failure only leaves the entry until it expires.
+		if err := inv.store.Delete(ctx, inv.keys...); err != nil {
+			logger.L(ctx).Warn("failed to invalidate cache after commit",
+				zap.Strings("keys", inv.keys),
+				zap.Error(err),
+			)
+		}
+	}
+	return nil
+}
+
+func inRWTx(ctx context.Context) bool {
+	_, ok := ctx.Value(ctxTxKey{}).(*txState)
+	return ok
+}
+
+// invalidate deletes the keys written by the repository and, within a read-write transaction,
+// deletes them again after it commits.
+func invalidate(ctx context.Context, store Store, keys ...string) error {
+	if state, ok := ctx.Value(ctxTxKey{}).(*txState); ok {
+		state.mu.Lock()
+		state.invalidations = append(state.invalidations, invalidation{
+			store: store,
+			keys:  keys,
+		})
+		state.mu.Unlock()
+	}
+	return store.Delete(ctx, keys...)
+}
@@ -50,3 +50,15 @@ func NewServiceAvailableError(errCode string, msg string) *goerr.Error {
 		WithCategory(ErrorCategoryServiceAvailable.String()).
 		WithCode(errCode)
 }
+
+func NewTooManyRequestsError(errCode string, msg string) *goerr.Error {
+	return goerr.New("%s", msg).
+		WithCategory(ErrorCategoryTooManyRequests.String()).
+		WithCode(errCode)
+}
+
+func NewFailedPreconditionError(errCode string, msg string) *goerr.Error {
+	return goerr.New("%s", msg).
+		WithCategory(ErrorCategoryFailedPrecondition.String()).
+		WithCode(errCode)
+}
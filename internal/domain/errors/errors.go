@@ -10,13 +10,42 @@ var (
 	AssetNotFoundErr           = NewNotFoundError("E100007", "Asset not found")
 	CanceledErr                = NewCanceledError("E100008", "Canceled")
 	RequireAdminSessionErr     = NewUnauthorizedError("E100009", "Require admin session")
+	PermissionDeniedErr        = NewForbiddenError("E100010", "Permission denied")
+	InvalidCursorErr           = NewBadRequestError("E100011", "Invalid cursor")
+	RateLimitExceededErr       = NewTooManyRequestsError("E100012", "Rate limit exceeded")
+	IdempotencyKeyMismatchErr  = NewConflictError("E100013", "Idempotency key is used for another request")
+	IdempotencyKeyInUseErr     = NewConflictError("E100014", "Request with the idempotency key is in progress")
 
 	// tenant error.
-	TenantNotFoundErr = NewNotFoundError("E200101", "Tenant not found")
+	TenantNotFoundErr   = NewNotFoundError("E200101", "Tenant not found")
+	TenantNotDeletedErr = NewConflictError("E200102", "Tenant is not deleted")
+	TenantTagInvalidErr = NewBadRequestError("E200103", "Tenant tag is invalid")
+	TenantModifiedErr   = NewFailedPreconditionError("E200104", "Tenant has been modified")
 
 	// staff error.
-	StaffNotFoundErr = NewNotFoundError("E200201", "Staff not found")
+	StaffNotFoundErr      = NewNotFoundError("E200201", "Staff not found")
+	StaffAlreadyExistsErr = NewConflictError("E200202", "Staff already exists")
+	StaffModifiedErr      = NewFailedPreconditionError("E200203", "Staff has been modified")
 
 	// admin error.
-	AdminNotFoundErr = NewNotFoundError("E200301", "Admin not found")
+	AdminNotFoundErr          = NewNotFoundError("E200301", "Admin not found")
+	AdminAlreadyExistsErr     = NewConflictError("E200302", "Admin already exists")
+	AdminCannotOperateSelfErr = NewForbiddenError("E200303", "Admin cannot operate on own account")
+
+	// invitation error.
+	InvitationNotFoundErr      = NewNotFoundError("E200401", "Invitation not found")
+	InvitationNotPendingErr    = NewConflictError("E200402", "Invitation is not pending")
+	InvitationExpiredErr       = NewConflictError("E200403", "Invitation has expired")
+	InvitationEmailMismatchErr = NewForbiddenError("E200404", "Invitation is for another email")
+
+	// job error.
+	JobHandlerNotFoundErr = NewInternalError("E200501", "Job handler not found")
+	JobLeaseExpiredErr    = NewInternalError("E200502", "Job lease expired")
+	JobPanickedErr        = NewInternalError("E200503", "Job handler panicked")
+
+	// asset error.
+	AssetNotUploadedErr = NewConflictError("E200601", "Asset is not uploaded")
+
+	// outbox error.
+	OutboxEventSubscriberFailedErr = NewInternalError("E200701", "Outbox event subscriber failed")
 )
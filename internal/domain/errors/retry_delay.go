@@ -0,0 +1,29 @@
+package errors
+
+import (
+	"time"
+
+	"github.com/abyssparanoia/goerr"
+)
+
+// retryDelayValueKey is the reserved goerr.Values key under which the delay
+// before the client may retry is stored.
+const retryDelayValueKey = "_retry_delay"
+
+// WithRetryDelay attaches the delay after which the failed request may be
+// retried. It is exposed to API clients via a google.rpc.RetryInfo detail
+// in the gRPC error response.
+func WithRetryDelay(err *goerr.Error, delay time.Duration) *goerr.Error {
+	return err.WithValue(retryDelayValueKey, delay)
+}
+
+// RetryDelay extracts the delay attached to err (or any wrapped goerr.Error).
+// Returns false if none was set.
+func RetryDelay(err error) (time.Duration, bool) {
+	ge := goerr.Unwrap(err)
+	if ge == nil {
+		return 0, false
+	}
+	delay, ok := ge.Values()[retryDelayValueKey].(time.Duration)
+	return delay, ok
+}
@@ -0,0 +1,50 @@
+package errors
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/abyssparanoia/goerr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err       error
+		wantDelay time.Duration
+		wantOK    bool
+	}{
+		"delay set on the error": {
+			err:       WithRetryDelay(goerr.New("test error"), 3*time.Second),
+			wantDelay: 3 * time.Second,
+			wantOK:    true,
+		},
+		"delay set on a wrapped error": {
+			err:       fmt.Errorf("outer: %w", WithRetryDelay(goerr.New("inner"), time.Second)),
+			wantDelay: time.Second,
+			wantOK:    true,
+		},
+		"goerr without delay": {
+			err:       goerr.New("no delay"),
+			wantDelay: 0,
+			wantOK:    false,
+		},
+		"plain error": {
+			err:       fmt.Errorf("plain error"),
+			wantDelay: 0,
+			wantOK:    false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			delay, ok := RetryDelay(tc.err)
+			require.Equal(t, tc.wantOK, ok)
+			require.Equal(t, tc.wantDelay, delay)
+		})
+	}
+}
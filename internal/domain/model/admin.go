@@ -37,6 +37,15 @@ func NewAdmin(
 	}
 }
 
+// AuditLogSnapshot returns the fields of the admin recorded in audit logs.
+func (m *Admin) AuditLogSnapshot() AuditLogSnapshot {
+	return AuditLogSnapshot{
+		"role":         m.Role.String(),
+		"email":        m.Email,
+		"display_name": m.DisplayName,
+	}
+}
+
 func (m *Admin) Update(
 	displayName null.String,
 	t time.Time,
@@ -2,23 +2,33 @@ package model
 
 import (
 	"fmt"
+	"path"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/uuid"
 )
 
+// Asset is an object uploaded to the storage through a presigned URL.
+// It is pending until its upload is confirmed, and its object is deleted by the cleanup task
+// once it expires without an upload or is orphaned.
 type Asset struct {
 	ID          string
 	ContentType ContentType
 	Type        AssetType
 	Path        string
 	AuthContext AssetAuthContext
-	ExpiresAt   time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	Status      AssetStatus
+	// Size is the object size in bytes, known once the upload is confirmed.
+	Size       null.Int64
+	ExpiresAt  time.Time
+	UploadedAt null.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
 type Assets []*Asset
@@ -39,7 +49,10 @@ func NewAsset(
 		Type:        assetType,
 		Path:        fmt.Sprintf("%s/%s.%s", assetType.String(), uuid.UUIDBase64(), contentType.Extension()),
 		AuthContext: authContext,
-		ExpiresAt:   t.Add(15 * time.Minute),
+		Status:      AssetStatusPending,
+		Size:        null.Int64{},
+		ExpiresAt:   t.Add(assetType.Policy().Expiration),
+		UploadedAt:  null.Time{},
 		CreatedAt:   t,
 		UpdatedAt:   t,
 	}
@@ -49,36 +62,89 @@ func (m *Asset) Expiration() time.Duration {
 	return m.ExpiresAt.Sub(m.CreatedAt)
 }
 
-type AssetType string
-
-const (
-	AssetTypeUnknown   AssetType = "unknown"
-	AssetTypeUserImage AssetType = "private/user_images"
-)
+// IsExpired reports whether the upload URL of a pending asset has expired.
+func (m *Asset) IsExpired(t time.Time) bool {
+	return m.Status == AssetStatusPending && !t.Before(m.ExpiresAt)
+}
 
-func NewAssetType(str string) AssetType {
-	switch str {
-	case AssetTypeUserImage.String():
-		return AssetType(str)
+// ConfirmUpload records the uploaded object of a pending asset.
+// Confirming an uploaded asset again refreshes its size, so the call is idempotent.
+func (m *Asset) ConfirmUpload(
+	object *AssetObject,
+	t time.Time,
+) error {
+	if maxSize := m.Type.Policy().MaxSize; object.Size > maxSize {
+		return errors.AssetInvalidErr.New().
+			WithDetail("asset is too large").
+			WithValue("size", object.Size).
+			WithValue("max_size", maxSize)
+	}
+	switch m.Status {
+	case AssetStatusPending:
+		m.UploadedAt = null.TimeFrom(t)
+	case AssetStatusUploaded:
 	default:
-		return AssetTypeUnknown
+		return errors.AssetInvalidErr.New().
+			WithDetail("asset is already used").
+			WithValue("status", m.Status.String())
+	}
+	m.Status = AssetStatusUploaded
+	m.Size = null.Int64From(object.Size)
+	m.UpdatedAt = t
+	return nil
+}
+
+// ObjectPaths returns the paths of the objects of the asset, which are the original and its image variants.
+func (m *Asset) ObjectPaths() []string {
+	paths := []string{m.Path}
+	for _, variant := range m.Type.Policy().ImageVariants {
+		paths = append(paths, variant.Path(m.Path))
 	}
+	return paths
 }
 
-func (m AssetType) String() string {
-	return string(m)
+// Attach marks the asset as bound to an entity.
+func (m *Asset) Attach(t time.Time) *Asset {
+	m.Status = AssetStatusAttached
+	m.UpdatedAt = t
+	return m
 }
 
-func (m AssetType) Valid() bool {
-	return m != "" && m != AssetTypeUnknown
+// Orphan marks an uploaded asset never attached for deletion.
+func (m *Asset) Orphan(t time.Time) *Asset {
+	m.Status = AssetStatusOrphaned
+	m.UpdatedAt = t
+	return m
 }
 
-func (m AssetType) IsPrivate() bool {
-	return strings.HasPrefix(m.String(), "private")
+// AssetObject is the object stored at the path of an asset.
+type AssetObject struct {
+	Path string
+	Size int64
 }
 
-func (m AssetType) IsPublic() bool {
-	return strings.HasPrefix(m.String(), "public")
+// AssetImageVariant is a thumbnail of an uploaded image,
+// scaled down to fit in a square of MaxDimension pixels.
+type AssetImageVariant struct {
+	Name         string
+	MaxDimension int
+}
+
+// Path returns the path of the variant, stored next to the original at originalPath.
+// e.g. private/user_images/abc.png -> private/user_images/abc_small.png
+func (m AssetImageVariant) Path(originalPath string) string {
+	ext := path.Ext(originalPath)
+	return fmt.Sprintf("%s_%s%s", strings.TrimSuffix(originalPath, ext), m.Name, ext)
+}
+
+// AssetPresignedUpload is how a client uploads the object of an asset without credentials.
+// A POST upload sends Fields as the multipart form fields before the file,
+// and a PUT upload sends the object as the body with Headers.
+type AssetPresignedUpload struct {
+	Method  string
+	URL     string
+	Fields  map[string]string
+	Headers map[string]string
 }
 
 func ValidateAssetPath(
@@ -97,3 +163,16 @@ func ValidateAssetPath(
 	}
 	return nil
 }
+
+func ValidateAssetContentType(
+	assetType AssetType,
+	contentType ContentType,
+) error {
+	if !slices.Contains(assetType.Policy().ContentTypes, contentType) {
+		return errors.AssetInvalidErr.New().
+			WithDetail("content_type is not allowed for the asset_type").
+			WithValue("asset_type", assetType.String()).
+			WithValue("content_type", contentType.String())
+	}
+	return nil
+}
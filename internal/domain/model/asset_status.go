@@ -0,0 +1,34 @@
+package model
+
+type AssetStatus string
+
+const (
+	AssetStatusUnknown AssetStatus = "unknown"
+	// AssetStatusPending is the status of an asset whose upload URL is issued but not confirmed.
+	AssetStatusPending  AssetStatus = "pending"
+	AssetStatusUploaded AssetStatus = "uploaded"
+	// AssetStatusAttached is the status of an asset bound to an entity.
+	AssetStatusAttached AssetStatus = "attached"
+	// AssetStatusOrphaned is the status of an uploaded asset never attached, waiting for its object to be deleted.
+	AssetStatusOrphaned AssetStatus = "orphaned"
+)
+
+func NewAssetStatus(s string) AssetStatus {
+	switch s {
+	case AssetStatusPending.String(),
+		AssetStatusUploaded.String(),
+		AssetStatusAttached.String(),
+		AssetStatusOrphaned.String():
+		return AssetStatus(s)
+	default:
+		return AssetStatusUnknown
+	}
+}
+
+func (m AssetStatus) String() string {
+	return string(m)
+}
+
+func (m AssetStatus) Valid() bool {
+	return m != AssetStatusUnknown && m != ""
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"time"
+)
+
+type AssetType string
+
+const (
+	AssetTypeUnknown   AssetType = "unknown"
+	AssetTypeUserImage AssetType = "private/user_images"
+)
+
+// assetTypePolicies is the registry of the asset types, keyed by the path prefix of their assets.
+// The prefix starts with the visibility of the type, which routes its assets to the private or the public bucket.
+var assetTypePolicies = map[AssetType]AssetTypePolicy{
+	AssetTypeUserImage: {
+		Visibility: AssetVisibilityPrivate,
+		ContentTypes: []ContentType{
+			ContentTypeImagePNG,
+			ContentTypeImageJPEG,
+		},
+		MaxSize:    5 << 20,
+		Expiration: 15 * time.Minute,
+		ImageVariants: []AssetImageVariant{
+			{Name: "small", MaxDimension: 128},
+			{Name: "medium", MaxDimension: 512},
+		},
+	},
+}
+
+// AssetTypePolicy is the upload policy of an asset type.
+type AssetTypePolicy struct {
+	Visibility   AssetVisibility
+	ContentTypes []ContentType
+	// MaxSize is the max object size in bytes.
+	MaxSize int64
+	// Expiration is how long the upload URL is valid for.
+	Expiration time.Duration
+	// ImageVariants are the thumbnails generated next to an uploaded image.
+	// An image type has its metadata stripped once uploaded even without variants.
+	ImageVariants []AssetImageVariant
+}
+
+// IsImage reports whether the assets of the policy are images processed once uploaded.
+func (m AssetTypePolicy) IsImage() bool {
+	for _, contentType := range m.ContentTypes {
+		if !contentType.IsImage() {
+			return false
+		}
+	}
+	return len(m.ContentTypes) > 0
+}
+
+func NewAssetType(str string) AssetType {
+	if _, ok := assetTypePolicies[AssetType(str)]; ok {
+		return AssetType(str)
+	}
+	return AssetTypeUnknown
+}
+
+func (m AssetType) String() string {
+	return string(m)
+}
+
+func (m AssetType) Valid() bool {
+	_, ok := assetTypePolicies[m]
+	return ok
+}
+
+// Policy returns the upload policy of the type, which is zero for an unknown type.
+func (m AssetType) Policy() AssetTypePolicy {
+	return assetTypePolicies[m]
+}
+
+func (m AssetType) IsPrivate() bool {
+	return m.Policy().Visibility == AssetVisibilityPrivate
+}
+
+func (m AssetType) IsPublic() bool {
+	return m.Policy().Visibility == AssetVisibilityPublic
+}
+
+type AssetVisibility string
+
+const (
+	AssetVisibilityPrivate AssetVisibility = "private"
+	AssetVisibilityPublic  AssetVisibility = "public"
+)
+
+func (m AssetVisibility) String() string {
+	return string(m)
+}
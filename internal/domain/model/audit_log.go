@@ -0,0 +1,123 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
+)
+
+type AuditLog struct {
+	ID         string
+	TenantID   null.String
+	ActorType  AuditLogActorType
+	ActorID    string
+	Method     string
+	TargetType AuditLogTargetType
+	TargetID   string
+	Diff       AuditLogDiff
+	CreatedAt  time.Time
+}
+
+type AuditLogs []*AuditLog
+
+func NewAuditLog(
+	actor AuditLogActor,
+	tenantID null.String,
+	targetType AuditLogTargetType,
+	targetID string,
+	before AuditLogSnapshot,
+	after AuditLogSnapshot,
+	t time.Time,
+) *AuditLog {
+	return &AuditLog{
+		ID:         id.New(),
+		TenantID:   tenantID,
+		ActorType:  actor.Type,
+		ActorID:    actor.ID,
+		Method:     actor.Method,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Diff:       NewAuditLogDiff(before, after),
+		CreatedAt:  t,
+	}
+}
+
+// AuditLogActor is who made an audited change, and through which RPC method.
+type AuditLogActor struct {
+	Type   AuditLogActorType
+	ID     string
+	Method string
+}
+
+func NewAdminAuditLogActor(
+	adminID string,
+	method string,
+) AuditLogActor {
+	return AuditLogActor{
+		Type:   AuditLogActorTypeAdmin,
+		ID:     adminID,
+		Method: method,
+	}
+}
+
+func NewStaffAuditLogActor(
+	staffID string,
+	method string,
+) AuditLogActor {
+	return AuditLogActor{
+		Type:   AuditLogActorTypeStaff,
+		ID:     staffID,
+		Method: method,
+	}
+}
+
+// AuditLogSnapshot holds the audited fields of an entity keyed by field name.
+// A nil snapshot stands for an entity that does not exist, before creation or after deletion.
+type AuditLogSnapshot map[string]any
+
+// AuditLogDiff holds the values of the changed fields before and after the change.
+type AuditLogDiff struct {
+	Before map[string]any `json:"before"`
+	After  map[string]any `json:"after"`
+}
+
+func NewAuditLogDiff(
+	before AuditLogSnapshot,
+	after AuditLogSnapshot,
+) AuditLogDiff {
+	b, a := before.normalize(), after.normalize()
+	diff := AuditLogDiff{
+		Before: map[string]any{},
+		After:  map[string]any{},
+	}
+	for key, value := range b {
+		if afterValue, ok := a[key]; !ok || !reflect.DeepEqual(value, afterValue) {
+			diff.Before[key] = value
+		}
+	}
+	for key, value := range a {
+		if beforeValue, ok := b[key]; !ok || !reflect.DeepEqual(value, beforeValue) {
+			diff.After[key] = value
+		}
+	}
+	return diff
+}
+
+func (m AuditLogDiff) IsEmpty() bool {
+	return len(m.Before) == 0 && len(m.After) == 0
+}
+
+// normalize converts the snapshot into its JSON representation,
+// so that it compares the same way as a diff read back from the database.
+func (m AuditLogSnapshot) normalize() map[string]any {
+	dst := map[string]any{}
+	if m == nil {
+		return dst
+	}
+	b, _ := json.Marshal(m) //nolint:errchkjson
+	_ = json.Unmarshal(b, &dst)
+	return dst
+}
@@ -0,0 +1,27 @@
+package model
+
+type AuditLogActorType string
+
+const (
+	AuditLogActorTypeUnknown AuditLogActorType = "unknown"
+	AuditLogActorTypeAdmin   AuditLogActorType = "admin"
+	AuditLogActorTypeStaff   AuditLogActorType = "staff"
+)
+
+func NewAuditLogActorType(s string) AuditLogActorType {
+	switch s {
+	case AuditLogActorTypeAdmin.String(),
+		AuditLogActorTypeStaff.String():
+		return AuditLogActorType(s)
+	default:
+		return AuditLogActorTypeUnknown
+	}
+}
+
+func (m AuditLogActorType) String() string {
+	return string(m)
+}
+
+func (m AuditLogActorType) Valid() bool {
+	return m != AuditLogActorTypeUnknown && m != ""
+}
@@ -0,0 +1,31 @@
+package model
+
+type AuditLogTargetType string
+
+const (
+	AuditLogTargetTypeUnknown    AuditLogTargetType = "unknown"
+	AuditLogTargetTypeTenant     AuditLogTargetType = "tenant"
+	AuditLogTargetTypeStaff      AuditLogTargetType = "staff"
+	AuditLogTargetTypeAdmin      AuditLogTargetType = "admin"
+	AuditLogTargetTypeInvitation AuditLogTargetType = "invitation"
+)
+
+func NewAuditLogTargetType(s string) AuditLogTargetType {
+	switch s {
+	case AuditLogTargetTypeTenant.String(),
+		AuditLogTargetTypeStaff.String(),
+		AuditLogTargetTypeAdmin.String(),
+		AuditLogTargetTypeInvitation.String():
+		return AuditLogTargetType(s)
+	default:
+		return AuditLogTargetTypeUnknown
+	}
+}
+
+func (m AuditLogTargetType) String() string {
+	return string(m)
+}
+
+func (m AuditLogTargetType) Valid() bool {
+	return m != AuditLogTargetTypeUnknown && m != ""
+}
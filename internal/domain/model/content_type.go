@@ -32,6 +32,15 @@ func (m ContentType) Valid() bool {
 	return m != ContentTypeUnknown && m != ""
 }
 
+func (m ContentType) IsImage() bool {
+	switch m {
+	case ContentTypeImagePNG, ContentTypeImageJPEG:
+		return true
+	default:
+		return false
+	}
+}
+
 func (m ContentType) Extension() string {
 	switch m {
 	case ContentTypeImagePNG:
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+)
+
+// Cursor points at the last item of a keyset paginated list.
+// Value holds the sort column value of that item, so that the next page can be
+// queried without an offset.
+type Cursor struct {
+	SortKey string `json:"k"`
+	Value   string `json:"v"`
+	ID      string `json:"i"`
+}
+
+func NewCursor(
+	sortKey string,
+	value string,
+	id string,
+) *Cursor {
+	return &Cursor{
+		SortKey: sortKey,
+		Value:   value,
+		ID:      id,
+	}
+}
+
+func NewTimeCursor(
+	sortKey string,
+	value time.Time,
+	id string,
+) *Cursor {
+	return NewCursor(sortKey, value.UTC().Format(time.RFC3339Nano), id)
+}
+
+// ParseCursor decodes an opaque cursor issued for the given sort key.
+func ParseCursor(
+	s string,
+	sortKey string,
+) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.InvalidCursorErr.Wrap(err)
+	}
+	cursor := &Cursor{} //nolint:exhaustruct
+	if err := json.Unmarshal(b, cursor); err != nil {
+		return nil, errors.InvalidCursorErr.Wrap(err)
+	}
+	if cursor.ID == "" {
+		return nil, errors.InvalidCursorErr.New().
+			WithDetail("cursor has no id")
+	}
+	if cursor.SortKey != sortKey {
+		return nil, errors.InvalidCursorErr.New().
+			WithDetail("cursor was issued for another sort key").
+			WithValue("sort_key", sortKey)
+	}
+	return cursor, nil
+}
+
+func (c *Cursor) Encode() string {
+	b, _ := json.Marshal(c) //nolint:errchkjson
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (c *Cursor) TimeValue() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, c.Value)
+	if err != nil {
+		return time.Time{}, errors.InvalidCursorErr.Wrap(err)
+	}
+	return t, nil
+}
+
+// NextCursor trims items that were fetched with one extra row beyond limit and
+// returns the cursor of the last kept item when more items remain.
+func NextCursor[S ~[]E, E any](
+	items S,
+	limit uint64,
+	cursorOf func(E) *Cursor,
+) (S, null.String) {
+	if uint64(len(items)) <= limit {
+		return items, null.String{}
+	}
+	items = items[:limit]
+	return items, null.StringFrom(cursorOf(items[len(items)-1]).Encode())
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
+)
+
+// DomainEvent is a fact emitted by an interactor in the same transaction as the change it describes.
+// Events are stored in the outbox and delivered to subscribers at least once,
+// so subscribers must tolerate duplicates.
+type DomainEvent struct {
+	ID          string
+	Type        DomainEventType
+	TenantID    null.String
+	AggregateID string
+	Payload     DomainEventPayload
+	OccurredAt  time.Time
+}
+
+type DomainEvents []*DomainEvent
+
+// DomainEventPayload holds the event specific fields keyed by field name.
+// It is stored as JSON, so the values come back as JSON types.
+type DomainEventPayload map[string]any
+
+func NewDomainEvent(
+	eventType DomainEventType,
+	tenantID null.String,
+	aggregateID string,
+	payload DomainEventPayload,
+	t time.Time,
+) *DomainEvent {
+	if payload == nil {
+		payload = DomainEventPayload{}
+	}
+	return &DomainEvent{
+		ID:          id.New(),
+		Type:        eventType,
+		TenantID:    tenantID,
+		AggregateID: aggregateID,
+		Payload:     payload,
+		OccurredAt:  t,
+	}
+}
+
+func NewTenantCreatedEvent(tenant *Tenant, t time.Time) *DomainEvent {
+	return NewDomainEvent(DomainEventTypeTenantCreated, null.StringFrom(tenant.ID), tenant.ID, nil, t)
+}
+
+func NewTenantUpdatedEvent(tenant *Tenant, t time.Time) *DomainEvent {
+	return NewDomainEvent(DomainEventTypeTenantUpdated, null.StringFrom(tenant.ID), tenant.ID, nil, t)
+}
+
+func NewTenantDeletedEvent(tenant *Tenant, t time.Time) *DomainEvent {
+	return NewDomainEvent(DomainEventTypeTenantDeleted, null.StringFrom(tenant.ID), tenant.ID, nil, t)
+}
+
+func NewTenantRestoredEvent(tenant *Tenant, t time.Time) *DomainEvent {
+	return NewDomainEvent(DomainEventTypeTenantRestored, null.StringFrom(tenant.ID), tenant.ID, nil, t)
+}
+
+func NewStaffCreatedEvent(staff *Staff, t time.Time) *DomainEvent {
+	return NewDomainEvent(DomainEventTypeStaffCreated, null.StringFrom(staff.TenantID), staff.ID, DomainEventPayload{
+		"role": staff.Role.String(),
+	}, t)
+}
+
+func NewStaffUpdatedEvent(staff *Staff, t time.Time) *DomainEvent {
+	return NewDomainEvent(DomainEventTypeStaffUpdated, null.StringFrom(staff.TenantID), staff.ID, nil, t)
+}
+
+func NewStaffRoleChangedEvent(staff *Staff, beforeRole StaffRole, t time.Time) *DomainEvent {
+	return NewDomainEvent(DomainEventTypeStaffRoleChanged, null.StringFrom(staff.TenantID), staff.ID, DomainEventPayload{
+		"before_role": beforeRole.String(),
+		"after_role":  staff.Role.String(),
+	}, t)
+}
+
+func NewStaffDeletedEvent(staff *Staff, t time.Time) *DomainEvent {
+	return NewDomainEvent(DomainEventTypeStaffDeleted, null.StringFrom(staff.TenantID), staff.ID, nil, t)
+}
+
+func NewStaffRestoredEvent(staff *Staff, t time.Time) *DomainEvent {
+	return NewDomainEvent(DomainEventTypeStaffRestored, null.StringFrom(staff.TenantID), staff.ID, nil, t)
+}
+
+// NewAssetUploadedEvent is emitted when the upload of an asset is confirmed,
+// so that its object is processed after the commit.
+func NewAssetUploadedEvent(asset *Asset, t time.Time) *DomainEvent {
+	return NewDomainEvent(DomainEventTypeAssetUploaded, null.String{}, asset.ID, DomainEventPayload{
+		"asset_type": asset.Type.String(),
+	}, t)
+}
@@ -0,0 +1,43 @@
+package model
+
+type DomainEventType string
+
+const (
+	DomainEventTypeUnknown          DomainEventType = "unknown"
+	DomainEventTypeTenantCreated    DomainEventType = "tenant.created"
+	DomainEventTypeTenantUpdated    DomainEventType = "tenant.updated"
+	DomainEventTypeTenantDeleted    DomainEventType = "tenant.deleted"
+	DomainEventTypeTenantRestored   DomainEventType = "tenant.restored"
+	DomainEventTypeStaffCreated     DomainEventType = "staff.created"
+	DomainEventTypeStaffUpdated     DomainEventType = "staff.updated"
+	DomainEventTypeStaffRoleChanged DomainEventType = "staff.role_changed"
+	DomainEventTypeStaffDeleted     DomainEventType = "staff.deleted"
+	DomainEventTypeStaffRestored    DomainEventType = "staff.restored"
+	DomainEventTypeAssetUploaded    DomainEventType = "asset.uploaded"
+)
+
+func NewDomainEventType(s string) DomainEventType {
+	switch s {
+	case DomainEventTypeTenantCreated.String(),
+		DomainEventTypeTenantUpdated.String(),
+		DomainEventTypeTenantDeleted.String(),
+		DomainEventTypeTenantRestored.String(),
+		DomainEventTypeStaffCreated.String(),
+		DomainEventTypeStaffUpdated.String(),
+		DomainEventTypeStaffRoleChanged.String(),
+		DomainEventTypeStaffDeleted.String(),
+		DomainEventTypeStaffRestored.String(),
+		DomainEventTypeAssetUploaded.String():
+		return DomainEventType(s)
+	default:
+		return DomainEventTypeUnknown
+	}
+}
+
+func (m DomainEventType) String() string {
+	return string(m)
+}
+
+func (m DomainEventType) Valid() bool {
+	return m != DomainEventTypeUnknown && m != ""
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NewETag returns the etag of the version of an entity.
+// The version is incremented on every update, so every update changes the etag
+// however close in time the updates are.
+func NewETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// MatchETag reports whether the etags sent as a precondition, separated by commas as in If-Match, match current.
+// * matches any version, and the quotes may be omitted.
+// The comparison is strong as for If-Match, so a weak etag prefixed with W/ never matches.
+func MatchETag(etags string, current string) bool {
+	for _, etag := range strings.Split(etags, ",") {
+		etag = strings.TrimSpace(etag)
+		if etag == "*" {
+			return true
+		}
+		if strings.HasPrefix(etag, "W/") {
+			continue
+		}
+		if strings.Trim(etag, `"`) == strings.Trim(current, `"`) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchETag(t *testing.T) {
+	t.Parallel()
+
+	current := NewETag(2)
+
+	tests := map[string]struct {
+		etags string
+		want  bool
+	}{
+		"same etag matches": {
+			etags: `"2"`,
+			want:  true,
+		},
+		"other version does not match": {
+			etags: `"1"`,
+			want:  false,
+		},
+		"etag in a comma separated list matches": {
+			etags: `"1", "2" ,"3"`,
+			want:  true,
+		},
+		"list without the etag does not match": {
+			etags: `"1","3"`,
+			want:  false,
+		},
+		"wildcard matches any version": {
+			etags: "*",
+			want:  true,
+		},
+		"wildcard in a list matches any version": {
+			etags: `"1", *`,
+			want:  true,
+		},
+		"etag without quotes matches": {
+			etags: "2",
+			want:  true,
+		},
+		"weak etag does not match": {
+			etags: `W/"2"`,
+			want:  false,
+		},
+		"strong etag in a list with a weak one matches": {
+			etags: `W/"2", "2"`,
+			want:  true,
+		},
+		"empty etag does not match": {
+			etags: "",
+			want:  false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, MatchETag(tc.etags, current))
+		})
+	}
+}
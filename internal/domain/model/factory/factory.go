@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
 	"github.com/go-faker/faker/v4"
@@ -17,6 +18,7 @@ func NewFactory() struct {
 	Staff       *model.Staff
 	Admin       *model.Admin
 	Asset       *model.Asset
+	Invitation  *model.Invitation
 } {
 	opts := []options.OptionFunc{
 		options.WithIgnoreInterface(true),
@@ -31,6 +33,8 @@ func NewFactory() struct {
 	}
 	tenant.CreatedAt = n
 	tenant.UpdatedAt = n
+	tenant.DeletedAt = null.Time{}
+	tenant.Version = 1
 
 	user := &model.Staff{}
 	if err := faker.FakeData(user, opts...); err != nil {
@@ -44,6 +48,8 @@ func NewFactory() struct {
 	}
 	user.CreatedAt = n
 	user.UpdatedAt = n
+	user.DeletedAt = null.Time{}
+	user.Version = 1
 
 	admin := &model.Admin{}
 	if err := faker.FakeData(admin, opts...); err != nil {
@@ -60,22 +66,42 @@ func NewFactory() struct {
 	asset.Type = model.AssetTypeUserImage
 	asset.Path = "private/user_images/mock.png"
 	asset.AuthContext = model.NewStaffAssetAuthContext(user.ID)
+	asset.Status = model.AssetStatusPending
+	asset.Size = null.Int64{}
 	asset.ExpiresAt = n.Add(15 * time.Minute)
+	asset.UploadedAt = null.Time{}
 	asset.CreatedAt = n
 	asset.UpdatedAt = n
 
+	invitation := &model.Invitation{}
+	if err := faker.FakeData(invitation, opts...); err != nil {
+		panic(err)
+	}
+	invitation.TenantID = tenant.ID
+	invitation.Email = faker.Email()
+	invitation.Role = model.StaffRoleNormal
+	invitation.InvitedBy = user.ID
+	invitation.ExpiresAt = n.Add(model.InvitationExpiresIn)
+	invitation.AcceptedAt = null.Time{}
+	invitation.AcceptedStaffID = null.String{}
+	invitation.RevokedAt = null.Time{}
+	invitation.CreatedAt = n
+	invitation.UpdatedAt = n
+
 	return struct {
 		RequestTime time.Time
 		Tenant      *model.Tenant
 		Staff       *model.Staff
 		Admin       *model.Admin
 		Asset       *model.Asset
+		Invitation  *model.Invitation
 	}{
 		RequestTime: n,
 		Tenant:      tenant,
 		Staff:       user,
 		Admin:       admin,
 		Asset:       asset,
+		Invitation:  invitation,
 	}
 }
 
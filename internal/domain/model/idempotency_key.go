@@ -0,0 +1,61 @@
+package model
+
+import (
+	"time"
+)
+
+const (
+	// IdempotencyKeyClaimDuration is how long a request in progress holds its key,
+	// after which the key is released for a retry in case the request never completed.
+	IdempotencyKeyClaimDuration = 1 * time.Minute
+)
+
+// IdempotencyKey records a request made with an Idempotency-Key header, and its response once completed.
+// ID identifies the key of the client within the RPC and the caller.
+type IdempotencyKey struct {
+	ID string
+	// Fingerprint is the digest of the request, telling a replay from another request reusing the key.
+	Fingerprint string
+	// Response is the serialized response, which is nil while the request is in progress.
+	Response  []byte
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewIdempotencyKey returns the key claimed by a request in progress.
+func NewIdempotencyKey(
+	id string,
+	fingerprint string,
+	t time.Time,
+) *IdempotencyKey {
+	return &IdempotencyKey{
+		ID:          id,
+		Fingerprint: fingerprint,
+		Response:    nil,
+		ExpiresAt:   t.Add(IdempotencyKeyClaimDuration),
+		CreatedAt:   t,
+		UpdatedAt:   t,
+	}
+}
+
+// Complete records the response of the request, kept for ttl to be replayed.
+func (m *IdempotencyKey) Complete(
+	response []byte,
+	ttl time.Duration,
+	t time.Time,
+) {
+	m.Response = response
+	m.ExpiresAt = t.Add(ttl)
+	m.UpdatedAt = t
+}
+
+// Completed reports whether the request has completed with a response to replay.
+func (m *IdempotencyKey) Completed() bool {
+	return m.Response != nil
+}
+
+// Expired reports whether the key may be claimed again at t.
+func (m *IdempotencyKey) Expired(t time.Time) bool {
+	return !t.Before(m.ExpiresAt)
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
+)
+
+// InvitationExpiresIn is how long an invitation can be accepted after it is created.
+const InvitationExpiresIn = 7 * 24 * time.Hour
+
+// Invitation invites an email address to join a tenant as a staff.
+// Only the hash of the invitation token is kept, the token itself is sent to the invitee.
+type Invitation struct {
+	ID              string
+	TenantID        string
+	Email           string
+	Role            StaffRole
+	TokenHash       string
+	InvitedBy       string
+	ExpiresAt       time.Time
+	AcceptedAt      null.Time
+	AcceptedStaffID null.String
+	RevokedAt       null.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type Invitations []*Invitation
+
+func NewInvitation(
+	tenantID string,
+	email string,
+	role StaffRole,
+	tokenHash string,
+	invitedBy string,
+	t time.Time,
+) *Invitation {
+	return &Invitation{
+		ID:              id.New(),
+		TenantID:        tenantID,
+		Email:           email,
+		Role:            role,
+		TokenHash:       tokenHash,
+		InvitedBy:       invitedBy,
+		ExpiresAt:       t.Add(InvitationExpiresIn),
+		AcceptedAt:      null.Time{},
+		AcceptedStaffID: null.String{},
+		RevokedAt:       null.Time{},
+		CreatedAt:       t,
+		UpdatedAt:       t,
+	}
+}
+
+func (m *Invitation) Exist() bool {
+	return m != nil
+}
+
+// Status returns the status of the invitation at t.
+func (m *Invitation) Status(t time.Time) InvitationStatus {
+	switch {
+	case m.AcceptedAt.Valid:
+		return InvitationStatusAccepted
+	case m.RevokedAt.Valid:
+		return InvitationStatusRevoked
+	case !t.Before(m.ExpiresAt):
+		return InvitationStatusExpired
+	default:
+		return InvitationStatusPending
+	}
+}
+
+// AuditLogSnapshot returns the fields of the invitation recorded in audit logs.
+// The token hash is left out on purpose.
+func (m *Invitation) AuditLogSnapshot() AuditLogSnapshot {
+	return AuditLogSnapshot{
+		"tenant_id":         m.TenantID,
+		"email":             m.Email,
+		"role":              m.Role.String(),
+		"invited_by":        m.InvitedBy,
+		"expires_at":        m.ExpiresAt,
+		"accepted_at":       m.AcceptedAt,
+		"accepted_staff_id": m.AcceptedStaffID,
+		"revoked_at":        m.RevokedAt,
+	}
+}
+
+func (m *Invitation) Accept(
+	staffID string,
+	t time.Time,
+) *Invitation {
+	m.AcceptedAt = null.TimeFrom(t)
+	m.AcceptedStaffID = null.StringFrom(staffID)
+	m.UpdatedAt = t
+	return m
+}
+
+func (m *Invitation) Revoke(
+	t time.Time,
+) *Invitation {
+	m.RevokedAt = null.TimeFrom(t)
+	m.UpdatedAt = t
+	return m
+}
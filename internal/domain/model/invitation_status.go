@@ -0,0 +1,36 @@
+package model
+
+// InvitationStatus is derived from the accepted, revoked and expiry times of an invitation.
+type InvitationStatus string
+
+const (
+	InvitationStatusUnknown  InvitationStatus = "unknown"
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+	InvitationStatusExpired  InvitationStatus = "expired"
+)
+
+func NewInvitationStatus(s string) InvitationStatus {
+	switch s {
+	case InvitationStatusPending.String(),
+		InvitationStatusAccepted.String(),
+		InvitationStatusRevoked.String(),
+		InvitationStatusExpired.String():
+		return InvitationStatus(s)
+	default:
+		return InvitationStatusUnknown
+	}
+}
+
+func (m InvitationStatus) String() string {
+	return string(m)
+}
+
+func (m InvitationStatus) IsPending() bool {
+	return m == InvitationStatusPending
+}
+
+func (m InvitationStatus) Valid() bool {
+	return m != InvitationStatusUnknown && m != ""
+}
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/backoff"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
+)
+
+const (
+	// JobDefaultMaxAttempts is the number of attempts before a job is dead lettered.
+	JobDefaultMaxAttempts uint64 = 5
+	// JobRetryBaseDelay is the delay before the first retry of a failed job.
+	JobRetryBaseDelay = 10 * time.Second
+	// JobRetryMaxDelay caps the exponential delay between retries.
+	JobRetryMaxDelay = 1 * time.Hour
+)
+
+// Job is a unit of background work run by the worker.
+// A running job is leased until LockedUntil, after which another worker may take it over,
+// so handlers must be idempotent.
+type Job struct {
+	ID          string
+	Type        JobType
+	Payload     JobPayload
+	Status      JobStatus
+	Attempts    uint64
+	MaxAttempts uint64
+	RunAt       time.Time
+	LockedUntil null.Time
+	LastError   null.String
+	FinishedAt  null.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type Jobs []*Job
+
+// JobPayload holds the job specific arguments keyed by name.
+// It is stored as JSON, so the values come back as JSON types.
+type JobPayload map[string]any
+
+func NewJob(
+	jobType JobType,
+	payload JobPayload,
+	maxAttempts uint64,
+	runAt time.Time,
+	t time.Time,
+) *Job {
+	if payload == nil {
+		payload = JobPayload{}
+	}
+	return &Job{
+		ID:          id.New(),
+		Type:        jobType,
+		Payload:     payload,
+		Status:      JobStatusPending,
+		Attempts:    0,
+		MaxAttempts: maxAttempts,
+		RunAt:       runAt,
+		LockedUntil: null.Time{},
+		LastError:   null.String{},
+		FinishedAt:  null.Time{},
+		CreatedAt:   t,
+		UpdatedAt:   t,
+	}
+}
+
+// Start leases the job to a worker until lockedUntil.
+func (m *Job) Start(
+	lockedUntil time.Time,
+	t time.Time,
+) *Job {
+	m.Status = JobStatusRunning
+	m.Attempts++
+	m.LockedUntil = null.TimeFrom(lockedUntil)
+	m.UpdatedAt = t
+	return m
+}
+
+// ExceededAttempts reports whether a job taken over after an expired lease
+// has already used all of its attempts.
+func (m *Job) ExceededAttempts() bool {
+	return m.Attempts > m.MaxAttempts
+}
+
+func (m *Job) Succeed(
+	t time.Time,
+) *Job {
+	m.Status = JobStatusSucceeded
+	m.LockedUntil = null.Time{}
+	m.LastError = null.String{}
+	m.FinishedAt = null.TimeFrom(t)
+	m.UpdatedAt = t
+	return m
+}
+
+// Fail schedules a retry with an exponential backoff,
+// or dead letters the job once it has used all of its attempts.
+func (m *Job) Fail(
+	cause error,
+	t time.Time,
+) *Job {
+	m.LockedUntil = null.Time{}
+	m.LastError = null.StringFrom(cause.Error())
+	m.UpdatedAt = t
+	if m.Attempts >= m.MaxAttempts {
+		m.Status = JobStatusDead
+		m.FinishedAt = null.TimeFrom(t)
+		return m
+	}
+	m.Status = JobStatusPending
+	m.RunAt = t.Add(backoff.Exponential(m.Attempts, JobRetryBaseDelay, JobRetryMaxDelay))
+	return m
+}
@@ -0,0 +1,32 @@
+package model
+
+type JobStatus string
+
+const (
+	JobStatusUnknown   JobStatus = "unknown"
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	// JobStatusDead is the dead letter status of a job that ran out of attempts.
+	JobStatusDead JobStatus = "dead"
+)
+
+func NewJobStatus(s string) JobStatus {
+	switch s {
+	case JobStatusPending.String(),
+		JobStatusRunning.String(),
+		JobStatusSucceeded.String(),
+		JobStatusDead.String():
+		return JobStatus(s)
+	default:
+		return JobStatusUnknown
+	}
+}
+
+func (m JobStatus) String() string {
+	return string(m)
+}
+
+func (m JobStatus) Valid() bool {
+	return m != JobStatusUnknown && m != ""
+}
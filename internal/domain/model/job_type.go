@@ -0,0 +1,25 @@
+package model
+
+type JobType string
+
+const (
+	JobTypeUnknown             JobType = "unknown"
+	JobTypePurgeDeletedTenants JobType = "purge_deleted_tenants"
+)
+
+func NewJobType(s string) JobType {
+	switch s {
+	case JobTypePurgeDeletedTenants.String():
+		return JobType(s)
+	default:
+		return JobTypeUnknown
+	}
+}
+
+func (m JobType) String() string {
+	return string(m)
+}
+
+func (m JobType) Valid() bool {
+	return m != JobTypeUnknown && m != ""
+}
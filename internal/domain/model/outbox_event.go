@@ -0,0 +1,97 @@
+package model
+
+import (
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/backoff"
+)
+
+const (
+	// OutboxEventRetryBaseDelay is the delay before the first redelivery of a failed event.
+	OutboxEventRetryBaseDelay = 5 * time.Second
+	// OutboxEventRetryMaxDelay caps the exponential delay between redeliveries.
+	OutboxEventRetryMaxDelay = 1 * time.Hour
+)
+
+// OutboxEvent is a domain event waiting in the outbox together with its delivery state.
+// It shares its ID with the event.
+type OutboxEvent struct {
+	ID            string
+	Event         *DomainEvent
+	Status        OutboxEventStatus
+	Attempts      uint64
+	NextAttemptAt time.Time
+	LastError     null.String
+	DeliveredAt   null.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type OutboxEvents []*OutboxEvent
+
+func NewOutboxEvent(
+	event *DomainEvent,
+) *OutboxEvent {
+	return &OutboxEvent{
+		ID:            event.ID,
+		Event:         event,
+		Status:        OutboxEventStatusPending,
+		Attempts:      0,
+		NextAttemptAt: event.OccurredAt,
+		LastError:     null.String{},
+		DeliveredAt:   null.Time{},
+		CreatedAt:     event.OccurredAt,
+		UpdatedAt:     event.OccurredAt,
+	}
+}
+
+func NewOutboxEvents(
+	events ...*DomainEvent,
+) OutboxEvents {
+	dsts := make(OutboxEvents, 0, len(events))
+	for _, event := range events {
+		dsts = append(dsts, NewOutboxEvent(event))
+	}
+	return dsts
+}
+
+// Claim leases the event to a worker by postponing its next attempt until lockedUntil,
+// so that the event is delivered again if the worker dies before recording the delivery.
+func (m *OutboxEvent) Claim(
+	lockedUntil time.Time,
+	t time.Time,
+) *OutboxEvent {
+	m.NextAttemptAt = lockedUntil
+	m.UpdatedAt = t
+	return m
+}
+
+func (m *OutboxEvent) Delivered(
+	t time.Time,
+) *OutboxEvent {
+	m.Attempts++
+	m.Status = OutboxEventStatusDelivered
+	m.LastError = null.String{}
+	m.DeliveredAt = null.TimeFrom(t)
+	m.UpdatedAt = t
+	return m
+}
+
+// DeliveryFailed schedules the next attempt with an exponential backoff,
+// or gives up on the event once maxAttempts is reached.
+func (m *OutboxEvent) DeliveryFailed(
+	cause error,
+	maxAttempts uint64,
+	t time.Time,
+) *OutboxEvent {
+	m.Attempts++
+	m.LastError = null.StringFrom(cause.Error())
+	if m.Attempts >= maxAttempts {
+		m.Status = OutboxEventStatusFailed
+	} else {
+		m.NextAttemptAt = t.Add(backoff.Exponential(m.Attempts, OutboxEventRetryBaseDelay, OutboxEventRetryMaxDelay))
+	}
+	m.UpdatedAt = t
+	return m
+}
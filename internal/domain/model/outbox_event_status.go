@@ -0,0 +1,29 @@
+package model
+
+type OutboxEventStatus string
+
+const (
+	OutboxEventStatusUnknown   OutboxEventStatus = "unknown"
+	OutboxEventStatusPending   OutboxEventStatus = "pending"
+	OutboxEventStatusDelivered OutboxEventStatus = "delivered"
+	OutboxEventStatusFailed    OutboxEventStatus = "failed"
+)
+
+func NewOutboxEventStatus(s string) OutboxEventStatus {
+	switch s {
+	case OutboxEventStatusPending.String(),
+		OutboxEventStatusDelivered.String(),
+		OutboxEventStatusFailed.String():
+		return OutboxEventStatus(s)
+	default:
+		return OutboxEventStatusUnknown
+	}
+}
+
+func (m OutboxEventStatus) String() string {
+	return string(m)
+}
+
+func (m OutboxEventStatus) Valid() bool {
+	return m != OutboxEventStatusUnknown && m != ""
+}
@@ -38,3 +38,19 @@ func NewPagination(
 
 	return pagination
 }
+
+// NewCursorPagination builds the pagination of a cursor paginated list, which has no page numbers.
+func NewCursorPagination(
+	limit uint64,
+	totalCount uint64,
+	hasNext bool,
+) *Pagination {
+	return &Pagination{
+		CurrentPage: 0,
+		PrevPage:    0,
+		NextPage:    0,
+		TotalPage:   uint64(math.Ceil(float64(totalCount) / float64(limit))),
+		TotalCount:  totalCount,
+		HasNext:     hasNext,
+	}
+}
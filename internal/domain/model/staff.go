@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
 )
@@ -18,12 +19,17 @@ type Staff struct {
 	Email       string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	DeletedAt   null.Time
+	// Version is incremented on every update, and identifies the version in the etag.
+	Version int64
 
 	ReadonlyReference *struct {
 		Tenant *Tenant
 	}
 
 	ImageURL null.String
+	// ImageVariantURLs are the URLs of the image thumbnails keyed by the variant name.
+	ImageVariantURLs map[string]string
 }
 
 type Staffs []*Staff
@@ -47,10 +53,13 @@ func NewStaff(
 		Email:       email,
 		CreatedAt:   t,
 		UpdatedAt:   t,
+		DeletedAt:   null.Time{},
+		Version:     1,
 
 		ReadonlyReference: nil,
 
-		ImageURL: null.String{},
+		ImageURL:         null.String{},
+		ImageVariantURLs: nil,
 	}
 }
 
@@ -65,6 +74,60 @@ func (m *Staff) SetImageURL(
 	return m
 }
 
+func (m *Staff) SetImageVariantURL(
+	name string,
+	variantURL string,
+) *Staff {
+	if m.ImageVariantURLs == nil {
+		m.ImageVariantURLs = map[string]string{}
+	}
+	m.ImageVariantURLs[name] = variantURL
+	return m
+}
+
+// AuditLogSnapshot returns the fields of the staff recorded in audit logs.
+func (m *Staff) AuditLogSnapshot() AuditLogSnapshot {
+	snapshot := AuditLogSnapshot{
+		"tenant_id":    m.TenantID,
+		"role":         m.Role.String(),
+		"display_name": m.DisplayName,
+		"image_path":   m.ImagePath,
+		"email":        m.Email,
+	}
+	// set only while deleted, so that restoring the staff shows up in the diff
+	if m.DeletedAt.Valid {
+		snapshot["deleted_at"] = m.DeletedAt.Time
+	}
+	return snapshot
+}
+
+// Cursor returns the cursor pointing at the staff in a list sorted by sortKey.
+func (m *Staff) Cursor(sortKey StaffSortKey) *Cursor {
+	switch sortKey {
+	case StaffSortKeyDisplayNameAsc, StaffSortKeyDisplayNameDesc:
+		return NewCursor(sortKey.String(), m.DisplayName, m.ID)
+	case StaffSortKeyCreatedAtDesc, StaffSortKeyCreatedAtAsc, StaffSortKeyUnknown:
+		fallthrough
+	default:
+		return NewTimeCursor(sortKey.String(), m.CreatedAt, m.ID)
+	}
+}
+
+// ETag returns the etag of the version of the staff.
+func (m *Staff) ETag() string {
+	return NewETag(m.Version)
+}
+
+// ValidateETag returns StaffModifiedErr unless etag, when set, matches the version of the staff.
+func (m *Staff) ValidateETag(etag null.String) error {
+	if etag.Valid && !MatchETag(etag.String, m.ETag()) {
+		return errors.StaffModifiedErr.New().
+			WithDetail("staff has been modified since the etag was read").
+			WithValue("staff_id", m.ID)
+	}
+	return nil
+}
+
 func (m *Staff) Update(
 	displayName null.String,
 	role nullable.Type[StaffRole],
@@ -81,9 +144,45 @@ func (m *Staff) Update(
 		m.ImagePath = imagePath.String
 	}
 	m.UpdatedAt = t
+	m.Version++
 	return m
 }
 
+func (m *Staff) IsDeleted() bool {
+	return m.DeletedAt.Valid
+}
+
+// Delete marks the staff as soft deleted. The row is kept until it is purged.
+func (m *Staff) Delete(
+	t time.Time,
+) *Staff {
+	m.DeletedAt = null.TimeFrom(t)
+	m.UpdatedAt = t
+	m.Version++
+	return m
+}
+
+func (m *Staff) Restore(
+	t time.Time,
+) *Staff {
+	m.DeletedAt = null.Time{}
+	m.UpdatedAt = t
+	m.Version++
+	return m
+}
+
+// FilterDeletedAt returns the staffs soft deleted at t,
+// such as the staffs deleted together with their tenant.
+func (es Staffs) FilterDeletedAt(t time.Time) Staffs {
+	dsts := make(Staffs, 0, len(es))
+	for _, e := range es {
+		if e.DeletedAt.Valid && e.DeletedAt.Time.Equal(t) {
+			dsts = append(dsts, e)
+		}
+	}
+	return dsts
+}
+
 func (es Staffs) IDs() []string {
 	ids := make([]string, 0, len(es))
 	for _, e := range es {
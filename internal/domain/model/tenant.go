@@ -1,10 +1,13 @@
 package model
 
 import (
+	"slices"
 	"time"
 
 	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
 )
 
 type Tenant struct {
@@ -13,20 +16,30 @@ type Tenant struct {
 	Tags      TenantTags
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt null.Time
+	// Version is incremented on every update, and identifies the version in the etag.
+	Version int64
 }
 
 type Tenants []*Tenant
 
 func NewTenant(
 	name string,
+	tagTypes []TenantTagType,
 	t time.Time,
 ) *Tenant {
+	tags := make(TenantTags, 0, len(tagTypes))
+	for _, tagType := range tagTypes {
+		tags = append(tags, NewTenantTag(tagType, t))
+	}
 	return &Tenant{
 		ID:        id.New(),
 		Name:      name,
-		Tags:      make(TenantTags, 0),
+		Tags:      tags,
 		CreatedAt: t,
 		UpdatedAt: t,
+		DeletedAt: null.Time{},
+		Version:   1,
 	}
 }
 
@@ -51,15 +64,108 @@ func NewTenantTag(
 	}
 }
 
+// AuditLogSnapshot returns the fields of the tenant recorded in audit logs.
+func (m *Tenant) AuditLogSnapshot() AuditLogSnapshot {
+	tags := make([]string, 0, len(m.Tags))
+	for _, tag := range m.Tags {
+		tags = append(tags, tag.Type.String())
+	}
+	slices.Sort(tags)
+	snapshot := AuditLogSnapshot{
+		"name": m.Name,
+		"tags": tags,
+	}
+	// set only while deleted, so that restoring the tenant shows up in the diff
+	if m.DeletedAt.Valid {
+		snapshot["deleted_at"] = m.DeletedAt.Time
+	}
+	return snapshot
+}
+
+// Cursor returns the cursor pointing at the tenant in a list sorted by sortKey.
+func (m *Tenant) Cursor(sortKey TenantSortKey) *Cursor {
+	switch sortKey {
+	case TenantSortKeyNameAsc, TenantSortKeyNameDesc:
+		return NewCursor(sortKey.String(), m.Name, m.ID)
+	case TenantSortKeyCreatedAtDesc, TenantSortKeyCreatedAtAsc, TenantSortKeyUnknown:
+		fallthrough
+	default:
+		return NewTimeCursor(sortKey.String(), m.CreatedAt, m.ID)
+	}
+}
+
+// ETag returns the etag of the version of the tenant.
+func (m *Tenant) ETag() string {
+	return NewETag(m.Version)
+}
+
+// ValidateETag returns TenantModifiedErr unless etag, when set, matches the version of the tenant.
+func (m *Tenant) ValidateETag(etag null.String) error {
+	if etag.Valid && !MatchETag(etag.String, m.ETag()) {
+		return errors.TenantModifiedErr.New().
+			WithDetail("tenant has been modified since the etag was read").
+			WithValue("tenant_id", m.ID)
+	}
+	return nil
+}
+
 func (m *Tenant) Update(
 	name null.String,
+	tagTypes nullable.Type[[]TenantTagType],
 	t time.Time,
 ) *Tenant {
 	if name.Valid {
 		m.Name = name.String
 	}
+	if tagTypes.Valid {
+		m.replaceTags(tagTypes.Value(), t)
+	}
+
+	m.UpdatedAt = t
+	m.Version++
+	return m
+}
+
+// replaceTags sets the tags to tagTypes, keeping the existing tag of a type that is still set.
+func (m *Tenant) replaceTags(
+	tagTypes []TenantTagType,
+	t time.Time,
+) {
+	existing := make(map[TenantTagType]*TenantTag, len(m.Tags))
+	for _, tag := range m.Tags {
+		existing[tag.Type] = tag
+	}
+	tags := make(TenantTags, 0, len(tagTypes))
+	for _, tagType := range tagTypes {
+		if tag, ok := existing[tagType]; ok {
+			tags = append(tags, tag)
+			continue
+		}
+		tags = append(tags, NewTenantTag(tagType, t))
+	}
+	m.Tags = tags
+}
+
+func (m *Tenant) IsDeleted() bool {
+	return m.DeletedAt.Valid
+}
 
+// Delete marks the tenant as soft deleted. The row is kept until it is purged.
+func (m *Tenant) Delete(
+	t time.Time,
+) *Tenant {
+	m.DeletedAt = null.TimeFrom(t)
+	m.UpdatedAt = t
+	m.Version++
+	return m
+}
+
+func (m *Tenant) Restore(
+	t time.Time,
+) *Tenant {
+	m.DeletedAt = null.Time{}
 	m.UpdatedAt = t
+	m.Version++
 	return m
 }
 
@@ -1,5 +1,9 @@
 package model
 
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+)
+
 type TenantTagType string
 
 const (
@@ -29,3 +33,25 @@ func (m TenantTagType) String() string {
 func (m TenantTagType) Valid() bool {
 	return m != TenantTagTypeUnknown && m != ""
 }
+
+// ValidateTenantTagTypes checks that every tag type is valid and set at most once,
+// since a tenant holds a single tag per type.
+func ValidateTenantTagTypes(
+	tagTypes []TenantTagType,
+) error {
+	seen := make(map[TenantTagType]struct{}, len(tagTypes))
+	for _, tagType := range tagTypes {
+		if !tagType.Valid() {
+			return errors.TenantTagInvalidErr.New().
+				WithDetail("tag_type is invalid").
+				WithValue("tag_type", tagType.String())
+		}
+		if _, ok := seen[tagType]; ok {
+			return errors.TenantTagInvalidErr.New().
+				WithDetail("tag_type is duplicated").
+				WithValue("tag_type", tagType.String())
+		}
+		seen[tagType] = struct{}{}
+	}
+	return nil
+}
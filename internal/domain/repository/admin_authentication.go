@@ -13,6 +13,8 @@ type AdminAuthentication interface {
 	GetUserByEmail(ctx context.Context, email string) (*AdminAuthenticationGetUserByEmailResult, error)
 	CreateUser(ctx context.Context, param AdminAuthenticationCreateUserParam) (string, error)
 	StoreClaims(ctx context.Context, authUID string, adminClaims *model.AdminClaims) error
+	UpdatePassword(ctx context.Context, authUID string, email string, password string) error
+	DeleteUser(ctx context.Context, authUID string, email string) error
 	CreateCustomToken(ctx context.Context, authUID string) (string, error)
 	CreateIDToken(ctx context.Context, email string, password string) (string, error)
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
@@ -10,12 +11,12 @@ import (
 //go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
 type Asset interface {
 	// GenerateWritePresignedURL selects bucket based on path prefix (public/ vs private/)
+	// The content type and the max size of the asset type policy are signed into the upload,
+	// so the storage rejects any other object.
 	GenerateWritePresignedURL(
 		ctx context.Context,
-		contentType model.ContentType,
-		path string,
-		expires time.Duration,
-	) (string, error)
+		asset *model.Asset,
+	) (*model.AssetPresignedUpload, error)
 
 	// GenerateReadURL returns asset read URL
 	// For private paths: returns presigned URL with rounded expiration for caching
@@ -25,4 +26,32 @@ type Asset interface {
 		path string,
 		requestTime time.Time,
 	) (string, error)
+
+	// Head returns the object stored at path, or nil when nothing is uploaded there
+	Head(
+		ctx context.Context,
+		path string,
+	) (*model.AssetObject, error)
+
+	// NewReader returns the object stored at path, failing with AssetNotFoundErr when there is none
+	// The caller must close the reader
+	NewReader(
+		ctx context.Context,
+		path string,
+	) (io.ReadCloser, error)
+
+	// NewWriter returns a writer storing an object of contentType at path
+	// The object replaces the one at path once the writer is closed,
+	// and a write is abandoned by cancelling ctx before closing the writer
+	NewWriter(
+		ctx context.Context,
+		path string,
+		contentType model.ContentType,
+	) (io.WriteCloser, error)
+
+	// Delete removes the object stored at path, succeeding when there is none
+	Delete(
+		ctx context.Context,
+		path string,
+	) error
 }
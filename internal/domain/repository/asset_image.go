@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type AssetImage interface {
+	// Process decodes data as an image of contentType, failing with AssetInvalidErr when it is not one,
+	// and encodes it again without its metadata along with the variants.
+	Process(
+		ctx context.Context,
+		contentType model.ContentType,
+		data []byte,
+		variants []model.AssetImageVariant,
+	) (*ProcessedAssetImage, error)
+}
+
+// ProcessedAssetImage holds the encoded images in the content type of the original.
+type ProcessedAssetImage struct {
+	Original []byte
+	// Variants are keyed by the variant name.
+	Variants map[string][]byte
+}
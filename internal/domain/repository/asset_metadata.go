@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type AssetMetadata interface {
+	Get(
+		ctx context.Context,
+		query GetAssetMetadataQuery,
+	) (*model.Asset, error)
+	List(
+		ctx context.Context,
+		query ListAssetMetadataQuery,
+	) (model.Assets, error)
+	Create(
+		ctx context.Context,
+		asset *model.Asset,
+	) error
+	Update(
+		ctx context.Context,
+		asset *model.Asset,
+	) error
+	Delete(
+		ctx context.Context,
+		id string,
+	) error
+}
+
+type GetAssetMetadataQuery struct {
+	BaseGetOptions
+	ID          null.String
+	AuthContext nullable.Type[model.AssetAuthContext]
+}
+
+// ListAssetMetadataQuery lists assets oldest first.
+type ListAssetMetadataQuery struct {
+	BaseListOptions
+	Status         nullable.Type[model.AssetStatus]
+	ExpiresBefore  null.Time // matches assets expiring at or before the time
+	UploadedBefore null.Time // matches assets uploaded at or before the time
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type AuditLog interface {
+	List(
+		ctx context.Context,
+		query ListAuditLogsQuery,
+	) (model.AuditLogs, error)
+	Count(
+		ctx context.Context,
+		query ListAuditLogsQuery,
+	) (uint64, error)
+	Create(
+		ctx context.Context,
+		auditLog *model.AuditLog,
+	) error
+}
+
+// ListAuditLogsQuery lists audit logs from the newest.
+type ListAuditLogsQuery struct {
+	BaseListOptions
+	TenantID   null.String
+	ActorType  nullable.Type[model.AuditLogActorType]
+	ActorID    null.String
+	TargetType nullable.Type[model.AuditLogTargetType]
+	TargetID   null.String
+}
@@ -1,6 +1,10 @@
 package repository
 
-import "github.com/aarondl/null/v8"
+import (
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+)
 
 type BaseGetOptions struct {
 	OrFail     bool
@@ -9,9 +13,14 @@ type BaseGetOptions struct {
 	SkipLocked bool
 }
 
+// BaseListOptions paginates by offset when Page is set.
+// With Keyset, Limit rows are returned instead, starting after Cursor when it is set,
+// ordered by the sort key and then the ID.
 type BaseListOptions struct {
 	Page       null.Uint64
 	Limit      null.Uint64
+	Keyset     bool
+	Cursor     nullable.Type[model.Cursor]
 	Preload    bool
 	ForUpdate  bool
 	SkipLocked bool
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type Invitation interface {
+	Get(
+		ctx context.Context,
+		query GetInvitationQuery,
+	) (*model.Invitation, error)
+	List(
+		ctx context.Context,
+		query ListInvitationsQuery,
+	) (model.Invitations, error)
+	Count(
+		ctx context.Context,
+		query ListInvitationsQuery,
+	) (uint64, error)
+	Create(
+		ctx context.Context,
+		invitation *model.Invitation,
+	) error
+	Update(
+		ctx context.Context,
+		invitation *model.Invitation,
+	) error
+}
+
+type GetInvitationQuery struct {
+	BaseGetOptions
+	ID        null.String
+	TenantID  null.String
+	TokenHash null.String
+}
+
+type ListInvitationsQuery struct {
+	BaseListOptions
+	TenantID null.String
+}
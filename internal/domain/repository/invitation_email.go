@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type InvitationEmail interface {
+	Send(
+		ctx context.Context,
+		param InvitationEmailSendParam,
+	) error
+}
+
+// InvitationEmailSendParam carries the raw invitation token,
+// which is never stored and only reaches the invitee through this email.
+type InvitationEmailSendParam struct {
+	Email      string
+	TenantName string
+	Role       model.StaffRole
+	Token      string
+	ExpiresAt  time.Time
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type JobQueue interface {
+	// Enqueue stores a pending job. Database backed queues join the transaction in ctx,
+	// so a job enqueued inside RWTx becomes visible only when the transaction commits.
+	Enqueue(
+		ctx context.Context,
+		job *model.Job,
+	) error
+	// Dequeue claims due jobs and jobs whose lease has expired, and starts them.
+	// Database backed queues must be called inside RWTx.
+	Dequeue(
+		ctx context.Context,
+		query DequeueJobsQuery,
+	) (model.Jobs, error)
+	// Update records the result of a dequeued job, fenced by the attempt it was dequeued for,
+	// and returns JobLeaseExpiredErr when the lease has expired and the job has been dequeued again.
+	// Database backed queues must be called inside RWTx.
+	Update(
+		ctx context.Context,
+		job *model.Job,
+	) error
+}
+
+type DequeueJobsQuery struct {
+	Limit         uint64
+	LeaseDuration time.Duration
+	RequestTime   time.Time
+}
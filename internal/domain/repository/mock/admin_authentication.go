@@ -87,6 +87,20 @@ func (mr *MockAdminAuthenticationMockRecorder) CreateUser(ctx, param any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockAdminAuthentication)(nil).CreateUser), ctx, param)
 }
 
+// DeleteUser mocks base method.
+func (m *MockAdminAuthentication) DeleteUser(ctx context.Context, authUID, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, authUID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockAdminAuthenticationMockRecorder) DeleteUser(ctx, authUID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockAdminAuthentication)(nil).DeleteUser), ctx, authUID, email)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockAdminAuthentication) GetUserByEmail(ctx context.Context, email string) (*repository.AdminAuthenticationGetUserByEmailResult, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +130,20 @@ func (mr *MockAdminAuthenticationMockRecorder) StoreClaims(ctx, authUID, adminCl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreClaims", reflect.TypeOf((*MockAdminAuthentication)(nil).StoreClaims), ctx, authUID, adminClaims)
 }
 
+// UpdatePassword mocks base method.
+func (m *MockAdminAuthentication) UpdatePassword(ctx context.Context, authUID, email, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, authUID, email, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockAdminAuthenticationMockRecorder) UpdatePassword(ctx, authUID, email, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockAdminAuthentication)(nil).UpdatePassword), ctx, authUID, email, password)
+}
+
 // VerifyIDToken mocks base method.
 func (m *MockAdminAuthentication) VerifyIDToken(ctx context.Context, idToken string) (*model.AdminClaims, error) {
 	m.ctrl.T.Helper()
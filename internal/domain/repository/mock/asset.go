@@ -11,6 +11,7 @@ package mock_repository
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 	time "time"
 
@@ -42,6 +43,20 @@ func (m *MockAsset) EXPECT() *MockAssetMockRecorder {
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockAsset) Delete(ctx context.Context, path string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAssetMockRecorder) Delete(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAsset)(nil).Delete), ctx, path)
+}
+
 // GenerateReadURL mocks base method.
 func (m *MockAsset) GenerateReadURL(ctx context.Context, path string, requestTime time.Time) (string, error) {
 	m.ctrl.T.Helper()
@@ -58,16 +73,61 @@ func (mr *MockAssetMockRecorder) GenerateReadURL(ctx, path, requestTime any) *go
 }
 
 // GenerateWritePresignedURL mocks base method.
-func (m *MockAsset) GenerateWritePresignedURL(ctx context.Context, contentType model.ContentType, path string, expires time.Duration) (string, error) {
+func (m *MockAsset) GenerateWritePresignedURL(ctx context.Context, asset *model.Asset) (*model.AssetPresignedUpload, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateWritePresignedURL", ctx, contentType, path, expires)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GenerateWritePresignedURL", ctx, asset)
+	ret0, _ := ret[0].(*model.AssetPresignedUpload)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GenerateWritePresignedURL indicates an expected call of GenerateWritePresignedURL.
-func (mr *MockAssetMockRecorder) GenerateWritePresignedURL(ctx, contentType, path, expires any) *gomock.Call {
+func (mr *MockAssetMockRecorder) GenerateWritePresignedURL(ctx, asset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWritePresignedURL", reflect.TypeOf((*MockAsset)(nil).GenerateWritePresignedURL), ctx, asset)
+}
+
+// Head mocks base method.
+func (m *MockAsset) Head(ctx context.Context, path string) (*model.AssetObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Head", ctx, path)
+	ret0, _ := ret[0].(*model.AssetObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Head indicates an expected call of Head.
+func (mr *MockAssetMockRecorder) Head(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Head", reflect.TypeOf((*MockAsset)(nil).Head), ctx, path)
+}
+
+// NewReader mocks base method.
+func (m *MockAsset) NewReader(ctx context.Context, path string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewReader", ctx, path)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewReader indicates an expected call of NewReader.
+func (mr *MockAssetMockRecorder) NewReader(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewReader", reflect.TypeOf((*MockAsset)(nil).NewReader), ctx, path)
+}
+
+// NewWriter mocks base method.
+func (m *MockAsset) NewWriter(ctx context.Context, path string, contentType model.ContentType) (io.WriteCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewWriter", ctx, path, contentType)
+	ret0, _ := ret[0].(io.WriteCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewWriter indicates an expected call of NewWriter.
+func (mr *MockAssetMockRecorder) NewWriter(ctx, path, contentType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWritePresignedURL", reflect.TypeOf((*MockAsset)(nil).GenerateWritePresignedURL), ctx, contentType, path, expires)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWriter", reflect.TypeOf((*MockAsset)(nil).NewWriter), ctx, path, contentType)
 }
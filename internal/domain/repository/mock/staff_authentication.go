@@ -87,6 +87,48 @@ func (mr *MockStaffAuthenticationMockRecorder) CreateUser(ctx, param any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStaffAuthentication)(nil).CreateUser), ctx, param)
 }
 
+// DeleteUser mocks base method.
+func (m *MockStaffAuthentication) DeleteUser(ctx context.Context, authUID, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, authUID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockStaffAuthenticationMockRecorder) DeleteUser(ctx, authUID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStaffAuthentication)(nil).DeleteUser), ctx, authUID, email)
+}
+
+// DisableUser mocks base method.
+func (m *MockStaffAuthentication) DisableUser(ctx context.Context, authUID, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableUser", ctx, authUID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableUser indicates an expected call of DisableUser.
+func (mr *MockStaffAuthenticationMockRecorder) DisableUser(ctx, authUID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableUser", reflect.TypeOf((*MockStaffAuthentication)(nil).DisableUser), ctx, authUID, email)
+}
+
+// EnableUser mocks base method.
+func (m *MockStaffAuthentication) EnableUser(ctx context.Context, authUID, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableUser", ctx, authUID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableUser indicates an expected call of EnableUser.
+func (mr *MockStaffAuthenticationMockRecorder) EnableUser(ctx, authUID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUser", reflect.TypeOf((*MockStaffAuthentication)(nil).EnableUser), ctx, authUID, email)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockStaffAuthentication) GetUserByEmail(ctx context.Context, email string) (*repository.StaffAuthenticationGetUserByEmailResult, error) {
 	m.ctrl.T.Helper()
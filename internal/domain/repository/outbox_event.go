@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+)
+
+//go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type OutboxEvent interface {
+	List(
+		ctx context.Context,
+		query ListOutboxEventsQuery,
+	) (model.OutboxEvents, error)
+	BatchCreate(
+		ctx context.Context,
+		outboxEvents model.OutboxEvents,
+	) error
+	Update(
+		ctx context.Context,
+		outboxEvent *model.OutboxEvent,
+	) error
+}
+
+// ListOutboxEventsQuery lists outbox events oldest first.
+type ListOutboxEventsQuery struct {
+	BaseListOptions
+	Status            nullable.Type[model.OutboxEventStatus]
+	NextAttemptBefore null.Time // matches events due at or before the time
+}
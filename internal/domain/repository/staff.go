@@ -42,12 +42,24 @@ type Staff interface {
 
 type GetStaffQuery struct {
 	BaseGetOptions
-	ID      null.String
-	AuthUID null.String
+	ID             null.String
+	AuthUID        null.String
+	IncludeDeleted bool
 }
 
 type ListStaffQuery struct {
 	BaseListOptions
-	TenantID null.String
-	SortKey  nullable.Type[model.StaffSortKey]
+	TenantID       null.String
+	SortKey        nullable.Type[model.StaffSortKey]
+	IncludeDeleted bool
+	// DeletedBefore narrows the list to staffs soft deleted before the time.
+	// It is only meaningful together with IncludeDeleted.
+	DeletedBefore null.Time
+	// DisplayName narrows the list to staffs whose display name contains the text, ignoring case.
+	DisplayName null.String
+	Email       null.String
+	Role        nullable.Type[model.StaffRole]
+	// CreatedAtFrom and CreatedAtTo narrow the list to staffs created in [from, to).
+	CreatedAtFrom null.Time
+	CreatedAtTo   null.Time
 }
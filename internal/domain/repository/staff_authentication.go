@@ -26,6 +26,21 @@ type StaffAuthentication interface {
 		authUID string,
 		staffClaims *model.StaffClaims,
 	) error
+	DisableUser(
+		ctx context.Context,
+		authUID string,
+		email string,
+	) error
+	EnableUser(
+		ctx context.Context,
+		authUID string,
+		email string,
+	) error
+	DeleteUser(
+		ctx context.Context,
+		authUID string,
+		email string,
+	) error
 	CreateCustomToken(
 		ctx context.Context,
 		authUID string,
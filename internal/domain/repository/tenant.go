@@ -46,10 +46,22 @@ type Tenant interface {
 
 type GetTenantQuery struct {
 	BaseGetOptions
-	ID null.String
+	ID             null.String
+	IncludeDeleted bool
 }
 
 type ListTenantsQuery struct {
 	BaseListOptions
-	SortKey nullable.Type[model.TenantSortKey]
+	SortKey        nullable.Type[model.TenantSortKey]
+	IncludeDeleted bool
+	// DeletedBefore narrows the list to tenants soft deleted before the time.
+	// It is only meaningful together with IncludeDeleted.
+	DeletedBefore null.Time
+	// Name narrows the list to tenants whose name contains the text, ignoring case.
+	Name null.String
+	// CreatedAtFrom and CreatedAtTo narrow the list to tenants created in [from, to).
+	CreatedAtFrom null.Time
+	CreatedAtTo   null.Time
+	// TagTypes narrows the list to tenants tagged with any of the types.
+	TagTypes []model.TenantTagType
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
@@ -16,12 +17,42 @@ type Asset interface {
 		authContext model.AssetAuthContext,
 		requestTime time.Time,
 	) (*AssetCreatePresignedURLResult, error)
+	// Upload stores content as the object of a new pending asset, verifying it against its hex-encoded SHA-256.
+	// The object is abandoned when the content is too large or does not match the checksum,
+	// and the asset is confirmed with ConfirmUpload, which is not done here since the content is streamed outside a transaction.
+	Upload(
+		ctx context.Context,
+		assetType model.AssetType,
+		contentType model.ContentType,
+		checksum string,
+		content io.Reader,
+		authContext model.AssetAuthContext,
+		requestTime time.Time,
+	) (*model.Asset, error)
+	// Download returns the uploaded asset and its content, which the caller must close.
+	Download(
+		ctx context.Context,
+		assetID string,
+		authContext model.AssetAuthContext,
+	) (*AssetDownloadResult, error)
+	// ConfirmUpload checks the object of the asset is uploaded and records its size.
+	// It emits an event, so it must be called in a read-write transaction.
+	ConfirmUpload(
+		ctx context.Context,
+		assetID string,
+		authContext model.AssetAuthContext,
+		requestTime time.Time,
+	) (*model.Asset, error)
+	// GetWithValidate returns the path of an asset to attach to an entity, and marks the asset as attached.
+	// A pending asset is confirmed on the way, so its object must be uploaded,
+	// and it must be called in the read-write transaction binding the asset to the entity.
 	GetWithValidate(
 		ctx context.Context,
 		assetType model.AssetType,
 		assetID string,
 		authContext model.AssetAuthContext,
-	) (string, func(), error)
+		requestTime time.Time,
+	) (string, error)
 	BatchSetTenantURLs(
 		ctx context.Context,
 		tenants model.Tenants,
@@ -40,6 +71,11 @@ type Asset interface {
 }
 
 type AssetCreatePresignedURLResult struct {
-	AssetID      string
-	PresignedURL string
+	AssetID         string
+	PresignedUpload *model.AssetPresignedUpload
+}
+
+type AssetDownloadResult struct {
+	Asset   *model.Asset
+	Content io.ReadCloser
 }
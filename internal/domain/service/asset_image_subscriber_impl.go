@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	goerrors "errors"
+	"io"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type assetImageSubscriber struct {
+	assetRepository         repository.Asset
+	assetMetadataRepository repository.AssetMetadata
+	assetImageRepository    repository.AssetImage
+}
+
+// NewAssetImageSubscriber processes an uploaded image once its upload is confirmed.
+// The original is replaced with the image stripped of its metadata, and the variants are stored next to it.
+// An object not matching the content type of the asset is deleted and the asset orphaned, so that it is never served.
+func NewAssetImageSubscriber(
+	assetRepository repository.Asset,
+	assetMetadataRepository repository.AssetMetadata,
+	assetImageRepository repository.AssetImage,
+) DomainEventSubscriber {
+	return &assetImageSubscriber{
+		assetRepository,
+		assetMetadataRepository,
+		assetImageRepository,
+	}
+}
+
+func (s *assetImageSubscriber) Name() string {
+	return "asset_image"
+}
+
+func (s *assetImageSubscriber) Subscribes(
+	eventType model.DomainEventType,
+) bool {
+	return eventType == model.DomainEventTypeAssetUploaded
+}
+
+func (s *assetImageSubscriber) Handle(
+	ctx context.Context,
+	event *model.DomainEvent,
+) error {
+	asset, err := s.assetMetadataRepository.Get(ctx, repository.GetAssetMetadataQuery{
+		ID: null.StringFrom(event.AggregateID),
+	})
+	if err != nil {
+		return err
+	}
+	// The asset has been cleaned up since the event occurred, or is about to be.
+	if asset == nil || asset.Status == model.AssetStatusOrphaned {
+		return nil
+	}
+	policy := asset.Type.Policy()
+	if !policy.IsImage() {
+		return nil
+	}
+
+	data, err := s.read(ctx, asset.Path, policy.MaxSize)
+	if goerrors.Is(err, errors.AssetNotFoundErr) {
+		return nil
+	}
+	var processed *repository.ProcessedAssetImage
+	if err == nil {
+		processed, err = s.assetImageRepository.Process(ctx, asset.ContentType, data, policy.ImageVariants)
+	}
+	if goerrors.Is(err, errors.AssetInvalidErr) {
+		return s.reject(ctx, asset, event, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, variant := range policy.ImageVariants {
+		if err := s.write(ctx, variant.Path(asset.Path), asset.ContentType, processed.Variants[variant.Name]); err != nil {
+			return err
+		}
+	}
+	// The original is replaced last, so that a retry processes the image as uploaded
+	return s.write(ctx, asset.Path, asset.ContentType, processed.Original)
+}
+
+// reject orphans the asset and deletes its object.
+// An asset already attached is orphaned as well, leaving its entity with an image that is not served.
+func (s *assetImageSubscriber) reject(
+	ctx context.Context,
+	asset *model.Asset,
+	event *model.DomainEvent,
+	cause error,
+) error {
+	logger.L(ctx).Warn("uploaded image is rejected",
+		zap.String("asset_id", asset.ID),
+		zap.String("content_type", asset.ContentType.String()),
+		zap.Error(cause),
+	)
+	if err := s.assetMetadataRepository.Update(ctx, asset.Orphan(event.OccurredAt)); err != nil {
+		return err
+	}
+	return s.assetRepository.Delete(ctx, asset.Path)
+}
+
+func (s *assetImageSubscriber) read(
+	ctx context.Context,
+	path string,
+	maxSize int64,
+) ([]byte, error) {
+	reader, err := s.assetRepository.NewReader(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	// The size is checked on confirmation, and the limit guards against an object replaced since then
+	data, err := io.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, errors.AssetInvalidErr.New().
+			WithDetail("asset is too large").
+			WithValue("max_size", maxSize)
+	}
+	return data, nil
+}
+
+func (s *assetImageSubscriber) write(
+	ctx context.Context,
+	path string,
+	contentType model.ContentType,
+	data []byte,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	writer, err := s.assetRepository.NewWriter(ctx, path, contentType)
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(data); err != nil {
+		// cancelling abandons the object before it is closed
+		cancel()
+		_ = writer.Close()
+		return err
+	}
+	return writer.Close()
+}
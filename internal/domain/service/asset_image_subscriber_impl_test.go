@@ -0,0 +1,229 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// objectBuffer is an object writer keeping what is written until it is closed.
+// It is abandoned when ctx, the context it is created with, is cancelled before closing it.
+type objectBuffer struct {
+	bytes.Buffer
+	ctx       context.Context
+	closed    bool
+	abandoned bool
+}
+
+func (b *objectBuffer) Close() error {
+	b.closed = true
+	b.abandoned = b.ctx != nil && b.ctx.Err() != nil
+	return nil
+}
+
+func TestAssetImageSubscriber_Handle(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		event *model.DomainEvent
+	}
+
+	type want struct {
+		expectedResult error
+		// objects are the bytes expected to be written to the paths
+		objects map[string][]byte
+	}
+
+	type testcase struct {
+		args       args
+		subscriber DomainEventSubscriber
+		want       want
+		written    map[string]*objectBuffer
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	getQuery := func(assetID string) repository.GetAssetMetadataQuery {
+		return repository.GetAssetMetadataQuery{
+			ID: null.StringFrom(assetID),
+		}
+	}
+
+	tests := map[string]testcaseFunc{
+		"uploaded image is stored stripped with its variants": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			asset.Status = model.AssetStatusUploaded
+			uploaded := []byte("uploaded")
+			processed := &repository.ProcessedAssetImage{
+				Original: []byte("stripped"),
+				Variants: map[string][]byte{
+					"small":  []byte("small"),
+					"medium": []byte("medium"),
+				},
+			}
+
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset.ID)).
+				Return(asset, nil)
+			mockAssetRepo := mock_repository.NewMockAsset(ctrl)
+			mockAssetRepo.EXPECT().
+				NewReader(gomock.Any(), asset.Path).
+				Return(io.NopCloser(bytes.NewReader(uploaded)), nil)
+			mockAssetImageRepo := mock_repository.NewMockAssetImage(ctrl)
+			mockAssetImageRepo.EXPECT().
+				Process(gomock.Any(), asset.ContentType, uploaded, asset.Type.Policy().ImageVariants).
+				Return(processed, nil)
+			written := map[string]*objectBuffer{}
+			mockAssetRepo.EXPECT().
+				NewWriter(gomock.Any(), gomock.Any(), asset.ContentType).
+				DoAndReturn(func(_ context.Context, path string, _ model.ContentType) (io.WriteCloser, error) {
+					written[path] = &objectBuffer{}
+					return written[path], nil
+				}).
+				Times(3)
+
+			return testcase{
+				args: args{
+					event: model.NewAssetUploadedEvent(asset, testdata.RequestTime),
+				},
+				subscriber: &assetImageSubscriber{
+					assetRepository:         mockAssetRepo,
+					assetMetadataRepository: mockAssetMetadataRepo,
+					assetImageRepository:    mockAssetImageRepo,
+				},
+				want: want{
+					objects: map[string][]byte{
+						"private/user_images/mock.png":        []byte("stripped"),
+						"private/user_images/mock_small.png":  []byte("small"),
+						"private/user_images/mock_medium.png": []byte("medium"),
+					},
+				},
+				written: written,
+			}
+		},
+		"object not matching the content type is deleted": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			asset.Status = model.AssetStatusUploaded
+			uploaded := []byte("<html></html>")
+
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset.ID)).
+				Return(asset, nil)
+			mockAssetRepo := mock_repository.NewMockAsset(ctrl)
+			mockAssetRepo.EXPECT().
+				NewReader(gomock.Any(), asset.Path).
+				Return(io.NopCloser(bytes.NewReader(uploaded)), nil)
+			mockAssetImageRepo := mock_repository.NewMockAssetImage(ctrl)
+			mockAssetImageRepo.EXPECT().
+				Process(gomock.Any(), asset.ContentType, uploaded, asset.Type.Policy().ImageVariants).
+				Return(nil, errors.AssetInvalidErr.New())
+
+			orphaned := *asset
+			orphaned.Status = model.AssetStatusOrphaned
+			orphaned.UpdatedAt = testdata.RequestTime
+			mockAssetMetadataRepo.EXPECT().
+				Update(gomock.Any(), &orphaned).
+				Return(nil)
+			mockAssetRepo.EXPECT().
+				Delete(gomock.Any(), asset.Path).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					event: model.NewAssetUploadedEvent(asset, testdata.RequestTime),
+				},
+				subscriber: &assetImageSubscriber{
+					assetRepository:         mockAssetRepo,
+					assetMetadataRepository: mockAssetMetadataRepo,
+					assetImageRepository:    mockAssetImageRepo,
+				},
+				want: want{},
+			}
+		},
+		"deleted object is skipped": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			asset.Status = model.AssetStatusAttached
+
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset.ID)).
+				Return(asset, nil)
+			mockAssetRepo := mock_repository.NewMockAsset(ctrl)
+			mockAssetRepo.EXPECT().
+				NewReader(gomock.Any(), asset.Path).
+				Return(nil, errors.AssetNotFoundErr.New())
+
+			return testcase{
+				args: args{
+					event: model.NewAssetUploadedEvent(asset, testdata.RequestTime),
+				},
+				subscriber: &assetImageSubscriber{
+					assetRepository:         mockAssetRepo,
+					assetMetadataRepository: mockAssetMetadataRepo,
+					assetImageRepository:    mock_repository.NewMockAssetImage(ctrl),
+				},
+				want: want{},
+			}
+		},
+		"cleaned up asset is skipped": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset.ID)).
+				Return(nil, nil)
+
+			return testcase{
+				args: args{
+					event: model.NewAssetUploadedEvent(asset, testdata.RequestTime),
+				},
+				subscriber: &assetImageSubscriber{
+					assetRepository:         mock_repository.NewMockAsset(ctrl),
+					assetMetadataRepository: mockAssetMetadataRepo,
+					assetImageRepository:    mock_repository.NewMockAssetImage(ctrl),
+				},
+				want: want{},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			require.True(t, tc.subscriber.Subscribes(tc.args.event.Type))
+			err := tc.subscriber.Handle(ctx, tc.args.event)
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.want.expectedResult)
+			}
+			require.Len(t, tc.written, len(tc.want.objects))
+			for path, object := range tc.want.objects {
+				require.True(t, tc.written[path].closed)
+				require.Equal(t, object, tc.written[path].Bytes())
+			}
+		})
+	}
+}
@@ -2,25 +2,38 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
 )
 
 type assetService struct {
-	assetRepository repository.Asset
-	assetPathCache  cache.AssetPath
+	assetRepository         repository.Asset
+	assetMetadataRepository repository.AssetMetadata
+	outboxEventRepository   repository.OutboxEvent
+	assetPathCache          cache.AssetPath
 }
 
 func NewAsset(
 	assetRepository repository.Asset,
+	assetMetadataRepository repository.AssetMetadata,
+	outboxEventRepository repository.OutboxEvent,
 	assetPathCache cache.AssetPath,
 ) Asset {
 	return &assetService{
-		assetRepository: assetRepository,
-		assetPathCache:  assetPathCache,
+		assetRepository:         assetRepository,
+		assetMetadataRepository: assetMetadataRepository,
+		outboxEventRepository:   outboxEventRepository,
+		assetPathCache:          assetPathCache,
 	}
 }
 
@@ -31,22 +44,23 @@ func (s *assetService) CreatePresignedURL(
 	authContext model.AssetAuthContext,
 	requestTime time.Time,
 ) (*AssetCreatePresignedURLResult, error) {
+	if err := model.ValidateAssetContentType(assetType, contentType); err != nil {
+		return nil, err
+	}
 	asset := model.NewAsset(
 		assetType,
 		contentType,
 		authContext,
 		requestTime,
 	)
-	presignedURL, err := s.assetRepository.GenerateWritePresignedURL(
+	presignedUpload, err := s.assetRepository.GenerateWritePresignedURL(
 		ctx,
-		contentType,
-		asset.Path,
-		asset.Expiration(),
+		asset,
 	)
 	if err != nil {
 		return nil, err
 	}
-	if err := s.assetPathCache.Set(
+	if err := s.assetMetadataRepository.Create(
 		ctx,
 		asset,
 	); err != nil {
@@ -54,29 +68,214 @@ func (s *assetService) CreatePresignedURL(
 	}
 
 	return &AssetCreatePresignedURLResult{
-		AssetID:      asset.ID,
-		PresignedURL: presignedURL,
+		AssetID:         asset.ID,
+		PresignedUpload: presignedUpload,
+	}, nil
+}
+
+func (s *assetService) Upload(
+	ctx context.Context,
+	assetType model.AssetType,
+	contentType model.ContentType,
+	checksum string,
+	content io.Reader,
+	authContext model.AssetAuthContext,
+	requestTime time.Time,
+) (*model.Asset, error) {
+	if err := model.ValidateAssetContentType(assetType, contentType); err != nil {
+		return nil, err
+	}
+	asset := model.NewAsset(
+		assetType,
+		contentType,
+		authContext,
+		requestTime,
+	)
+	// The asset is recorded first, so that the cleanup task deletes an object stored but never confirmed
+	if err := s.assetMetadataRepository.Create(
+		ctx,
+		asset,
+	); err != nil {
+		return nil, err
+	}
+	if err := s.write(ctx, asset, checksum, content); err != nil {
+		return nil, err
+	}
+	return asset, nil
+}
+
+// write streams content to the object of asset, which is stored only when it fits the policy and matches checksum.
+func (s *assetService) write(
+	ctx context.Context,
+	asset *model.Asset,
+	checksum string,
+	content io.Reader,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	writer, err := s.assetRepository.NewWriter(ctx, asset.Path, asset.ContentType)
+	if err != nil {
+		return err
+	}
+	// cancelling abandons the object before it is closed
+	abandon := func(err error) error {
+		cancel()
+		_ = writer.Close()
+		return err
+	}
+
+	maxSize := asset.Type.Policy().MaxSize
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(writer, hash), io.LimitReader(content, maxSize+1))
+	if err != nil {
+		return abandon(err)
+	}
+	if size > maxSize {
+		return abandon(errors.AssetInvalidErr.New().
+			WithDetail("asset is too large").
+			WithValue("max_size", maxSize))
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != strings.ToLower(checksum) {
+		return abandon(errors.AssetInvalidErr.New().
+			WithDetail("asset content does not match the checksum").
+			WithValue("checksum", checksum).
+			WithValue("sha256", sum))
+	}
+	return writer.Close()
+}
+
+func (s *assetService) Download(
+	ctx context.Context,
+	assetID string,
+	authContext model.AssetAuthContext,
+) (*AssetDownloadResult, error) {
+	asset, err := s.getAsset(ctx, assetID, authContext, false)
+	if err != nil {
+		return nil, err
+	}
+	switch asset.Status {
+	case model.AssetStatusUploaded, model.AssetStatusAttached:
+	case model.AssetStatusPending:
+		return nil, errors.AssetNotUploadedErr.New().
+			WithValue("asset_id", asset.ID)
+	default:
+		return nil, errors.AssetNotFoundErr.New().
+			WithValue("asset_id", asset.ID)
+	}
+	content, err := s.assetRepository.NewReader(ctx, asset.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &AssetDownloadResult{
+		Asset:   asset,
+		Content: content,
 	}, nil
 }
 
+func (s *assetService) ConfirmUpload(
+	ctx context.Context,
+	assetID string,
+	authContext model.AssetAuthContext,
+	requestTime time.Time,
+) (*model.Asset, error) {
+	asset, err := s.getAsset(ctx, assetID, authContext, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.confirmUpload(ctx, asset, requestTime); err != nil {
+		return nil, err
+	}
+	// the path is cached until the asset is attached
+	if err := s.assetPathCache.Set(ctx, asset); err != nil {
+		return nil, err
+	}
+	return asset, nil
+}
+
 func (s *assetService) GetWithValidate(
 	ctx context.Context,
 	assetType model.AssetType,
 	assetID string,
 	authContext model.AssetAuthContext,
-) (string, func(), error) {
-	noop := func() {}
-	got, err := s.assetPathCache.Get(ctx, assetID, authContext)
+	requestTime time.Time,
+) (string, error) {
+	// The asset is read locked rather than from the cache, which cannot tell whether
+	// a concurrent request has attached it, so that it is attached only once.
+	asset, err := s.getAsset(ctx, assetID, authContext, true)
 	if err != nil {
-		return "", noop, err
+		return "", err
 	}
-	if err := model.ValidateAssetPath(assetType, got); err != nil {
-		return "", noop, err
+	if err := s.confirmUpload(ctx, asset, requestTime); err != nil {
+		return "", err
 	}
-	clearCache := func() {
-		_ = s.assetPathCache.Clear(ctx, assetID)
+	if err := model.ValidateAssetPath(assetType, asset.Path); err != nil {
+		return "", err
 	}
-	return got, clearCache, nil
+	if err := s.assetMetadataRepository.Update(ctx, asset.Attach(requestTime)); err != nil {
+		return "", err
+	}
+	// cleared in the transaction, so that no process serves the path of the attached asset
+	if err := s.assetPathCache.Clear(ctx, asset.ID); err != nil {
+		return "", err
+	}
+	return asset.Path, nil
+}
+
+func (s *assetService) getAsset(
+	ctx context.Context,
+	assetID string,
+	authContext model.AssetAuthContext,
+	forUpdate bool,
+) (*model.Asset, error) {
+	return s.assetMetadataRepository.Get(ctx, repository.GetAssetMetadataQuery{
+		BaseGetOptions: repository.BaseGetOptions{
+			OrFail:    true,
+			ForUpdate: forUpdate,
+		},
+		ID:          null.StringFrom(assetID),
+		AuthContext: nullable.TypeFrom(authContext),
+	})
+}
+
+// confirmUpload heads the object of a pending asset and records it,
+// emitting the event the object is processed on after the commit.
+// Confirming an uploaded asset again is a no-op, and an attached or orphaned asset is already used.
+func (s *assetService) confirmUpload(
+	ctx context.Context,
+	asset *model.Asset,
+	requestTime time.Time,
+) error {
+	switch asset.Status {
+	case model.AssetStatusPending:
+	case model.AssetStatusUploaded:
+		return nil
+	default:
+		return errors.AssetInvalidErr.New().
+			WithDetail("asset is already used").
+			WithValue("status", asset.Status.String())
+	}
+	if asset.IsExpired(requestTime) {
+		return errors.AssetNotFoundErr.New().
+			WithDetail("asset upload has expired").
+			WithValue("asset_id", asset.ID)
+	}
+	object, err := s.assetRepository.Head(ctx, asset.Path)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		return errors.AssetNotUploadedErr.New().
+			WithValue("asset_id", asset.ID)
+	}
+	if err := asset.ConfirmUpload(object, requestTime); err != nil {
+		return err
+	}
+	if err := s.assetMetadataRepository.Update(ctx, asset); err != nil {
+		return err
+	}
+	return s.outboxEventRepository.BatchCreate(ctx, model.NewOutboxEvents(
+		model.NewAssetUploadedEvent(asset, requestTime),
+	))
 }
 
 func (s *assetService) BatchSetStaffURLs(
@@ -95,6 +294,19 @@ func (s *assetService) BatchSetStaffURLs(
 				return err
 			}
 			staff.SetImageURL(imageURL)
+
+			// The variants are generated once the image is processed after its upload
+			for _, variant := range model.AssetTypeUserImage.Policy().ImageVariants {
+				variantURL, err := s.assetRepository.GenerateReadURL(
+					ctx,
+					variant.Path(staff.ImagePath),
+					requestTime,
+				)
+				if err != nil {
+					return err
+				}
+				staff.SetImageVariantURL(variant.Name, variantURL)
+			}
 		}
 
 		if staff.ReadonlyReference != nil && staff.ReadonlyReference.Tenant != nil {
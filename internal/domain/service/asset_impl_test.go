@@ -1,15 +1,25 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	mock_cache "github.com/abyssparanoia/rapid-go/internal/domain/cache/mock"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
 	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -45,21 +55,24 @@ func TestAssetService_CreatePresignedURL(t *testing.T) {
 			testdata := factory.NewFactory()
 			asset := testdata.Asset
 			asset.ID = mockID
-			presignedURL := "presignedURL"
+			presignedUpload := &model.AssetPresignedUpload{
+				Method:  http.MethodPut,
+				URL:     "presignedURL",
+				Fields:  map[string]string{},
+				Headers: map[string]string{},
+			}
 			requestTime := testdata.RequestTime
 
 			mockAssetRepo := mock_repository.NewMockAsset(ctrl)
-			mockAssetPathCache := mock_cache.NewMockAssetPath(ctrl)
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
 			mockAssetRepo.EXPECT().
 				GenerateWritePresignedURL(
 					gomock.Any(),
-					asset.ContentType,
-					asset.Path,
-					asset.Expiration(),
+					asset,
 				).
-				Return(presignedURL, nil)
-			mockAssetPathCache.EXPECT().
-				Set(
+				Return(presignedUpload, nil)
+			mockAssetMetadataRepo.EXPECT().
+				Create(
 					gomock.Any(),
 					asset,
 				).
@@ -73,17 +86,34 @@ func TestAssetService_CreatePresignedURL(t *testing.T) {
 					requestTime: requestTime,
 				},
 				service: &assetService{
-					assetRepository: mockAssetRepo,
-					assetPathCache:  mockAssetPathCache,
+					assetRepository:         mockAssetRepo,
+					assetMetadataRepository: mockAssetMetadataRepo,
 				},
 				want: want{
 					got: &AssetCreatePresignedURLResult{
-						AssetID:      asset.ID,
-						PresignedURL: presignedURL,
+						AssetID:         asset.ID,
+						PresignedUpload: presignedUpload,
 					},
 				},
 			}
 		},
+		"content type not allowed for the asset type": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+
+			return testcase{
+				args: args{
+					assetType:   asset.Type,
+					contentType: model.ContentTypeApplicationPDF,
+					authContext: asset.AuthContext,
+					requestTime: testdata.RequestTime,
+				},
+				service: &assetService{},
+				want: want{
+					expectedResult: errors.AssetInvalidErr,
+				},
+			}
+		},
 	}
 
 	for name, tc := range tests {
@@ -105,3 +135,742 @@ func TestAssetService_CreatePresignedURL(t *testing.T) {
 		})
 	}
 }
+
+func TestAssetService_Upload(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		assetType   model.AssetType
+		contentType model.ContentType
+		checksum    string
+		content     io.Reader
+		authContext model.AssetAuthContext
+		requestTime time.Time
+	}
+
+	type want struct {
+		got            *model.Asset
+		expectedResult error
+		// content is what the object is stored with, nil when it is abandoned
+		content []byte
+	}
+
+	type testcase struct {
+		args    args
+		service Asset
+		want    want
+		written *objectBuffer
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	checksum := func(content []byte) string {
+		sum := sha256.Sum256(content)
+		return hex.EncodeToString(sum[:])
+	}
+
+	newTestcase := func(ctrl *gomock.Controller, content []byte, sum string, want want) testcase {
+		mockID := id.Mock()
+		uuid.MockUUIDBase64()
+		testdata := factory.NewFactory()
+		asset := testdata.Asset
+		asset.ID = mockID
+
+		mockAssetRepo := mock_repository.NewMockAsset(ctrl)
+		mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+		mockAssetMetadataRepo.EXPECT().
+			Create(gomock.Any(), asset).
+			Return(nil)
+		written := &objectBuffer{}
+		mockAssetRepo.EXPECT().
+			NewWriter(gomock.Any(), asset.Path, asset.ContentType).
+			DoAndReturn(func(ctx context.Context, _ string, _ model.ContentType) (io.WriteCloser, error) {
+				written.ctx = ctx
+				return written, nil
+			})
+		if want.expectedResult == nil {
+			want.got = asset
+		}
+
+		return testcase{
+			args: args{
+				assetType:   asset.Type,
+				contentType: asset.ContentType,
+				checksum:    sum,
+				content:     bytes.NewReader(content),
+				authContext: asset.AuthContext,
+				requestTime: testdata.RequestTime,
+			},
+			service: &assetService{
+				assetRepository:         mockAssetRepo,
+				assetMetadataRepository: mockAssetMetadataRepo,
+			},
+			want:    want,
+			written: written,
+		}
+	}
+
+	tests := map[string]testcaseFunc{
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			content := []byte("content")
+			return newTestcase(ctrl, content, checksum(content), want{
+				content: content,
+			})
+		},
+		"checksum in upper case": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			content := []byte("content")
+			return newTestcase(ctrl, content, strings.ToUpper(checksum(content)), want{
+				content: content,
+			})
+		},
+		"content not matching the checksum is abandoned": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return newTestcase(ctrl, []byte("content"), checksum([]byte("other")), want{
+				expectedResult: errors.AssetInvalidErr,
+			})
+		},
+		"content larger than the max size is abandoned": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			content := bytes.Repeat([]byte("a"), int(model.AssetTypeUserImage.Policy().MaxSize)+1)
+			return newTestcase(ctrl, content, checksum(content), want{
+				expectedResult: errors.AssetInvalidErr,
+			})
+		},
+		"content type not allowed for the asset type": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+
+			return testcase{
+				args: args{
+					assetType:   asset.Type,
+					contentType: model.ContentTypeApplicationPDF,
+					checksum:    checksum(nil),
+					content:     bytes.NewReader(nil),
+					authContext: asset.AuthContext,
+					requestTime: testdata.RequestTime,
+				},
+				service: &assetService{},
+				want: want{
+					expectedResult: errors.AssetInvalidErr,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.service.Upload(ctx, tc.args.assetType, tc.args.contentType, tc.args.checksum, tc.args.content, tc.args.authContext, tc.args.requestTime)
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.got, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+			if tc.written != nil {
+				require.True(t, tc.written.closed)
+				require.Equal(t, tc.want.content == nil, tc.written.abandoned)
+				if tc.want.content != nil {
+					require.Equal(t, tc.want.content, tc.written.Bytes())
+				}
+			}
+		})
+	}
+}
+
+func TestAssetService_Download(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		assetID     string
+		authContext model.AssetAuthContext
+	}
+
+	type want struct {
+		got            *AssetDownloadResult
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		service Asset
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	getQuery := func(asset *model.Asset) repository.GetAssetMetadataQuery {
+		return repository.GetAssetMetadataQuery{
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail: true,
+			},
+			ID:          null.StringFrom(asset.ID),
+			AuthContext: nullable.TypeFrom(asset.AuthContext),
+		}
+	}
+
+	tests := map[string]testcaseFunc{
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			asset.Status = model.AssetStatusAttached
+			content := io.NopCloser(bytes.NewReader([]byte("content")))
+
+			mockAssetRepo := mock_repository.NewMockAsset(ctrl)
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset)).
+				Return(asset, nil)
+			mockAssetRepo.EXPECT().
+				NewReader(gomock.Any(), asset.Path).
+				Return(content, nil)
+
+			return testcase{
+				args: args{
+					assetID:     asset.ID,
+					authContext: asset.AuthContext,
+				},
+				service: &assetService{
+					assetRepository:         mockAssetRepo,
+					assetMetadataRepository: mockAssetMetadataRepo,
+				},
+				want: want{
+					got: &AssetDownloadResult{
+						Asset:   asset,
+						Content: content,
+					},
+				},
+			}
+		},
+		"pending asset is not uploaded": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset)).
+				Return(asset, nil)
+
+			return testcase{
+				args: args{
+					assetID:     asset.ID,
+					authContext: asset.AuthContext,
+				},
+				service: &assetService{
+					assetMetadataRepository: mockAssetMetadataRepo,
+				},
+				want: want{
+					expectedResult: errors.AssetNotUploadedErr,
+				},
+			}
+		},
+		"orphaned asset is not found": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			asset.Status = model.AssetStatusOrphaned
+
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset)).
+				Return(asset, nil)
+
+			return testcase{
+				args: args{
+					assetID:     asset.ID,
+					authContext: asset.AuthContext,
+				},
+				service: &assetService{
+					assetMetadataRepository: mockAssetMetadataRepo,
+				},
+				want: want{
+					expectedResult: errors.AssetNotFoundErr,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.service.Download(ctx, tc.args.assetID, tc.args.authContext)
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.got, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
+
+func TestAssetService_ConfirmUpload(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		assetID     string
+		authContext model.AssetAuthContext
+		requestTime time.Time
+	}
+
+	type want struct {
+		got            *model.Asset
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		service Asset
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	getQuery := func(asset *model.Asset) repository.GetAssetMetadataQuery {
+		return repository.GetAssetMetadataQuery{
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail: true,
+			},
+			ID:          null.StringFrom(asset.ID),
+			AuthContext: nullable.TypeFrom(asset.AuthContext),
+		}
+	}
+
+	tests := map[string]testcaseFunc{
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			requestTime := testdata.RequestTime
+			object := &model.AssetObject{
+				Path: asset.Path,
+				Size: 1024,
+			}
+
+			mockAssetRepo := mock_repository.NewMockAsset(ctrl)
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetPathCache := mock_cache.NewMockAssetPath(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset)).
+				Return(asset, nil)
+			mockAssetRepo.EXPECT().
+				Head(gomock.Any(), asset.Path).
+				Return(object, nil)
+			mockAssetMetadataRepo.EXPECT().
+				Update(gomock.Any(), asset).
+				Return(nil)
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewAssetUploadedEvent(asset, requestTime),
+				)).
+				Return(nil)
+			mockAssetPathCache.EXPECT().
+				Set(gomock.Any(), asset).
+				Return(nil)
+
+			confirmed := *asset
+			confirmed.Status = model.AssetStatusUploaded
+			confirmed.Size = null.Int64From(object.Size)
+			confirmed.UploadedAt = null.TimeFrom(requestTime)
+			confirmed.UpdatedAt = requestTime
+
+			return testcase{
+				args: args{
+					assetID:     asset.ID,
+					authContext: asset.AuthContext,
+					requestTime: requestTime,
+				},
+				service: &assetService{
+					assetRepository:         mockAssetRepo,
+					assetMetadataRepository: mockAssetMetadataRepo,
+					outboxEventRepository:   mockOutboxEventRepo,
+					assetPathCache:          mockAssetPathCache,
+				},
+				want: want{
+					got: &confirmed,
+				},
+			}
+		},
+		"not uploaded": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+
+			mockAssetRepo := mock_repository.NewMockAsset(ctrl)
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset)).
+				Return(asset, nil)
+			mockAssetRepo.EXPECT().
+				Head(gomock.Any(), asset.Path).
+				Return(nil, nil)
+
+			return testcase{
+				args: args{
+					assetID:     asset.ID,
+					authContext: asset.AuthContext,
+					requestTime: testdata.RequestTime,
+				},
+				service: &assetService{
+					assetRepository:         mockAssetRepo,
+					assetMetadataRepository: mockAssetMetadataRepo,
+				},
+				want: want{
+					expectedResult: errors.AssetNotUploadedErr,
+				},
+			}
+		},
+		"too large": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+
+			mockAssetRepo := mock_repository.NewMockAsset(ctrl)
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset)).
+				Return(asset, nil)
+			mockAssetRepo.EXPECT().
+				Head(gomock.Any(), asset.Path).
+				Return(&model.AssetObject{Path: asset.Path, Size: asset.Type.Policy().MaxSize + 1}, nil)
+
+			return testcase{
+				args: args{
+					assetID:     asset.ID,
+					authContext: asset.AuthContext,
+					requestTime: testdata.RequestTime,
+				},
+				service: &assetService{
+					assetRepository:         mockAssetRepo,
+					assetMetadataRepository: mockAssetMetadataRepo,
+				},
+				want: want{
+					expectedResult: errors.AssetInvalidErr,
+				},
+			}
+		},
+		"expired": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset)).
+				Return(asset, nil)
+
+			return testcase{
+				args: args{
+					assetID:     asset.ID,
+					authContext: asset.AuthContext,
+					requestTime: asset.ExpiresAt,
+				},
+				service: &assetService{
+					assetMetadataRepository: mockAssetMetadataRepo,
+				},
+				want: want{
+					expectedResult: errors.AssetNotFoundErr,
+				},
+			}
+		},
+		"already attached": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			asset.Status = model.AssetStatusAttached
+
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset)).
+				Return(asset, nil)
+
+			return testcase{
+				args: args{
+					assetID:     asset.ID,
+					authContext: asset.AuthContext,
+					requestTime: testdata.RequestTime,
+				},
+				service: &assetService{
+					assetMetadataRepository: mockAssetMetadataRepo,
+				},
+				want: want{
+					expectedResult: errors.AssetInvalidErr,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.service.ConfirmUpload(ctx, tc.args.assetID, tc.args.authContext, tc.args.requestTime)
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.got, got)
+			} else {
+				require.ErrorIs(t, err, tc.want.expectedResult)
+			}
+		})
+	}
+}
+
+func TestAssetService_GetWithValidate(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		assetType   model.AssetType
+		assetID     string
+		authContext model.AssetAuthContext
+		requestTime time.Time
+	}
+
+	type want struct {
+		got            string
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		service Asset
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	getQuery := func(asset *model.Asset) repository.GetAssetMetadataQuery {
+		return repository.GetAssetMetadataQuery{
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail:    true,
+				ForUpdate: true,
+			},
+			ID:          null.StringFrom(asset.ID),
+			AuthContext: nullable.TypeFrom(asset.AuthContext),
+		}
+	}
+
+	tests := map[string]testcaseFunc{
+		"uploaded asset is attached": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			asset.Status = model.AssetStatusUploaded
+			attached := &model.Asset{} //nolint:exhaustruct
+			factory.CloneValue(asset, attached)
+			attached.Attach(testdata.RequestTime)
+
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset)).
+				Return(asset, nil)
+			mockAssetMetadataRepo.EXPECT().
+				Update(gomock.Any(), attached).
+				Return(nil)
+			mockAssetPathCache := mock_cache.NewMockAssetPath(ctrl)
+			mockAssetPathCache.EXPECT().
+				Clear(gomock.Any(), asset.ID).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					assetType:   asset.Type,
+					assetID:     asset.ID,
+					authContext: asset.AuthContext,
+					requestTime: testdata.RequestTime,
+				},
+				service: &assetService{
+					assetMetadataRepository: mockAssetMetadataRepo,
+					assetPathCache:          mockAssetPathCache,
+				},
+				want: want{
+					got: asset.Path,
+				},
+			}
+		},
+		"pending asset is confirmed": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+
+			mockAssetRepo := mock_repository.NewMockAsset(ctrl)
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset)).
+				Return(asset, nil)
+			mockAssetRepo.EXPECT().
+				Head(gomock.Any(), asset.Path).
+				Return(&model.AssetObject{Path: asset.Path, Size: 1024}, nil)
+			mockAssetMetadataRepo.EXPECT().
+				Update(gomock.Any(), asset).
+				Return(nil).
+				Times(2)
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewAssetUploadedEvent(asset, testdata.RequestTime),
+				)).
+				Return(nil)
+			mockAssetPathCache := mock_cache.NewMockAssetPath(ctrl)
+			mockAssetPathCache.EXPECT().
+				Clear(gomock.Any(), asset.ID).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					assetType:   asset.Type,
+					assetID:     asset.ID,
+					authContext: asset.AuthContext,
+					requestTime: testdata.RequestTime,
+				},
+				service: &assetService{
+					assetRepository:         mockAssetRepo,
+					assetMetadataRepository: mockAssetMetadataRepo,
+					outboxEventRepository:   mockOutboxEventRepo,
+					assetPathCache:          mockAssetPathCache,
+				},
+				want: want{
+					got: asset.Path,
+				},
+			}
+		},
+		"already attached": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			asset.Status = model.AssetStatusAttached
+
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			mockAssetMetadataRepo.EXPECT().
+				Get(gomock.Any(), getQuery(asset)).
+				Return(asset, nil)
+
+			return testcase{
+				args: args{
+					assetType:   asset.Type,
+					assetID:     asset.ID,
+					authContext: asset.AuthContext,
+					requestTime: testdata.RequestTime,
+				},
+				service: &assetService{
+					assetMetadataRepository: mockAssetMetadataRepo,
+				},
+				want: want{
+					expectedResult: errors.AssetInvalidErr,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.service.GetWithValidate(ctx, tc.args.assetType, tc.args.assetID, tc.args.authContext, tc.args.requestTime)
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.got, got)
+			} else {
+				require.ErrorIs(t, err, tc.want.expectedResult)
+			}
+		})
+	}
+}
+
+func TestAssetService_BatchSetStaffURLs(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		imageURL         null.String
+		imageVariantURLs map[string]string
+	}
+
+	type testcase struct {
+		staff       *model.Staff
+		requestTime time.Time
+		service     Asset
+		want        want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"image and its variants": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			staff.ImagePath = "private/user_images/staff.png"
+			staff.ImageURL = null.String{}
+			staff.ImageVariantURLs = nil
+			staff.ReadonlyReference = nil
+
+			mockAssetRepo := mock_repository.NewMockAsset(ctrl)
+			for _, path := range []string{
+				"private/user_images/staff.png",
+				"private/user_images/staff_small.png",
+				"private/user_images/staff_medium.png",
+			} {
+				mockAssetRepo.EXPECT().
+					GenerateReadURL(gomock.Any(), path, testdata.RequestTime).
+					Return("https://example.com/"+path, nil)
+			}
+
+			return testcase{
+				staff:       staff,
+				requestTime: testdata.RequestTime,
+				service: &assetService{
+					assetRepository: mockAssetRepo,
+				},
+				want: want{
+					imageURL: null.StringFrom("https://example.com/private/user_images/staff.png"),
+					imageVariantURLs: map[string]string{
+						"small":  "https://example.com/private/user_images/staff_small.png",
+						"medium": "https://example.com/private/user_images/staff_medium.png",
+					},
+				},
+			}
+		},
+		"no image": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			staff.ImagePath = ""
+			staff.ImageURL = null.String{}
+			staff.ImageVariantURLs = nil
+			staff.ReadonlyReference = nil
+
+			return testcase{
+				staff:       staff,
+				requestTime: testdata.RequestTime,
+				service: &assetService{
+					assetRepository: mock_repository.NewMockAsset(ctrl),
+				},
+				want: want{},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			require.NoError(t, tc.service.BatchSetStaffURLs(ctx, model.Staffs{tc.staff}, tc.requestTime))
+			require.Equal(t, tc.want.imageURL, tc.staff.ImageURL)
+			require.Equal(t, tc.want.imageVariantURLs, tc.staff.ImageVariantURLs)
+		})
+	}
+}
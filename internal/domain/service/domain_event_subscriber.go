@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
+// DomainEventSubscriber handles domain events delivered by the outbox relay.
+// Events are delivered at least once, so Handle must be idempotent.
+//
+//go:generate go tool go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type DomainEventSubscriber interface {
+	Name() string
+	Subscribes(
+		eventType model.DomainEventType,
+	) bool
+	Handle(
+		ctx context.Context,
+		event *model.DomainEvent,
+	) error
+}
@@ -11,6 +11,7 @@ package mock_service
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 	time "time"
 
@@ -85,6 +86,21 @@ func (mr *MockAssetMockRecorder) BatchSetTenantURLs(ctx, tenants, requestTime an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSetTenantURLs", reflect.TypeOf((*MockAsset)(nil).BatchSetTenantURLs), ctx, tenants, requestTime)
 }
 
+// ConfirmUpload mocks base method.
+func (m *MockAsset) ConfirmUpload(ctx context.Context, assetID string, authContext model.AssetAuthContext, requestTime time.Time) (*model.Asset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmUpload", ctx, assetID, authContext, requestTime)
+	ret0, _ := ret[0].(*model.Asset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmUpload indicates an expected call of ConfirmUpload.
+func (mr *MockAssetMockRecorder) ConfirmUpload(ctx, assetID, authContext, requestTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmUpload", reflect.TypeOf((*MockAsset)(nil).ConfirmUpload), ctx, assetID, authContext, requestTime)
+}
+
 // CreatePresignedURL mocks base method.
 func (m *MockAsset) CreatePresignedURL(ctx context.Context, assetType model.AssetType, contentType model.ContentType, authContext model.AssetAuthContext, requestTime time.Time) (*service.AssetCreatePresignedURLResult, error) {
 	m.ctrl.T.Helper()
@@ -100,18 +116,47 @@ func (mr *MockAssetMockRecorder) CreatePresignedURL(ctx, assetType, contentType,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePresignedURL", reflect.TypeOf((*MockAsset)(nil).CreatePresignedURL), ctx, assetType, contentType, authContext, requestTime)
 }
 
+// Download mocks base method.
+func (m *MockAsset) Download(ctx context.Context, assetID string, authContext model.AssetAuthContext) (*service.AssetDownloadResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, assetID, authContext)
+	ret0, _ := ret[0].(*service.AssetDownloadResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockAssetMockRecorder) Download(ctx, assetID, authContext any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockAsset)(nil).Download), ctx, assetID, authContext)
+}
+
 // GetWithValidate mocks base method.
-func (m *MockAsset) GetWithValidate(ctx context.Context, assetType model.AssetType, assetID string, authContext model.AssetAuthContext) (string, func(), error) {
+func (m *MockAsset) GetWithValidate(ctx context.Context, assetType model.AssetType, assetID string, authContext model.AssetAuthContext, requestTime time.Time) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWithValidate", ctx, assetType, assetID, authContext)
+	ret := m.ctrl.Call(m, "GetWithValidate", ctx, assetType, assetID, authContext, requestTime)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(func())
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // GetWithValidate indicates an expected call of GetWithValidate.
-func (mr *MockAssetMockRecorder) GetWithValidate(ctx, assetType, assetID, authContext any) *gomock.Call {
+func (mr *MockAssetMockRecorder) GetWithValidate(ctx, assetType, assetID, authContext, requestTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithValidate", reflect.TypeOf((*MockAsset)(nil).GetWithValidate), ctx, assetType, assetID, authContext, requestTime)
+}
+
+// Upload mocks base method.
+func (m *MockAsset) Upload(ctx context.Context, assetType model.AssetType, contentType model.ContentType, checksum string, content io.Reader, authContext model.AssetAuthContext, requestTime time.Time) (*model.Asset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, assetType, contentType, checksum, content, authContext, requestTime)
+	ret0, _ := ret[0].(*model.Asset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAssetMockRecorder) Upload(ctx, assetType, contentType, checksum, content, authContext, requestTime any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithValidate", reflect.TypeOf((*MockAsset)(nil).GetWithValidate), ctx, assetType, assetID, authContext)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAsset)(nil).Upload), ctx, assetType, contentType, checksum, content, authContext, requestTime)
 }
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+)
+
+type staffAuthenticationSubscriber struct {
+	staffRepository               repository.Staff
+	staffAuthenticationRepository repository.StaffAuthentication
+}
+
+// NewStaffAuthenticationSubscriber keeps the auth provider in sync with staffs.
+// It reads the current staff instead of trusting the event payload,
+// so redelivered or out of order events converge to the latest state.
+func NewStaffAuthenticationSubscriber(
+	staffRepository repository.Staff,
+	staffAuthenticationRepository repository.StaffAuthentication,
+) DomainEventSubscriber {
+	return &staffAuthenticationSubscriber{
+		staffRepository,
+		staffAuthenticationRepository,
+	}
+}
+
+func (s *staffAuthenticationSubscriber) Name() string {
+	return "staff_authentication"
+}
+
+func (s *staffAuthenticationSubscriber) Subscribes(
+	eventType model.DomainEventType,
+) bool {
+	switch eventType { //nolint:exhaustive // other events are not subscribed
+	case model.DomainEventTypeStaffRoleChanged,
+		model.DomainEventTypeStaffDeleted,
+		model.DomainEventTypeStaffRestored:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *staffAuthenticationSubscriber) Handle(
+	ctx context.Context,
+	event *model.DomainEvent,
+) error {
+	staff, err := s.staffRepository.Get(ctx, repository.GetStaffQuery{
+		ID:             null.StringFrom(event.AggregateID),
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return err
+	}
+	// The staff has been purged since the event occurred.
+	if !staff.Exist() {
+		return nil
+	}
+
+	switch event.Type { //nolint:exhaustive // only subscribed events are delivered
+	case model.DomainEventTypeStaffRoleChanged:
+		claims := model.NewStaffClaims(
+			staff.AuthUID,
+			staff.Email,
+			null.StringFrom(staff.TenantID),
+			null.StringFrom(staff.ID),
+			nullable.TypeFrom(staff.Role),
+		)
+		return s.staffAuthenticationRepository.StoreClaims(ctx, staff.AuthUID, claims)
+	case model.DomainEventTypeStaffDeleted, model.DomainEventTypeStaffRestored:
+		if staff.IsDeleted() {
+			return s.staffAuthenticationRepository.DisableUser(ctx, staff.AuthUID, staff.Email)
+		}
+		return s.staffAuthenticationRepository.EnableUser(ctx, staff.AuthUID, staff.Email)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStaffAuthenticationSubscriber_Handle(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		event *model.DomainEvent
+	}
+
+	type want struct {
+		expectedResult error
+	}
+
+	type testcase struct {
+		args       args
+		subscriber DomainEventSubscriber
+		want       want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	getQuery := func(staffID string) repository.GetStaffQuery {
+		return repository.GetStaffQuery{
+			ID:             null.StringFrom(staffID),
+			IncludeDeleted: true,
+		}
+	}
+
+	tests := map[string]testcaseFunc{
+		"role changed stores the current claims": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			staff.Role = model.StaffRoleAdmin
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(), getQuery(staff.ID)).
+				Return(staff, nil)
+			mockStaffAuthenticationRepo := mock_repository.NewMockStaffAuthentication(ctrl)
+			mockStaffAuthenticationRepo.EXPECT().
+				StoreClaims(gomock.Any(), staff.AuthUID, model.NewStaffClaims(
+					staff.AuthUID,
+					staff.Email,
+					null.StringFrom(staff.TenantID),
+					null.StringFrom(staff.ID),
+					nullable.TypeFrom(model.StaffRoleAdmin),
+				)).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					event: model.NewStaffRoleChangedEvent(staff, model.StaffRoleNormal, testdata.RequestTime),
+				},
+				subscriber: &staffAuthenticationSubscriber{
+					staffRepository:               mockStaffRepo,
+					staffAuthenticationRepository: mockStaffAuthenticationRepo,
+				},
+				want: want{},
+			}
+		},
+		"deleted disables the user": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			staff.Delete(testdata.RequestTime)
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(), getQuery(staff.ID)).
+				Return(staff, nil)
+			mockStaffAuthenticationRepo := mock_repository.NewMockStaffAuthentication(ctrl)
+			mockStaffAuthenticationRepo.EXPECT().
+				DisableUser(gomock.Any(), staff.AuthUID, staff.Email).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					event: model.NewStaffDeletedEvent(staff, testdata.RequestTime),
+				},
+				subscriber: &staffAuthenticationSubscriber{
+					staffRepository:               mockStaffRepo,
+					staffAuthenticationRepository: mockStaffAuthenticationRepo,
+				},
+				want: want{},
+			}
+		},
+		"deleted but restored since enables the user": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(), getQuery(staff.ID)).
+				Return(staff, nil)
+			mockStaffAuthenticationRepo := mock_repository.NewMockStaffAuthentication(ctrl)
+			mockStaffAuthenticationRepo.EXPECT().
+				EnableUser(gomock.Any(), staff.AuthUID, staff.Email).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					event: model.NewStaffDeletedEvent(staff, testdata.RequestTime),
+				},
+				subscriber: &staffAuthenticationSubscriber{
+					staffRepository:               mockStaffRepo,
+					staffAuthenticationRepository: mockStaffAuthenticationRepo,
+				},
+				want: want{},
+			}
+		},
+		"purged staff is skipped": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(), getQuery(staff.ID)).
+				Return(nil, nil)
+
+			return testcase{
+				args: args{
+					event: model.NewStaffRestoredEvent(staff, testdata.RequestTime),
+				},
+				subscriber: &staffAuthenticationSubscriber{
+					staffRepository:               mockStaffRepo,
+					staffAuthenticationRepository: mock_repository.NewMockStaffAuthentication(ctrl),
+				},
+				want: want{},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			err := tc.subscriber.Handle(ctx, tc.args.event)
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
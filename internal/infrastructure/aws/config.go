@@ -12,5 +12,7 @@ func NewConfig(ctx context.Context, region string) aws.Config {
 	if err != nil {
 		panic(err)
 	}
+	// the calls of the clients made with the config, such as S3 and Cognito, are traced
+	cfg.APIOptions = append(cfg.APIOptions, appendTracingMiddleware)
 	return cfg
 }
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"context"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/tracing"
+)
+
+// appendTracingMiddleware starts a client span around each API call.
+// It is added after the service metadata is registered so that the span can be named by the operation,
+// and a span covers the retries of the call.
+func appendTracingMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(
+		middleware.InitializeMiddlewareFunc("Tracing", func(
+			ctx context.Context,
+			in middleware.InitializeInput,
+			next middleware.InitializeHandler,
+		) (middleware.InitializeOutput, middleware.Metadata, error) {
+			service := awsmiddleware.GetServiceID(ctx)
+			operation := awsmiddleware.GetOperationName(ctx)
+			ctx, span := tracing.Tracer().Start(ctx, service+"."+operation,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					semconv.RPCSystemNameKey.String("aws-api"),
+					semconv.RPCMethod(service+"/"+operation),
+					semconv.CloudRegion(awsmiddleware.GetRegion(ctx)),
+				),
+			)
+			defer span.End()
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			return out, metadata, err
+		}),
+		middleware.After,
+	)
+}
@@ -0,0 +1,45 @@
+//nolint:forbidigo
+package cleanup_assets_cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+	"github.com/abyssparanoia/rapid-go/internal/usecase"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/spf13/cobra"
+)
+
+type CMD struct {
+	ctx                 context.Context
+	taskAssetInteractor usecase.TaskAssetInteractor
+}
+
+func (c *CMD) CleanupAssets(cmd *cobra.Command) error {
+	retention, err := cmd.Flags().GetDuration("retention")
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	if retention <= 0 {
+		return errors.InternalErr.WithDetail("retention must be positive")
+	}
+
+	result, err := c.taskAssetInteractor.CleanupAssets(
+		c.ctx,
+		input.NewTaskCleanupAssets(
+			retention,
+			now.Now(),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Output result
+	fmt.Printf("OrphanedAssets: %d\n", result.OrphanedAssetCount)
+	fmt.Printf("DeletedAssets: %d\n", result.DeletedAssetCount)
+
+	return nil
+}
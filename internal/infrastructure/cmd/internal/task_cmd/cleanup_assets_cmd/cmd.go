@@ -0,0 +1,45 @@
+package cleanup_assets_cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/dependency"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/environment"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/logger"
+	"github.com/caarlos0/env/v11"
+	"github.com/spf13/cobra"
+)
+
+const defaultRetention = 24 * time.Hour
+
+func NewCleanupAssetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cleanup-assets",
+		Short: "delete the objects of expired pending and orphaned assets from the bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+
+			e := &environment.Environment{}
+			if err := env.Parse(e); err != nil {
+				panic(err)
+			}
+
+			l := logger.New(e.MinLogLevel.ZapLogLevel())
+			ctx = logger.ToContext(ctx, l)
+
+			d := &dependency.Dependency{}
+			d.Inject(ctx, e)
+
+			c := &CMD{
+				ctx,
+				d.TaskAssetInteractor,
+			}
+			if err := c.CleanupAssets(cmd); err != nil {
+				panic(err)
+			}
+		},
+	}
+	cmd.Flags().DurationP("retention", "r", defaultRetention, "how long uploaded assets are kept before they are attached")
+	return cmd
+}
@@ -1,7 +1,10 @@
 package task_cmd
 
 import (
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/cmd/internal/task_cmd/cleanup_assets_cmd"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/cmd/internal/task_cmd/create_root_admin_cmd"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/cmd/internal/task_cmd/enqueue_job_cmd"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/cmd/internal/task_cmd/purge_deleted_tenants_cmd"
 	"github.com/spf13/cobra"
 )
 
@@ -16,5 +19,8 @@ func NewTaskCmd() *cobra.Command {
 		},
 	}
 	cmd.AddCommand(create_root_admin_cmd.NewCreateRootAdminCmd())
+	cmd.AddCommand(purge_deleted_tenants_cmd.NewPurgeDeletedTenantsCmd())
+	cmd.AddCommand(enqueue_job_cmd.NewEnqueueJobCmd())
+	cmd.AddCommand(cleanup_assets_cmd.NewCleanupAssetsCmd())
 	return cmd
 }
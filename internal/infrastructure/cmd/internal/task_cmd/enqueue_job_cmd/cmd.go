@@ -0,0 +1,47 @@
+package enqueue_job_cmd
+
+import (
+	"context"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/dependency"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/environment"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/logger"
+	"github.com/caarlos0/env/v11"
+	"github.com/spf13/cobra"
+)
+
+func NewEnqueueJobCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enqueue-job",
+		Short: "enqueue a background job run by the worker",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+
+			e := &environment.Environment{}
+			if err := env.Parse(e); err != nil {
+				panic(err)
+			}
+
+			l := logger.New(e.MinLogLevel.ZapLogLevel())
+			ctx = logger.ToContext(ctx, l)
+
+			d := &dependency.Dependency{}
+			d.Inject(ctx, e)
+
+			c := &CMD{
+				ctx,
+				d.TaskJobInteractor,
+			}
+			if err := c.EnqueueJob(cmd); err != nil {
+				panic(err)
+			}
+		},
+	}
+	cmd.Flags().StringP("type", "t", "", "job type")
+	cmd.Flags().StringP("payload", "p", "{}", "job payload as json")
+	cmd.Flags().String("run-at", "", "scheduled time in RFC3339, runs immediately when empty")
+	cmd.Flags().Uint64("max-attempts", model.JobDefaultMaxAttempts, "number of attempts before the job is dead lettered")
+	_ = cmd.MarkFlagRequired("type")
+	return cmd
+}
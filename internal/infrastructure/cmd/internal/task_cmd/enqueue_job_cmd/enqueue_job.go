@@ -0,0 +1,74 @@
+//nolint:forbidigo
+package enqueue_job_cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+	"github.com/abyssparanoia/rapid-go/internal/usecase"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/spf13/cobra"
+)
+
+type CMD struct {
+	ctx               context.Context
+	taskJobInteractor usecase.TaskJobInteractor
+}
+
+func (c *CMD) EnqueueJob(cmd *cobra.Command) error {
+	jobType, err := cmd.Flags().GetString("type")
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	rawPayload, err := cmd.Flags().GetString("payload")
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	rawRunAt, err := cmd.Flags().GetString("run-at")
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	maxAttempts, err := cmd.Flags().GetUint64("max-attempts")
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+
+	payload := model.JobPayload{}
+	if err := json.Unmarshal([]byte(rawPayload), &payload); err != nil {
+		return errors.InternalErr.Wrap(err).WithDetail("payload must be a json object")
+	}
+	runAt := null.Time{}
+	if rawRunAt != "" {
+		t, err := time.Parse(time.RFC3339, rawRunAt)
+		if err != nil {
+			return errors.InternalErr.Wrap(err).WithDetail("run-at must be in RFC3339")
+		}
+		runAt = null.TimeFrom(t)
+	}
+
+	result, err := c.taskJobInteractor.Enqueue(
+		c.ctx,
+		input.NewTaskEnqueueJob(
+			model.NewJobType(jobType),
+			payload,
+			runAt,
+			maxAttempts,
+			now.Now(),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Output result
+	fmt.Printf("JobID: %s\n", result.JobID)
+	fmt.Printf("RunAt: %s\n", result.RunAt.Format(time.RFC3339))
+
+	return nil
+}
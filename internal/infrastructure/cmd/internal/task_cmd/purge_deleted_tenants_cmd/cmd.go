@@ -0,0 +1,45 @@
+package purge_deleted_tenants_cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/dependency"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/environment"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/logger"
+	"github.com/caarlos0/env/v11"
+	"github.com/spf13/cobra"
+)
+
+const defaultRetention = 30 * 24 * time.Hour
+
+func NewPurgeDeletedTenantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge-deleted-tenants",
+		Short: "hard delete tenants and staffs soft deleted before the retention window",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+
+			e := &environment.Environment{}
+			if err := env.Parse(e); err != nil {
+				panic(err)
+			}
+
+			l := logger.New(e.MinLogLevel.ZapLogLevel())
+			ctx = logger.ToContext(ctx, l)
+
+			d := &dependency.Dependency{}
+			d.Inject(ctx, e)
+
+			c := &CMD{
+				ctx,
+				d.TaskTenantInteractor,
+			}
+			if err := c.PurgeDeletedTenants(cmd); err != nil {
+				panic(err)
+			}
+		},
+	}
+	cmd.Flags().DurationP("retention", "r", defaultRetention, "how long soft deleted rows are kept")
+	return cmd
+}
@@ -0,0 +1,45 @@
+//nolint:forbidigo
+package purge_deleted_tenants_cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+	"github.com/abyssparanoia/rapid-go/internal/usecase"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/spf13/cobra"
+)
+
+type CMD struct {
+	ctx                  context.Context
+	taskTenantInteractor usecase.TaskTenantInteractor
+}
+
+func (c *CMD) PurgeDeletedTenants(cmd *cobra.Command) error {
+	retention, err := cmd.Flags().GetDuration("retention")
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	if retention <= 0 {
+		return errors.InternalErr.WithDetail("retention must be positive")
+	}
+
+	result, err := c.taskTenantInteractor.PurgeDeleted(
+		c.ctx,
+		input.NewTaskPurgeDeletedTenants(
+			retention,
+			now.Now(),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Output result
+	fmt.Printf("PurgedTenants: %d\n", result.PurgedTenantCount)
+	fmt.Printf("PurgedStaffs: %d\n", result.PurgedStaffCount)
+
+	return nil
+}
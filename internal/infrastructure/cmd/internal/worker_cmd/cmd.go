@@ -0,0 +1,26 @@
+package worker_cmd
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/worker"
+	"github.com/spf13/cobra"
+)
+
+func NewWorkerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "worker",
+		Short: "cli background worker",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+			}
+		},
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "run",
+		Short: "running worker relaying outbox events and processing background jobs",
+		Run: func(cmd *cobra.Command, args []string) {
+			worker.Run()
+		},
+	})
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/cmd/internal/http_server_cmd"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/cmd/internal/schema_migration_cmd"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/cmd/internal/task_cmd"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/cmd/internal/worker_cmd"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +21,7 @@ func NewCmdRoot() *cobra.Command {
 	}
 	cmd.AddCommand(http_server_cmd.NewHTTPServerCmd())
 	cmd.AddCommand(task_cmd.NewTaskCmd())
+	cmd.AddCommand(worker_cmd.NewWorkerCmd())
 	cmd.AddCommand(schema_migration_cmd.NewSchemaMigrationCmd())
 	return cmd
 }
@@ -198,6 +198,41 @@ func (r *adminAuthentication) StoreClaims(
 	return nil
 }
 
+func (r *adminAuthentication) UpdatePassword(
+	ctx context.Context,
+	authUID string,
+	email string,
+	password string,
+) error {
+	req := &cognitoidentityprovider.AdminSetUserPasswordInput{
+		UserPoolId: aws.String(r.userPoolID),
+		Username:   aws.String(email),
+		Password:   aws.String(password),
+		Permanent:  true,
+	}
+	_, err := r.cli.AdminSetUserPassword(ctx, req)
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *adminAuthentication) DeleteUser(
+	ctx context.Context,
+	authUID string,
+	email string,
+) error {
+	req := &cognitoidentityprovider.AdminDeleteUserInput{
+		UserPoolId: aws.String(r.userPoolID),
+		Username:   aws.String(email),
+	}
+	_, err := r.cli.AdminDeleteUser(ctx, req)
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
 func (r *adminAuthentication) CreateCustomToken(
 	ctx context.Context,
 	authUID string,
@@ -202,6 +202,54 @@ func (r *staffAuthentication) StoreClaims(
 	return nil
 }
 
+func (r *staffAuthentication) DisableUser(
+	ctx context.Context,
+	authUID string,
+	email string,
+) error {
+	req := &cognitoidentityprovider.AdminDisableUserInput{
+		UserPoolId: aws.String(r.userPoolID),
+		Username:   aws.String(email),
+	}
+	_, err := r.cli.AdminDisableUser(ctx, req)
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *staffAuthentication) EnableUser(
+	ctx context.Context,
+	authUID string,
+	email string,
+) error {
+	req := &cognitoidentityprovider.AdminEnableUserInput{
+		UserPoolId: aws.String(r.userPoolID),
+		Username:   aws.String(email),
+	}
+	_, err := r.cli.AdminEnableUser(ctx, req)
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *staffAuthentication) DeleteUser(
+	ctx context.Context,
+	authUID string,
+	email string,
+) error {
+	req := &cognitoidentityprovider.AdminDeleteUserInput{
+		UserPoolId: aws.String(r.userPoolID),
+		Username:   aws.String(email),
+	}
+	_, err := r.cli.AdminDeleteUser(ctx, req)
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
 func (r *staffAuthentication) CreateCustomToken(
 	ctx context.Context,
 	authUID string,
@@ -0,0 +1,26 @@
+package dependency
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/environment"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/localfs"
+	localfs_repository "github.com/abyssparanoia/rapid-go/internal/infrastructure/localfs/repository"
+)
+
+// newAssetRepository returns the local filesystem asset repository when ENV is local,
+// along with its storage for the HTTP server to serve, or else the bucket of the cloud
+// the binary is built with.
+func newAssetRepository(
+	e *environment.Environment,
+	newBucketAsset func() repository.Asset,
+) (repository.Asset, *localfs.Storage) {
+	if e.Environment != environment.ApplicationEnvironmentLocal {
+		return newBucketAsset(), nil
+	}
+	storage := localfs.NewStorage(
+		e.LocalFSDir,
+		e.LocalFSSigningKey,
+		e.LocalFSURL(),
+	)
+	return localfs_repository.NewAsset(storage), storage
+}
@@ -0,0 +1,101 @@
+package dependency
+
+import (
+	"fmt"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/environment"
+	local_cache "github.com/abyssparanoia/rapid-go/internal/infrastructure/local/cache"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/metrics"
+	redis_cache "github.com/abyssparanoia/rapid-go/internal/infrastructure/redis/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+// newAssetPathCache returns the asset path cache of the backend selected by CACHE_BACKEND,
+// with its lookups counted in the metrics.
+// newDatabaseAssetPath is the constructor of the database the binary is built with.
+func newAssetPathCache(
+	e *environment.Environment,
+	redisCli *redis.Client,
+	newDatabaseAssetPath func() cache.AssetPath,
+) cache.AssetPath {
+	var assetPath cache.AssetPath
+	switch e.CacheBackend {
+	case environment.CacheBackendDatabase:
+		assetPath = newDatabaseAssetPath()
+	case environment.CacheBackendRedis:
+		assetPath = redis_cache.NewAssetPath(redisCli)
+	case environment.CacheBackendMemory:
+		assetPath = local_cache.NewAssetPath()
+	default:
+		panic(fmt.Sprintf("unknown cache backend: %s", e.CacheBackend))
+	}
+	return metrics.NewAssetPath(assetPath, string(e.CacheBackend))
+}
+
+// newRepositoryCacheStore returns the store the repositories cache their entities in,
+// or nil for the database backend, where caching the entities of the database in itself gains nothing.
+func newRepositoryCacheStore(
+	e *environment.Environment,
+	redisCli *redis.Client,
+) cache.Store {
+	switch e.CacheBackend {
+	case environment.CacheBackendDatabase:
+		return nil
+	case environment.CacheBackendRedis:
+		return redis_cache.NewStore(redisCli)
+	case environment.CacheBackendMemory:
+		return local_cache.NewStore(e.CacheMemoryCapacity)
+	default:
+		panic(fmt.Sprintf("unknown cache backend: %s", e.CacheBackend))
+	}
+}
+
+// newRateLimiter returns the rate limiter counting in redis with the redis backend,
+// falling back to the process memory while redis fails, and in the process memory otherwise.
+// The memory backend is rejected outside ENV=local, where the limits would be per replica.
+func newRateLimiter(
+	e *environment.Environment,
+	redisCli *redis.Client,
+) cache.RateLimiter {
+	switch e.CacheBackend {
+	case environment.CacheBackendRedis:
+		return cache.NewFallbackRateLimiter(redis_cache.NewRateLimiter(redisCli), local_cache.NewRateLimiter())
+	case environment.CacheBackendDatabase:
+		return local_cache.NewRateLimiter()
+	case environment.CacheBackendMemory:
+		mustBeLocal(e, "rate limiter")
+		return local_cache.NewRateLimiter()
+	default:
+		panic(fmt.Sprintf("unknown cache backend: %s", e.CacheBackend))
+	}
+}
+
+// newIdempotencyKeyCache returns the idempotency key cache stored in the backend itself, the database included,
+// so that the retries reaching another server are replayed as well.
+// The memory backend is rejected outside ENV=local, where only the retries reaching the same replica would be.
+// newDatabaseIdempotencyKey is the constructor of the database the binary is built with.
+func newIdempotencyKeyCache(
+	e *environment.Environment,
+	redisCli *redis.Client,
+	newDatabaseIdempotencyKey func() cache.IdempotencyKey,
+) cache.IdempotencyKey {
+	switch e.CacheBackend {
+	case environment.CacheBackendDatabase:
+		return newDatabaseIdempotencyKey()
+	case environment.CacheBackendRedis:
+		return redis_cache.NewIdempotencyKey(redisCli)
+	case environment.CacheBackendMemory:
+		mustBeLocal(e, "idempotency key cache")
+		return local_cache.NewIdempotencyKey()
+	default:
+		panic(fmt.Sprintf("unknown cache backend: %s", e.CacheBackend))
+	}
+}
+
+// mustBeLocal panics unless ENV is local, for the caches that must be shared by the replicas.
+func mustBeLocal(e *environment.Environment, name string) {
+	if e.Environment != environment.ApplicationEnvironmentLocal {
+		panic(fmt.Sprintf("the %s cannot use the cache backend %s when ENV is %s", name, e.CacheBackend, e.Environment))
+	}
+}
@@ -1,4 +1,4 @@
-//go:build !gcp
+//go:build !gcp && !spanner
 
 // nolint:godot,gci
 package dependency
@@ -6,15 +6,21 @@ package dependency
 import (
 	"context"
 
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
 	"github.com/abyssparanoia/rapid-go/internal/domain/service"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/aws"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/cognito"
 	cognito_repository "github.com/abyssparanoia/rapid-go/internal/infrastructure/cognito/repository"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/environment"
+	local_repository "github.com/abyssparanoia/rapid-go/internal/infrastructure/local/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/localfs"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/metrics"
 	database "github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql"
 	database_cache "github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/cache"
 	database_repository "github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/repository"
 	database_transactable "github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/transactable"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/redis"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/s3"
 	s3_repository "github.com/abyssparanoia/rapid-go/internal/infrastructure/s3/repository"
 	"github.com/abyssparanoia/rapid-go/internal/usecase"
@@ -22,17 +28,26 @@ import (
 
 type Dependency struct {
 	DatabaseCli *database.Client
+	// LocalFSStorage is served by the HTTP server, only when ENV is local
+	LocalFSStorage *localfs.Storage
+	// RateLimiter counts the requests of the rate limited RPCs
+	RateLimiter cache.RateLimiter
+	// IdempotencyKeyCache stores the responses of the RPCs retried with an idempotency key
+	IdempotencyKeyCache cache.IdempotencyKey
 
 	// admin
-	AdminTenantInteractor usecase.AdminTenantInteractor
-	AdminStaffInteractor  usecase.AdminStaffInteractor
-	AdminAssetInteractor  usecase.AdminAssetInteractor
+	AdminTenantInteractor   usecase.AdminTenantInteractor
+	AdminStaffInteractor    usecase.AdminStaffInteractor
+	AdminAssetInteractor    usecase.AdminAssetInteractor
+	AdminAdminInteractor    usecase.AdminAdminInteractor
+	AdminAuditLogInteractor usecase.AdminAuditLogInteractor
 
 	// staff
-	StaffMeInteractor       usecase.StaffMeInteractor
-	StaffMeTenantInteractor usecase.StaffMeTenantInteractor
-	StaffStaffInteractor    usecase.StaffStaffInteractor
-	StaffAssetInteractor    usecase.StaffAssetInteractor
+	StaffMeInteractor         usecase.StaffMeInteractor
+	StaffMeTenantInteractor   usecase.StaffMeTenantInteractor
+	StaffStaffInteractor      usecase.StaffStaffInteractor
+	StaffAssetInteractor      usecase.StaffAssetInteractor
+	StaffInvitationInteractor usecase.StaffInvitationInteractor
 
 	// Other
 	AuthenticationInteractor      usecase.AuthenticationInteractor
@@ -40,7 +55,14 @@ type Dependency struct {
 	DebugInteractor               usecase.DebugInteractor
 
 	// task
-	TaskAdminInteractor usecase.TaskAdminInteractor
+	TaskAdminInteractor  usecase.TaskAdminInteractor
+	TaskTenantInteractor usecase.TaskTenantInteractor
+	TaskJobInteractor    usecase.TaskJobInteractor
+	TaskAssetInteractor  usecase.TaskAssetInteractor
+
+	// worker
+	WorkerOutboxInteractor usecase.WorkerOutboxInteractor
+	WorkerJobInteractor    usecase.WorkerJobInteractor
 }
 
 func (d *Dependency) Inject(
@@ -48,13 +70,24 @@ func (d *Dependency) Inject(
 	e *environment.Environment,
 ) {
 	d.DatabaseCli = database.NewClient(e.DBHost, e.DBUser, e.DBPassword, e.DBDatabase, e.DBLogEnable)
+	metrics.RegisterDBStats(d.DatabaseCli.DB, e.DBDatabase)
 
 	// AWS
 	awsSession := aws.NewConfig(ctx, e.AWSRegion)
 	s3Client := s3.NewClient(awsSession, e.AWSEmulatorHost)
 	cognitoCli := cognito.NewClient(awsSession, e.AWSCognitoEmulatorHost)
 
-	transactable := database_transactable.NewTransactable()
+	transactable := database_transactable.NewTransactable(e.TxRetryPolicy())
+
+	// One redis client is shared by the caches and the job queue backed by redis.
+	// It connects on the first command, so it is left unused with the other backends.
+	redisCli := redis.NewClient(
+		e.RedisHost,
+		e.RedisPort,
+		e.RedisUsername,
+		e.RedisPassword,
+		e.RedisTLSEnable,
+	)
 
 	staffAuthenticationRepository := cognito_repository.NewStaffAuthentication(
 		ctx,
@@ -72,22 +105,53 @@ func (d *Dependency) Inject(
 		e.AWSCognitoEmulatorHost,
 		e.AWSRegion,
 	)
+	// The latency of the ID token verification is observed in the metrics
+	staffAuthenticationRepository = metrics.NewStaffAuthentication(staffAuthenticationRepository)
+	adminAuthenticationRepository = metrics.NewAdminAuthentication(adminAuthenticationRepository)
 	tenantRepository := database_repository.NewTenant()
 	staffRepository := database_repository.NewStaff()
 	adminRepository := database_repository.NewAdmin()
+	auditLogRepository := database_repository.NewAuditLog()
+	invitationRepository := database_repository.NewInvitation()
+	outboxEventRepository := database_repository.NewOutboxEvent()
+	jobQueueRepository := newJobQueue(e, redisCli, database_repository.NewJobQueue)
+	assetMetadataRepository := database_repository.NewAssetMetadata()
 
-	// S3 asset repository
-	assetRepository := s3_repository.NewAsset(
-		s3Client,
-		e.AWSPrivateBucketName,
-		e.AWSPublicBucketName,
-		e.AWSPublicAssetBaseURL,
-	)
+	assetPathCache := newAssetPathCache(e, redisCli, database_cache.NewAssetPath)
+	repositoryCacheStore := newRepositoryCacheStore(e, redisCli)
+	rateLimiter := newRateLimiter(e, redisCli)
+	idempotencyKeyCache := newIdempotencyKeyCache(e, redisCli, database_cache.NewIdempotencyKey)
+
+	// Tenants and staffs are read through the cache, except in read-write transactions.
+	if repositoryCacheStore != nil {
+		transactable = cache.NewTransactable(transactable)
+		tenantRepository = cache.NewTenant(tenantRepository, repositoryCacheStore, e.CacheEntityTTL)
+		staffRepository = cache.NewStaff(staffRepository, tenantRepository, repositoryCacheStore, e.CacheEntityTTL)
+	}
 
-	assetPathCache := database_cache.NewAssetPath()
+	// Invitation emails are only logged until a mail provider is configured.
+	invitationEmailRepository := local_repository.NewInvitationEmail()
+
+	// Uploaded images are processed in the worker itself
+	assetImageRepository := local_repository.NewAssetImage()
+
+	// S3 asset repository, or the local filesystem in local development
+	assetRepository, localFSStorage := newAssetRepository(e, func() repository.Asset {
+		return s3_repository.NewAsset(
+			s3Client,
+			e.AWSPrivateBucketName,
+			e.AWSPublicBucketName,
+			e.AWSPublicAssetBaseURL,
+		)
+	})
+	d.LocalFSStorage = localFSStorage
+	d.RateLimiter = rateLimiter
+	d.IdempotencyKeyCache = idempotencyKeyCache
 
 	assetService := service.NewAsset(
 		assetRepository,
+		assetMetadataRepository,
+		outboxEventRepository,
 		assetPathCache,
 	)
 
@@ -96,32 +160,64 @@ func (d *Dependency) Inject(
 		staffAuthenticationRepository,
 	)
 
+	// Subscribers run in the worker, after the transaction emitting the event has committed.
+	domainEventSubscribers := []service.DomainEventSubscriber{
+		service.NewAssetImageSubscriber(
+			assetRepository,
+			assetMetadataRepository,
+			assetImageRepository,
+		),
+		service.NewStaffAuthenticationSubscriber(
+			staffRepository,
+			staffAuthenticationRepository,
+		),
+	}
+
 	d.AdminTenantInteractor = usecase.NewAdminTenantInteractor(
 		transactable,
 		tenantRepository,
+		staffRepository,
+		auditLogRepository,
+		outboxEventRepository,
 		assetService,
 	)
 	d.AdminStaffInteractor = usecase.NewAdminStaffInteractor(
 		transactable,
 		tenantRepository,
 		staffRepository,
+		auditLogRepository,
+		outboxEventRepository,
 		staffService,
 		assetService,
 	)
 	d.AdminAssetInteractor = usecase.NewAdminAssetInteractor(
+		transactable,
 		assetService,
 	)
+	d.AdminAdminInteractor = usecase.NewAdminAdminInteractor(
+		transactable,
+		adminRepository,
+		auditLogRepository,
+		adminAuthenticationRepository,
+	)
+	d.AdminAuditLogInteractor = usecase.NewAdminAuditLogInteractor(
+		auditLogRepository,
+	)
 
 	d.StaffMeInteractor = usecase.NewStaffMeInteractor(
 		transactable,
 		tenantRepository,
 		staffRepository,
+		auditLogRepository,
+		outboxEventRepository,
 		staffService,
 		assetService,
 	)
 	d.StaffMeTenantInteractor = usecase.NewStaffMeTenantInteractor(
 		transactable,
 		tenantRepository,
+		auditLogRepository,
+		outboxEventRepository,
 		assetService,
 	)
 	d.StaffStaffInteractor = usecase.NewStaffStaffInteractor(
@@ -129,6 +225,18 @@ func (d *Dependency) Inject(
 		assetService,
 	)
 	d.StaffAssetInteractor = usecase.NewStaffAssetInteractor(
+		transactable,
+		assetService,
+	)
+	d.StaffInvitationInteractor = usecase.NewStaffInvitationInteractor(
+		transactable,
+		tenantRepository,
+		staffRepository,
+		invitationRepository,
+		auditLogRepository,
+		outboxEventRepository,
+		invitationEmailRepository,
+		staffService,
 		assetService,
 	)
 
@@ -150,4 +258,37 @@ func (d *Dependency) Inject(
 		adminRepository,
 		adminAuthenticationRepository,
 	)
+
+	d.TaskTenantInteractor = usecase.NewTaskTenantInteractor(
+		transactable,
+		tenantRepository,
+		staffRepository,
+		staffAuthenticationRepository,
+	)
+
+	d.TaskJobInteractor = usecase.NewTaskJobInteractor(
+		jobQueueRepository,
+	)
+
+	d.TaskAssetInteractor = usecase.NewTaskAssetInteractor(
+		transactable,
+		assetRepository,
+		assetMetadataRepository,
+	)
+
+	d.WorkerOutboxInteractor = usecase.NewWorkerOutboxInteractor(
+		transactable,
+		outboxEventRepository,
+		domainEventSubscribers,
+	)
+
+	// Job handlers run by the worker, one per job type.
+	jobHandlers := []usecase.JobHandler{
+		usecase.NewPurgeDeletedTenantsJobHandler(d.TaskTenantInteractor),
+	}
+	d.WorkerJobInteractor = usecase.NewWorkerJobInteractor(
+		transactable,
+		jobQueueRepository,
+		jobHandlers,
+	)
 }
@@ -0,0 +1,291 @@
+//go:build spanner
+
+// nolint:godot,gci
+package dependency
+
+import (
+	"context"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/domain/service"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/environment"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/firebase"
+	firebase_repository "github.com/abyssparanoia/rapid-go/internal/infrastructure/firebase/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/gcs"
+	gcs_repository "github.com/abyssparanoia/rapid-go/internal/infrastructure/gcs/repository"
+	local_repository "github.com/abyssparanoia/rapid-go/internal/infrastructure/local/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/localfs"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/metrics"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/redis"
+	database "github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner"
+	database_cache "github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/cache"
+	database_repository "github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/repository"
+	database_transactable "github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/transactable"
+	"github.com/abyssparanoia/rapid-go/internal/usecase"
+)
+
+type Dependency struct {
+	DatabaseCli *database.Client
+	// LocalFSStorage is served by the HTTP server, only when ENV is local
+	LocalFSStorage *localfs.Storage
+	// RateLimiter counts the requests of the rate limited RPCs
+	RateLimiter cache.RateLimiter
+	// IdempotencyKeyCache stores the responses of the RPCs retried with an idempotency key
+	IdempotencyKeyCache cache.IdempotencyKey
+
+	// admin
+	AdminTenantInteractor   usecase.AdminTenantInteractor
+	AdminStaffInteractor    usecase.AdminStaffInteractor
+	AdminAssetInteractor    usecase.AdminAssetInteractor
+	AdminAdminInteractor    usecase.AdminAdminInteractor
+	AdminAuditLogInteractor usecase.AdminAuditLogInteractor
+
+	// staff
+	StaffMeInteractor         usecase.StaffMeInteractor
+	StaffMeTenantInteractor   usecase.StaffMeTenantInteractor
+	StaffStaffInteractor      usecase.StaffStaffInteractor
+	StaffAssetInteractor      usecase.StaffAssetInteractor
+	StaffInvitationInteractor usecase.StaffInvitationInteractor
+
+	// Other
+	AuthenticationInteractor      usecase.AuthenticationInteractor
+	AdminAuthenticationInteractor usecase.AdminAuthenticationInteractor
+	DebugInteractor               usecase.DebugInteractor
+
+	// task
+	TaskAdminInteractor  usecase.TaskAdminInteractor
+	TaskTenantInteractor usecase.TaskTenantInteractor
+	TaskJobInteractor    usecase.TaskJobInteractor
+	TaskAssetInteractor  usecase.TaskAssetInteractor
+
+	// worker
+	WorkerOutboxInteractor usecase.WorkerOutboxInteractor
+	WorkerJobInteractor    usecase.WorkerJobInteractor
+}
+
+func (d *Dependency) Inject(
+	ctx context.Context,
+	e *environment.Environment,
+) {
+	d.DatabaseCli = database.NewClient(e.SpannerProjectID, e.SpannerInstanceID, e.SpannerDatabaseID)
+
+	// GCP Cloud Storage
+	gcsCli := gcs.NewClient(ctx, e.GCSEmulatorHost)
+	gcsPrivateBucketHandle := gcs.NewBucketHandle(gcsCli, e.GCPPrivateBucketName)
+	gcsPublicBucketHandle := gcs.NewBucketHandle(gcsCli, e.GCPPublicBucketName)
+
+	// Firebase Auth
+	firebaseCli := firebase.NewClient(e.GCPProjectID, e.FirebaseAuthEmulatorHost)
+
+	transactable := database_transactable.NewTransactable(d.DatabaseCli.Client, e.TxRetryPolicy())
+
+	// One redis client is shared by the caches and the job queue backed by redis.
+	// It connects on the first command, so it is left unused with the other backends.
+	redisCli := redis.NewClient(
+		e.RedisHost,
+		e.RedisPort,
+		e.RedisUsername,
+		e.RedisPassword,
+		e.RedisTLSEnable,
+	)
+
+	staffAuthenticationRepository := firebase_repository.NewStaffAuthentication(
+		firebaseCli,
+		e.FirebaseClientAPIKey,
+		e.FirebaseAuthEmulatorHost,
+	)
+	adminAuthenticationRepository := firebase_repository.NewAdminAuthentication(
+		firebaseCli,
+		e.FirebaseClientAPIKey,
+		e.FirebaseAuthEmulatorHost,
+	)
+	// The latency of the ID token verification is observed in the metrics
+	staffAuthenticationRepository = metrics.NewStaffAuthentication(staffAuthenticationRepository)
+	adminAuthenticationRepository = metrics.NewAdminAuthentication(adminAuthenticationRepository)
+	tenantRepository := database_repository.NewTenant()
+	staffRepository := database_repository.NewStaff()
+	adminRepository := database_repository.NewAdmin()
+	auditLogRepository := database_repository.NewAuditLog()
+	invitationRepository := database_repository.NewInvitation()
+	outboxEventRepository := database_repository.NewOutboxEvent()
+	jobQueueRepository := newJobQueue(e, redisCli, database_repository.NewJobQueue)
+	assetMetadataRepository := database_repository.NewAssetMetadata()
+
+	assetPathCache := newAssetPathCache(e, redisCli, database_cache.NewAssetPath)
+	repositoryCacheStore := newRepositoryCacheStore(e, redisCli)
+	rateLimiter := newRateLimiter(e, redisCli)
+	idempotencyKeyCache := newIdempotencyKeyCache(e, redisCli, database_cache.NewIdempotencyKey)
+
+	// Tenants and staffs are read through the cache, except in read-write transactions.
+	if repositoryCacheStore != nil {
+		transactable = cache.NewTransactable(transactable)
+		tenantRepository = cache.NewTenant(tenantRepository, repositoryCacheStore, e.CacheEntityTTL)
+		staffRepository = cache.NewStaff(staffRepository, tenantRepository, repositoryCacheStore, e.CacheEntityTTL)
+	}
+
+	// Invitation emails are only logged until a mail provider is configured.
+	invitationEmailRepository := local_repository.NewInvitationEmail()
+
+	// Uploaded images are processed in the worker itself
+	assetImageRepository := local_repository.NewAssetImage()
+
+	// GCS asset repository, or the local filesystem in local development
+	assetRepository, localFSStorage := newAssetRepository(e, func() repository.Asset {
+		return gcs_repository.NewAsset(
+			gcsPrivateBucketHandle,
+			gcsPublicBucketHandle,
+			e.GCPPublicAssetBaseURL,
+			e.GCSEmulatorHost,
+			e.GCPPrivateBucketName,
+			e.GCPPublicBucketName,
+		)
+	})
+	d.LocalFSStorage = localFSStorage
+	d.RateLimiter = rateLimiter
+	d.IdempotencyKeyCache = idempotencyKeyCache
+
+	assetService := service.NewAsset(
+		assetRepository,
+		assetMetadataRepository,
+		outboxEventRepository,
+		assetPathCache,
+	)
+
+	staffService := service.NewStaff(
+		staffRepository,
+		staffAuthenticationRepository,
+	)
+
+	// Subscribers run in the worker, after the transaction emitting the event has committed.
+	domainEventSubscribers := []service.DomainEventSubscriber{
+		service.NewAssetImageSubscriber(
+			assetRepository,
+			assetMetadataRepository,
+			assetImageRepository,
+		),
+		service.NewStaffAuthenticationSubscriber(
+			staffRepository,
+			staffAuthenticationRepository,
+		),
+	}
+
+	d.AdminTenantInteractor = usecase.NewAdminTenantInteractor(
+		transactable,
+		tenantRepository,
+		staffRepository,
+		auditLogRepository,
+		outboxEventRepository,
+		assetService,
+	)
+	d.AdminStaffInteractor = usecase.NewAdminStaffInteractor(
+		transactable,
+		tenantRepository,
+		staffRepository,
+		auditLogRepository,
+		outboxEventRepository,
+		staffService,
+		assetService,
+	)
+	d.AdminAssetInteractor = usecase.NewAdminAssetInteractor(
+		transactable,
+		assetService,
+	)
+	d.AdminAdminInteractor = usecase.NewAdminAdminInteractor(
+		transactable,
+		adminRepository,
+		auditLogRepository,
+		adminAuthenticationRepository,
+	)
+	d.AdminAuditLogInteractor = usecase.NewAdminAuditLogInteractor(
+		auditLogRepository,
+	)
+
+	d.StaffMeInteractor = usecase.NewStaffMeInteractor(
+		transactable,
+		tenantRepository,
+		staffRepository,
+		auditLogRepository,
+		outboxEventRepository,
+		staffService,
+		assetService,
+	)
+	d.StaffMeTenantInteractor = usecase.NewStaffMeTenantInteractor(
+		transactable,
+		tenantRepository,
+		auditLogRepository,
+		outboxEventRepository,
+		assetService,
+	)
+	d.StaffStaffInteractor = usecase.NewStaffStaffInteractor(
+		staffRepository,
+		assetService,
+	)
+	d.StaffAssetInteractor = usecase.NewStaffAssetInteractor(
+		transactable,
+		assetService,
+	)
+	d.StaffInvitationInteractor = usecase.NewStaffInvitationInteractor(
+		transactable,
+		tenantRepository,
+		staffRepository,
+		invitationRepository,
+		auditLogRepository,
+		outboxEventRepository,
+		invitationEmailRepository,
+		staffService,
+		assetService,
+	)
+
+	d.AuthenticationInteractor = usecase.NewAuthenticationInteractor(
+		staffAuthenticationRepository,
+	)
+
+	d.AdminAuthenticationInteractor = usecase.NewAdminAuthenticationInteractor(
+		adminAuthenticationRepository,
+	)
+
+	d.DebugInteractor = usecase.NewDebugInteractor(
+		adminAuthenticationRepository,
+		staffAuthenticationRepository,
+	)
+
+	d.TaskAdminInteractor = usecase.NewTaskAdminInteractor(
+		transactable,
+		adminRepository,
+		adminAuthenticationRepository,
+	)
+
+	d.TaskTenantInteractor = usecase.NewTaskTenantInteractor(
+		transactable,
+		tenantRepository,
+		staffRepository,
+		staffAuthenticationRepository,
+	)
+
+	d.TaskJobInteractor = usecase.NewTaskJobInteractor(
+		jobQueueRepository,
+	)
+
+	d.TaskAssetInteractor = usecase.NewTaskAssetInteractor(
+		transactable,
+		assetRepository,
+		assetMetadataRepository,
+	)
+
+	d.WorkerOutboxInteractor = usecase.NewWorkerOutboxInteractor(
+		transactable,
+		outboxEventRepository,
+		domainEventSubscribers,
+	)
+
+	// Job handlers run by the worker, one per job type.
+	jobHandlers := []usecase.JobHandler{
+		usecase.NewPurgeDeletedTenantsJobHandler(d.TaskTenantInteractor),
+	}
+	d.WorkerJobInteractor = usecase.NewWorkerJobInteractor(
+		transactable,
+		jobQueueRepository,
+		jobHandlers,
+	)
+}
@@ -0,0 +1,27 @@
+package dependency
+
+import (
+	"fmt"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/environment"
+	redis_repository "github.com/abyssparanoia/rapid-go/internal/infrastructure/redis/repository"
+	"github.com/redis/go-redis/v9"
+)
+
+// newJobQueue returns the job queue of the backend selected by JOB_QUEUE_BACKEND.
+// newDatabaseJobQueue is the constructor of the database the binary is built with.
+func newJobQueue(
+	e *environment.Environment,
+	redisCli *redis.Client,
+	newDatabaseJobQueue func() repository.JobQueue,
+) repository.JobQueue {
+	switch e.JobQueueBackend {
+	case environment.JobQueueBackendDatabase:
+		return newDatabaseJobQueue()
+	case environment.JobQueueBackendRedis:
+		return redis_repository.NewJobQueue(redisCli)
+	default:
+		panic(fmt.Sprintf("unknown job queue backend: %s", e.JobQueueBackend))
+	}
+}
@@ -1,6 +1,12 @@
 package environment
 
-import "go.uber.org/zap/zapcore"
+import (
+	"fmt"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/pkg/backoff"
+	"go.uber.org/zap/zapcore"
+)
 
 type Environment struct {
 	Port        string                 `env:"PORT,required"`
@@ -8,9 +14,24 @@ type Environment struct {
 	MinLogLevel MinLogLevel            `env:"MIN_LOG_LEVEL" envDefault:"info"`
 	DatabaseEnvironment
 	RedisEnvironment
+	CacheEnvironment
 	GCPEnvironment
 	AWSEnvironment
+	LocalFSEnvironment
 	SpannerEnvironment
+	WorkerEnvironment
+	JobQueueEnvironment
+	TracingEnvironment
+	RateLimitEnvironment
+	IdempotencyEnvironment
+}
+
+// LocalFSURL returns the base URL of the local filesystem asset storage.
+func (e *Environment) LocalFSURL() string {
+	if e.LocalFSBaseURL != "" {
+		return e.LocalFSBaseURL
+	}
+	return fmt.Sprintf("http://localhost:%s", e.Port)
 }
 
 type ApplicationEnvironment string
@@ -53,6 +74,18 @@ type DatabaseEnvironment struct {
 	DBPassword  string `env:"DB_PASSWORD,required"`
 	DBDatabase  string `env:"DB_DATABASE,required"`
 	DBLogEnable bool   `env:"DB_LOG_ENABLE"        envDefault:"false"`
+	// retry policy of transactions failing with a deadlock, lock timeout or serialization failure
+	DBTxRetryMaxAttempts uint64        `env:"DB_TX_RETRY_MAX_ATTEMPTS" envDefault:"4"`
+	DBTxRetryBaseDelay   time.Duration `env:"DB_TX_RETRY_BASE_DELAY"   envDefault:"50ms"`
+	DBTxRetryMaxDelay    time.Duration `env:"DB_TX_RETRY_MAX_DELAY"    envDefault:"1s"`
+}
+
+func (e DatabaseEnvironment) TxRetryPolicy() backoff.RetryPolicy {
+	return backoff.NewRetryPolicy(
+		e.DBTxRetryMaxAttempts,
+		e.DBTxRetryBaseDelay,
+		e.DBTxRetryMaxDelay,
+	)
 }
 
 type RedisEnvironment struct {
@@ -63,6 +96,28 @@ type RedisEnvironment struct {
 	RedisTLSEnable bool   `env:"REDIS_TLS_ENABLE"        envDefault:"true"`
 }
 
+type CacheEnvironment struct {
+	CacheBackend CacheBackend `env:"CACHE_BACKEND" envDefault:"database"`
+	// tenants and staffs are cached for CacheEntityTTL, unless the backend is database
+	CacheEntityTTL time.Duration `env:"CACHE_ENTITY_TTL" envDefault:"1m"`
+	// the number of entries the memory backend holds before evicting the least recently used one
+	CacheMemoryCapacity int `env:"CACHE_MEMORY_CAPACITY" envDefault:"10000"`
+}
+
+// CacheBackend selects where caches such as the asset path cache are stored.
+type CacheBackend string
+
+const (
+	CacheBackendDatabase CacheBackend = "database"
+	CacheBackendRedis    CacheBackend = "redis"
+	// CacheBackendMemory keeps entries in the process, which is only meant for local development and tests.
+	CacheBackendMemory CacheBackend = "memory"
+)
+
+func (cb CacheBackend) String() string {
+	return string(cb)
+}
+
 type GCPEnvironment struct {
 	GCPProjectID             string `env:"GCP_PROJECT_ID,required"`
 	FirebaseClientAPIKey     string `env:"FIREBASE_CLIENT_API_KEY"`
@@ -86,8 +141,68 @@ type AWSEnvironment struct {
 	AWSCognitoAdminClientID   string `env:"AWS_COGNITO_ADMIN_CLIENT_ID,required"`
 }
 
+// LocalFSEnvironment configures the local filesystem asset storage, which replaces the bucket when ENV is local.
+type LocalFSEnvironment struct {
+	LocalFSDir        string `env:"LOCALFS_DIR"         envDefault:"./.localfs"`
+	LocalFSSigningKey string `env:"LOCALFS_SIGNING_KEY" envDefault:"localfs"`
+	// the base URL the signed URLs point to, which defaults to the server on localhost
+	LocalFSBaseURL string `env:"LOCALFS_BASE_URL"`
+}
+
 type SpannerEnvironment struct {
 	SpannerProjectID  string `env:"SPANNER_PROJECT_ID,required"`
 	SpannerInstanceID string `env:"SPANNER_INSTANCE_ID,required"`
 	SpannerDatabaseID string `env:"SPANNER_DATABASE_ID,required"`
 }
+
+type WorkerEnvironment struct {
+	WorkerPollInterval        time.Duration `env:"WORKER_POLL_INTERVAL"         envDefault:"1s"`
+	WorkerOutboxBatchSize     uint64        `env:"WORKER_OUTBOX_BATCH_SIZE"     envDefault:"100"`
+	WorkerOutboxMaxAttempts   uint64        `env:"WORKER_OUTBOX_MAX_ATTEMPTS"   envDefault:"10"`
+	WorkerOutboxLeaseDuration time.Duration `env:"WORKER_OUTBOX_LEASE_DURATION" envDefault:"5m"`
+	WorkerJobBatchSize        uint64        `env:"WORKER_JOB_BATCH_SIZE"        envDefault:"10"`
+	WorkerJobLeaseDuration    time.Duration `env:"WORKER_JOB_LEASE_DURATION"    envDefault:"5m"`
+	// the number of expired idempotency keys deleted from the database at once
+	WorkerIdempotencyKeyBatchSize uint64 `env:"WORKER_IDEMPOTENCY_KEY_BATCH_SIZE" envDefault:"1000"`
+}
+
+type JobQueueEnvironment struct {
+	JobQueueBackend JobQueueBackend `env:"JOB_QUEUE_BACKEND" envDefault:"database"`
+}
+
+// JobQueueBackend selects where the background jobs are queued.
+type JobQueueBackend string
+
+const (
+	JobQueueBackendDatabase JobQueueBackend = "database"
+	// JobQueueBackendRedis queues the jobs in redis, where a job enqueued in a transaction is visible before it commits.
+	JobQueueBackendRedis JobQueueBackend = "redis"
+)
+
+func (b JobQueueBackend) String() string {
+	return string(b)
+}
+
+// TracingEnvironment configures the export of the OpenTelemetry spans.
+type TracingEnvironment struct {
+	// the OTLP gRPC endpoint the spans are exported to, e.g. http://localhost:4317; spans are not recorded when it is empty
+	OTELExporterOTLPEndpoint string `env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	OTELServiceName          string `env:"OTEL_SERVICE_NAME"           envDefault:"rapid-go"`
+	// the ratio of the traces started here that are sampled, while a sampled parent is always followed
+	OTELTracesSampleRatio float64 `env:"OTEL_TRACES_SAMPLE_RATIO" envDefault:"1"`
+}
+
+// RateLimitEnvironment configures the rate limits of the RPCs, which are counted in the cache backend.
+type RateLimitEnvironment struct {
+	RateLimitEnable bool `env:"RATE_LIMIT_ENABLE" envDefault:"true"`
+	// the number of proxies appending the client IP to X-Forwarded-For in front of the gRPC server,
+	// which is 1 for the gateway plus the load balancers; X-Forwarded-For is ignored with 0,
+	// which only suits a gRPC server served to its clients directly without the gateway
+	RateLimitTrustedProxyHops int `env:"RATE_LIMIT_TRUSTED_PROXY_HOPS" envDefault:"1"`
+}
+
+// IdempotencyEnvironment configures the RPCs retried with an idempotency key, whose responses are stored in the cache backend.
+type IdempotencyEnvironment struct {
+	// the responses are replayed to the retries for IdempotencyKeyTTL after the first request succeeds
+	IdempotencyKeyTTL time.Duration `env:"IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+}
@@ -100,6 +100,29 @@ func (r *adminAuthentication) StoreClaims(
 	return nil
 }
 
+func (r *adminAuthentication) UpdatePassword(
+	ctx context.Context,
+	authUID string,
+	email string,
+	password string,
+) error {
+	if _, err := r.cli.UpdateUser(ctx, authUID, (&auth.UserToUpdate{}).Password(password)); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *adminAuthentication) DeleteUser(
+	ctx context.Context,
+	authUID string,
+	email string,
+) error {
+	if err := r.cli.DeleteUser(ctx, authUID); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
 func (r *adminAuthentication) CreateCustomToken(
 	ctx context.Context,
 	authUID string,
@@ -98,6 +98,41 @@ func (r *staffAuthentication) StoreClaims(
 	return nil
 }
 
+func (r *staffAuthentication) DisableUser(
+	ctx context.Context,
+	authUID string,
+	email string,
+) error {
+	dto := (&auth.UserToUpdate{}).Disabled(true)
+	if _, err := r.cli.UpdateUser(ctx, authUID, dto); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *staffAuthentication) EnableUser(
+	ctx context.Context,
+	authUID string,
+	email string,
+) error {
+	dto := (&auth.UserToUpdate{}).Disabled(false)
+	if _, err := r.cli.UpdateUser(ctx, authUID, dto); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *staffAuthentication) DeleteUser(
+	ctx context.Context,
+	authUID string,
+	email string,
+) error {
+	if err := r.cli.DeleteUser(ctx, authUID); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
 func (r *staffAuthentication) CreateCustomToken(
 	ctx context.Context,
 	authUID string,
@@ -19,6 +19,7 @@ func NewClient(ctx context.Context, emulatorHost string) *storage.Client {
 		)
 	}
 
+	// The client traces its calls with the global tracer provider by itself
 	cStorage, err := storage.NewClient(ctx, opts...)
 	if err != nil {
 		panic(err)
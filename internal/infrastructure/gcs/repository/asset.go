@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -17,6 +19,8 @@ import (
 const (
 	// urlRoundingDuration is the time rounding unit for presigned URL cache optimization
 	urlRoundingDuration = 5 * time.Minute
+	// contentLengthRangeHeader limits the size of an object uploaded through a signed URL
+	contentLengthRangeHeader = "x-goog-content-length-range"
 )
 
 type asset struct {
@@ -46,37 +50,59 @@ func NewAsset(
 	}
 }
 
+func (r *asset) bucketHandle(path string) *storage.BucketHandle {
+	if strings.HasPrefix(path, "public/") {
+		return r.publicBucketHandle
+	}
+	return r.privateBucketHandle
+}
+
 func (r *asset) GenerateWritePresignedURL(
 	ctx context.Context,
-	contentType model.ContentType,
-	path string,
-	expires time.Duration,
-) (string, error) {
+	asset *model.Asset,
+) (*model.AssetPresignedUpload, error) {
+	contentType := asset.ContentType.String()
+
 	// Emulator mode: return direct HTTP URL (fake-gcs-server doesn't validate signatures)
 	if r.emulatorHost != "" {
 		bucketName := r.privateBucketName
-		if strings.HasPrefix(path, "public/") {
+		if strings.HasPrefix(asset.Path, "public/") {
 			bucketName = r.publicBucketName
 		}
-		return fmt.Sprintf("%s/%s/%s", r.emulatorHost, bucketName, path), nil
+		return &model.AssetPresignedUpload{
+			Method: http.MethodPut,
+			URL:    fmt.Sprintf("%s/%s/%s", r.emulatorHost, bucketName, asset.Path),
+			Fields: map[string]string{},
+			Headers: map[string]string{
+				"Content-Type": contentType,
+			},
+		}, nil
 	}
 
 	// Production mode: generate signed URL
-	bucketHandle := r.privateBucketHandle
-	if strings.HasPrefix(path, "public/") {
-		bucketHandle = r.publicBucketHandle
-	}
-
+	// The content length range is signed as an extension header, so GCS rejects a larger object
+	contentLengthRange := fmt.Sprintf("0,%d", asset.Type.Policy().MaxSize)
 	opts := &storage.SignedURLOptions{
-		Expires:     now.Now().Add(expires),
+		Expires:     now.Now().Add(asset.Expiration()),
 		Method:      http.MethodPut,
-		ContentType: contentType.String(),
+		ContentType: contentType,
+		Headers: []string{
+			fmt.Sprintf("%s:%s", contentLengthRangeHeader, contentLengthRange),
+		},
 	}
-	signedURL, err := bucketHandle.SignedURL(path, opts)
+	signedURL, err := r.bucketHandle(asset.Path).SignedURL(asset.Path, opts)
 	if err != nil {
-		return "", errors.InternalErr.Wrap(err)
+		return nil, errors.InternalErr.Wrap(err)
 	}
-	return signedURL, nil
+	return &model.AssetPresignedUpload{
+		Method: http.MethodPut,
+		URL:    signedURL,
+		Fields: map[string]string{},
+		Headers: map[string]string{
+			"Content-Type":           contentType,
+			contentLengthRangeHeader: contentLengthRange,
+		},
+	}, nil
 }
 
 func (r *asset) GenerateReadURL(
@@ -110,3 +136,81 @@ func (r *asset) GenerateReadURL(
 	}
 	return signedURL, nil
 }
+
+func (r *asset) Head(
+	ctx context.Context,
+	path string,
+) (*model.AssetObject, error) {
+	attrs, err := r.bucketHandle(path).Object(path).Attrs(ctx)
+	if err != nil {
+		if goerrors.Is(err, storage.ErrObjectNotExist) {
+			return nil, nil
+		}
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return &model.AssetObject{
+		Path: path,
+		Size: attrs.Size,
+	}, nil
+}
+
+func (r *asset) NewReader(
+	ctx context.Context,
+	path string,
+) (io.ReadCloser, error) {
+	reader, err := r.bucketHandle(path).Object(path).NewReader(ctx)
+	if err != nil {
+		if goerrors.Is(err, storage.ErrObjectNotExist) {
+			return nil, errors.AssetNotFoundErr.New().
+				WithValue("path", path)
+		}
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return reader, nil
+}
+
+func (r *asset) NewWriter(
+	ctx context.Context,
+	path string,
+	contentType model.ContentType,
+) (io.WriteCloser, error) {
+	// The object is only created once the writer is closed, and cancelling ctx abandons it
+	writer := r.bucketHandle(path).Object(path).NewWriter(ctx)
+	writer.ContentType = contentType.String()
+	return &objectWriter{
+		writer: writer,
+	}, nil
+}
+
+func (r *asset) Delete(
+	ctx context.Context,
+	path string,
+) error {
+	if err := r.bucketHandle(path).Object(path).Delete(ctx); err != nil {
+		if goerrors.Is(err, storage.ErrObjectNotExist) {
+			return nil
+		}
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+// objectWriter wraps the errors of a storage writer.
+type objectWriter struct {
+	writer *storage.Writer
+}
+
+func (w *objectWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	if err != nil {
+		return n, errors.InternalErr.Wrap(err)
+	}
+	return n, nil
+}
+
+func (w *objectWriter) Close() error {
+	if err := w.writer.Close(); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+// Package chunk streams the content of an asset as the chunks of the messages of a streaming RPC.
+package chunk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	goerrors "errors"
+	"io"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+)
+
+// Size is the size of the chunks sent, which stays well below the max message size of gRPC.
+const Size = 64 << 10
+
+type reader struct {
+	recv func() ([]byte, error)
+	buf  []byte
+}
+
+// NewReader returns a reader of the chunks recv returns in order, until it returns io.EOF.
+func NewReader(recv func() ([]byte, error)) io.Reader {
+	return &reader{
+		recv: recv,
+		buf:  nil,
+	}
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// Send sends the content of r in chunks, and returns the hex-encoded SHA-256 of the content sent.
+// The chunk passed to send is reused once it returns.
+func Send(r io.Reader, send func(chunk []byte) error) (string, error) {
+	hash := sha256.New()
+	buf := make([]byte, Size)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			_, _ = hash.Write(buf[:n])
+			if err := send(buf[:n]); err != nil {
+				return "", err
+			}
+		}
+		if goerrors.Is(err, io.EOF) || goerrors.Is(err, io.ErrUnexpectedEOF) {
+			return hex.EncodeToString(hash.Sum(nil)), nil
+		}
+		if err != nil {
+			return "", errors.InternalErr.Wrap(err)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package chunk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReader(t *testing.T) {
+	t.Parallel()
+
+	chunks := [][]byte{[]byte("he"), {}, []byte("llo")}
+	got, err := io.ReadAll(NewReader(func() ([]byte, error) {
+		if len(chunks) == 0 {
+			return nil, io.EOF
+		}
+		chunk := chunks[0]
+		chunks = chunks[1:]
+		return chunk, nil
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), got)
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	content := bytes.Repeat([]byte("a"), Size*2+1)
+	var sent [][]byte
+	sum, err := Send(bytes.NewReader(content), func(chunk []byte) error {
+		sent = append(sent, bytes.Clone(chunk))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, sent, 3)
+	require.Equal(t, content, bytes.Join(sent, nil))
+	want := sha256.Sum256(content)
+	require.Equal(t, hex.EncodeToString(want[:]), sum)
+}
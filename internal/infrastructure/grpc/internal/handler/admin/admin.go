@@ -0,0 +1,167 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/handler/admin/marshaller"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/request_interceptor"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
+	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+)
+
+func (h *AdminHandler) GetAdmin(ctx context.Context, req *admin_apiv1.GetAdminRequest) (*admin_apiv1.GetAdminResponse, error) {
+	got, err := h.adminInteractor.Get(
+		ctx,
+		input.NewAdminGetAdmin(
+			req.GetAdminId(),
+			request_interceptor.GetRequestTime(ctx),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin_apiv1.GetAdminResponse{
+		Admin: marshaller.AdminToPB(got),
+	}, nil
+}
+
+func (h *AdminHandler) ListAdmins(ctx context.Context, req *admin_apiv1.ListAdminsRequest) (*admin_apiv1.ListAdminsResponse, error) {
+	var role nullable.Type[model.AdminRole]
+	if req.Role != nil {
+		role = nullable.TypeFrom(marshaller.AdminRoleToModel(*req.Role))
+	}
+	var sortKey nullable.Type[model.AdminSortKey]
+	if req.SortKey != nil {
+		sortKey = nullable.TypeFrom(marshaller.AdminSortKeyToModel(*req.SortKey))
+	}
+
+	got, err := h.adminInteractor.List(
+		ctx,
+		input.NewAdminListAdmins(
+			req.GetPage(),
+			req.GetLimit(),
+			role,
+			sortKey,
+			request_interceptor.GetRequestTime(ctx),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin_apiv1.ListAdminsResponse{
+		Admins:     marshaller.AdminsToPB(got.Admins),
+		Pagination: marshaller.NewPagination(got.Pagination),
+	}, nil
+}
+
+func (h *AdminHandler) CreateAdmin(ctx context.Context, req *admin_apiv1.CreateAdminRequest) (*admin_apiv1.CreateAdminResponse, error) {
+	actor, err := session_interceptor.RequireAdminAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	got, err := h.adminInteractor.Create(
+		ctx,
+		input.NewAdminCreateAdmin(
+			req.GetEmail(),
+			req.GetDisplayName(),
+			marshaller.AdminRoleToModel(req.GetRole()),
+			actor,
+			request_interceptor.GetRequestTime(ctx),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin_apiv1.CreateAdminResponse{
+		Admin:    marshaller.AdminToPB(got.Admin),
+		Password: got.Password,
+	}, nil
+}
+
+func (h *AdminHandler) UpdateAdmin(ctx context.Context, req *admin_apiv1.UpdateAdminRequest) (*admin_apiv1.UpdateAdminResponse, error) {
+	actor, err := session_interceptor.RequireAdminAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	got, err := h.adminInteractor.Update(
+		ctx,
+		input.NewAdminUpdateAdmin(
+			req.GetAdminId(),
+			null.StringFromPtr(req.DisplayName),
+			actor,
+			request_interceptor.GetRequestTime(ctx),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin_apiv1.UpdateAdminResponse{
+		Admin: marshaller.AdminToPB(got),
+	}, nil
+}
+
+func (h *AdminHandler) UpdateAdminRole(ctx context.Context, req *admin_apiv1.UpdateAdminRoleRequest) (*admin_apiv1.UpdateAdminRoleResponse, error) {
+	claims, err := session_interceptor.RequireAdminSessionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := session_interceptor.RequireAdminAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	got, err := h.adminInteractor.UpdateRole(
+		ctx,
+		input.NewAdminUpdateAdminRole(
+			claims.AdminID.String,
+			req.GetAdminId(),
+			marshaller.AdminRoleToModel(req.GetRole()),
+			actor,
+			request_interceptor.GetRequestTime(ctx),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin_apiv1.UpdateAdminRoleResponse{
+		Admin: marshaller.AdminToPB(got),
+	}, nil
+}
+
+func (h *AdminHandler) DeleteAdmin(ctx context.Context, req *admin_apiv1.DeleteAdminRequest) (*admin_apiv1.DeleteAdminResponse, error) {
+	claims, err := session_interceptor.RequireAdminSessionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := session_interceptor.RequireAdminAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.adminInteractor.Delete(
+		ctx,
+		input.NewAdminDeleteAdmin(
+			claims.AdminID.String,
+			req.GetAdminId(),
+			actor,
+			request_interceptor.GetRequestTime(ctx),
+		),
+	); err != nil {
+		return nil, err
+	}
+
+	return &admin_apiv1.DeleteAdminResponse{}, nil
+}
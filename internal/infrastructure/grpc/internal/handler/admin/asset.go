@@ -2,7 +2,11 @@ package admin
 
 import (
 	"context"
+	goerrors "errors"
+	"io"
 
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/chunk"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/handler/admin/marshaller"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/request_interceptor"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
@@ -29,7 +33,122 @@ func (h *AdminHandler) CreateAssetPresignedURL(ctx context.Context, req *admin_a
 		return nil, err
 	}
 	return &admin_apiv1.CreateAssetPresignedURLResponse{
-		AssetId:      got.AssetID,
-		PresignedUrl: got.PresignedURL,
+		AssetId:       got.AssetID,
+		PresignedUrl:  got.PresignedUpload.URL,
+		UploadMethod:  got.PresignedUpload.Method,
+		UploadFields:  got.PresignedUpload.Fields,
+		UploadHeaders: got.PresignedUpload.Headers,
 	}, nil
 }
+
+func (h *AdminHandler) ConfirmAssetUpload(ctx context.Context, req *admin_apiv1.ConfirmAssetUploadRequest) (*admin_apiv1.ConfirmAssetUploadResponse, error) {
+	claims, err := session_interceptor.RequireAdminSessionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	got, err := h.assetInteractor.ConfirmUpload(
+		ctx,
+		input.NewAdminConfirmAssetUpload(
+			claims.AdminID.String,
+			req.GetAssetId(),
+			request_interceptor.GetRequestTime(ctx),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_apiv1.ConfirmAssetUploadResponse{
+		Asset: marshaller.AssetToPB(got),
+	}, nil
+}
+
+func (h *AdminHandler) UploadAsset(stream admin_apiv1.AdminV1Service_UploadAssetServer) error {
+	ctx := stream.Context()
+	claims, err := session_interceptor.RequireAdminSessionContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := stream.Recv()
+	if goerrors.Is(err, io.EOF) || (err == nil && req.GetHeader() == nil) {
+		return errors.RequestInvalidArgumentErr.New().
+			WithDetail("the first message must be the header")
+	}
+	if err != nil {
+		return err
+	}
+	header := req.GetHeader()
+
+	got, err := h.assetInteractor.Upload(
+		ctx,
+		input.NewAdminUploadAsset(
+			claims.AdminID.String,
+			marshaller.AdminContentTypeToModel(header.GetContentType()),
+			marshaller.AdminAssetTypeToModel(header.GetAssetType()),
+			header.GetSha256(),
+			chunk.NewReader(func() ([]byte, error) {
+				req, err := stream.Recv()
+				if err != nil {
+					return nil, err
+				}
+				if req.GetHeader() != nil {
+					return nil, errors.RequestInvalidArgumentErr.New().
+						WithDetail("the header must be sent only once")
+				}
+				return req.GetChunk(), nil
+			}),
+			request_interceptor.GetRequestTime(ctx),
+		),
+	)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(&admin_apiv1.UploadAssetResponse{
+		Asset: marshaller.AssetToPB(got),
+	})
+}
+
+func (h *AdminHandler) DownloadAsset(req *admin_apiv1.DownloadAssetRequest, stream admin_apiv1.AdminV1Service_DownloadAssetServer) error {
+	ctx := stream.Context()
+	claims, err := session_interceptor.RequireAdminSessionContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	got, err := h.assetInteractor.Download(
+		ctx,
+		input.NewAdminDownloadAsset(
+			claims.AdminID.String,
+			req.GetAssetId(),
+		),
+	)
+	if err != nil {
+		return err
+	}
+	defer got.Content.Close()
+
+	if err := stream.Send(&admin_apiv1.DownloadAssetResponse{
+		Payload: &admin_apiv1.DownloadAssetResponse_Asset{
+			Asset: marshaller.AssetToPB(got.Asset),
+		},
+	}); err != nil {
+		return err
+	}
+	sum, err := chunk.Send(got.Content, func(c []byte) error {
+		return stream.Send(&admin_apiv1.DownloadAssetResponse{
+			Payload: &admin_apiv1.DownloadAssetResponse_Chunk{
+				Chunk: c,
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	// the checksum lets the client verify the content it received
+	return stream.Send(&admin_apiv1.DownloadAssetResponse{
+		Payload: &admin_apiv1.DownloadAssetResponse_Sha256{
+			Sha256: sum,
+		},
+	})
+}
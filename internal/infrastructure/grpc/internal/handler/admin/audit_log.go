@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/handler/admin/marshaller"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/request_interceptor"
+	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+)
+
+func (h *AdminHandler) ListAuditLogs(ctx context.Context, req *admin_apiv1.ListAuditLogsRequest) (*admin_apiv1.ListAuditLogsResponse, error) {
+	var actorType nullable.Type[model.AuditLogActorType]
+	if req.ActorType != nil {
+		actorType = nullable.TypeFrom(marshaller.AuditLogActorTypeToModel(*req.ActorType))
+	}
+	var targetType nullable.Type[model.AuditLogTargetType]
+	if req.TargetType != nil {
+		targetType = nullable.TypeFrom(marshaller.AuditLogTargetTypeToModel(*req.TargetType))
+	}
+
+	got, err := h.auditLogInteractor.List(
+		ctx,
+		input.NewAdminListAuditLogs(
+			req.GetPage(),
+			req.GetLimit(),
+			null.StringFromPtr(req.TenantId),
+			actorType,
+			null.StringFromPtr(req.ActorId),
+			targetType,
+			null.StringFromPtr(req.TargetId),
+			request_interceptor.GetRequestTime(ctx),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin_apiv1.ListAuditLogsResponse{
+		AuditLogs:  marshaller.AuditLogsToPB(got.AuditLogs),
+		Pagination: marshaller.NewPagination(got.Pagination),
+	}, nil
+}
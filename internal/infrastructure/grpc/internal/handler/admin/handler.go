@@ -6,19 +6,25 @@ import (
 )
 
 type AdminHandler struct {
-	tenantInteractor usecase.AdminTenantInteractor
-	staffInteractor  usecase.AdminStaffInteractor
-	assetInteractor  usecase.AdminAssetInteractor
+	tenantInteractor   usecase.AdminTenantInteractor
+	staffInteractor    usecase.AdminStaffInteractor
+	assetInteractor    usecase.AdminAssetInteractor
+	adminInteractor    usecase.AdminAdminInteractor
+	auditLogInteractor usecase.AdminAuditLogInteractor
 }
 
 func NewAdminHandler(
 	tenantInteractor usecase.AdminTenantInteractor,
 	staffInteractor usecase.AdminStaffInteractor,
 	assetInteractor usecase.AdminAssetInteractor,
+	adminInteractor usecase.AdminAdminInteractor,
+	auditLogInteractor usecase.AdminAuditLogInteractor,
 ) admin_apiv1.AdminV1ServiceServer {
 	return &AdminHandler{
-		tenantInteractor: tenantInteractor,
-		staffInteractor:  staffInteractor,
-		assetInteractor:  assetInteractor,
+		tenantInteractor:   tenantInteractor,
+		staffInteractor:    staffInteractor,
+		assetInteractor:    assetInteractor,
+		adminInteractor:    adminInteractor,
+		auditLogInteractor: auditLogInteractor,
 	}
 }
@@ -0,0 +1,48 @@
+package marshaller
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AdminToPB(m *model.Admin) *admin_apiv1.Admin {
+	if m == nil {
+		return nil
+	}
+
+	return &admin_apiv1.Admin{
+		Id:          m.ID,
+		Role:        AdminRoleToPB(m.Role),
+		AuthUid:     m.AuthUID,
+		Email:       m.Email,
+		DisplayName: m.DisplayName,
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+		UpdatedAt:   timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func AdminsToPB(slice model.Admins) []*admin_apiv1.Admin {
+	dsts := make([]*admin_apiv1.Admin, len(slice))
+	for idx, m := range slice {
+		dsts[idx] = AdminToPB(m)
+	}
+	return dsts
+}
+
+func AdminSortKeyToModel(s admin_apiv1.ListAdminsRequest_ListAdminsSortKey) model.AdminSortKey {
+	switch s {
+	case admin_apiv1.ListAdminsRequest_LIST_ADMINS_SORT_KEY_CREATED_AT_DESC:
+		return model.AdminSortKeyCreatedAtDesc
+	case admin_apiv1.ListAdminsRequest_LIST_ADMINS_SORT_KEY_CREATED_AT_ASC:
+		return model.AdminSortKeyCreatedAtAsc
+	case admin_apiv1.ListAdminsRequest_LIST_ADMINS_SORT_KEY_DISPLAY_NAME_ASC:
+		return model.AdminSortKeyDisplayNameAsc
+	case admin_apiv1.ListAdminsRequest_LIST_ADMINS_SORT_KEY_DISPLAY_NAME_DESC:
+		return model.AdminSortKeyDisplayNameDesc
+	case admin_apiv1.ListAdminsRequest_LIST_ADMINS_SORT_KEY_UNSPECIFIED:
+		return model.AdminSortKeyUnknown
+	default:
+		return model.AdminSortKeyUnknown
+	}
+}
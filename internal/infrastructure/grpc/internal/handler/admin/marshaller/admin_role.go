@@ -0,0 +1,32 @@
+package marshaller
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
+)
+
+func AdminRoleToModel(adminRole admin_apiv1.AdminRole) model.AdminRole {
+	switch adminRole {
+	case admin_apiv1.AdminRole_ADMIN_ROLE_ROOT:
+		return model.AdminRoleRoot
+	case admin_apiv1.AdminRole_ADMIN_ROLE_NORMAL:
+		return model.AdminRoleNormal
+	case admin_apiv1.AdminRole_ADMIN_ROLE_UNSPECIFIED:
+		fallthrough
+	default:
+		return model.AdminRoleUnknown
+	}
+}
+
+func AdminRoleToPB(adminRole model.AdminRole) admin_apiv1.AdminRole {
+	switch adminRole {
+	case model.AdminRoleRoot:
+		return admin_apiv1.AdminRole_ADMIN_ROLE_ROOT
+	case model.AdminRoleNormal:
+		return admin_apiv1.AdminRole_ADMIN_ROLE_NORMAL
+	case model.AdminRoleUnknown:
+		fallthrough
+	default:
+		return admin_apiv1.AdminRole_ADMIN_ROLE_UNSPECIFIED
+	}
+}
@@ -15,3 +15,14 @@ func AdminAssetTypeToModel(assetType admin_apiv1.AssetType) model.AssetType {
 		return model.AssetTypeUnknown
 	}
 }
+
+func AssetTypeToPB(assetType model.AssetType) admin_apiv1.AssetType {
+	switch assetType {
+	case model.AssetTypeUserImage:
+		return admin_apiv1.AssetType_ASSET_TYPE_USER_IMAGE
+	case model.AssetTypeUnknown:
+		fallthrough
+	default:
+		return admin_apiv1.AssetType_ASSET_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,104 @@
+package marshaller
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AuditLogToPB(m *model.AuditLog) *admin_apiv1.AuditLog {
+	if m == nil {
+		return nil
+	}
+
+	return &admin_apiv1.AuditLog{
+		Id:         m.ID,
+		TenantId:   m.TenantID.Ptr(),
+		ActorType:  AuditLogActorTypeToPB(m.ActorType),
+		ActorId:    m.ActorID,
+		Method:     m.Method,
+		TargetType: AuditLogTargetTypeToPB(m.TargetType),
+		TargetId:   m.TargetID,
+		Before:     auditLogValuesToPB(m.Diff.Before),
+		After:      auditLogValuesToPB(m.Diff.After),
+		CreatedAt:  timestamppb.New(m.CreatedAt),
+	}
+}
+
+func AuditLogsToPB(slice model.AuditLogs) []*admin_apiv1.AuditLog {
+	dsts := make([]*admin_apiv1.AuditLog, len(slice))
+	for idx, m := range slice {
+		dsts[idx] = AuditLogToPB(m)
+	}
+	return dsts
+}
+
+// auditLogValuesToPB converts diff values, which are already normalized into JSON types.
+func auditLogValuesToPB(values map[string]any) *structpb.Struct {
+	dst, err := structpb.NewStruct(values)
+	if err != nil {
+		return &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	}
+	return dst
+}
+
+func AuditLogActorTypeToModel(actorType admin_apiv1.AuditLogActorType) model.AuditLogActorType {
+	switch actorType {
+	case admin_apiv1.AuditLogActorType_AUDIT_LOG_ACTOR_TYPE_ADMIN:
+		return model.AuditLogActorTypeAdmin
+	case admin_apiv1.AuditLogActorType_AUDIT_LOG_ACTOR_TYPE_STAFF:
+		return model.AuditLogActorTypeStaff
+	case admin_apiv1.AuditLogActorType_AUDIT_LOG_ACTOR_TYPE_UNSPECIFIED:
+		fallthrough
+	default:
+		return model.AuditLogActorTypeUnknown
+	}
+}
+
+func AuditLogActorTypeToPB(actorType model.AuditLogActorType) admin_apiv1.AuditLogActorType {
+	switch actorType {
+	case model.AuditLogActorTypeAdmin:
+		return admin_apiv1.AuditLogActorType_AUDIT_LOG_ACTOR_TYPE_ADMIN
+	case model.AuditLogActorTypeStaff:
+		return admin_apiv1.AuditLogActorType_AUDIT_LOG_ACTOR_TYPE_STAFF
+	case model.AuditLogActorTypeUnknown:
+		fallthrough
+	default:
+		return admin_apiv1.AuditLogActorType_AUDIT_LOG_ACTOR_TYPE_UNSPECIFIED
+	}
+}
+
+func AuditLogTargetTypeToModel(targetType admin_apiv1.AuditLogTargetType) model.AuditLogTargetType {
+	switch targetType {
+	case admin_apiv1.AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_TENANT:
+		return model.AuditLogTargetTypeTenant
+	case admin_apiv1.AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_STAFF:
+		return model.AuditLogTargetTypeStaff
+	case admin_apiv1.AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_ADMIN:
+		return model.AuditLogTargetTypeAdmin
+	case admin_apiv1.AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_INVITATION:
+		return model.AuditLogTargetTypeInvitation
+	case admin_apiv1.AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_UNSPECIFIED:
+		fallthrough
+	default:
+		return model.AuditLogTargetTypeUnknown
+	}
+}
+
+func AuditLogTargetTypeToPB(targetType model.AuditLogTargetType) admin_apiv1.AuditLogTargetType {
+	switch targetType {
+	case model.AuditLogTargetTypeTenant:
+		return admin_apiv1.AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_TENANT
+	case model.AuditLogTargetTypeStaff:
+		return admin_apiv1.AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_STAFF
+	case model.AuditLogTargetTypeAdmin:
+		return admin_apiv1.AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_ADMIN
+	case model.AuditLogTargetTypeInvitation:
+		return admin_apiv1.AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_INVITATION
+	case model.AuditLogTargetTypeUnknown:
+		fallthrough
+	default:
+		return admin_apiv1.AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_UNSPECIFIED
+	}
+}
@@ -23,3 +23,22 @@ func AdminContentTypeToModel(contentType admin_apiv1.ContentType) model.ContentT
 		return model.ContentTypeUnknown
 	}
 }
+
+func ContentTypeToPB(contentType model.ContentType) admin_apiv1.ContentType {
+	switch contentType {
+	case model.ContentTypeImagePNG:
+		return admin_apiv1.ContentType_CONTENT_TYPE_IMAGE_PNG
+	case model.ContentTypeImageJPEG:
+		return admin_apiv1.ContentType_CONTENT_TYPE_IMAGE_JPEG
+	case model.ContentTypeApplicationZIP:
+		return admin_apiv1.ContentType_CONTENT_TYPE_APPLICATION_ZIP
+	case model.ContentTypeApplicationPDF:
+		return admin_apiv1.ContentType_CONTENT_TYPE_APPLICATION_PDF
+	case model.ContentTypeTextCSV:
+		return admin_apiv1.ContentType_CONTENT_TYPE_TEXT_CSV
+	case model.ContentTypeUnknown:
+		fallthrough
+	default:
+		return admin_apiv1.ContentType_CONTENT_TYPE_UNSPECIFIED
+	}
+}
@@ -6,6 +6,9 @@ import (
 )
 
 func NewPagination(m *model.Pagination) *admin_apiv1.Pagination {
+	if m == nil {
+		return nil
+	}
 	return &admin_apiv1.Pagination{
 		CurrentPage: m.CurrentPage,
 		PrevPage:    m.PrevPage,
@@ -13,8 +13,11 @@ func TenantToPB(m *model.Tenant) *admin_apiv1.Tenant {
 	return &admin_apiv1.Tenant{
 		Id:        m.ID,
 		Name:      m.Name,
+		Tags:      TenantTagsToPB(m.Tags),
 		CreatedAt: timestamppb.New(m.CreatedAt),
 		UpdatedAt: timestamppb.New(m.UpdatedAt),
+		DeletedAt: NullTimeToPB(m.DeletedAt),
+		Etag:      m.ETag(),
 	}
 }
 
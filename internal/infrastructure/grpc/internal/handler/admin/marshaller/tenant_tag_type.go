@@ -0,0 +1,56 @@
+package marshaller
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
+)
+
+func TenantTagTypeToModel(tagType admin_apiv1.TenantTagType) model.TenantTagType {
+	switch tagType {
+	case admin_apiv1.TenantTagType_TENANT_TAG_TYPE_ENTERTAINMENT:
+		return model.TenantTagTypeEntertainment
+	case admin_apiv1.TenantTagType_TENANT_TAG_TYPE_EDUCATION:
+		return model.TenantTagTypeEducation
+	case admin_apiv1.TenantTagType_TENANT_TAG_TYPE_BUSINESS:
+		return model.TenantTagTypeBusiness
+	case admin_apiv1.TenantTagType_TENANT_TAG_TYPE_OTHER:
+		return model.TenantTagTypeOther
+	case admin_apiv1.TenantTagType_TENANT_TAG_TYPE_UNSPECIFIED:
+		fallthrough
+	default:
+		return model.TenantTagTypeUnknown
+	}
+}
+
+func TenantTagTypesToModel(slice []admin_apiv1.TenantTagType) []model.TenantTagType {
+	dsts := make([]model.TenantTagType, len(slice))
+	for idx, tagType := range slice {
+		dsts[idx] = TenantTagTypeToModel(tagType)
+	}
+	return dsts
+}
+
+func TenantTagTypeToPB(tagType model.TenantTagType) admin_apiv1.TenantTagType {
+	switch tagType {
+	case model.TenantTagTypeEntertainment:
+		return admin_apiv1.TenantTagType_TENANT_TAG_TYPE_ENTERTAINMENT
+	case model.TenantTagTypeEducation:
+		return admin_apiv1.TenantTagType_TENANT_TAG_TYPE_EDUCATION
+	case model.TenantTagTypeBusiness:
+		return admin_apiv1.TenantTagType_TENANT_TAG_TYPE_BUSINESS
+	case model.TenantTagTypeOther:
+		return admin_apiv1.TenantTagType_TENANT_TAG_TYPE_OTHER
+	case model.TenantTagTypeUnknown:
+		fallthrough
+	default:
+		return admin_apiv1.TenantTagType_TENANT_TAG_TYPE_UNSPECIFIED
+	}
+}
+
+func TenantTagsToPB(tags model.TenantTags) []admin_apiv1.TenantTagType {
+	dsts := make([]admin_apiv1.TenantTagType, len(tags))
+	for idx, tag := range tags {
+		dsts[idx] = TenantTagTypeToPB(tag.Type)
+	}
+	return dsts
+}
@@ -0,0 +1,20 @@
+package marshaller
+
+import (
+	"github.com/aarondl/null/v8"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NullTimeToPB(t null.Time) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+func NullTimeToModel(t *timestamppb.Timestamp) null.Time {
+	if t == nil {
+		return null.Time{}
+	}
+	return null.TimeFrom(t.AsTime())
+}
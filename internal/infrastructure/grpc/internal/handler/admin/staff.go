@@ -35,6 +35,10 @@ func (h *AdminHandler) ListStaffs(ctx context.Context, req *admin_apiv1.ListStaf
 	if req.SortKey != nil {
 		sortKey = nullable.TypeFrom(marshaller.StaffSortKeyToModel(*req.SortKey))
 	}
+	var role nullable.Type[model.StaffRole]
+	if req.Role != nil {
+		role = nullable.TypeFrom(marshaller.StaffRoleToModel(*req.Role))
+	}
 
 	got, err := h.staffInteractor.List(
 		ctx,
@@ -43,6 +47,14 @@ func (h *AdminHandler) ListStaffs(ctx context.Context, req *admin_apiv1.ListStaf
 			req.GetPage(),
 			req.GetLimit(),
 			sortKey,
+			null.StringFromPtr(req.Cursor),
+			req.GetIncludeTotalCount(),
+			req.GetIncludeDeleted(),
+			null.StringFromPtr(req.DisplayName),
+			null.StringFromPtr(req.Email),
+			role,
+			marshaller.NullTimeToModel(req.GetCreatedAtFrom()),
+			marshaller.NullTimeToModel(req.GetCreatedAtTo()),
 			request_interceptor.GetRequestTime(ctx),
 		),
 	)
@@ -53,6 +65,7 @@ func (h *AdminHandler) ListStaffs(ctx context.Context, req *admin_apiv1.ListStaf
 	return &admin_apiv1.ListStaffsResponse{
 		Staffs:     marshaller.StaffsToPB(got.Staffs),
 		Pagination: marshaller.NewPagination(got.Pagination),
+		NextCursor: got.NextCursor.Ptr(),
 	}, nil
 }
 
@@ -62,6 +75,11 @@ func (h *AdminHandler) CreateStaff(ctx context.Context, req *admin_apiv1.CreateS
 		return nil, err
 	}
 
+	actor, err := session_interceptor.RequireAdminAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	got, err := h.staffInteractor.Create(
 		ctx,
 		input.NewAdminCreateStaff(
@@ -71,6 +89,7 @@ func (h *AdminHandler) CreateStaff(ctx context.Context, req *admin_apiv1.CreateS
 			req.GetDisplayName(),
 			marshaller.StaffRoleToModel(req.GetRole()),
 			req.GetImageAssetId(),
+			actor,
 			request_interceptor.GetRequestTime(ctx),
 		),
 	)
@@ -90,6 +109,11 @@ func (h *AdminHandler) UpdateStaff(ctx context.Context, req *admin_apiv1.UpdateS
 		return nil, err
 	}
 
+	actor, err := session_interceptor.RequireAdminAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var role nullable.Type[model.StaffRole]
 	if req.Role != nil {
 		role = nullable.TypeFrom(marshaller.StaffRoleToModel(*req.Role))
@@ -101,6 +125,8 @@ func (h *AdminHandler) UpdateStaff(ctx context.Context, req *admin_apiv1.UpdateS
 		null.StringFromPtr(req.DisplayName),
 		role,
 		null.StringFromPtr(req.ImageAssetId),
+		null.StringFromPtr(req.Etag),
+		actor,
 		request_interceptor.GetRequestTime(ctx),
 	))
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/handler/admin/marshaller"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/request_interceptor"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
 	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
 	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
@@ -17,6 +18,7 @@ func (h *AdminHandler) GetTenant(ctx context.Context, req *admin_apiv1.GetTenant
 		ctx,
 		input.NewAdminGetTenant(
 			req.GetTenantId(),
+			req.GetIncludeDeleted(),
 			request_interceptor.GetRequestTime(ctx),
 		),
 	)
@@ -40,6 +42,13 @@ func (h *AdminHandler) ListTenants(ctx context.Context, req *admin_apiv1.ListTen
 			req.GetPage(),
 			req.GetLimit(),
 			sortKey,
+			null.StringFromPtr(req.Cursor),
+			req.GetIncludeTotalCount(),
+			req.GetIncludeDeleted(),
+			null.StringFromPtr(req.Name),
+			marshaller.TenantTagTypesToModel(req.GetTagTypes()),
+			marshaller.NullTimeToModel(req.GetCreatedAtFrom()),
+			marshaller.NullTimeToModel(req.GetCreatedAtTo()),
 			request_interceptor.GetRequestTime(ctx),
 		),
 	)
@@ -49,14 +58,22 @@ func (h *AdminHandler) ListTenants(ctx context.Context, req *admin_apiv1.ListTen
 	return &admin_apiv1.ListTenantsResponse{
 		Tenants:    marshaller.TenantsToPB(got.Tenants),
 		Pagination: marshaller.NewPagination(got.Pagination),
+		NextCursor: got.NextCursor.Ptr(),
 	}, nil
 }
 
 func (h *AdminHandler) CreateTenant(ctx context.Context, req *admin_apiv1.CreateTenantRequest) (*admin_apiv1.CreateTenantResponse, error) {
+	actor, err := session_interceptor.RequireAdminAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	got, err := h.tenantInteractor.Create(
 		ctx,
 		input.NewAdminCreateTenant(
 			req.GetName(),
+			marshaller.TenantTagTypesToModel(req.GetTags()),
+			actor,
 			request_interceptor.GetRequestTime(ctx),
 		),
 	)
@@ -69,11 +86,24 @@ func (h *AdminHandler) CreateTenant(ctx context.Context, req *admin_apiv1.Create
 }
 
 func (h *AdminHandler) UpdateTenant(ctx context.Context, req *admin_apiv1.UpdateTenantRequest) (*admin_apiv1.UpdateTenantResponse, error) {
+	actor, err := session_interceptor.RequireAdminAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tagTypes nullable.Type[[]model.TenantTagType]
+	if req.Tags != nil {
+		tagTypes = nullable.TypeFrom(marshaller.TenantTagTypesToModel(req.Tags.GetValues()))
+	}
+
 	got, err := h.tenantInteractor.Update(
 		ctx,
 		input.NewAdminUpdateTenant(
 			req.GetTenantId(),
 			null.StringFromPtr(req.Name),
+			tagTypes,
+			null.StringFromPtr(req.Etag),
+			actor,
 			request_interceptor.GetRequestTime(ctx),
 		),
 	)
@@ -86,10 +116,16 @@ func (h *AdminHandler) UpdateTenant(ctx context.Context, req *admin_apiv1.Update
 }
 
 func (h *AdminHandler) DeleteTenant(ctx context.Context, req *admin_apiv1.DeleteTenantRequest) (*admin_apiv1.DeleteTenantResponse, error) {
-	err := h.tenantInteractor.Delete(
+	actor, err := session_interceptor.RequireAdminAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.tenantInteractor.Delete(
 		ctx,
 		input.NewAdminDeleteTenant(
 			req.GetTenantId(),
+			actor,
 			request_interceptor.GetRequestTime(ctx),
 		),
 	)
@@ -98,3 +134,25 @@ func (h *AdminHandler) DeleteTenant(ctx context.Context, req *admin_apiv1.Delete
 	}
 	return &admin_apiv1.DeleteTenantResponse{}, nil
 }
+
+func (h *AdminHandler) RestoreTenant(ctx context.Context, req *admin_apiv1.RestoreTenantRequest) (*admin_apiv1.RestoreTenantResponse, error) {
+	actor, err := session_interceptor.RequireAdminAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	got, err := h.tenantInteractor.Restore(
+		ctx,
+		input.NewAdminRestoreTenant(
+			req.GetTenantId(),
+			actor,
+			request_interceptor.GetRequestTime(ctx),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_apiv1.RestoreTenantResponse{
+		Tenant: marshaller.TenantToPB(got),
+	}, nil
+}
@@ -1,16 +1,22 @@
 package public
 
 import (
+	"context"
+
 	public_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/public_api/v1"
-	database "github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql"
 )
 
+// DatabaseClient is the database client of whichever backend the server is built with.
+type DatabaseClient interface {
+	Ping(ctx context.Context) error
+}
+
 type PublicHandler struct {
-	databaseCli *database.Client
+	databaseCli DatabaseClient
 }
 
 func NewPublicHandler(
-	databaseCli *database.Client,
+	databaseCli DatabaseClient,
 ) public_apiv1.PublicV1ServiceServer {
 	return &PublicHandler{
 		databaseCli: databaseCli,
@@ -10,8 +10,8 @@ import (
 
 func (h *PublicHandler) DeepHealthCheck(ctx context.Context, req *public_apiv1.DeepHealthCheckRequest) (*public_apiv1.DeepHealthCheckResponse, error) {
 	databaseStatus := "up"
-	if err := h.databaseCli.DB.Ping(); err != nil {
-		logger.L(ctx).Error("failed to  h.databaseCli.DB.Ping", logger_field.Error(err))
+	if err := h.databaseCli.Ping(ctx); err != nil {
+		logger.L(ctx).Error("failed to h.databaseCli.Ping", logger_field.Error(err))
 		databaseStatus = "down"
 	}
 
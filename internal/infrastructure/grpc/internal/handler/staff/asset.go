@@ -2,7 +2,11 @@ package staff
 
 import (
 	"context"
+	goerrors "errors"
+	"io"
 
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/chunk"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/handler/staff/marshaller"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/request_interceptor"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
@@ -29,7 +33,122 @@ func (h *StaffHandler) CreateAssetPresignedURL(ctx context.Context, req *staff_a
 		return nil, err
 	}
 	return &staff_apiv1.CreateAssetPresignedURLResponse{
-		AssetId:      got.AssetID,
-		PresignedUrl: got.PresignedURL,
+		AssetId:       got.AssetID,
+		PresignedUrl:  got.PresignedUpload.URL,
+		UploadMethod:  got.PresignedUpload.Method,
+		UploadFields:  got.PresignedUpload.Fields,
+		UploadHeaders: got.PresignedUpload.Headers,
 	}, nil
 }
+
+func (h *StaffHandler) ConfirmAssetUpload(ctx context.Context, req *staff_apiv1.ConfirmAssetUploadRequest) (*staff_apiv1.ConfirmAssetUploadResponse, error) {
+	claims, err := session_interceptor.RequireStaffSessionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	got, err := h.assetInteractor.ConfirmUpload(
+		ctx,
+		input.NewStaffConfirmAssetUpload(
+			claims.StaffID.String,
+			req.GetAssetId(),
+			request_interceptor.GetRequestTime(ctx),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &staff_apiv1.ConfirmAssetUploadResponse{
+		Asset: marshaller.AssetToPB(got),
+	}, nil
+}
+
+func (h *StaffHandler) UploadAsset(stream staff_apiv1.StaffV1Service_UploadAssetServer) error {
+	ctx := stream.Context()
+	claims, err := session_interceptor.RequireStaffSessionContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := stream.Recv()
+	if goerrors.Is(err, io.EOF) || (err == nil && req.GetHeader() == nil) {
+		return errors.RequestInvalidArgumentErr.New().
+			WithDetail("the first message must be the header")
+	}
+	if err != nil {
+		return err
+	}
+	header := req.GetHeader()
+
+	got, err := h.assetInteractor.Upload(
+		ctx,
+		input.NewStaffUploadAsset(
+			claims.StaffID.String,
+			marshaller.StaffContentTypeToModel(header.GetContentType()),
+			marshaller.StaffAssetTypeToModel(header.GetAssetType()),
+			header.GetSha256(),
+			chunk.NewReader(func() ([]byte, error) {
+				req, err := stream.Recv()
+				if err != nil {
+					return nil, err
+				}
+				if req.GetHeader() != nil {
+					return nil, errors.RequestInvalidArgumentErr.New().
+						WithDetail("the header must be sent only once")
+				}
+				return req.GetChunk(), nil
+			}),
+			request_interceptor.GetRequestTime(ctx),
+		),
+	)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(&staff_apiv1.UploadAssetResponse{
+		Asset: marshaller.AssetToPB(got),
+	})
+}
+
+func (h *StaffHandler) DownloadAsset(req *staff_apiv1.DownloadAssetRequest, stream staff_apiv1.StaffV1Service_DownloadAssetServer) error {
+	ctx := stream.Context()
+	claims, err := session_interceptor.RequireStaffSessionContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	got, err := h.assetInteractor.Download(
+		ctx,
+		input.NewStaffDownloadAsset(
+			claims.StaffID.String,
+			req.GetAssetId(),
+		),
+	)
+	if err != nil {
+		return err
+	}
+	defer got.Content.Close()
+
+	if err := stream.Send(&staff_apiv1.DownloadAssetResponse{
+		Payload: &staff_apiv1.DownloadAssetResponse_Asset{
+			Asset: marshaller.AssetToPB(got.Asset),
+		},
+	}); err != nil {
+		return err
+	}
+	sum, err := chunk.Send(got.Content, func(c []byte) error {
+		return stream.Send(&staff_apiv1.DownloadAssetResponse{
+			Payload: &staff_apiv1.DownloadAssetResponse_Chunk{
+				Chunk: c,
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	// the checksum lets the client verify the content it received
+	return stream.Send(&staff_apiv1.DownloadAssetResponse{
+		Payload: &staff_apiv1.DownloadAssetResponse_Sha256{
+			Sha256: sum,
+		},
+	})
+}
@@ -6,10 +6,11 @@ import (
 )
 
 type StaffHandler struct {
-	meInteractor       usecase.StaffMeInteractor
-	meTenantInteractor usecase.StaffMeTenantInteractor
-	staffInteractor    usecase.StaffStaffInteractor
-	assetInteractor    usecase.StaffAssetInteractor
+	meInteractor         usecase.StaffMeInteractor
+	meTenantInteractor   usecase.StaffMeTenantInteractor
+	staffInteractor      usecase.StaffStaffInteractor
+	assetInteractor      usecase.StaffAssetInteractor
+	invitationInteractor usecase.StaffInvitationInteractor
 }
 
 func NewStaffHandler(
@@ -17,11 +18,13 @@ func NewStaffHandler(
 	meTenantInteractor usecase.StaffMeTenantInteractor,
 	staffInteractor usecase.StaffStaffInteractor,
 	assetInteractor usecase.StaffAssetInteractor,
+	invitationInteractor usecase.StaffInvitationInteractor,
 ) staff_apiv1.StaffV1ServiceServer {
 	return &StaffHandler{
-		meInteractor:       meInteractor,
-		meTenantInteractor: meTenantInteractor,
-		staffInteractor:    staffInteractor,
-		assetInteractor:    assetInteractor,
+		meInteractor:         meInteractor,
+		meTenantInteractor:   meTenantInteractor,
+		staffInteractor:      staffInteractor,
+		assetInteractor:      assetInteractor,
+		invitationInteractor: invitationInteractor,
 	}
 }
@@ -0,0 +1,137 @@
+package staff
+
+import (
+	"context"
+
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/handler/staff/marshaller"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/request_interceptor"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
+	staff_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"google.golang.org/grpc"
+)
+
+func (h *StaffHandler) CreateInvitation(
+	ctx context.Context,
+	req *staff_apiv1.CreateInvitationRequest,
+) (*staff_apiv1.CreateInvitationResponse, error) {
+	claims, err := session_interceptor.RequireStaffSessionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	actor, err := session_interceptor.RequireStaffAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestTime := request_interceptor.GetRequestTime(ctx)
+
+	invitation, err := h.invitationInteractor.Create(
+		ctx,
+		input.NewStaffCreateInvitation(
+			claims.TenantID.String,
+			claims.StaffID.String,
+			req.GetEmail(),
+			marshaller.StaffRoleToModel(req.GetRole()),
+			actor,
+			requestTime,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff_apiv1.CreateInvitationResponse{
+		Invitation: marshaller.InvitationToPB(invitation, requestTime),
+	}, nil
+}
+
+func (h *StaffHandler) ListInvitations(
+	ctx context.Context,
+	req *staff_apiv1.ListInvitationsRequest,
+) (*staff_apiv1.ListInvitationsResponse, error) {
+	claims, err := session_interceptor.RequireStaffSessionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestTime := request_interceptor.GetRequestTime(ctx)
+
+	got, err := h.invitationInteractor.List(
+		ctx,
+		input.NewStaffListInvitations(
+			claims.TenantID.String,
+			claims.StaffID.String,
+			req.GetPage(),
+			req.GetLimit(),
+			requestTime,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff_apiv1.ListInvitationsResponse{
+		Invitations: marshaller.InvitationsToPB(got.Invitations, requestTime),
+		Pagination:  marshaller.NewPagination(got.Pagination),
+	}, nil
+}
+
+func (h *StaffHandler) RevokeInvitation(
+	ctx context.Context,
+	req *staff_apiv1.RevokeInvitationRequest,
+) (*staff_apiv1.RevokeInvitationResponse, error) {
+	claims, err := session_interceptor.RequireStaffSessionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	actor, err := session_interceptor.RequireStaffAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestTime := request_interceptor.GetRequestTime(ctx)
+
+	invitation, err := h.invitationInteractor.Revoke(
+		ctx,
+		input.NewStaffRevokeInvitation(
+			claims.TenantID.String,
+			claims.StaffID.String,
+			req.GetInvitationId(),
+			actor,
+			requestTime,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff_apiv1.RevokeInvitationResponse{
+		Invitation: marshaller.InvitationToPB(invitation, requestTime),
+	}, nil
+}
+
+func (h *StaffHandler) AcceptInvitation(
+	ctx context.Context,
+	req *staff_apiv1.AcceptInvitationRequest,
+) (*staff_apiv1.AcceptInvitationResponse, error) {
+	claims, err := session_interceptor.RequireStaffSessionContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	method, _ := grpc.Method(ctx)
+	requestTime := request_interceptor.GetRequestTime(ctx)
+
+	staff, err := h.invitationInteractor.Accept(ctx, input.NewStaffAcceptInvitation(
+		claims.AuthUID,
+		claims.Email,
+		req.GetToken(),
+		req.GetDisplayName(),
+		method,
+		requestTime,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return &staff_apiv1.AcceptInvitationResponse{
+		Staff: marshaller.StaffToPB(staff),
+	}, nil
+}
@@ -0,0 +1,39 @@
+package marshaller
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	staff_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AssetToPB(m *model.Asset) *staff_apiv1.Asset {
+	if m == nil {
+		return nil
+	}
+	return &staff_apiv1.Asset{
+		Id:          m.ID,
+		AssetType:   AssetTypeToPB(m.Type),
+		ContentType: ContentTypeToPB(m.ContentType),
+		Status:      AssetStatusToPB(m.Status),
+		Size:        m.Size.Int64,
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+		UpdatedAt:   timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func AssetStatusToPB(status model.AssetStatus) staff_apiv1.AssetStatus {
+	switch status {
+	case model.AssetStatusPending:
+		return staff_apiv1.AssetStatus_ASSET_STATUS_PENDING
+	case model.AssetStatusUploaded:
+		return staff_apiv1.AssetStatus_ASSET_STATUS_UPLOADED
+	case model.AssetStatusAttached:
+		return staff_apiv1.AssetStatus_ASSET_STATUS_ATTACHED
+	case model.AssetStatusOrphaned:
+		return staff_apiv1.AssetStatus_ASSET_STATUS_ORPHANED
+	case model.AssetStatusUnknown:
+		fallthrough
+	default:
+		return staff_apiv1.AssetStatus_ASSET_STATUS_UNSPECIFIED
+	}
+}
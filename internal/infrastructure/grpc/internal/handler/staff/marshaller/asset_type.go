@@ -15,3 +15,14 @@ func StaffAssetTypeToModel(assetType staff_apiv1.AssetType) model.AssetType {
 		return model.AssetTypeUnknown
 	}
 }
+
+func AssetTypeToPB(assetType model.AssetType) staff_apiv1.AssetType {
+	switch assetType {
+	case model.AssetTypeUserImage:
+		return staff_apiv1.AssetType_ASSET_TYPE_USER_IMAGE
+	case model.AssetTypeUnknown:
+		fallthrough
+	default:
+		return staff_apiv1.AssetType_ASSET_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,55 @@
+package marshaller
+
+import (
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	staff_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// InvitationToPB converts the invitation with its status at t.
+func InvitationToPB(m *model.Invitation, t time.Time) *staff_apiv1.Invitation {
+	if m == nil {
+		return nil
+	}
+	return &staff_apiv1.Invitation{
+		Id:              m.ID,
+		TenantId:        m.TenantID,
+		Email:           m.Email,
+		Role:            StaffRoleToPB(m.Role),
+		Status:          InvitationStatusToPB(m.Status(t)),
+		InvitedBy:       m.InvitedBy,
+		ExpiresAt:       timestamppb.New(m.ExpiresAt),
+		AcceptedAt:      NullTimeToPB(m.AcceptedAt),
+		AcceptedStaffId: m.AcceptedStaffID.Ptr(),
+		RevokedAt:       NullTimeToPB(m.RevokedAt),
+		CreatedAt:       timestamppb.New(m.CreatedAt),
+		UpdatedAt:       timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func InvitationsToPB(slice model.Invitations, t time.Time) []*staff_apiv1.Invitation {
+	dsts := make([]*staff_apiv1.Invitation, len(slice))
+	for idx, m := range slice {
+		dsts[idx] = InvitationToPB(m, t)
+	}
+	return dsts
+}
+
+func InvitationStatusToPB(status model.InvitationStatus) staff_apiv1.InvitationStatus {
+	switch status {
+	case model.InvitationStatusPending:
+		return staff_apiv1.InvitationStatus_INVITATION_STATUS_PENDING
+	case model.InvitationStatusAccepted:
+		return staff_apiv1.InvitationStatus_INVITATION_STATUS_ACCEPTED
+	case model.InvitationStatusRevoked:
+		return staff_apiv1.InvitationStatus_INVITATION_STATUS_REVOKED
+	case model.InvitationStatusExpired:
+		return staff_apiv1.InvitationStatus_INVITATION_STATUS_EXPIRED
+	case model.InvitationStatusUnknown:
+		fallthrough
+	default:
+		return staff_apiv1.InvitationStatus_INVITATION_STATUS_UNSPECIFIED
+	}
+}
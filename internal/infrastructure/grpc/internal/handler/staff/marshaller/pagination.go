@@ -6,6 +6,9 @@ import (
 )
 
 func NewPagination(m *model.Pagination) *staff_apiv1.Pagination {
+	if m == nil {
+		return nil
+	}
 	return &staff_apiv1.Pagination{
 		CurrentPage: m.CurrentPage,
 		PrevPage:    m.PrevPage,
@@ -17,15 +17,17 @@ func StaffToPB(m *model.Staff) *staff_apiv1.Staff {
 	}
 
 	return &staff_apiv1.Staff{
-		Id:          m.ID,
-		Tenant:      tenant,
-		Role:        StaffRoleToPB(m.Role),
-		AuthUid:     m.AuthUID,
-		DisplayName: m.DisplayName,
-		ImageUrl:    m.ImageURL.String,
-		Email:       m.Email,
-		CreatedAt:   timestamppb.New(m.CreatedAt),
-		UpdatedAt:   timestamppb.New(m.UpdatedAt),
+		Id:               m.ID,
+		Tenant:           tenant,
+		Role:             StaffRoleToPB(m.Role),
+		AuthUid:          m.AuthUID,
+		DisplayName:      m.DisplayName,
+		ImageUrl:         m.ImageURL.String,
+		ImageVariantUrls: m.ImageVariantURLs,
+		Email:            m.Email,
+		CreatedAt:        timestamppb.New(m.CreatedAt),
+		UpdatedAt:        timestamppb.New(m.UpdatedAt),
+		Etag:             m.ETag(),
 	}
 }
 
@@ -40,13 +42,14 @@ func StaffPartialToPB(m *model.Staff) *staff_apiv1.StaffPartial {
 	}
 
 	return &staff_apiv1.StaffPartial{
-		Id:          m.ID,
-		Tenant:      tenant,
-		Role:        StaffRoleToPB(m.Role),
-		AuthUid:     m.AuthUID,
-		DisplayName: m.DisplayName,
-		ImageUrl:    m.ImageURL.String,
-		Email:       m.Email,
+		Id:               m.ID,
+		Tenant:           tenant,
+		Role:             StaffRoleToPB(m.Role),
+		AuthUid:          m.AuthUID,
+		DisplayName:      m.DisplayName,
+		ImageUrl:         m.ImageURL.String,
+		ImageVariantUrls: m.ImageVariantURLs,
+		Email:            m.Email,
 	}
 }
 
@@ -13,8 +13,10 @@ func TenantToPB(m *model.Tenant) *staff_apiv1.Tenant {
 	return &staff_apiv1.Tenant{
 		Id:        m.ID,
 		Name:      m.Name,
+		Tags:      TenantTagsToPB(m.Tags),
 		CreatedAt: timestamppb.New(m.CreatedAt),
 		UpdatedAt: timestamppb.New(m.UpdatedAt),
+		Etag:      m.ETag(),
 	}
 }
 
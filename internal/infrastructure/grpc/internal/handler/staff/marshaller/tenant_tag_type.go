@@ -0,0 +1,56 @@
+package marshaller
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	staff_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1"
+)
+
+func TenantTagTypeToModel(tagType staff_apiv1.TenantTagType) model.TenantTagType {
+	switch tagType {
+	case staff_apiv1.TenantTagType_TENANT_TAG_TYPE_ENTERTAINMENT:
+		return model.TenantTagTypeEntertainment
+	case staff_apiv1.TenantTagType_TENANT_TAG_TYPE_EDUCATION:
+		return model.TenantTagTypeEducation
+	case staff_apiv1.TenantTagType_TENANT_TAG_TYPE_BUSINESS:
+		return model.TenantTagTypeBusiness
+	case staff_apiv1.TenantTagType_TENANT_TAG_TYPE_OTHER:
+		return model.TenantTagTypeOther
+	case staff_apiv1.TenantTagType_TENANT_TAG_TYPE_UNSPECIFIED:
+		fallthrough
+	default:
+		return model.TenantTagTypeUnknown
+	}
+}
+
+func TenantTagTypesToModel(slice []staff_apiv1.TenantTagType) []model.TenantTagType {
+	dsts := make([]model.TenantTagType, len(slice))
+	for idx, tagType := range slice {
+		dsts[idx] = TenantTagTypeToModel(tagType)
+	}
+	return dsts
+}
+
+func TenantTagTypeToPB(tagType model.TenantTagType) staff_apiv1.TenantTagType {
+	switch tagType {
+	case model.TenantTagTypeEntertainment:
+		return staff_apiv1.TenantTagType_TENANT_TAG_TYPE_ENTERTAINMENT
+	case model.TenantTagTypeEducation:
+		return staff_apiv1.TenantTagType_TENANT_TAG_TYPE_EDUCATION
+	case model.TenantTagTypeBusiness:
+		return staff_apiv1.TenantTagType_TENANT_TAG_TYPE_BUSINESS
+	case model.TenantTagTypeOther:
+		return staff_apiv1.TenantTagType_TENANT_TAG_TYPE_OTHER
+	case model.TenantTagTypeUnknown:
+		fallthrough
+	default:
+		return staff_apiv1.TenantTagType_TENANT_TAG_TYPE_UNSPECIFIED
+	}
+}
+
+func TenantTagsToPB(tags model.TenantTags) []staff_apiv1.TenantTagType {
+	dsts := make([]staff_apiv1.TenantTagType, len(tags))
+	for idx, tag := range tags {
+		dsts[idx] = TenantTagTypeToPB(tag.Type)
+	}
+	return dsts
+}
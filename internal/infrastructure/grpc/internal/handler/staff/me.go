@@ -9,6 +9,7 @@ import (
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
 	staff_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1"
 	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"google.golang.org/grpc"
 )
 
 func (h *StaffHandler) GetMe(
@@ -43,6 +44,7 @@ func (h *StaffHandler) SignUp(
 	if err != nil {
 		return nil, err
 	}
+	method, _ := grpc.Method(ctx)
 	requestTime := request_interceptor.GetRequestTime(ctx)
 
 	staff, err := h.meInteractor.SignUp(ctx, input.NewStaffSignUp(
@@ -51,6 +53,7 @@ func (h *StaffHandler) SignUp(
 		req.GetTenantName(),
 		req.GetDisplayName(),
 		req.GetImageAssetId(),
+		method,
 		requestTime,
 	))
 	if err != nil {
@@ -70,6 +73,10 @@ func (h *StaffHandler) UpdateMe(
 	if err != nil {
 		return nil, err
 	}
+	actor, err := session_interceptor.RequireStaffAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
 	requestTime := request_interceptor.GetRequestTime(ctx)
 
 	staff, err := h.meInteractor.Update(
@@ -79,6 +86,8 @@ func (h *StaffHandler) UpdateMe(
 			claims.StaffID.String,
 			null.StringFromPtr(req.DisplayName),
 			null.StringFromPtr(req.ImageAssetId),
+			null.StringFromPtr(req.Etag),
+			actor,
 			requestTime,
 		),
 	)
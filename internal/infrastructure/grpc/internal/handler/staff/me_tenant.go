@@ -4,10 +4,12 @@ import (
 	"context"
 
 	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/handler/staff/marshaller"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/request_interceptor"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
 	staff_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
 	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
 )
 
@@ -46,14 +48,26 @@ func (h *StaffHandler) UpdateMeTenant(
 	if err != nil {
 		return nil, err
 	}
+	actor, err := session_interceptor.RequireStaffAuditLogActor(ctx)
+	if err != nil {
+		return nil, err
+	}
 	requestTime := request_interceptor.GetRequestTime(ctx)
 
+	var tagTypes nullable.Type[[]model.TenantTagType]
+	if req.Tags != nil {
+		tagTypes = nullable.TypeFrom(marshaller.TenantTagTypesToModel(req.Tags.GetValues()))
+	}
+
 	tenant, err := h.meTenantInteractor.Update(
 		ctx,
 		input.NewStaffUpdateMeTenant(
 			claims.TenantID.String,
 			claims.StaffID.String,
 			null.StringFromPtr(req.Name),
+			tagTypes,
+			null.StringFromPtr(req.Etag),
+			actor,
 			requestTime,
 		),
 	)
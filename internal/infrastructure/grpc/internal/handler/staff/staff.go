@@ -3,6 +3,7 @@ package staff
 import (
 	"context"
 
+	"github.com/aarondl/null/v8"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/handler/staff/marshaller"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/request_interceptor"
@@ -46,6 +47,10 @@ func (h *StaffHandler) ListStaffs(ctx context.Context, req *staff_apiv1.ListStaf
 	if req.SortKey != nil {
 		sortKey = nullable.TypeFrom(marshaller.StaffSortKeyToModel(*req.SortKey))
 	}
+	var role nullable.Type[model.StaffRole]
+	if req.Role != nil {
+		role = nullable.TypeFrom(marshaller.StaffRoleToModel(*req.Role))
+	}
 
 	got, err := h.staffInteractor.List(
 		ctx,
@@ -55,6 +60,13 @@ func (h *StaffHandler) ListStaffs(ctx context.Context, req *staff_apiv1.ListStaf
 			req.GetPage(),
 			req.GetLimit(),
 			sortKey,
+			null.StringFromPtr(req.Cursor),
+			req.GetIncludeTotalCount(),
+			null.StringFromPtr(req.DisplayName),
+			null.StringFromPtr(req.Email),
+			role,
+			marshaller.NullTimeToModel(req.GetCreatedAtFrom()),
+			marshaller.NullTimeToModel(req.GetCreatedAtTo()),
 			request_interceptor.GetRequestTime(ctx),
 		),
 	)
@@ -65,5 +77,6 @@ func (h *StaffHandler) ListStaffs(ctx context.Context, req *staff_apiv1.ListStaf
 	return &staff_apiv1.ListStaffsResponse{
 		Staffs:     marshaller.StaffsToPB(got.Staffs),
 		Pagination: marshaller.NewPagination(got.Pagination),
+		NextCursor: got.NextCursor.Ptr(),
 	}, nil
 }
@@ -2,17 +2,21 @@ package authorization_interceptor
 
 import (
 	"context"
-	"strings"
 
-	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
 	"google.golang.org/grpc"
 )
 
-type Authorization struct{}
+type Authorization struct {
+	policy *Policy
+}
 
-func NewAuthorization() *Authorization {
-	return &Authorization{}
+func NewAuthorization(
+	policy *Policy,
+) *Authorization {
+	return &Authorization{
+		policy: policy,
+	}
 }
 
 func (i *Authorization) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
@@ -25,16 +29,37 @@ func (i *Authorization) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		interface{},
 		error,
 	) {
-		adminSessionContext, ok := session_interceptor.GetAdminSessionContext(ctx)
-		method := info.FullMethod
-		if strings.Contains(method, "AdminV1Service") {
-			if !ok || !adminSessionContext.AdminRole.Value().Valid() {
-				return nil, errors.InvalidAdminRequestUserErr.New()
-			}
+		if err := i.policy.Authorize(info.FullMethod, subjectFromContext(ctx)); err != nil {
+			return nil, err
 		}
-
-		// TODO:
-		// use casbin
 		return handler(ctx, req)
 	}
 }
+
+func (i *Authorization) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := i.policy.Authorize(info.FullMethod, subjectFromContext(stream.Context())); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+func subjectFromContext(ctx context.Context) Subject {
+	subject := Subject{
+		Admin: nil,
+		Staff: nil,
+	}
+	if claims, ok := session_interceptor.GetAdminSessionContext(ctx); ok {
+		subject.Admin = claims
+	}
+	if claims, ok := session_interceptor.GetStaffSessionContext(ctx); ok {
+		subject.Staff = claims
+	}
+	return subject
+}
@@ -0,0 +1,100 @@
+package authorization_interceptor
+
+import (
+	"slices"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
+// Subject is the authenticated caller a rule is evaluated against.
+// Either side may be nil when the request carries no session for it.
+type Subject struct {
+	Admin *model.AdminClaims
+	Staff *model.StaffClaims
+}
+
+// Rule decides whether a subject may invoke an RPC. It returns nil to allow.
+type Rule func(subject Subject) error
+
+// AllowAnonymous allows any caller, with or without a session.
+func AllowAnonymous() Rule {
+	return func(_ Subject) error {
+		return nil
+	}
+}
+
+// AllowAdmin allows admins having one of the given roles.
+// When no role is given, any valid admin role is allowed.
+func AllowAdmin(roles ...model.AdminRole) Rule {
+	return func(subject Subject) error {
+		if subject.Admin == nil {
+			return errors.RequireAdminSessionErr.New()
+		}
+		if !subject.Admin.AdminRole.Valid || !subject.Admin.AdminRole.Value().Valid() {
+			return errors.InvalidAdminRequestUserErr.New()
+		}
+		role := subject.Admin.AdminRole.Value()
+		if len(roles) > 0 && !slices.Contains(roles, role) {
+			return errors.PermissionDeniedErr.Errorf("admin role %s is not allowed", role)
+		}
+		return nil
+	}
+}
+
+// AllowStaffIdentity allows any caller holding a staff session,
+// including identities that have not signed up to a tenant yet.
+func AllowStaffIdentity() Rule {
+	return func(subject Subject) error {
+		if subject.Staff == nil {
+			return errors.RequireStaffSessionErr.New()
+		}
+		return nil
+	}
+}
+
+// AllowStaff allows registered staffs having one of the given roles.
+// When no role is given, any valid staff role is allowed.
+func AllowStaff(roles ...model.StaffRole) Rule {
+	return func(subject Subject) error {
+		if subject.Staff == nil {
+			return errors.RequireStaffSessionErr.New()
+		}
+		if !subject.Staff.TenantID.Valid || !subject.Staff.StaffID.Valid ||
+			!subject.Staff.StaffRole.Valid || !subject.Staff.StaffRole.Value().Valid() {
+			return errors.PermissionDeniedErr.Errorf("staff is not registered")
+		}
+		role := subject.Staff.StaffRole.Value()
+		if len(roles) > 0 && !slices.Contains(roles, role) {
+			return errors.PermissionDeniedErr.Errorf("staff role %s is not allowed", role)
+		}
+		return nil
+	}
+}
+
+// Policy maps gRPC full method names to rules.
+// Methods without a rule are denied.
+type Policy struct {
+	rules map[string]Rule
+}
+
+func NewPolicy(rules map[string]Rule) *Policy {
+	return &Policy{
+		rules: rules,
+	}
+}
+
+// Authorize returns nil when the subject may invoke the method.
+func (p *Policy) Authorize(method string, subject Subject) error {
+	rule, ok := p.rules[method]
+	if !ok {
+		return errors.PermissionDeniedErr.Errorf("no policy for %s", method)
+	}
+	return rule(subject)
+}
+
+// Has reports whether the method has a rule.
+func (p *Policy) Has(method string) bool {
+	_, ok := p.rules[method]
+	return ok
+}
@@ -0,0 +1,238 @@
+package authorization_interceptor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
+	debug_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/debug_api/v1"
+	public_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/public_api/v1"
+	staff_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	reflection_v1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflection_v1alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+func newAdminSubject(role model.AdminRole) Subject {
+	return Subject{
+		Admin: model.NewAdminClaims(
+			id.Mock(),
+			"admin@example.com",
+			null.StringFrom(id.Mock()),
+			nullable.TypeFrom(role),
+		),
+		Staff: nil,
+	}
+}
+
+func newStaffSubject(role model.StaffRole) Subject {
+	return Subject{
+		Admin: nil,
+		Staff: model.NewStaffClaims(
+			id.Mock(),
+			"staff@example.com",
+			null.StringFrom(id.Mock()),
+			null.StringFrom(id.Mock()),
+			nullable.TypeFrom(role),
+		),
+	}
+}
+
+func newUnregisteredStaffSubject() Subject {
+	return Subject{
+		Admin: nil,
+		Staff: model.NewStaffClaims(
+			id.Mock(),
+			"staff@example.com",
+			null.String{},
+			null.String{},
+			nullable.Type[model.StaffRole]{},
+		),
+	}
+}
+
+func TestPolicy_Authorize(t *testing.T) {
+	t.Parallel()
+
+	const method = "/rapid.test.v1.TestService/Do"
+
+	type args struct {
+		rule    Rule
+		method  string
+		subject Subject
+	}
+
+	tests := map[string]struct {
+		args           args
+		expectedResult error
+	}{
+		"deny method without rule": {
+			args: args{
+				rule:    AllowAnonymous(),
+				method:  "/rapid.test.v1.TestService/Unknown",
+				subject: newAdminSubject(model.AdminRoleRoot),
+			},
+			expectedResult: errors.PermissionDeniedErr,
+		},
+		"anonymous allows empty subject": {
+			args: args{
+				rule:    AllowAnonymous(),
+				method:  method,
+				subject: Subject{Admin: nil, Staff: nil},
+			},
+		},
+		"admin requires admin session": {
+			args: args{
+				rule:    AllowAdmin(),
+				method:  method,
+				subject: newStaffSubject(model.StaffRoleAdmin),
+			},
+			expectedResult: errors.RequireAdminSessionErr,
+		},
+		"admin rejects unknown role": {
+			args: args{
+				rule:    AllowAdmin(),
+				method:  method,
+				subject: newAdminSubject(model.AdminRoleUnknown),
+			},
+			expectedResult: errors.InvalidAdminRequestUserErr,
+		},
+		"admin allows any valid role": {
+			args: args{
+				rule:    AllowAdmin(),
+				method:  method,
+				subject: newAdminSubject(model.AdminRoleNormal),
+			},
+		},
+		"admin rejects role not listed": {
+			args: args{
+				rule:    AllowAdmin(model.AdminRoleRoot),
+				method:  method,
+				subject: newAdminSubject(model.AdminRoleNormal),
+			},
+			expectedResult: errors.PermissionDeniedErr,
+		},
+		"admin allows listed role": {
+			args: args{
+				rule:    AllowAdmin(model.AdminRoleRoot),
+				method:  method,
+				subject: newAdminSubject(model.AdminRoleRoot),
+			},
+		},
+		"staff identity requires staff session": {
+			args: args{
+				rule:    AllowStaffIdentity(),
+				method:  method,
+				subject: newAdminSubject(model.AdminRoleRoot),
+			},
+			expectedResult: errors.RequireStaffSessionErr,
+		},
+		"staff identity allows unregistered staff": {
+			args: args{
+				rule:    AllowStaffIdentity(),
+				method:  method,
+				subject: newUnregisteredStaffSubject(),
+			},
+		},
+		"staff requires staff session": {
+			args: args{
+				rule:    AllowStaff(),
+				method:  method,
+				subject: Subject{Admin: nil, Staff: nil},
+			},
+			expectedResult: errors.RequireStaffSessionErr,
+		},
+		"staff rejects unregistered staff": {
+			args: args{
+				rule:    AllowStaff(),
+				method:  method,
+				subject: newUnregisteredStaffSubject(),
+			},
+			expectedResult: errors.PermissionDeniedErr,
+		},
+		"staff allows any valid role": {
+			args: args{
+				rule:    AllowStaff(),
+				method:  method,
+				subject: newStaffSubject(model.StaffRoleNormal),
+			},
+		},
+		"staff rejects role not listed": {
+			args: args{
+				rule:    AllowStaff(model.StaffRoleAdmin),
+				method:  method,
+				subject: newStaffSubject(model.StaffRoleNormal),
+			},
+			expectedResult: errors.PermissionDeniedErr,
+		},
+		"staff allows listed role": {
+			args: args{
+				rule:    AllowStaff(model.StaffRoleAdmin),
+				method:  method,
+				subject: newStaffSubject(model.StaffRoleAdmin),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := NewPolicy(map[string]Rule{
+				method: tc.args.rule,
+			})
+
+			err := policy.Authorize(tc.args.method, tc.args.subject)
+			if tc.expectedResult == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedResult.Error())
+			}
+		})
+	}
+}
+
+// fullMethods returns the full names of the unary and the streaming RPCs of desc.
+func fullMethods(desc grpc.ServiceDesc) []string {
+	fullMethods := make([]string, 0, len(desc.Methods)+len(desc.Streams))
+	for _, method := range desc.Methods {
+		fullMethods = append(fullMethods, fmt.Sprintf("/%s/%s", desc.ServiceName, method.MethodName))
+	}
+	for _, stream := range desc.Streams {
+		fullMethods = append(fullMethods, fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName))
+	}
+	return fullMethods
+}
+
+func TestNewDefaultPolicy_CoversAllMethods(t *testing.T) {
+	t.Parallel()
+
+	policy := NewDefaultPolicy()
+	for _, desc := range []grpc.ServiceDesc{
+		admin_apiv1.AdminV1Service_ServiceDesc,
+		staff_apiv1.StaffV1Service_ServiceDesc,
+		public_apiv1.PublicV1Service_ServiceDesc,
+		debug_apiv1.DebugV1Service_ServiceDesc,
+		reflection_v1.ServerReflection_ServiceDesc,
+		reflection_v1alpha.ServerReflection_ServiceDesc,
+	} {
+		for _, fullMethod := range fullMethods(desc) {
+			require.True(t, policy.Has(fullMethod), "missing policy for %s", fullMethod)
+		}
+	}
+}
+
+func TestNewDefaultPolicy_AdminAPIRejectsStaff(t *testing.T) {
+	t.Parallel()
+
+	policy := NewDefaultPolicy()
+	for _, fullMethod := range fullMethods(admin_apiv1.AdminV1Service_ServiceDesc) {
+		err := policy.Authorize(fullMethod, newStaffSubject(model.StaffRoleAdmin))
+		require.Error(t, err, fullMethod)
+	}
+}
@@ -0,0 +1,69 @@
+package authorization_interceptor
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
+	debug_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/debug_api/v1"
+	public_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/public_api/v1"
+	staff_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1"
+	reflection_v1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflection_v1alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// NewDefaultPolicy returns the policy for every RPC served by this application.
+// Every new RPC must be listed here, otherwise it is denied.
+func NewDefaultPolicy() *Policy {
+	return NewPolicy(map[string]Rule{
+		// admin api
+		admin_apiv1.AdminV1Service_CreateAssetPresignedURL_FullMethodName: AllowAdmin(),
+		admin_apiv1.AdminV1Service_ConfirmAssetUpload_FullMethodName:      AllowAdmin(),
+		admin_apiv1.AdminV1Service_UploadAsset_FullMethodName:             AllowAdmin(),
+		admin_apiv1.AdminV1Service_DownloadAsset_FullMethodName:           AllowAdmin(),
+		admin_apiv1.AdminV1Service_GetTenant_FullMethodName:               AllowAdmin(),
+		admin_apiv1.AdminV1Service_ListTenants_FullMethodName:             AllowAdmin(),
+		admin_apiv1.AdminV1Service_CreateTenant_FullMethodName:            AllowAdmin(),
+		admin_apiv1.AdminV1Service_UpdateTenant_FullMethodName:            AllowAdmin(),
+		admin_apiv1.AdminV1Service_DeleteTenant_FullMethodName:            AllowAdmin(),
+		admin_apiv1.AdminV1Service_RestoreTenant_FullMethodName:           AllowAdmin(),
+		admin_apiv1.AdminV1Service_GetStaff_FullMethodName:                AllowAdmin(),
+		admin_apiv1.AdminV1Service_ListStaffs_FullMethodName:              AllowAdmin(),
+		admin_apiv1.AdminV1Service_CreateStaff_FullMethodName:             AllowAdmin(),
+		admin_apiv1.AdminV1Service_UpdateStaff_FullMethodName:             AllowAdmin(),
+		admin_apiv1.AdminV1Service_GetAdmin_FullMethodName:                AllowAdmin(model.AdminRoleRoot),
+		admin_apiv1.AdminV1Service_ListAdmins_FullMethodName:              AllowAdmin(model.AdminRoleRoot),
+		admin_apiv1.AdminV1Service_CreateAdmin_FullMethodName:             AllowAdmin(model.AdminRoleRoot),
+		admin_apiv1.AdminV1Service_UpdateAdmin_FullMethodName:             AllowAdmin(model.AdminRoleRoot),
+		admin_apiv1.AdminV1Service_UpdateAdminRole_FullMethodName:         AllowAdmin(model.AdminRoleRoot),
+		admin_apiv1.AdminV1Service_DeleteAdmin_FullMethodName:             AllowAdmin(model.AdminRoleRoot),
+		admin_apiv1.AdminV1Service_ListAuditLogs_FullMethodName:           AllowAdmin(model.AdminRoleRoot),
+
+		// staff api
+		staff_apiv1.StaffV1Service_CreateAssetPresignedURL_FullMethodName: AllowStaffIdentity(),
+		staff_apiv1.StaffV1Service_ConfirmAssetUpload_FullMethodName:      AllowStaffIdentity(),
+		staff_apiv1.StaffV1Service_UploadAsset_FullMethodName:             AllowStaffIdentity(),
+		staff_apiv1.StaffV1Service_DownloadAsset_FullMethodName:           AllowStaffIdentity(),
+		staff_apiv1.StaffV1Service_SignUp_FullMethodName:                  AllowStaffIdentity(),
+		staff_apiv1.StaffV1Service_GetMe_FullMethodName:                   AllowStaff(),
+		staff_apiv1.StaffV1Service_GetMeTenant_FullMethodName:             AllowStaff(),
+		staff_apiv1.StaffV1Service_UpdateMe_FullMethodName:                AllowStaff(),
+		staff_apiv1.StaffV1Service_UpdateMeTenant_FullMethodName:          AllowStaff(model.StaffRoleAdmin),
+		staff_apiv1.StaffV1Service_GetStaff_FullMethodName:                AllowStaff(),
+		staff_apiv1.StaffV1Service_ListStaffs_FullMethodName:              AllowStaff(),
+		staff_apiv1.StaffV1Service_CreateInvitation_FullMethodName:        AllowStaff(model.StaffRoleAdmin),
+		staff_apiv1.StaffV1Service_ListInvitations_FullMethodName:         AllowStaff(model.StaffRoleAdmin),
+		staff_apiv1.StaffV1Service_RevokeInvitation_FullMethodName:        AllowStaff(model.StaffRoleAdmin),
+		staff_apiv1.StaffV1Service_AcceptInvitation_FullMethodName:        AllowStaffIdentity(),
+
+		// public api
+		public_apiv1.PublicV1Service_DeepHealthCheck_FullMethodName: AllowAnonymous(),
+
+		// debug api
+		debug_apiv1.DebugV1Service_CreateAdminIDToken_FullMethodName: AllowAnonymous(),
+		debug_apiv1.DebugV1Service_CreateStaffIDToken_FullMethodName: AllowAnonymous(),
+		debug_apiv1.DebugV1Service_CreateStaffAuthUID_FullMethodName: AllowAnonymous(),
+
+		// server reflection, registered for grpcurl and other clients listing the services
+		reflection_v1.ServerReflection_ServerReflectionInfo_FullMethodName:      AllowAnonymous(),
+		reflection_v1alpha.ServerReflection_ServerReflectionInfo_FullMethodName: AllowAnonymous(),
+	})
+}
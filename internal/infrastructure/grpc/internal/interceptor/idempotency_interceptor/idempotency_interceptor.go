@@ -0,0 +1,202 @@
+package idempotency_interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/logger"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/logger/logger_field"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// HeaderIdempotencyKey is the request metadata of the idempotency key,
+	// which the gateway maps from the Idempotency-Key HTTP header.
+	HeaderIdempotencyKey = "idempotency-key"
+	// HeaderIdempotentReplayed is the response metadata set to true on a replayed response,
+	// which the gateway forwards as the HTTP header of the same name.
+	HeaderIdempotentReplayed = "idempotent-replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+type Idempotency struct {
+	policy *Policy
+	store  cache.IdempotencyKey
+	ttl    time.Duration
+}
+
+func NewIdempotency(
+	policy *Policy,
+	store cache.IdempotencyKey,
+	ttl time.Duration,
+) *Idempotency {
+	return &Idempotency{
+		policy: policy,
+		store:  store,
+		ttl:    ttl,
+	}
+}
+
+// UnaryServerInterceptor answers a request retried with the same idempotency key with the response of the first one,
+// and rejects the key reused for a different request with IdempotencyKeyMismatchErr,
+// or while the first request is in progress with IdempotencyKeyInUseErr.
+// The key of a failed request is released, so that the request can be retried.
+// It runs after the authorization, so that the keys are scoped to the verified session.
+func (i *Idempotency) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (
+		interface{},
+		error,
+	) {
+		if !i.policy.Enabled(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		idempotencyKey := metadata.ValueFromIncomingContext(ctx, HeaderIdempotencyKey)
+		if len(idempotencyKey) == 0 {
+			return handler(ctx, req)
+		}
+		if idempotencyKey[0] == "" || len(idempotencyKey[0]) > maxIdempotencyKeyLength {
+			return nil, errors.RequestInvalidArgumentErr.Errorf(
+				"%s must be 1 to %d characters", HeaderIdempotencyKey, maxIdempotencyKeyLength,
+			)
+		}
+
+		fingerprint, err := fingerprint(req)
+		if err != nil {
+			return nil, err
+		}
+		t := now.Now()
+		key, claimed, err := i.store.Claim(
+			ctx,
+			model.NewIdempotencyKey(i.keyID(ctx, info.FullMethod, idempotencyKey[0]), fingerprint, t),
+			t,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if !claimed {
+			return i.replay(ctx, key, fingerprint)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if releaseErr := i.store.Release(ctx, key.ID); releaseErr != nil {
+				logger.L(ctx).Warn("failed to release the idempotency key", logger_field.Error(releaseErr))
+			}
+			return nil, err
+		}
+		i.complete(ctx, key, resp)
+		return resp, nil
+	}
+}
+
+// replay returns the response stored with the key claimed by another request.
+func (i *Idempotency) replay(
+	ctx context.Context,
+	key *model.IdempotencyKey,
+	fingerprint string,
+) (interface{}, error) {
+	if key.Fingerprint != fingerprint {
+		return nil, errors.IdempotencyKeyMismatchErr.New()
+	}
+	if !key.Completed() {
+		return nil, errors.IdempotencyKeyInUseErr.New()
+	}
+	response := &anypb.Any{}
+	if err := proto.Unmarshal(key.Response, response); err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	resp, err := response.UnmarshalNew()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(HeaderIdempotentReplayed, strconv.FormatBool(true))); err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return resp, nil
+}
+
+// complete stores the response with the key.
+// The response is returned even when it fails, as the request has completed,
+// and the key is claimable again once the claim expires.
+func (i *Idempotency) complete(
+	ctx context.Context,
+	key *model.IdempotencyKey,
+	resp interface{},
+) {
+	b, err := marshalResponse(resp)
+	if err == nil {
+		key.Complete(b, i.ttl, now.Now())
+		err = i.store.Complete(ctx, key)
+	}
+	if err != nil {
+		logger.L(ctx).Warn("failed to store the response of the idempotency key", logger_field.Error(err))
+	}
+}
+
+// marshalResponse serializes the response as an Any, which records the type to unmarshal it into.
+func marshalResponse(resp interface{}) ([]byte, error) {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return nil, errors.InternalErr.Errorf("response of %T is not a proto message", resp)
+	}
+	response, err := anypb.New(message)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	b, err := proto.Marshal(response)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return b, nil
+}
+
+// keyID scopes the idempotency key to the method and the caller,
+// so that the keys chosen by different clients never collide.
+func (i *Idempotency) keyID(
+	ctx context.Context,
+	method string,
+	idempotencyKey string,
+) string {
+	var caller string
+	if claims, ok := session_interceptor.GetAdminSessionContext(ctx); ok {
+		caller = "admin:" + claims.AuthUID
+	} else if claims, ok := session_interceptor.GetStaffSessionContext(ctx); ok {
+		caller = "staff:" + claims.AuthUID
+	}
+	return digest([]byte(method + "\n" + caller + "\n" + idempotencyKey))
+}
+
+// fingerprint digests the request, serialized deterministically so that the same request has the same fingerprint.
+func fingerprint(req interface{}) (string, error) {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return "", errors.InternalErr.Errorf("request of %T is not a proto message", req)
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(message) //nolint:exhaustruct
+	if err != nil {
+		return "", errors.InternalErr.Wrap(err)
+	}
+	return digest(b), nil
+}
+
+func digest(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,296 @@
+package idempotency_interceptor
+
+import (
+	"context"
+	goerrors "errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	mock_cache "github.com/abyssparanoia/rapid-go/internal/domain/cache/mock"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// headerStream is a grpc.ServerTransportStream recording the headers set by the interceptor.
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestIdempotency_UnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const (
+		enabledMethod  = "/rapid.Service/Create"
+		disabledMethod = "/rapid.Service/Update"
+		ttl            = 24 * time.Hour
+	)
+	policy := NewPolicy([]string{enabledMethod})
+	staffClaims := model.NewStaffClaims(
+		"authUID",
+		"staff@example.com",
+		null.StringFrom("tenantID"),
+		null.StringFrom("staffID"),
+		nullable.TypeFrom(model.StaffRoleNormal),
+	)
+	req := wrapperspb.String("request")
+	resp := wrapperspb.String("response")
+	reqFingerprint, err := fingerprint(req)
+	require.NoError(t, err)
+	storedResponse, err := marshalResponse(resp)
+	require.NoError(t, err)
+	keyID := digest([]byte(enabledMethod + "\nstaff:authUID\nkey"))
+	handlerErr := goerrors.New("handler failed")
+
+	isClaimedKey := gomock.Cond(func(key *model.IdempotencyKey) bool {
+		return key.ID == keyID && key.Fingerprint == reqFingerprint && !key.Completed()
+	})
+
+	type args struct {
+		md         metadata.MD
+		method     string
+		handlerErr error
+	}
+
+	type want struct {
+		handled bool
+		resp    interface{}
+		header  metadata.MD
+		err     error
+	}
+
+	type testcase struct {
+		args args
+		want want
+	}
+
+	tests := map[string]func(ctrl *gomock.Controller) (cache.IdempotencyKey, testcase){
+		"method without the policy ignores the key": func(ctrl *gomock.Controller) (cache.IdempotencyKey, testcase) {
+			return mock_cache.NewMockIdempotencyKey(ctrl), testcase{
+				args: args{
+					md:         metadata.Pairs(HeaderIdempotencyKey, "key"),
+					method:     disabledMethod,
+					handlerErr: nil,
+				},
+				want: want{
+					handled: true,
+					resp:    resp,
+					header:  nil,
+					err:     nil,
+				},
+			}
+		},
+		"request without the key is handled as is": func(ctrl *gomock.Controller) (cache.IdempotencyKey, testcase) {
+			return mock_cache.NewMockIdempotencyKey(ctrl), testcase{
+				args: args{
+					md:         metadata.MD{},
+					method:     enabledMethod,
+					handlerErr: nil,
+				},
+				want: want{
+					handled: true,
+					resp:    resp,
+					header:  nil,
+					err:     nil,
+				},
+			}
+		},
+		"too long key is invalid": func(ctrl *gomock.Controller) (cache.IdempotencyKey, testcase) {
+			return mock_cache.NewMockIdempotencyKey(ctrl), testcase{
+				args: args{
+					md:         metadata.Pairs(HeaderIdempotencyKey, strings.Repeat("a", 256)),
+					method:     enabledMethod,
+					handlerErr: nil,
+				},
+				want: want{
+					handled: false,
+					resp:    nil,
+					header:  nil,
+					err:     errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"first request stores the response": func(ctrl *gomock.Controller) (cache.IdempotencyKey, testcase) {
+			mockStore := mock_cache.NewMockIdempotencyKey(ctrl)
+			mockStore.EXPECT().
+				Claim(gomock.Any(), isClaimedKey, gomock.Any()).
+				DoAndReturn(func(_ context.Context, key *model.IdempotencyKey, _ time.Time) (*model.IdempotencyKey, bool, error) {
+					return key, true, nil
+				})
+			mockStore.EXPECT().
+				Complete(gomock.Any(), gomock.Cond(func(key *model.IdempotencyKey) bool {
+					return key.ID == keyID &&
+						proto.Equal(mustUnmarshalResponse(t, key.Response), resp) &&
+						key.ExpiresAt.Equal(key.UpdatedAt.Add(ttl))
+				})).
+				Return(nil)
+			return mockStore, testcase{
+				args: args{
+					md:         metadata.Pairs(HeaderIdempotencyKey, "key"),
+					method:     enabledMethod,
+					handlerErr: nil,
+				},
+				want: want{
+					handled: true,
+					resp:    resp,
+					header:  nil,
+					err:     nil,
+				},
+			}
+		},
+		"failed request releases the key": func(ctrl *gomock.Controller) (cache.IdempotencyKey, testcase) {
+			mockStore := mock_cache.NewMockIdempotencyKey(ctrl)
+			mockStore.EXPECT().
+				Claim(gomock.Any(), isClaimedKey, gomock.Any()).
+				DoAndReturn(func(_ context.Context, key *model.IdempotencyKey, _ time.Time) (*model.IdempotencyKey, bool, error) {
+					return key, true, nil
+				})
+			mockStore.EXPECT().
+				Release(gomock.Any(), keyID).
+				Return(nil)
+			return mockStore, testcase{
+				args: args{
+					md:         metadata.Pairs(HeaderIdempotencyKey, "key"),
+					method:     enabledMethod,
+					handlerErr: handlerErr,
+				},
+				want: want{
+					handled: true,
+					resp:    nil,
+					header:  nil,
+					err:     handlerErr,
+				},
+			}
+		},
+		"retried request is replayed": func(ctrl *gomock.Controller) (cache.IdempotencyKey, testcase) {
+			mockStore := mock_cache.NewMockIdempotencyKey(ctrl)
+			mockStore.EXPECT().
+				Claim(gomock.Any(), isClaimedKey, gomock.Any()).
+				DoAndReturn(func(_ context.Context, key *model.IdempotencyKey, t time.Time) (*model.IdempotencyKey, bool, error) {
+					stored := model.NewIdempotencyKey(keyID, reqFingerprint, t.Add(-time.Second))
+					stored.Complete(storedResponse, ttl, t.Add(-time.Second))
+					return stored, false, nil
+				})
+			return mockStore, testcase{
+				args: args{
+					md:         metadata.Pairs(HeaderIdempotencyKey, "key"),
+					method:     enabledMethod,
+					handlerErr: nil,
+				},
+				want: want{
+					handled: false,
+					resp:    resp,
+					header:  metadata.Pairs(HeaderIdempotentReplayed, "true"),
+					err:     nil,
+				},
+			}
+		},
+		"key reused for another request is rejected": func(ctrl *gomock.Controller) (cache.IdempotencyKey, testcase) {
+			mockStore := mock_cache.NewMockIdempotencyKey(ctrl)
+			mockStore.EXPECT().
+				Claim(gomock.Any(), isClaimedKey, gomock.Any()).
+				DoAndReturn(func(_ context.Context, key *model.IdempotencyKey, t time.Time) (*model.IdempotencyKey, bool, error) {
+					stored := model.NewIdempotencyKey(keyID, "another", t.Add(-time.Second))
+					stored.Complete(storedResponse, ttl, t.Add(-time.Second))
+					return stored, false, nil
+				})
+			return mockStore, testcase{
+				args: args{
+					md:         metadata.Pairs(HeaderIdempotencyKey, "key"),
+					method:     enabledMethod,
+					handlerErr: nil,
+				},
+				want: want{
+					handled: false,
+					resp:    nil,
+					header:  nil,
+					err:     errors.IdempotencyKeyMismatchErr,
+				},
+			}
+		},
+		"retried request in progress is rejected": func(ctrl *gomock.Controller) (cache.IdempotencyKey, testcase) {
+			mockStore := mock_cache.NewMockIdempotencyKey(ctrl)
+			mockStore.EXPECT().
+				Claim(gomock.Any(), isClaimedKey, gomock.Any()).
+				DoAndReturn(func(_ context.Context, key *model.IdempotencyKey, t time.Time) (*model.IdempotencyKey, bool, error) {
+					return model.NewIdempotencyKey(keyID, reqFingerprint, t.Add(-time.Second)), false, nil
+				})
+			return mockStore, testcase{
+				args: args{
+					md:         metadata.Pairs(HeaderIdempotencyKey, "key"),
+					method:     enabledMethod,
+					handlerErr: nil,
+				},
+				want: want{
+					handled: false,
+					resp:    nil,
+					header:  nil,
+					err:     errors.IdempotencyKeyInUseErr,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			store, tc := tc(ctrl)
+			interceptor := NewIdempotency(policy, store, ttl)
+
+			stream := &headerStream{}
+			ctx := session_interceptor.SaveStaffSessionContext(context.Background(), staffClaims)
+			ctx = metadata.NewIncomingContext(ctx, tc.args.md)
+			ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+			handled := false
+			got, err := interceptor.UnaryServerInterceptor()(
+				ctx,
+				req,
+				&grpc.UnaryServerInfo{Server: nil, FullMethod: tc.args.method},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					handled = true
+					if tc.args.handlerErr != nil {
+						return nil, tc.args.handlerErr
+					}
+					return resp, nil
+				},
+			)
+			if tc.want.err != nil {
+				require.ErrorIs(t, err, tc.want.err)
+				require.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				require.True(t, proto.Equal(tc.want.resp.(proto.Message), got.(proto.Message)))
+			}
+			require.Equal(t, tc.want.handled, handled)
+			require.Equal(t, tc.want.header, stream.header)
+		})
+	}
+}
+
+func mustUnmarshalResponse(t *testing.T, b []byte) proto.Message {
+	t.Helper()
+	response := &anypb.Any{}
+	require.NoError(t, proto.Unmarshal(b, response))
+	message, err := response.UnmarshalNew()
+	require.NoError(t, err)
+	return message
+}
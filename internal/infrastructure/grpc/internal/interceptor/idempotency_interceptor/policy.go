@@ -0,0 +1,23 @@
+package idempotency_interceptor
+
+// Policy is the set of gRPC full method names honoring the idempotency key.
+// The other methods ignore it.
+type Policy struct {
+	methods map[string]struct{}
+}
+
+func NewPolicy(methods []string) *Policy {
+	p := &Policy{
+		methods: make(map[string]struct{}, len(methods)),
+	}
+	for _, method := range methods {
+		p.methods[method] = struct{}{}
+	}
+	return p
+}
+
+// Enabled reports whether the method honors the idempotency key.
+func (p *Policy) Enabled(method string) bool {
+	_, ok := p.methods[method]
+	return ok
+}
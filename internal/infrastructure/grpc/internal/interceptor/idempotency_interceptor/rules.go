@@ -0,0 +1,19 @@
+package idempotency_interceptor
+
+import (
+	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
+	staff_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1"
+)
+
+// NewDefaultPolicy returns the RPCs served by this application honoring the idempotency key,
+// which are those creating a tenant or a user that a client may retry after a timeout.
+func NewDefaultPolicy() *Policy {
+	return NewPolicy([]string{
+		// admin api
+		admin_apiv1.AdminV1Service_CreateTenant_FullMethodName,
+		admin_apiv1.AdminV1Service_CreateStaff_FullMethodName,
+
+		// staff api
+		staff_apiv1.StaffV1Service_SignUp_FullMethodName,
+	})
+}
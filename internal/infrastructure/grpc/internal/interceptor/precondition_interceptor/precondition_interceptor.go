@@ -0,0 +1,56 @@
+package precondition_interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// HeaderIfMatch is the request metadata of the etag precondition,
+	// which the gateway maps from the If-Match HTTP header.
+	HeaderIfMatch = "if-match"
+
+	etagFieldName protoreflect.Name = "etag"
+)
+
+type Precondition struct{}
+
+func NewPrecondition() *Precondition {
+	return &Precondition{}
+}
+
+// UnaryServerInterceptor sets the If-Match header to the etag field of the request when it is unset,
+// so that the updates taking an etag can be sent with the header instead of the field.
+// The etag field in the request takes precedence over the header.
+func (p *Precondition) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (
+		interface{},
+		error,
+	) {
+		ifMatch := metadata.ValueFromIncomingContext(ctx, HeaderIfMatch)
+		if len(ifMatch) == 0 {
+			return handler(ctx, req)
+		}
+		if msg, ok := req.(proto.Message); ok {
+			setETag(msg.ProtoReflect(), ifMatch[0])
+		}
+		return handler(ctx, req)
+	}
+}
+
+func setETag(msg protoreflect.Message, etag string) {
+	field := msg.Descriptor().Fields().ByName(etagFieldName)
+	if field == nil || field.Kind() != protoreflect.StringKind || !field.HasPresence() || msg.Has(field) {
+		return
+	}
+	msg.Set(field, protoreflect.ValueOfString(etag))
+}
@@ -0,0 +1,63 @@
+package precondition_interceptor
+
+import (
+	"context"
+	"testing"
+
+	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestPrecondition_UnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		md   metadata.MD
+		req  proto.Message
+		want proto.Message
+	}{
+		"if-match is set to the unset etag": {
+			md:   metadata.Pairs(HeaderIfMatch, `"etag"`),
+			req:  &admin_apiv1.UpdateTenantRequest{TenantId: "tenantID"},
+			want: &admin_apiv1.UpdateTenantRequest{TenantId: "tenantID", Etag: proto.String(`"etag"`)},
+		},
+		"etag in the request takes precedence": {
+			md:   metadata.Pairs(HeaderIfMatch, `"etag"`),
+			req:  &admin_apiv1.UpdateTenantRequest{TenantId: "tenantID", Etag: proto.String(`"request"`)},
+			want: &admin_apiv1.UpdateTenantRequest{TenantId: "tenantID", Etag: proto.String(`"request"`)},
+		},
+		"request is unchanged without if-match": {
+			md:   metadata.MD{},
+			req:  &admin_apiv1.UpdateTenantRequest{TenantId: "tenantID"},
+			want: &admin_apiv1.UpdateTenantRequest{TenantId: "tenantID"},
+		},
+		"request without etag is unchanged": {
+			md:   metadata.Pairs(HeaderIfMatch, `"etag"`),
+			req:  wrapperspb.String("request"),
+			want: wrapperspb.String("request"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := metadata.NewIncomingContext(t.Context(), tc.md)
+			handler := func(_ context.Context, req interface{}) (interface{}, error) {
+				return req, nil
+			}
+
+			got, err := NewPrecondition().UnaryServerInterceptor()(
+				ctx,
+				tc.req,
+				&grpc.UnaryServerInfo{FullMethod: "/rapid.Service/Update"}, //nolint:exhaustruct
+				handler,
+			)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(tc.want, got.(proto.Message)))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package rate_limit_interceptor
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// xForwardedFor is the metadata the gateway forwards X-Forwarded-For in,
+// with the address of its own client appended.
+const xForwardedFor = "x-forwarded-for"
+
+// clientIP returns the IP of the client appended to X-Forwarded-For by the first of trustedProxyHops proxies,
+// the gateway included, in front of the server. The entries before it are set by the client itself, so they are not trusted.
+// Without trusted proxies, X-Forwarded-For is ignored, since a direct gRPC client can set it to anything,
+// and the peer address is used.
+func clientIP(ctx context.Context, trustedProxyHops int) string {
+	var addrs []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && trustedProxyHops > 0 {
+		for _, value := range md.Get(xForwardedFor) {
+			for _, addr := range strings.Split(value, ",") {
+				if addr = strings.TrimSpace(addr); addr != "" {
+					addrs = append(addrs, addr)
+				}
+			}
+		}
+	}
+	if len(addrs) > 0 {
+		return addrs[max(len(addrs)-trustedProxyHops, 0)]
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+package rate_limit_interceptor
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
+// Caller is the client whose requests are counted.
+// Either session may be nil when the request carries none.
+type Caller struct {
+	Admin    *model.AdminClaims
+	Staff    *model.StaffClaims
+	ClientIP string
+}
+
+// Key returns the value the requests of a caller are counted by.
+// It returns an empty string when the caller has none, and the requests are counted by the client IP instead.
+type Key func(caller Caller) string
+
+// ByStaff counts the requests of each staff.
+// Identities not signed up to a tenant yet are counted by their auth user.
+func ByStaff() Key {
+	return func(caller Caller) string {
+		switch {
+		case caller.Staff == nil:
+			return ""
+		case caller.Staff.StaffID.Valid:
+			return "staff:" + caller.Staff.StaffID.String
+		default:
+			return "staff_auth:" + caller.Staff.AuthUID
+		}
+	}
+}
+
+// ByTenant counts the requests of the staffs of each tenant together.
+func ByTenant() Key {
+	return func(caller Caller) string {
+		if caller.Staff == nil || !caller.Staff.TenantID.Valid {
+			return ""
+		}
+		return "tenant:" + caller.Staff.TenantID.String
+	}
+}
+
+// ByAdmin counts the requests of each admin.
+func ByAdmin() Key {
+	return func(caller Caller) string {
+		if caller.Admin == nil || !caller.Admin.AdminID.Valid {
+			return ""
+		}
+		return "admin:" + caller.Admin.AdminID.String
+	}
+}
+
+// ByClientIP counts the requests of each client IP, for RPCs callable without a session.
+func ByClientIP() Key {
+	return func(caller Caller) string {
+		return "ip:" + caller.ClientIP
+	}
+}
+
+// Rule limits the requests to an RPC counted by Key.
+type Rule struct {
+	Key   Key
+	Limit cache.RateLimit
+}
+
+// Policy maps gRPC full method names to rules.
+// Methods without a rule are not limited.
+type Policy struct {
+	rules map[string]Rule
+}
+
+func NewPolicy(rules map[string]Rule) *Policy {
+	return &Policy{
+		rules: rules,
+	}
+}
+
+// Rule returns the rule of the method, reporting false when it is not limited.
+func (p *Policy) Rule(method string) (Rule, bool) {
+	rule, ok := p.rules[method]
+	return rule, ok
+}
+
+// key returns the key the requests of the caller to the method are counted under,
+// which is the client IP when the caller has no value of the rule key.
+func (r Rule) key(method string, caller Caller) string {
+	key := r.Key(caller)
+	if key == "" {
+		key = ByClientIP()(caller)
+	}
+	return method + ":" + key
+}
@@ -0,0 +1,134 @@
+package rate_limit_interceptor
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// The headers of the rate limit sent with the responses of the limited RPCs,
+// which the gateway forwards as the HTTP headers of the same names.
+const (
+	HeaderLimit      = "x-ratelimit-limit"
+	HeaderRemaining  = "x-ratelimit-remaining"
+	HeaderReset      = "x-ratelimit-reset"
+	HeaderRetryAfter = "retry-after"
+)
+
+type RateLimit struct {
+	policy           *Policy
+	limiter          cache.RateLimiter
+	trustedProxyHops int
+}
+
+func NewRateLimit(
+	policy *Policy,
+	limiter cache.RateLimiter,
+	trustedProxyHops int,
+) *RateLimit {
+	return &RateLimit{
+		policy:           policy,
+		limiter:          limiter,
+		trustedProxyHops: trustedProxyHops,
+	}
+}
+
+// UnaryServerInterceptor rejects the requests over the limit of the RPC with RateLimitExceededErr.
+// It runs after the authorization, so that the requests are counted by the verified session.
+func (i *RateLimit) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (
+		interface{},
+		error,
+	) {
+		if err := i.allow(ctx, info.FullMethod, func(md metadata.MD) error {
+			return grpc.SetHeader(ctx, md)
+		}); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (i *RateLimit) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := i.allow(stream.Context(), info.FullMethod, stream.SetHeader); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// allow counts the request to method, and sets the headers of the rate limit with setHeader.
+func (i *RateLimit) allow(
+	ctx context.Context,
+	method string,
+	setHeader func(md metadata.MD) error,
+) error {
+	rule, ok := i.policy.Rule(method)
+	if !ok {
+		return nil
+	}
+	key := rule.key(method, i.callerFromContext(ctx))
+	result, err := i.limiter.Allow(ctx, key, rule.Limit)
+	if err != nil {
+		return err
+	}
+
+	md := metadata.Pairs(
+		HeaderLimit, strconv.Itoa(rule.Limit.Limit),
+		HeaderRemaining, strconv.Itoa(result.Remaining),
+		HeaderReset, seconds(result.ResetAfter),
+	)
+	if !result.Allowed {
+		md.Set(HeaderRetryAfter, seconds(result.RetryAfter))
+	}
+	if err := setHeader(md); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	if !result.Allowed {
+		return errors.WithRetryDelay(
+			errors.RateLimitExceededErr.Errorf(
+				"%s is limited to %d requests per %s", method, rule.Limit.Limit, rule.Limit.Period,
+			),
+			result.RetryAfter,
+		)
+	}
+	return nil
+}
+
+func (i *RateLimit) callerFromContext(ctx context.Context) Caller {
+	caller := Caller{
+		Admin:    nil,
+		Staff:    nil,
+		ClientIP: clientIP(ctx, i.trustedProxyHops),
+	}
+	if claims, ok := session_interceptor.GetAdminSessionContext(ctx); ok {
+		caller.Admin = claims
+	}
+	if claims, ok := session_interceptor.GetStaffSessionContext(ctx); ok {
+		caller.Staff = claims
+	}
+	return caller
+}
+
+// seconds formats d in whole seconds rounded up, so that a client waiting for them is not rejected again.
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
@@ -0,0 +1,348 @@
+package rate_limit_interceptor
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	mock_cache "github.com/abyssparanoia/rapid-go/internal/domain/cache/mock"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// headerStream is a grpc.ServerTransportStream recording the headers set by the interceptor.
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestRateLimit_UnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const (
+		limitedMethod   = "/rapid.Service/Limited"
+		unlimitedMethod = "/rapid.Service/Unlimited"
+	)
+	limit := cache.RateLimit{
+		Limit:  10,
+		Period: time.Minute,
+	}
+	policy := NewPolicy(map[string]Rule{
+		limitedMethod: {
+			Key:   ByStaff(),
+			Limit: limit,
+		},
+	})
+	staffClaims := model.NewStaffClaims(
+		"authUID",
+		"staff@example.com",
+		null.StringFrom("tenantID"),
+		null.StringFrom("staffID"),
+		nullable.TypeFrom(model.StaffRoleNormal),
+	)
+
+	type args struct {
+		ctx    context.Context
+		method string
+	}
+
+	type want struct {
+		handled bool
+		header  metadata.MD
+		err     error
+	}
+
+	type testcase struct {
+		args args
+		want want
+	}
+
+	tests := map[string]func(ctx context.Context, ctrl *gomock.Controller) (cache.RateLimiter, testcase){
+		"method without a rule is not limited": func(ctx context.Context, ctrl *gomock.Controller) (cache.RateLimiter, testcase) {
+			return mock_cache.NewMockRateLimiter(ctrl), testcase{
+				args: args{
+					ctx:    ctx,
+					method: unlimitedMethod,
+				},
+				want: want{
+					handled: true,
+					header:  nil,
+					err:     nil,
+				},
+			}
+		},
+		"allowed request of a staff is counted by the staff": func(ctx context.Context, ctrl *gomock.Controller) (cache.RateLimiter, testcase) {
+			mockRateLimiter := mock_cache.NewMockRateLimiter(ctrl)
+			mockRateLimiter.EXPECT().
+				Allow(gomock.Any(), limitedMethod+":staff:staffID", limit).
+				Return(&cache.RateLimitResult{
+					Allowed:    true,
+					Remaining:  9,
+					RetryAfter: 0,
+					ResetAfter: 6 * time.Second,
+				}, nil)
+			return mockRateLimiter, testcase{
+				args: args{
+					ctx:    session_interceptor.SaveStaffSessionContext(ctx, staffClaims),
+					method: limitedMethod,
+				},
+				want: want{
+					handled: true,
+					header: metadata.Pairs(
+						HeaderLimit, "10",
+						HeaderRemaining, "9",
+						HeaderReset, "6",
+					),
+					err: nil,
+				},
+			}
+		},
+		"request without a session is counted by the forwarded client IP": func(ctx context.Context, ctrl *gomock.Controller) (cache.RateLimiter, testcase) {
+			mockRateLimiter := mock_cache.NewMockRateLimiter(ctrl)
+			mockRateLimiter.EXPECT().
+				Allow(gomock.Any(), limitedMethod+":ip:203.0.113.1", limit).
+				Return(&cache.RateLimitResult{
+					Allowed:    true,
+					Remaining:  9,
+					RetryAfter: 0,
+					ResetAfter: 6 * time.Second,
+				}, nil)
+			return mockRateLimiter, testcase{
+				args: args{
+					ctx: metadata.NewIncomingContext(ctx, metadata.Pairs(
+						xForwardedFor, "198.51.100.1, 203.0.113.1, 10.0.0.1",
+					)),
+					method: limitedMethod,
+				},
+				want: want{
+					handled: true,
+					header: metadata.Pairs(
+						HeaderLimit, "10",
+						HeaderRemaining, "9",
+						HeaderReset, "6",
+					),
+					err: nil,
+				},
+			}
+		},
+		"request over the limit is rejected with the retry delay": func(ctx context.Context, ctrl *gomock.Controller) (cache.RateLimiter, testcase) {
+			mockRateLimiter := mock_cache.NewMockRateLimiter(ctrl)
+			mockRateLimiter.EXPECT().
+				Allow(gomock.Any(), limitedMethod+":staff:staffID", limit).
+				Return(&cache.RateLimitResult{
+					Allowed:    false,
+					Remaining:  0,
+					RetryAfter: 1500 * time.Millisecond,
+					ResetAfter: time.Minute,
+				}, nil)
+			return mockRateLimiter, testcase{
+				args: args{
+					ctx:    session_interceptor.SaveStaffSessionContext(ctx, staffClaims),
+					method: limitedMethod,
+				},
+				want: want{
+					handled: false,
+					header: metadata.Pairs(
+						HeaderLimit, "10",
+						HeaderRemaining, "0",
+						HeaderReset, "60",
+						HeaderRetryAfter, "2",
+					),
+					err: errors.RateLimitExceededErr,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			rateLimiter, tc := tc(ctx, ctrl)
+			// the client IP is appended to X-Forwarded-For by a load balancer in front of the gateway
+			interceptor := NewRateLimit(policy, rateLimiter, 2)
+
+			stream := &headerStream{}
+			ctx = grpc.NewContextWithServerTransportStream(tc.args.ctx, stream)
+			handled := false
+			_, err := interceptor.UnaryServerInterceptor()(
+				ctx,
+				nil,
+				&grpc.UnaryServerInfo{Server: nil, FullMethod: tc.args.method},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					handled = true
+					return nil, nil
+				},
+			)
+			if tc.want.err != nil {
+				require.ErrorIs(t, err, tc.want.err)
+				delay, ok := errors.RetryDelay(err)
+				require.True(t, ok)
+				require.Equal(t, 1500*time.Millisecond, delay)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.want.handled, handled)
+			require.Equal(t, tc.want.header, stream.header)
+		})
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	t.Parallel()
+
+	peerContext := peer.NewContext(context.Background(), &peer.Peer{
+		Addr:      &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 50051},
+		LocalAddr: nil,
+		AuthInfo:  nil,
+	})
+
+	tests := map[string]struct {
+		ctx              context.Context
+		trustedProxyHops int
+		want             string
+	}{
+		"the last entry is the peer of the gateway without a load balancer": {
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				xForwardedFor, "198.51.100.1, 203.0.113.1",
+			)),
+			trustedProxyHops: 1,
+			want:             "203.0.113.1",
+		},
+		"the entries of the trusted proxies are skipped": {
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				xForwardedFor, "198.51.100.1, 203.0.113.1, 10.0.0.1",
+			)),
+			trustedProxyHops: 2,
+			want:             "203.0.113.1",
+		},
+		"more hops than the entries fall back to the first entry": {
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				xForwardedFor, "203.0.113.1",
+			)),
+			trustedProxyHops: 3,
+			want:             "203.0.113.1",
+		},
+		"x-forwarded-for set by a direct gRPC client is ignored without trusted proxies": {
+			ctx: metadata.NewIncomingContext(peerContext, metadata.Pairs(
+				xForwardedFor, "198.51.100.1",
+			)),
+			trustedProxyHops: 0,
+			want:             "192.0.2.1",
+		},
+		"a direct gRPC client is identified by its peer address": {
+			ctx:              peerContext,
+			trustedProxyHops: 0,
+			want:             "192.0.2.1",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.want, clientIP(tc.ctx, tc.trustedProxyHops))
+		})
+	}
+}
+
+func TestRateLimit_ThroughGateway(t *testing.T) {
+	t.Parallel()
+
+	const method = "/rapid.Service/Limited"
+	limit := cache.RateLimit{
+		Limit:  10,
+		Period: time.Minute,
+	}
+	policy := NewPolicy(map[string]Rule{
+		method: {
+			Key:   ByStaff(),
+			Limit: limit,
+		},
+	})
+
+	tests := map[string]struct {
+		remoteAddr    string
+		xForwardedFor string
+		wantKey       string
+	}{
+		"request is keyed on the client of the gateway": {
+			remoteAddr:    "203.0.113.1:40000",
+			xForwardedFor: "",
+			wantKey:       method + ":ip:203.0.113.1",
+		},
+		"x-forwarded-for set by the client is not trusted": {
+			remoteAddr:    "203.0.113.1:40000",
+			xForwardedFor: "198.51.100.1",
+			wantKey:       method + ":ip:203.0.113.1",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			// the gateway appends the address of its client to X-Forwarded-For as it forwards the request
+			req := httptest.NewRequest(http.MethodPost, "/v1/limited", nil)
+			req.RemoteAddr = tc.remoteAddr
+			if tc.xForwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tc.xForwardedFor)
+			}
+			gatewayCtx, err := runtime.AnnotateContext(context.Background(), runtime.NewServeMux(), req, method)
+			require.NoError(t, err)
+			md, ok := metadata.FromOutgoingContext(gatewayCtx)
+			require.True(t, ok)
+
+			// the server receives the request from the gateway over the loopback
+			ctx := peer.NewContext(context.Background(), &peer.Peer{
+				Addr:      &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+				LocalAddr: nil,
+				AuthInfo:  nil,
+			})
+			ctx = metadata.NewIncomingContext(ctx, md)
+			ctx = grpc.NewContextWithServerTransportStream(ctx, &headerStream{})
+
+			mockRateLimiter := mock_cache.NewMockRateLimiter(ctrl)
+			mockRateLimiter.EXPECT().
+				Allow(gomock.Any(), tc.wantKey, limit).
+				Return(&cache.RateLimitResult{
+					Allowed:    true,
+					Remaining:  9,
+					RetryAfter: 0,
+					ResetAfter: 6 * time.Second,
+				}, nil)
+
+			// the default of RATE_LIMIT_TRUSTED_PROXY_HOPS, trusting the gateway only
+			interceptor := NewRateLimit(policy, mockRateLimiter, 1)
+			_, err = interceptor.UnaryServerInterceptor()(
+				ctx,
+				nil,
+				&grpc.UnaryServerInfo{Server: nil, FullMethod: method},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, nil
+				},
+			)
+			require.NoError(t, err)
+		})
+	}
+}
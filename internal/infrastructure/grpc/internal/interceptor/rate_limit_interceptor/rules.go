@@ -0,0 +1,61 @@
+package rate_limit_interceptor
+
+import (
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
+	debug_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/debug_api/v1"
+	staff_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1"
+)
+
+// NewDefaultPolicy returns the rate limits of the RPCs served by this application,
+// declared for those creating users, tokens or objects in the storage.
+func NewDefaultPolicy() *Policy {
+	return NewPolicy(map[string]Rule{
+		// admin api
+		admin_apiv1.AdminV1Service_CreateAssetPresignedURL_FullMethodName: {
+			Key:   ByAdmin(),
+			Limit: perMinute(60),
+		},
+		admin_apiv1.AdminV1Service_UploadAsset_FullMethodName: {
+			Key:   ByAdmin(),
+			Limit: perMinute(60),
+		},
+
+		// staff api
+		staff_apiv1.StaffV1Service_CreateAssetPresignedURL_FullMethodName: {
+			Key:   ByStaff(),
+			Limit: perMinute(60),
+		},
+		staff_apiv1.StaffV1Service_UploadAsset_FullMethodName: {
+			Key:   ByStaff(),
+			Limit: perMinute(60),
+		},
+		staff_apiv1.StaffV1Service_SignUp_FullMethodName: {
+			Key:   ByClientIP(),
+			Limit: perMinute(10),
+		},
+		staff_apiv1.StaffV1Service_CreateInvitation_FullMethodName: {
+			Key:   ByTenant(),
+			Limit: perMinute(30),
+		},
+
+		// debug api
+		debug_apiv1.DebugV1Service_CreateAdminIDToken_FullMethodName: {
+			Key:   ByClientIP(),
+			Limit: perMinute(30),
+		},
+		debug_apiv1.DebugV1Service_CreateStaffIDToken_FullMethodName: {
+			Key:   ByClientIP(),
+			Limit: perMinute(30),
+		},
+	})
+}
+
+func perMinute(limit int) cache.RateLimit {
+	return cache.RateLimit{
+		Limit:  limit,
+		Period: time.Minute,
+	}
+}
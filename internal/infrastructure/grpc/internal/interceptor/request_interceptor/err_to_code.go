@@ -27,6 +27,10 @@ func errToCode(err error) codes.Code {
 			return codes.Canceled
 		case errors.ErrorCategoryServiceAvailable.String():
 			return codes.Unavailable
+		case errors.ErrorCategoryTooManyRequests.String():
+			return codes.ResourceExhausted
+		case errors.ErrorCategoryFailedPrecondition.String():
+			return codes.FailedPrecondition
 		}
 	}
 	if status, ok := status.FromError(err); ok {
@@ -37,9 +41,9 @@ func errToCode(err error) codes.Code {
 
 func codeToZapCoreLevel(code codes.Code) zapcore.Level {
 	switch code {
-	case codes.NotFound, codes.InvalidArgument, codes.AlreadyExists, codes.Unauthenticated, codes.PermissionDenied, codes.FailedPrecondition:
+	case codes.NotFound, codes.InvalidArgument, codes.AlreadyExists, codes.Unauthenticated, codes.PermissionDenied, codes.FailedPrecondition, codes.ResourceExhausted:
 		return zapcore.WarnLevel
-	case codes.Internal, codes.Unknown, codes.Aborted, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Unavailable, codes.Canceled, codes.OutOfRange, codes.Unimplemented, codes.DataLoss:
+	case codes.Internal, codes.Unknown, codes.Aborted, codes.DeadlineExceeded, codes.Unavailable, codes.Canceled, codes.OutOfRange, codes.Unimplemented, codes.DataLoss:
 		return zapcore.ErrorLevel
 	case codes.OK:
 		fallthrough
@@ -11,11 +11,14 @@ import (
 	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
 	"github.com/blendle/zapdriver"
 	"github.com/google/uuid"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
 	"go.uber.org/zap"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -47,80 +50,112 @@ func (i *RequestLog) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		error,
 	) {
 		operationID := uuid.New()
-		now := now.Now()
-
-		ctx = logger.ToContext(
-			ctx,
-			i.logger.With(
-				zapdriver.OperationCont(operationID.String(), producerID),
-				zap.Time("RequestTime", now),
-			))
-		ctx = SetRequestTime(ctx, now)
+		ctx = i.newContext(ctx, operationID)
 
 		resp, err := handler(ctx, req)
-		fields := []zap.Field{
-			zapdriver.OperationEnd(operationID.String(), producerID),
-			zap.String("method", info.FullMethod),
-			zap.Any("request", req),
+		if err := i.finish(ctx, operationID, info.FullMethod, err, zap.Any("request", req)); err != nil {
+			return nil, err
 		}
-		if err != nil {
-			code := errToCode(err)
-			fields = append(
-				fields,
-				zap.String("grpc.code", code.String()),
-				logger_field.Error(err),
-			)
-			zapcoreLevel := codeToZapCoreLevel(code)
-			logger.L(ctx).Check(zapcoreLevel, fmt.Sprintf("code: %s  rpc: %s", code.String(), info.FullMethod)).
-				Write(fields...)
-
-			errCode, errMessage := extractErrInfo(err)
-			var st *status.Status
-			var stErr error
-			if meta := errors.PublicMetadata(err); len(meta) > 0 {
-				if s, convErr := structpb.NewStruct(meta); convErr == nil {
-					st, stErr = status.New(code, errCode).WithDetails(
-						&errdetails.DebugInfo{Detail: errMessage},
-						&errdetails.RequestInfo{RequestId: operationID.String()},
-						s,
-					)
-				} else {
-					logger.L(ctx).Warn("failed to encode public metadata as structpb.Struct", logger_field.Error(convErr))
-					st, stErr = status.New(code, errCode).WithDetails(
-						&errdetails.DebugInfo{Detail: errMessage},
-						&errdetails.RequestInfo{RequestId: operationID.String()},
-					)
-				}
-			} else {
-				st, stErr = status.New(code, errCode).WithDetails(
-					&errdetails.DebugInfo{Detail: errMessage},
-					&errdetails.RequestInfo{RequestId: operationID.String()},
-				)
-			}
-			if stErr != nil {
-				return nil, errors.InternalErr.Wrap(stErr)
-			}
+		return resp, nil
+	}
+}
 
-			return nil, st.Err()
-		}
+// StreamServerInterceptor is UnaryServerInterceptor for streaming RPCs, which logs once the stream ends.
+// The messages are not logged, since they are chunks of a large content.
+func (i *RequestLog) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		operationID := uuid.New()
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = i.newContext(stream.Context(), operationID)
 
-		// var logResp interface{}
-		// if len(fmt.Sprintf("%v", resp)) < MAX_RESPONSE_LOG_SIZE {
-		// 	logResp = resp
-		// }
+		err := handler(srv, wrapped)
+		return i.finish(wrapped.WrappedContext, operationID, info.FullMethod, err)
+	}
+}
+
+// newContext returns ctx with the logger and the time of the request.
+func (i *RequestLog) newContext(
+	ctx context.Context,
+	operationID uuid.UUID,
+) context.Context {
+	now := now.Now()
 
+	ctx = logger.ToContext(
+		ctx,
+		i.logger.With(
+			zapdriver.OperationCont(operationID.String(), producerID),
+			zap.Time("RequestTime", now),
+		))
+	return SetRequestTime(ctx, now)
+}
+
+// finish logs the end of the request and converts err to the status returned to the client.
+func (i *RequestLog) finish(
+	ctx context.Context,
+	operationID uuid.UUID,
+	fullMethod string,
+	err error,
+	requestFields ...zap.Field,
+) error {
+	fields := append([]zap.Field{
+		zapdriver.OperationEnd(operationID.String(), producerID),
+		zap.String("method", fullMethod),
+	}, requestFields...)
+	if err != nil {
+		code := errToCode(err)
 		fields = append(
 			fields,
-			zap.String("grpc.code", codes.OK.String()),
-			// zap.Any("response", logResp),
-		)
-		logger.L(ctx).Debug(
-			fmt.Sprintf("code: %s  rpc: %s", codes.OK.String(), info.FullMethod),
-			fields...,
+			zap.String("grpc.code", code.String()),
+			logger_field.Error(err),
 		)
+		zapcoreLevel := codeToZapCoreLevel(code)
+		logger.L(ctx).Check(zapcoreLevel, fmt.Sprintf("code: %s  rpc: %s", code.String(), fullMethod)).
+			Write(fields...)
 
-		return resp, nil
+		errCode, errMessage := extractErrInfo(err)
+		details := []protoadapt.MessageV1{
+			&errdetails.DebugInfo{Detail: errMessage},
+			&errdetails.RequestInfo{RequestId: operationID.String()},
+		}
+		if delay, ok := errors.RetryDelay(err); ok {
+			details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+		}
+		if meta := errors.PublicMetadata(err); len(meta) > 0 {
+			if s, convErr := structpb.NewStruct(meta); convErr == nil {
+				details = append(details, s)
+			} else {
+				logger.L(ctx).Warn("failed to encode public metadata as structpb.Struct", logger_field.Error(convErr))
+			}
+		}
+		st, stErr := status.New(code, errCode).WithDetails(details...)
+		if stErr != nil {
+			return errors.InternalErr.Wrap(stErr)
+		}
+
+		return st.Err()
 	}
+
+	// var logResp interface{}
+	// if len(fmt.Sprintf("%v", resp)) < MAX_RESPONSE_LOG_SIZE {
+	// 	logResp = resp
+	// }
+
+	fields = append(
+		fields,
+		zap.String("grpc.code", codes.OK.String()),
+		// zap.Any("response", logResp),
+	)
+	logger.L(ctx).Debug(
+		fmt.Sprintf("code: %s  rpc: %s", codes.OK.String(), fullMethod),
+		fields...,
+	)
+
+	return nil
 }
 
 func extractErrInfo(err error) (string, string) {
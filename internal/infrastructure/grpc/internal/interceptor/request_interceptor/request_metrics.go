@@ -0,0 +1,58 @@
+package request_interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type RequestMetrics struct{}
+
+func NewRequestMetrics() *RequestMetrics {
+	return &RequestMetrics{}
+}
+
+// UnaryServerInterceptor records the count and the latency of the RPCs by the code of errToCode.
+// It runs inside RequestLog, so that it sees the errors before they are converted to the status.
+func (i *RequestMetrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (
+		interface{},
+		error,
+	) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observe(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming RPCs, which observes the whole stream.
+func (i *RequestMetrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		observe(info.FullMethod, start, err)
+		return err
+	}
+}
+
+func observe(fullMethod string, start time.Time, err error) {
+	code := codes.OK
+	if err != nil {
+		code = errToCode(err)
+	}
+	metrics.ObserveRPC(fullMethod, code, time.Since(start))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"google.golang.org/grpc"
 )
 
 type adminContextKey struct{}
@@ -27,3 +28,17 @@ func GetAdminSessionContext(ctx context.Context) (*model.AdminClaims, bool) {
 	claims, ok := ctx.Value(adminSessionContextKey).(*model.AdminClaims)
 	return claims, ok
 }
+
+// RequireAdminAuditLogActor returns the session admin as the actor of an audited change made by the current RPC.
+func RequireAdminAuditLogActor(ctx context.Context) (model.AuditLogActor, error) {
+	claims, err := RequireAdminSessionContext(ctx)
+	if err != nil {
+		return model.AuditLogActor{}, err
+	}
+	if !claims.AdminID.Valid {
+		return model.AuditLogActor{}, errors.RequireAdminSessionErr.New().
+			WithDetail("admin id is not found in claims")
+	}
+	method, _ := grpc.Method(ctx)
+	return model.NewAdminAuditLogActor(claims.AdminID.String, method), nil
+}
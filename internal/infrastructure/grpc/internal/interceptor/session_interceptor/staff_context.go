@@ -7,6 +7,7 @@ import (
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/logger"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 type staffContextKey struct{}
@@ -33,3 +34,17 @@ func GetStaffSessionContext(ctx context.Context) (*model.StaffClaims, bool) {
 	sessionContext, ok := ctx.Value(staffSessionContextKey).(model.StaffClaims)
 	return &sessionContext, ok
 }
+
+// RequireStaffAuditLogActor returns the session staff as the actor of an audited change made by the current RPC.
+func RequireStaffAuditLogActor(ctx context.Context) (model.AuditLogActor, error) {
+	claims, err := RequireStaffSessionContext(ctx)
+	if err != nil {
+		return model.AuditLogActor{}, err
+	}
+	if !claims.StaffID.Valid {
+		return model.AuditLogActor{}, errors.RequireStaffSessionErr.New().
+			WithDetail("staff id is not found in claims")
+	}
+	method, _ := grpc.Method(ctx)
+	return model.NewStaffAuditLogActor(claims.StaffID.String, method), nil
+}
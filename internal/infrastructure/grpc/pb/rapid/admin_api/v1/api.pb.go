@@ -26,67 +26,122 @@ var File_rapid_admin_api_v1_api_proto protoreflect.FileDescriptor
 
 const file_rapid_admin_api_v1_api_proto_rawDesc = "" +
 	"\n" +
-	"\x1crapid/admin_api/v1/api.proto\x12\x12rapid.admin_api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\"rapid/admin_api/v1/api_asset.proto\x1a\"rapid/admin_api/v1/api_staff.proto\x1a#rapid/admin_api/v1/api_tenant.proto2\xd1\n" +
-	"\n" +
+	"\x1crapid/admin_api/v1/api.proto\x12\x12rapid.admin_api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\"rapid/admin_api/v1/api_admin.proto\x1a\"rapid/admin_api/v1/api_asset.proto\x1a&rapid/admin_api/v1/api_audit_log.proto\x1a\"rapid/admin_api/v1/api_staff.proto\x1a#rapid/admin_api/v1/api_tenant.proto2\xce\x16\n" +
 	"\x0eAdminV1Service\x12\xaf\x01\n" +
-	"\x17CreateAssetPresignedURL\x122.rapid.admin_api.v1.CreateAssetPresignedURLRequest\x1a3.rapid.admin_api.v1.CreateAssetPresignedURLResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /admin/v1/assets/-/presigned_url\x12\x7f\n" +
+	"\x17CreateAssetPresignedURL\x122.rapid.admin_api.v1.CreateAssetPresignedURLRequest\x1a3.rapid.admin_api.v1.CreateAssetPresignedURLResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /admin/v1/assets/-/presigned_url\x12\xa3\x01\n" +
+	"\x12ConfirmAssetUpload\x12-.rapid.admin_api.v1.ConfirmAssetUploadRequest\x1a..rapid.admin_api.v1.ConfirmAssetUploadResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/admin/v1/assets/{asset_id}/confirm\x12\x86\x01\n" +
+	"\vUploadAsset\x12&.rapid.admin_api.v1.UploadAssetRequest\x1a'.rapid.admin_api.v1.UploadAssetResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/admin/v1/assets/-/upload(\x01\x12\x94\x01\n" +
+	"\rDownloadAsset\x12(.rapid.admin_api.v1.DownloadAssetRequest\x1a).rapid.admin_api.v1.DownloadAssetResponse\",\x82\xd3\xe4\x93\x02&\x12$/admin/v1/assets/{asset_id}/download0\x01\x12\x7f\n" +
 	"\tGetTenant\x12$.rapid.admin_api.v1.GetTenantRequest\x1a%.rapid.admin_api.v1.GetTenantResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/admin/v1/tenants/{tenant_id}\x12y\n" +
 	"\vListTenants\x12&.rapid.admin_api.v1.ListTenantsRequest\x1a'.rapid.admin_api.v1.ListTenantsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/admin/v1/tenants\x12\x7f\n" +
 	"\fCreateTenant\x12'.rapid.admin_api.v1.CreateTenantRequest\x1a(.rapid.admin_api.v1.CreateTenantResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/admin/v1/tenants\x12\x8b\x01\n" +
 	"\fUpdateTenant\x12'.rapid.admin_api.v1.UpdateTenantRequest\x1a(.rapid.admin_api.v1.UpdateTenantResponse\"(\x82\xd3\xe4\x93\x02\":\x01*2\x1d/admin/v1/tenants/{tenant_id}\x12\x88\x01\n" +
-	"\fDeleteTenant\x12'.rapid.admin_api.v1.DeleteTenantRequest\x1a(.rapid.admin_api.v1.DeleteTenantResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/admin/v1/tenants/{tenant_id}\x12z\n" +
+	"\fDeleteTenant\x12'.rapid.admin_api.v1.DeleteTenantRequest\x1a(.rapid.admin_api.v1.DeleteTenantResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/admin/v1/tenants/{tenant_id}\x12\x96\x01\n" +
+	"\rRestoreTenant\x12(.rapid.admin_api.v1.RestoreTenantRequest\x1a).rapid.admin_api.v1.RestoreTenantResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/admin/v1/tenants/{tenant_id}/restore\x12z\n" +
 	"\bGetStaff\x12#.rapid.admin_api.v1.GetStaffRequest\x1a$.rapid.admin_api.v1.GetStaffResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/admin/v1/staffs/{staff_id}\x12u\n" +
 	"\n" +
 	"ListStaffs\x12%.rapid.admin_api.v1.ListStaffsRequest\x1a&.rapid.admin_api.v1.ListStaffsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/admin/v1/staffs\x12{\n" +
 	"\vCreateStaff\x12&.rapid.admin_api.v1.CreateStaffRequest\x1a'.rapid.admin_api.v1.CreateStaffResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/admin/v1/staffs\x12\x86\x01\n" +
-	"\vUpdateStaff\x12&.rapid.admin_api.v1.UpdateStaffRequest\x1a'.rapid.admin_api.v1.UpdateStaffResponse\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/admin/v1/staffs/{staff_id}B\xea\x01\n" +
+	"\vUpdateStaff\x12&.rapid.admin_api.v1.UpdateStaffRequest\x1a'.rapid.admin_api.v1.UpdateStaffResponse\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/admin/v1/staffs/{staff_id}\x12z\n" +
+	"\bGetAdmin\x12#.rapid.admin_api.v1.GetAdminRequest\x1a$.rapid.admin_api.v1.GetAdminResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/admin/v1/admins/{admin_id}\x12u\n" +
+	"\n" +
+	"ListAdmins\x12%.rapid.admin_api.v1.ListAdminsRequest\x1a&.rapid.admin_api.v1.ListAdminsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/admin/v1/admins\x12{\n" +
+	"\vCreateAdmin\x12&.rapid.admin_api.v1.CreateAdminRequest\x1a'.rapid.admin_api.v1.CreateAdminResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/admin/v1/admins\x12\x86\x01\n" +
+	"\vUpdateAdmin\x12&.rapid.admin_api.v1.UpdateAdminRequest\x1a'.rapid.admin_api.v1.UpdateAdminResponse\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/admin/v1/admins/{admin_id}\x12\x97\x01\n" +
+	"\x0fUpdateAdminRole\x12*.rapid.admin_api.v1.UpdateAdminRoleRequest\x1a+.rapid.admin_api.v1.UpdateAdminRoleResponse\"+\x82\xd3\xe4\x93\x02%:\x01*2 /admin/v1/admins/{admin_id}/role\x12\x83\x01\n" +
+	"\vDeleteAdmin\x12&.rapid.admin_api.v1.DeleteAdminRequest\x1a'.rapid.admin_api.v1.DeleteAdminResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/admin/v1/admins/{admin_id}\x12\x82\x01\n" +
+	"\rListAuditLogs\x12(.rapid.admin_api.v1.ListAuditLogsRequest\x1a).rapid.admin_api.v1.ListAuditLogsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/admin/v1/audit_logsB\xea\x01\n" +
 	"\x16com.rapid.admin_api.v1B\bApiProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1;admin_apiv1\xa2\x02\x03RAX\xaa\x02\x11Rapid.AdminApi.V1\xca\x02\x11Rapid\\AdminApi\\V1\xe2\x02\x1dRapid\\AdminApi\\V1\\GPBMetadata\xea\x02\x13Rapid::AdminApi::V1b\x06proto3"
 
 var file_rapid_admin_api_v1_api_proto_goTypes = []any{
 	(*CreateAssetPresignedURLRequest)(nil),  // 0: rapid.admin_api.v1.CreateAssetPresignedURLRequest
-	(*GetTenantRequest)(nil),                // 1: rapid.admin_api.v1.GetTenantRequest
-	(*ListTenantsRequest)(nil),              // 2: rapid.admin_api.v1.ListTenantsRequest
-	(*CreateTenantRequest)(nil),             // 3: rapid.admin_api.v1.CreateTenantRequest
-	(*UpdateTenantRequest)(nil),             // 4: rapid.admin_api.v1.UpdateTenantRequest
-	(*DeleteTenantRequest)(nil),             // 5: rapid.admin_api.v1.DeleteTenantRequest
-	(*GetStaffRequest)(nil),                 // 6: rapid.admin_api.v1.GetStaffRequest
-	(*ListStaffsRequest)(nil),               // 7: rapid.admin_api.v1.ListStaffsRequest
-	(*CreateStaffRequest)(nil),              // 8: rapid.admin_api.v1.CreateStaffRequest
-	(*UpdateStaffRequest)(nil),              // 9: rapid.admin_api.v1.UpdateStaffRequest
-	(*CreateAssetPresignedURLResponse)(nil), // 10: rapid.admin_api.v1.CreateAssetPresignedURLResponse
-	(*GetTenantResponse)(nil),               // 11: rapid.admin_api.v1.GetTenantResponse
-	(*ListTenantsResponse)(nil),             // 12: rapid.admin_api.v1.ListTenantsResponse
-	(*CreateTenantResponse)(nil),            // 13: rapid.admin_api.v1.CreateTenantResponse
-	(*UpdateTenantResponse)(nil),            // 14: rapid.admin_api.v1.UpdateTenantResponse
-	(*DeleteTenantResponse)(nil),            // 15: rapid.admin_api.v1.DeleteTenantResponse
-	(*GetStaffResponse)(nil),                // 16: rapid.admin_api.v1.GetStaffResponse
-	(*ListStaffsResponse)(nil),              // 17: rapid.admin_api.v1.ListStaffsResponse
-	(*CreateStaffResponse)(nil),             // 18: rapid.admin_api.v1.CreateStaffResponse
-	(*UpdateStaffResponse)(nil),             // 19: rapid.admin_api.v1.UpdateStaffResponse
+	(*ConfirmAssetUploadRequest)(nil),       // 1: rapid.admin_api.v1.ConfirmAssetUploadRequest
+	(*UploadAssetRequest)(nil),              // 2: rapid.admin_api.v1.UploadAssetRequest
+	(*DownloadAssetRequest)(nil),            // 3: rapid.admin_api.v1.DownloadAssetRequest
+	(*GetTenantRequest)(nil),                // 4: rapid.admin_api.v1.GetTenantRequest
+	(*ListTenantsRequest)(nil),              // 5: rapid.admin_api.v1.ListTenantsRequest
+	(*CreateTenantRequest)(nil),             // 6: rapid.admin_api.v1.CreateTenantRequest
+	(*UpdateTenantRequest)(nil),             // 7: rapid.admin_api.v1.UpdateTenantRequest
+	(*DeleteTenantRequest)(nil),             // 8: rapid.admin_api.v1.DeleteTenantRequest
+	(*RestoreTenantRequest)(nil),            // 9: rapid.admin_api.v1.RestoreTenantRequest
+	(*GetStaffRequest)(nil),                 // 10: rapid.admin_api.v1.GetStaffRequest
+	(*ListStaffsRequest)(nil),               // 11: rapid.admin_api.v1.ListStaffsRequest
+	(*CreateStaffRequest)(nil),              // 12: rapid.admin_api.v1.CreateStaffRequest
+	(*UpdateStaffRequest)(nil),              // 13: rapid.admin_api.v1.UpdateStaffRequest
+	(*GetAdminRequest)(nil),                 // 14: rapid.admin_api.v1.GetAdminRequest
+	(*ListAdminsRequest)(nil),               // 15: rapid.admin_api.v1.ListAdminsRequest
+	(*CreateAdminRequest)(nil),              // 16: rapid.admin_api.v1.CreateAdminRequest
+	(*UpdateAdminRequest)(nil),              // 17: rapid.admin_api.v1.UpdateAdminRequest
+	(*UpdateAdminRoleRequest)(nil),          // 18: rapid.admin_api.v1.UpdateAdminRoleRequest
+	(*DeleteAdminRequest)(nil),              // 19: rapid.admin_api.v1.DeleteAdminRequest
+	(*ListAuditLogsRequest)(nil),            // 20: rapid.admin_api.v1.ListAuditLogsRequest
+	(*CreateAssetPresignedURLResponse)(nil), // 21: rapid.admin_api.v1.CreateAssetPresignedURLResponse
+	(*ConfirmAssetUploadResponse)(nil),      // 22: rapid.admin_api.v1.ConfirmAssetUploadResponse
+	(*UploadAssetResponse)(nil),             // 23: rapid.admin_api.v1.UploadAssetResponse
+	(*DownloadAssetResponse)(nil),           // 24: rapid.admin_api.v1.DownloadAssetResponse
+	(*GetTenantResponse)(nil),               // 25: rapid.admin_api.v1.GetTenantResponse
+	(*ListTenantsResponse)(nil),             // 26: rapid.admin_api.v1.ListTenantsResponse
+	(*CreateTenantResponse)(nil),            // 27: rapid.admin_api.v1.CreateTenantResponse
+	(*UpdateTenantResponse)(nil),            // 28: rapid.admin_api.v1.UpdateTenantResponse
+	(*DeleteTenantResponse)(nil),            // 29: rapid.admin_api.v1.DeleteTenantResponse
+	(*RestoreTenantResponse)(nil),           // 30: rapid.admin_api.v1.RestoreTenantResponse
+	(*GetStaffResponse)(nil),                // 31: rapid.admin_api.v1.GetStaffResponse
+	(*ListStaffsResponse)(nil),              // 32: rapid.admin_api.v1.ListStaffsResponse
+	(*CreateStaffResponse)(nil),             // 33: rapid.admin_api.v1.CreateStaffResponse
+	(*UpdateStaffResponse)(nil),             // 34: rapid.admin_api.v1.UpdateStaffResponse
+	(*GetAdminResponse)(nil),                // 35: rapid.admin_api.v1.GetAdminResponse
+	(*ListAdminsResponse)(nil),              // 36: rapid.admin_api.v1.ListAdminsResponse
+	(*CreateAdminResponse)(nil),             // 37: rapid.admin_api.v1.CreateAdminResponse
+	(*UpdateAdminResponse)(nil),             // 38: rapid.admin_api.v1.UpdateAdminResponse
+	(*UpdateAdminRoleResponse)(nil),         // 39: rapid.admin_api.v1.UpdateAdminRoleResponse
+	(*DeleteAdminResponse)(nil),             // 40: rapid.admin_api.v1.DeleteAdminResponse
+	(*ListAuditLogsResponse)(nil),           // 41: rapid.admin_api.v1.ListAuditLogsResponse
 }
 var file_rapid_admin_api_v1_api_proto_depIdxs = []int32{
 	0,  // 0: rapid.admin_api.v1.AdminV1Service.CreateAssetPresignedURL:input_type -> rapid.admin_api.v1.CreateAssetPresignedURLRequest
-	1,  // 1: rapid.admin_api.v1.AdminV1Service.GetTenant:input_type -> rapid.admin_api.v1.GetTenantRequest
-	2,  // 2: rapid.admin_api.v1.AdminV1Service.ListTenants:input_type -> rapid.admin_api.v1.ListTenantsRequest
-	3,  // 3: rapid.admin_api.v1.AdminV1Service.CreateTenant:input_type -> rapid.admin_api.v1.CreateTenantRequest
-	4,  // 4: rapid.admin_api.v1.AdminV1Service.UpdateTenant:input_type -> rapid.admin_api.v1.UpdateTenantRequest
-	5,  // 5: rapid.admin_api.v1.AdminV1Service.DeleteTenant:input_type -> rapid.admin_api.v1.DeleteTenantRequest
-	6,  // 6: rapid.admin_api.v1.AdminV1Service.GetStaff:input_type -> rapid.admin_api.v1.GetStaffRequest
-	7,  // 7: rapid.admin_api.v1.AdminV1Service.ListStaffs:input_type -> rapid.admin_api.v1.ListStaffsRequest
-	8,  // 8: rapid.admin_api.v1.AdminV1Service.CreateStaff:input_type -> rapid.admin_api.v1.CreateStaffRequest
-	9,  // 9: rapid.admin_api.v1.AdminV1Service.UpdateStaff:input_type -> rapid.admin_api.v1.UpdateStaffRequest
-	10, // 10: rapid.admin_api.v1.AdminV1Service.CreateAssetPresignedURL:output_type -> rapid.admin_api.v1.CreateAssetPresignedURLResponse
-	11, // 11: rapid.admin_api.v1.AdminV1Service.GetTenant:output_type -> rapid.admin_api.v1.GetTenantResponse
-	12, // 12: rapid.admin_api.v1.AdminV1Service.ListTenants:output_type -> rapid.admin_api.v1.ListTenantsResponse
-	13, // 13: rapid.admin_api.v1.AdminV1Service.CreateTenant:output_type -> rapid.admin_api.v1.CreateTenantResponse
-	14, // 14: rapid.admin_api.v1.AdminV1Service.UpdateTenant:output_type -> rapid.admin_api.v1.UpdateTenantResponse
-	15, // 15: rapid.admin_api.v1.AdminV1Service.DeleteTenant:output_type -> rapid.admin_api.v1.DeleteTenantResponse
-	16, // 16: rapid.admin_api.v1.AdminV1Service.GetStaff:output_type -> rapid.admin_api.v1.GetStaffResponse
-	17, // 17: rapid.admin_api.v1.AdminV1Service.ListStaffs:output_type -> rapid.admin_api.v1.ListStaffsResponse
-	18, // 18: rapid.admin_api.v1.AdminV1Service.CreateStaff:output_type -> rapid.admin_api.v1.CreateStaffResponse
-	19, // 19: rapid.admin_api.v1.AdminV1Service.UpdateStaff:output_type -> rapid.admin_api.v1.UpdateStaffResponse
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	1,  // 1: rapid.admin_api.v1.AdminV1Service.ConfirmAssetUpload:input_type -> rapid.admin_api.v1.ConfirmAssetUploadRequest
+	2,  // 2: rapid.admin_api.v1.AdminV1Service.UploadAsset:input_type -> rapid.admin_api.v1.UploadAssetRequest
+	3,  // 3: rapid.admin_api.v1.AdminV1Service.DownloadAsset:input_type -> rapid.admin_api.v1.DownloadAssetRequest
+	4,  // 4: rapid.admin_api.v1.AdminV1Service.GetTenant:input_type -> rapid.admin_api.v1.GetTenantRequest
+	5,  // 5: rapid.admin_api.v1.AdminV1Service.ListTenants:input_type -> rapid.admin_api.v1.ListTenantsRequest
+	6,  // 6: rapid.admin_api.v1.AdminV1Service.CreateTenant:input_type -> rapid.admin_api.v1.CreateTenantRequest
+	7,  // 7: rapid.admin_api.v1.AdminV1Service.UpdateTenant:input_type -> rapid.admin_api.v1.UpdateTenantRequest
+	8,  // 8: rapid.admin_api.v1.AdminV1Service.DeleteTenant:input_type -> rapid.admin_api.v1.DeleteTenantRequest
+	9,  // 9: rapid.admin_api.v1.AdminV1Service.RestoreTenant:input_type -> rapid.admin_api.v1.RestoreTenantRequest
+	10, // 10: rapid.admin_api.v1.AdminV1Service.GetStaff:input_type -> rapid.admin_api.v1.GetStaffRequest
+	11, // 11: rapid.admin_api.v1.AdminV1Service.ListStaffs:input_type -> rapid.admin_api.v1.ListStaffsRequest
+	12, // 12: rapid.admin_api.v1.AdminV1Service.CreateStaff:input_type -> rapid.admin_api.v1.CreateStaffRequest
+	13, // 13: rapid.admin_api.v1.AdminV1Service.UpdateStaff:input_type -> rapid.admin_api.v1.UpdateStaffRequest
+	14, // 14: rapid.admin_api.v1.AdminV1Service.GetAdmin:input_type -> rapid.admin_api.v1.GetAdminRequest
+	15, // 15: rapid.admin_api.v1.AdminV1Service.ListAdmins:input_type -> rapid.admin_api.v1.ListAdminsRequest
+	16, // 16: rapid.admin_api.v1.AdminV1Service.CreateAdmin:input_type -> rapid.admin_api.v1.CreateAdminRequest
+	17, // 17: rapid.admin_api.v1.AdminV1Service.UpdateAdmin:input_type -> rapid.admin_api.v1.UpdateAdminRequest
+	18, // 18: rapid.admin_api.v1.AdminV1Service.UpdateAdminRole:input_type -> rapid.admin_api.v1.UpdateAdminRoleRequest
+	19, // 19: rapid.admin_api.v1.AdminV1Service.DeleteAdmin:input_type -> rapid.admin_api.v1.DeleteAdminRequest
+	20, // 20: rapid.admin_api.v1.AdminV1Service.ListAuditLogs:input_type -> rapid.admin_api.v1.ListAuditLogsRequest
+	21, // 21: rapid.admin_api.v1.AdminV1Service.CreateAssetPresignedURL:output_type -> rapid.admin_api.v1.CreateAssetPresignedURLResponse
+	22, // 22: rapid.admin_api.v1.AdminV1Service.ConfirmAssetUpload:output_type -> rapid.admin_api.v1.ConfirmAssetUploadResponse
+	23, // 23: rapid.admin_api.v1.AdminV1Service.UploadAsset:output_type -> rapid.admin_api.v1.UploadAssetResponse
+	24, // 24: rapid.admin_api.v1.AdminV1Service.DownloadAsset:output_type -> rapid.admin_api.v1.DownloadAssetResponse
+	25, // 25: rapid.admin_api.v1.AdminV1Service.GetTenant:output_type -> rapid.admin_api.v1.GetTenantResponse
+	26, // 26: rapid.admin_api.v1.AdminV1Service.ListTenants:output_type -> rapid.admin_api.v1.ListTenantsResponse
+	27, // 27: rapid.admin_api.v1.AdminV1Service.CreateTenant:output_type -> rapid.admin_api.v1.CreateTenantResponse
+	28, // 28: rapid.admin_api.v1.AdminV1Service.UpdateTenant:output_type -> rapid.admin_api.v1.UpdateTenantResponse
+	29, // 29: rapid.admin_api.v1.AdminV1Service.DeleteTenant:output_type -> rapid.admin_api.v1.DeleteTenantResponse
+	30, // 30: rapid.admin_api.v1.AdminV1Service.RestoreTenant:output_type -> rapid.admin_api.v1.RestoreTenantResponse
+	31, // 31: rapid.admin_api.v1.AdminV1Service.GetStaff:output_type -> rapid.admin_api.v1.GetStaffResponse
+	32, // 32: rapid.admin_api.v1.AdminV1Service.ListStaffs:output_type -> rapid.admin_api.v1.ListStaffsResponse
+	33, // 33: rapid.admin_api.v1.AdminV1Service.CreateStaff:output_type -> rapid.admin_api.v1.CreateStaffResponse
+	34, // 34: rapid.admin_api.v1.AdminV1Service.UpdateStaff:output_type -> rapid.admin_api.v1.UpdateStaffResponse
+	35, // 35: rapid.admin_api.v1.AdminV1Service.GetAdmin:output_type -> rapid.admin_api.v1.GetAdminResponse
+	36, // 36: rapid.admin_api.v1.AdminV1Service.ListAdmins:output_type -> rapid.admin_api.v1.ListAdminsResponse
+	37, // 37: rapid.admin_api.v1.AdminV1Service.CreateAdmin:output_type -> rapid.admin_api.v1.CreateAdminResponse
+	38, // 38: rapid.admin_api.v1.AdminV1Service.UpdateAdmin:output_type -> rapid.admin_api.v1.UpdateAdminResponse
+	39, // 39: rapid.admin_api.v1.AdminV1Service.UpdateAdminRole:output_type -> rapid.admin_api.v1.UpdateAdminRoleResponse
+	40, // 40: rapid.admin_api.v1.AdminV1Service.DeleteAdmin:output_type -> rapid.admin_api.v1.DeleteAdminResponse
+	41, // 41: rapid.admin_api.v1.AdminV1Service.ListAuditLogs:output_type -> rapid.admin_api.v1.ListAuditLogsResponse
+	21, // [21:42] is the sub-list for method output_type
+	0,  // [0:21] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -97,7 +152,9 @@ func file_rapid_admin_api_v1_api_proto_init() {
 	if File_rapid_admin_api_v1_api_proto != nil {
 		return
 	}
+	file_rapid_admin_api_v1_api_admin_proto_init()
 	file_rapid_admin_api_v1_api_asset_proto_init()
+	file_rapid_admin_api_v1_api_audit_log_proto_init()
 	file_rapid_admin_api_v1_api_staff_proto_init()
 	file_rapid_admin_api_v1_api_tenant_proto_init()
 	type x struct{}
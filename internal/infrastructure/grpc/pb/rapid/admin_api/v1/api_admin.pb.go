@@ -0,0 +1,820 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: rapid/admin_api/v1/api_admin.proto
+
+package admin_apiv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListAdminsRequest_ListAdminsSortKey int32
+
+const (
+	ListAdminsRequest_LIST_ADMINS_SORT_KEY_UNSPECIFIED       ListAdminsRequest_ListAdminsSortKey = 0
+	ListAdminsRequest_LIST_ADMINS_SORT_KEY_CREATED_AT_DESC   ListAdminsRequest_ListAdminsSortKey = 1
+	ListAdminsRequest_LIST_ADMINS_SORT_KEY_CREATED_AT_ASC    ListAdminsRequest_ListAdminsSortKey = 2
+	ListAdminsRequest_LIST_ADMINS_SORT_KEY_DISPLAY_NAME_ASC  ListAdminsRequest_ListAdminsSortKey = 3
+	ListAdminsRequest_LIST_ADMINS_SORT_KEY_DISPLAY_NAME_DESC ListAdminsRequest_ListAdminsSortKey = 4
+)
+
+// Enum value maps for ListAdminsRequest_ListAdminsSortKey.
+var (
+	ListAdminsRequest_ListAdminsSortKey_name = map[int32]string{
+		0: "LIST_ADMINS_SORT_KEY_UNSPECIFIED",
+		1: "LIST_ADMINS_SORT_KEY_CREATED_AT_DESC",
+		2: "LIST_ADMINS_SORT_KEY_CREATED_AT_ASC",
+		3: "LIST_ADMINS_SORT_KEY_DISPLAY_NAME_ASC",
+		4: "LIST_ADMINS_SORT_KEY_DISPLAY_NAME_DESC",
+	}
+	ListAdminsRequest_ListAdminsSortKey_value = map[string]int32{
+		"LIST_ADMINS_SORT_KEY_UNSPECIFIED":       0,
+		"LIST_ADMINS_SORT_KEY_CREATED_AT_DESC":   1,
+		"LIST_ADMINS_SORT_KEY_CREATED_AT_ASC":    2,
+		"LIST_ADMINS_SORT_KEY_DISPLAY_NAME_ASC":  3,
+		"LIST_ADMINS_SORT_KEY_DISPLAY_NAME_DESC": 4,
+	}
+)
+
+func (x ListAdminsRequest_ListAdminsSortKey) Enum() *ListAdminsRequest_ListAdminsSortKey {
+	p := new(ListAdminsRequest_ListAdminsSortKey)
+	*p = x
+	return p
+}
+
+func (x ListAdminsRequest_ListAdminsSortKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListAdminsRequest_ListAdminsSortKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_rapid_admin_api_v1_api_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (ListAdminsRequest_ListAdminsSortKey) Type() protoreflect.EnumType {
+	return &file_rapid_admin_api_v1_api_admin_proto_enumTypes[0]
+}
+
+func (x ListAdminsRequest_ListAdminsSortKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListAdminsRequest_ListAdminsSortKey.Descriptor instead.
+func (ListAdminsRequest_ListAdminsSortKey) EnumDescriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// Get
+type GetAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdminRequest) Reset() {
+	*x = GetAdminRequest{}
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminRequest) ProtoMessage() {}
+
+func (x *GetAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminRequest.ProtoReflect.Descriptor instead.
+func (*GetAdminRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAdminRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type GetAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admin         *Admin                 `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdminResponse) Reset() {
+	*x = GetAdminResponse{}
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminResponse) ProtoMessage() {}
+
+func (x *GetAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminResponse.ProtoReflect.Descriptor instead.
+func (*GetAdminResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAdminResponse) GetAdmin() *Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+// List
+type ListAdminsRequest struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Page          uint64                               `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint64                               `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Role          *AdminRole                           `protobuf:"varint,3,opt,name=role,proto3,enum=rapid.admin_api.v1.AdminRole,oneof" json:"role,omitempty"`
+	SortKey       *ListAdminsRequest_ListAdminsSortKey `protobuf:"varint,4,opt,name=sort_key,json=sortKey,proto3,enum=rapid.admin_api.v1.ListAdminsRequest_ListAdminsSortKey,oneof" json:"sort_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminsRequest) Reset() {
+	*x = ListAdminsRequest{}
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminsRequest) ProtoMessage() {}
+
+func (x *ListAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAdminsRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAdminsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAdminsRequest) GetRole() AdminRole {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return AdminRole_ADMIN_ROLE_UNSPECIFIED
+}
+
+func (x *ListAdminsRequest) GetSortKey() ListAdminsRequest_ListAdminsSortKey {
+	if x != nil && x.SortKey != nil {
+		return *x.SortKey
+	}
+	return ListAdminsRequest_LIST_ADMINS_SORT_KEY_UNSPECIFIED
+}
+
+type ListAdminsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admins        []*Admin               `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminsResponse) Reset() {
+	*x = ListAdminsResponse{}
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminsResponse) ProtoMessage() {}
+
+func (x *ListAdminsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAdminsResponse) GetAdmins() []*Admin {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *ListAdminsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Create
+type CreateAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Role          AdminRole              `protobuf:"varint,3,opt,name=role,proto3,enum=rapid.admin_api.v1.AdminRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAdminRequest) Reset() {
+	*x = CreateAdminRequest{}
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAdminRequest) ProtoMessage() {}
+
+func (x *CreateAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAdminRequest.ProtoReflect.Descriptor instead.
+func (*CreateAdminRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateAdminRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateAdminRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateAdminRequest) GetRole() AdminRole {
+	if x != nil {
+		return x.Role
+	}
+	return AdminRole_ADMIN_ROLE_UNSPECIFIED
+}
+
+type CreateAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admin         *Admin                 `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAdminResponse) Reset() {
+	*x = CreateAdminResponse{}
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAdminResponse) ProtoMessage() {}
+
+func (x *CreateAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAdminResponse.ProtoReflect.Descriptor instead.
+func (*CreateAdminResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateAdminResponse) GetAdmin() *Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+func (x *CreateAdminResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Update
+type UpdateAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	DisplayName   *string                `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdminRequest) Reset() {
+	*x = UpdateAdminRequest{}
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdminRequest) ProtoMessage() {}
+
+func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdminRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAdminRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateAdminRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+type UpdateAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admin         *Admin                 `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdminResponse) Reset() {
+	*x = UpdateAdminResponse{}
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdminResponse) ProtoMessage() {}
+
+func (x *UpdateAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdminResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAdminResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateAdminResponse) GetAdmin() *Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+// UpdateRole
+type UpdateAdminRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Role          AdminRole              `protobuf:"varint,2,opt,name=role,proto3,enum=rapid.admin_api.v1.AdminRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdminRoleRequest) Reset() {
+	*x = UpdateAdminRoleRequest{}
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdminRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdminRoleRequest) ProtoMessage() {}
+
+func (x *UpdateAdminRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdminRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAdminRoleRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateAdminRoleRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *UpdateAdminRoleRequest) GetRole() AdminRole {
+	if x != nil {
+		return x.Role
+	}
+	return AdminRole_ADMIN_ROLE_UNSPECIFIED
+}
+
+type UpdateAdminRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admin         *Admin                 `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdminRoleResponse) Reset() {
+	*x = UpdateAdminRoleResponse{}
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdminRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdminRoleResponse) ProtoMessage() {}
+
+func (x *UpdateAdminRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdminRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAdminRoleResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateAdminRoleResponse) GetAdmin() *Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+// Delete
+type DeleteAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAdminRequest) Reset() {
+	*x = DeleteAdminRequest{}
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAdminRequest) ProtoMessage() {}
+
+func (x *DeleteAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAdminRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAdminRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteAdminRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type DeleteAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAdminResponse) Reset() {
+	*x = DeleteAdminResponse{}
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAdminResponse) ProtoMessage() {}
+
+func (x *DeleteAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAdminResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAdminResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP(), []int{11}
+}
+
+var File_rapid_admin_api_v1_api_admin_proto protoreflect.FileDescriptor
+
+const file_rapid_admin_api_v1_api_admin_proto_rawDesc = "" +
+	"\n" +
+	"\"rapid/admin_api/v1/api_admin.proto\x12\x12rapid.admin_api.v1\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a$rapid/admin_api/v1/model_admin.proto\x1a)rapid/admin_api/v1/model_pagination.proto\">\n" +
+	"\x0fGetAdminRequest\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId:\x10\x92A\r\n" +
+	"\v\xd2\x01\badmin_id\"R\n" +
+	"\x10GetAdminResponse\x12/\n" +
+	"\x05admin\x18\x01 \x01(\v2\x19.rapid.admin_api.v1.AdminR\x05admin:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05admin\"\xca\x03\n" +
+	"\x11ListAdminsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x04R\x05limit\x126\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1d.rapid.admin_api.v1.AdminRoleH\x00R\x04role\x88\x01\x01\x12W\n" +
+	"\bsort_key\x18\x04 \x01(\x0e27.rapid.admin_api.v1.ListAdminsRequest.ListAdminsSortKeyH\x01R\asortKey\x88\x01\x01\"\xe3\x01\n" +
+	"\x11ListAdminsSortKey\x12$\n" +
+	" LIST_ADMINS_SORT_KEY_UNSPECIFIED\x10\x00\x12(\n" +
+	"$LIST_ADMINS_SORT_KEY_CREATED_AT_DESC\x10\x01\x12'\n" +
+	"#LIST_ADMINS_SORT_KEY_CREATED_AT_ASC\x10\x02\x12)\n" +
+	"%LIST_ADMINS_SORT_KEY_DISPLAY_NAME_ASC\x10\x03\x12*\n" +
+	"&LIST_ADMINS_SORT_KEY_DISPLAY_NAME_DESC\x10\x04B\a\n" +
+	"\x05_roleB\v\n" +
+	"\t_sort_key\"\xa4\x01\n" +
+	"\x12ListAdminsResponse\x121\n" +
+	"\x06admins\x18\x01 \x03(\v2\x19.rapid.admin_api.v1.AdminR\x06admins\x12>\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1e.rapid.admin_api.v1.PaginationR\n" +
+	"pagination:\x1b\x92A\x18\n" +
+	"\x16\xd2\x01\x06admins\xd2\x01\n" +
+	"pagination\"\xa5\x01\n" +
+	"\x12CreateAdminRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x121\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1d.rapid.admin_api.v1.AdminRoleR\x04role:#\x92A \n" +
+	"\x1e\xd2\x01\x05email\xd2\x01\fdisplay_name\xd2\x01\x04role\"|\n" +
+	"\x13CreateAdminResponse\x12/\n" +
+	"\x05admin\x18\x01 \x01(\v2\x19.rapid.admin_api.v1.AdminR\x05admin\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword:\x18\x92A\x15\n" +
+	"\x13\xd2\x01\x05admin\xd2\x01\bpassword\"z\n" +
+	"\x12UpdateAdminRequest\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12&\n" +
+	"\fdisplay_name\x18\x02 \x01(\tH\x00R\vdisplayName\x88\x01\x01:\x10\x92A\r\n" +
+	"\v\xd2\x01\badmin_idB\x0f\n" +
+	"\r_display_name\"U\n" +
+	"\x13UpdateAdminResponse\x12/\n" +
+	"\x05admin\x18\x01 \x01(\v2\x19.rapid.admin_api.v1.AdminR\x05admin:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05admin\"\x7f\n" +
+	"\x16UpdateAdminRoleRequest\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\x121\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1d.rapid.admin_api.v1.AdminRoleR\x04role:\x17\x92A\x14\n" +
+	"\x12\xd2\x01\badmin_id\xd2\x01\x04role\"Y\n" +
+	"\x17UpdateAdminRoleResponse\x12/\n" +
+	"\x05admin\x18\x01 \x01(\v2\x19.rapid.admin_api.v1.AdminR\x05admin:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05admin\"A\n" +
+	"\x12DeleteAdminRequest\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId:\x10\x92A\r\n" +
+	"\v\xd2\x01\badmin_id\"\x15\n" +
+	"\x13DeleteAdminResponseB\xef\x01\n" +
+	"\x16com.rapid.admin_api.v1B\rApiAdminProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1;admin_apiv1\xa2\x02\x03RAX\xaa\x02\x11Rapid.AdminApi.V1\xca\x02\x11Rapid\\AdminApi\\V1\xe2\x02\x1dRapid\\AdminApi\\V1\\GPBMetadata\xea\x02\x13Rapid::AdminApi::V1b\x06proto3"
+
+var (
+	file_rapid_admin_api_v1_api_admin_proto_rawDescOnce sync.Once
+	file_rapid_admin_api_v1_api_admin_proto_rawDescData []byte
+)
+
+func file_rapid_admin_api_v1_api_admin_proto_rawDescGZIP() []byte {
+	file_rapid_admin_api_v1_api_admin_proto_rawDescOnce.Do(func() {
+		file_rapid_admin_api_v1_api_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rapid_admin_api_v1_api_admin_proto_rawDesc), len(file_rapid_admin_api_v1_api_admin_proto_rawDesc)))
+	})
+	return file_rapid_admin_api_v1_api_admin_proto_rawDescData
+}
+
+var file_rapid_admin_api_v1_api_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rapid_admin_api_v1_api_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_rapid_admin_api_v1_api_admin_proto_goTypes = []any{
+	(ListAdminsRequest_ListAdminsSortKey)(0), // 0: rapid.admin_api.v1.ListAdminsRequest.ListAdminsSortKey
+	(*GetAdminRequest)(nil),                  // 1: rapid.admin_api.v1.GetAdminRequest
+	(*GetAdminResponse)(nil),                 // 2: rapid.admin_api.v1.GetAdminResponse
+	(*ListAdminsRequest)(nil),                // 3: rapid.admin_api.v1.ListAdminsRequest
+	(*ListAdminsResponse)(nil),               // 4: rapid.admin_api.v1.ListAdminsResponse
+	(*CreateAdminRequest)(nil),               // 5: rapid.admin_api.v1.CreateAdminRequest
+	(*CreateAdminResponse)(nil),              // 6: rapid.admin_api.v1.CreateAdminResponse
+	(*UpdateAdminRequest)(nil),               // 7: rapid.admin_api.v1.UpdateAdminRequest
+	(*UpdateAdminResponse)(nil),              // 8: rapid.admin_api.v1.UpdateAdminResponse
+	(*UpdateAdminRoleRequest)(nil),           // 9: rapid.admin_api.v1.UpdateAdminRoleRequest
+	(*UpdateAdminRoleResponse)(nil),          // 10: rapid.admin_api.v1.UpdateAdminRoleResponse
+	(*DeleteAdminRequest)(nil),               // 11: rapid.admin_api.v1.DeleteAdminRequest
+	(*DeleteAdminResponse)(nil),              // 12: rapid.admin_api.v1.DeleteAdminResponse
+	(*Admin)(nil),                            // 13: rapid.admin_api.v1.Admin
+	(AdminRole)(0),                           // 14: rapid.admin_api.v1.AdminRole
+	(*Pagination)(nil),                       // 15: rapid.admin_api.v1.Pagination
+}
+var file_rapid_admin_api_v1_api_admin_proto_depIdxs = []int32{
+	13, // 0: rapid.admin_api.v1.GetAdminResponse.admin:type_name -> rapid.admin_api.v1.Admin
+	14, // 1: rapid.admin_api.v1.ListAdminsRequest.role:type_name -> rapid.admin_api.v1.AdminRole
+	0,  // 2: rapid.admin_api.v1.ListAdminsRequest.sort_key:type_name -> rapid.admin_api.v1.ListAdminsRequest.ListAdminsSortKey
+	13, // 3: rapid.admin_api.v1.ListAdminsResponse.admins:type_name -> rapid.admin_api.v1.Admin
+	15, // 4: rapid.admin_api.v1.ListAdminsResponse.pagination:type_name -> rapid.admin_api.v1.Pagination
+	14, // 5: rapid.admin_api.v1.CreateAdminRequest.role:type_name -> rapid.admin_api.v1.AdminRole
+	13, // 6: rapid.admin_api.v1.CreateAdminResponse.admin:type_name -> rapid.admin_api.v1.Admin
+	13, // 7: rapid.admin_api.v1.UpdateAdminResponse.admin:type_name -> rapid.admin_api.v1.Admin
+	14, // 8: rapid.admin_api.v1.UpdateAdminRoleRequest.role:type_name -> rapid.admin_api.v1.AdminRole
+	13, // 9: rapid.admin_api.v1.UpdateAdminRoleResponse.admin:type_name -> rapid.admin_api.v1.Admin
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_rapid_admin_api_v1_api_admin_proto_init() }
+func file_rapid_admin_api_v1_api_admin_proto_init() {
+	if File_rapid_admin_api_v1_api_admin_proto != nil {
+		return
+	}
+	file_rapid_admin_api_v1_model_admin_proto_init()
+	file_rapid_admin_api_v1_model_pagination_proto_init()
+	file_rapid_admin_api_v1_api_admin_proto_msgTypes[2].OneofWrappers = []any{}
+	file_rapid_admin_api_v1_api_admin_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rapid_admin_api_v1_api_admin_proto_rawDesc), len(file_rapid_admin_api_v1_api_admin_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rapid_admin_api_v1_api_admin_proto_goTypes,
+		DependencyIndexes: file_rapid_admin_api_v1_api_admin_proto_depIdxs,
+		EnumInfos:         file_rapid_admin_api_v1_api_admin_proto_enumTypes,
+		MessageInfos:      file_rapid_admin_api_v1_api_admin_proto_msgTypes,
+	}.Build()
+	File_rapid_admin_api_v1_api_admin_proto = out.File
+	file_rapid_admin_api_v1_api_admin_proto_goTypes = nil
+	file_rapid_admin_api_v1_api_admin_proto_depIdxs = nil
+}
@@ -76,9 +76,15 @@ func (x *CreateAssetPresignedURLRequest) GetContentType() ContentType {
 }
 
 type CreateAssetPresignedURLResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AssetId       string                 `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	PresignedUrl  string                 `protobuf:"bytes,2,opt,name=presigned_url,json=presignedUrl,proto3" json:"presigned_url,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AssetId      string                 `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	PresignedUrl string                 `protobuf:"bytes,2,opt,name=presigned_url,json=presignedUrl,proto3" json:"presigned_url,omitempty"`
+	// HTTP method of the upload, POST or PUT.
+	UploadMethod string `protobuf:"bytes,3,opt,name=upload_method,json=uploadMethod,proto3" json:"upload_method,omitempty"`
+	// Form fields a POST upload sends before the file field.
+	UploadFields map[string]string `protobuf:"bytes,4,rep,name=upload_fields,json=uploadFields,proto3" json:"upload_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Headers a PUT upload sends with the object.
+	UploadHeaders map[string]string `protobuf:"bytes,5,rep,name=upload_headers,json=uploadHeaders,proto3" json:"upload_headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -127,6 +133,449 @@ func (x *CreateAssetPresignedURLResponse) GetPresignedUrl() string {
 	return ""
 }
 
+func (x *CreateAssetPresignedURLResponse) GetUploadMethod() string {
+	if x != nil {
+		return x.UploadMethod
+	}
+	return ""
+}
+
+func (x *CreateAssetPresignedURLResponse) GetUploadFields() map[string]string {
+	if x != nil {
+		return x.UploadFields
+	}
+	return nil
+}
+
+func (x *CreateAssetPresignedURLResponse) GetUploadHeaders() map[string]string {
+	if x != nil {
+		return x.UploadHeaders
+	}
+	return nil
+}
+
+type ConfirmAssetUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmAssetUploadRequest) Reset() {
+	*x = ConfirmAssetUploadRequest{}
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmAssetUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmAssetUploadRequest) ProtoMessage() {}
+
+func (x *ConfirmAssetUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmAssetUploadRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmAssetUploadRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_asset_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConfirmAssetUploadRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+type ConfirmAssetUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         *Asset                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmAssetUploadResponse) Reset() {
+	*x = ConfirmAssetUploadResponse{}
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmAssetUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmAssetUploadResponse) ProtoMessage() {}
+
+func (x *ConfirmAssetUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmAssetUploadResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmAssetUploadResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_asset_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConfirmAssetUploadResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+type UploadAssetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAssetRequest_Header
+	//	*UploadAssetRequest_Chunk
+	Payload       isUploadAssetRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAssetRequest) Reset() {
+	*x = UploadAssetRequest{}
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAssetRequest) ProtoMessage() {}
+
+func (x *UploadAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAssetRequest.ProtoReflect.Descriptor instead.
+func (*UploadAssetRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_asset_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UploadAssetRequest) GetPayload() isUploadAssetRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadAssetRequest) GetHeader() *UploadAssetHeader {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAssetRequest_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *UploadAssetRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAssetRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAssetRequest_Payload interface {
+	isUploadAssetRequest_Payload()
+}
+
+type UploadAssetRequest_Header struct {
+	// First message of the stream.
+	Header *UploadAssetHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type UploadAssetRequest_Chunk struct {
+	// Content of the object, sent in order after the header in chunks below the 4 MiB message size limit.
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAssetRequest_Header) isUploadAssetRequest_Payload() {}
+
+func (*UploadAssetRequest_Chunk) isUploadAssetRequest_Payload() {}
+
+type UploadAssetHeader struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AssetType   AssetType              `protobuf:"varint,1,opt,name=asset_type,json=assetType,proto3,enum=rapid.admin_api.v1.AssetType" json:"asset_type,omitempty"`
+	ContentType ContentType            `protobuf:"varint,2,opt,name=content_type,json=contentType,proto3,enum=rapid.admin_api.v1.ContentType" json:"content_type,omitempty"`
+	// Hex-encoded SHA-256 of the content, verified before the object is stored.
+	Sha256        string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAssetHeader) Reset() {
+	*x = UploadAssetHeader{}
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAssetHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAssetHeader) ProtoMessage() {}
+
+func (x *UploadAssetHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAssetHeader.ProtoReflect.Descriptor instead.
+func (*UploadAssetHeader) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_asset_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UploadAssetHeader) GetAssetType() AssetType {
+	if x != nil {
+		return x.AssetType
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
+}
+
+func (x *UploadAssetHeader) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *UploadAssetHeader) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type UploadAssetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         *Asset                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAssetResponse) Reset() {
+	*x = UploadAssetResponse{}
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAssetResponse) ProtoMessage() {}
+
+func (x *UploadAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAssetResponse.ProtoReflect.Descriptor instead.
+func (*UploadAssetResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_asset_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UploadAssetResponse) GetAsset() *Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+type DownloadAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssetId       string                 `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAssetRequest) Reset() {
+	*x = DownloadAssetRequest{}
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAssetRequest) ProtoMessage() {}
+
+func (x *DownloadAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAssetRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAssetRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_asset_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DownloadAssetRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+type DownloadAssetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DownloadAssetResponse_Asset
+	//	*DownloadAssetResponse_Chunk
+	//	*DownloadAssetResponse_Sha256
+	Payload       isDownloadAssetResponse_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAssetResponse) Reset() {
+	*x = DownloadAssetResponse{}
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAssetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAssetResponse) ProtoMessage() {}
+
+func (x *DownloadAssetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_asset_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAssetResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAssetResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_asset_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DownloadAssetResponse) GetPayload() isDownloadAssetResponse_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DownloadAssetResponse) GetAsset() *Asset {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAssetResponse_Asset); ok {
+			return x.Asset
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAssetResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAssetResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAssetResponse) GetSha256() string {
+	if x != nil {
+		if x, ok := x.Payload.(*DownloadAssetResponse_Sha256); ok {
+			return x.Sha256
+		}
+	}
+	return ""
+}
+
+type isDownloadAssetResponse_Payload interface {
+	isDownloadAssetResponse_Payload()
+}
+
+type DownloadAssetResponse_Asset struct {
+	// First message of the stream.
+	Asset *Asset `protobuf:"bytes,1,opt,name=asset,proto3,oneof"`
+}
+
+type DownloadAssetResponse_Chunk struct {
+	// Content of the object, sent in order after the asset.
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+type DownloadAssetResponse_Sha256 struct {
+	// Last message of the stream, the hex-encoded SHA-256 of the content sent.
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3,oneof"`
+}
+
+func (*DownloadAssetResponse_Asset) isDownloadAssetResponse_Payload() {}
+
+func (*DownloadAssetResponse_Chunk) isDownloadAssetResponse_Payload() {}
+
+func (*DownloadAssetResponse_Sha256) isDownloadAssetResponse_Payload() {}
+
 var File_rapid_admin_api_v1_api_asset_proto protoreflect.FileDescriptor
 
 const file_rapid_admin_api_v1_api_asset_proto_rawDesc = "" +
@@ -137,11 +586,50 @@ const file_rapid_admin_api_v1_api_asset_proto_rawDesc = "" +
 	"asset_type\x18\x01 \x01(\x0e2\x1d.rapid.admin_api.v1.AssetTypeR\tassetType\x12B\n" +
 	"\fcontent_type\x18\x02 \x01(\x0e2\x1f.rapid.admin_api.v1.ContentTypeR\vcontentType:!\x92A\x1e\n" +
 	"\x1c\xd2\x01\n" +
-	"asset_type\xd2\x01\fcontent_type\"\x83\x01\n" +
+	"asset_type\xd2\x01\fcontent_type\"\xb7\x04\n" +
 	"\x1fCreateAssetPresignedURLResponse\x12\x19\n" +
 	"\basset_id\x18\x01 \x01(\tR\aassetId\x12#\n" +
-	"\rpresigned_url\x18\x02 \x01(\tR\fpresignedUrl: \x92A\x1d\n" +
-	"\x1b\xd2\x01\basset_id\xd2\x01\rpresigned_urlB\xef\x01\n" +
+	"\rpresigned_url\x18\x02 \x01(\tR\fpresignedUrl\x12#\n" +
+	"\rupload_method\x18\x03 \x01(\tR\fuploadMethod\x12j\n" +
+	"\rupload_fields\x18\x04 \x03(\v2E.rapid.admin_api.v1.CreateAssetPresignedURLResponse.UploadFieldsEntryR\fuploadFields\x12m\n" +
+	"\x0eupload_headers\x18\x05 \x03(\v2F.rapid.admin_api.v1.CreateAssetPresignedURLResponse.UploadHeadersEntryR\ruploadHeaders\x1a?\n" +
+	"\x11UploadFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a@\n" +
+	"\x12UploadHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:Q\x92AN\n" +
+	"L\xd2\x01\basset_id\xd2\x01\rpresigned_url\xd2\x01\rupload_method\xd2\x01\rupload_fields\xd2\x01\x0eupload_headers\"H\n" +
+	"\x19ConfirmAssetUploadRequest\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\tR\aassetId:\x10\x92A\r\n" +
+	"\v\xd2\x01\basset_id\"\\\n" +
+	"\x1aConfirmAssetUploadResponse\x12/\n" +
+	"\x05asset\x18\x01 \x01(\v2\x19.rapid.admin_api.v1.AssetR\x05asset:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05asset\"x\n" +
+	"\x12UploadAssetRequest\x12?\n" +
+	"\x06header\x18\x01 \x01(\v2%.rapid.admin_api.v1.UploadAssetHeaderH\x00R\x06header\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"\xd9\x01\n" +
+	"\x11UploadAssetHeader\x12<\n" +
+	"\n" +
+	"asset_type\x18\x01 \x01(\x0e2\x1d.rapid.admin_api.v1.AssetTypeR\tassetType\x12B\n" +
+	"\fcontent_type\x18\x02 \x01(\x0e2\x1f.rapid.admin_api.v1.ContentTypeR\vcontentType\x12\x16\n" +
+	"\x06sha256\x18\x03 \x01(\tR\x06sha256:*\x92A'\n" +
+	"%\xd2\x01\n" +
+	"asset_type\xd2\x01\fcontent_type\xd2\x01\x06sha256\"U\n" +
+	"\x13UploadAssetResponse\x12/\n" +
+	"\x05asset\x18\x01 \x01(\v2\x19.rapid.admin_api.v1.AssetR\x05asset:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05asset\"C\n" +
+	"\x14DownloadAssetRequest\x12\x19\n" +
+	"\basset_id\x18\x01 \x01(\tR\aassetId:\x10\x92A\r\n" +
+	"\v\xd2\x01\basset_id\"\x87\x01\n" +
+	"\x15DownloadAssetResponse\x121\n" +
+	"\x05asset\x18\x01 \x01(\v2\x19.rapid.admin_api.v1.AssetH\x00R\x05asset\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunk\x12\x18\n" +
+	"\x06sha256\x18\x03 \x01(\tH\x00R\x06sha256B\t\n" +
+	"\apayloadB\xef\x01\n" +
 	"\x16com.rapid.admin_api.v1B\rApiAssetProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1;admin_apiv1\xa2\x02\x03RAX\xaa\x02\x11Rapid.AdminApi.V1\xca\x02\x11Rapid\\AdminApi\\V1\xe2\x02\x1dRapid\\AdminApi\\V1\\GPBMetadata\xea\x02\x13Rapid::AdminApi::V1b\x06proto3"
 
 var (
@@ -156,21 +644,39 @@ func file_rapid_admin_api_v1_api_asset_proto_rawDescGZIP() []byte {
 	return file_rapid_admin_api_v1_api_asset_proto_rawDescData
 }
 
-var file_rapid_admin_api_v1_api_asset_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rapid_admin_api_v1_api_asset_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_rapid_admin_api_v1_api_asset_proto_goTypes = []any{
 	(*CreateAssetPresignedURLRequest)(nil),  // 0: rapid.admin_api.v1.CreateAssetPresignedURLRequest
 	(*CreateAssetPresignedURLResponse)(nil), // 1: rapid.admin_api.v1.CreateAssetPresignedURLResponse
-	(AssetType)(0),                          // 2: rapid.admin_api.v1.AssetType
-	(ContentType)(0),                        // 3: rapid.admin_api.v1.ContentType
+	(*ConfirmAssetUploadRequest)(nil),       // 2: rapid.admin_api.v1.ConfirmAssetUploadRequest
+	(*ConfirmAssetUploadResponse)(nil),      // 3: rapid.admin_api.v1.ConfirmAssetUploadResponse
+	(*UploadAssetRequest)(nil),              // 4: rapid.admin_api.v1.UploadAssetRequest
+	(*UploadAssetHeader)(nil),               // 5: rapid.admin_api.v1.UploadAssetHeader
+	(*UploadAssetResponse)(nil),             // 6: rapid.admin_api.v1.UploadAssetResponse
+	(*DownloadAssetRequest)(nil),            // 7: rapid.admin_api.v1.DownloadAssetRequest
+	(*DownloadAssetResponse)(nil),           // 8: rapid.admin_api.v1.DownloadAssetResponse
+	nil,                                     // 9: rapid.admin_api.v1.CreateAssetPresignedURLResponse.UploadFieldsEntry
+	nil,                                     // 10: rapid.admin_api.v1.CreateAssetPresignedURLResponse.UploadHeadersEntry
+	(AssetType)(0),                          // 11: rapid.admin_api.v1.AssetType
+	(ContentType)(0),                        // 12: rapid.admin_api.v1.ContentType
+	(*Asset)(nil),                           // 13: rapid.admin_api.v1.Asset
 }
 var file_rapid_admin_api_v1_api_asset_proto_depIdxs = []int32{
-	2, // 0: rapid.admin_api.v1.CreateAssetPresignedURLRequest.asset_type:type_name -> rapid.admin_api.v1.AssetType
-	3, // 1: rapid.admin_api.v1.CreateAssetPresignedURLRequest.content_type:type_name -> rapid.admin_api.v1.ContentType
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	11, // 0: rapid.admin_api.v1.CreateAssetPresignedURLRequest.asset_type:type_name -> rapid.admin_api.v1.AssetType
+	12, // 1: rapid.admin_api.v1.CreateAssetPresignedURLRequest.content_type:type_name -> rapid.admin_api.v1.ContentType
+	9,  // 2: rapid.admin_api.v1.CreateAssetPresignedURLResponse.upload_fields:type_name -> rapid.admin_api.v1.CreateAssetPresignedURLResponse.UploadFieldsEntry
+	10, // 3: rapid.admin_api.v1.CreateAssetPresignedURLResponse.upload_headers:type_name -> rapid.admin_api.v1.CreateAssetPresignedURLResponse.UploadHeadersEntry
+	13, // 4: rapid.admin_api.v1.ConfirmAssetUploadResponse.asset:type_name -> rapid.admin_api.v1.Asset
+	5,  // 5: rapid.admin_api.v1.UploadAssetRequest.header:type_name -> rapid.admin_api.v1.UploadAssetHeader
+	11, // 6: rapid.admin_api.v1.UploadAssetHeader.asset_type:type_name -> rapid.admin_api.v1.AssetType
+	12, // 7: rapid.admin_api.v1.UploadAssetHeader.content_type:type_name -> rapid.admin_api.v1.ContentType
+	13, // 8: rapid.admin_api.v1.UploadAssetResponse.asset:type_name -> rapid.admin_api.v1.Asset
+	13, // 9: rapid.admin_api.v1.DownloadAssetResponse.asset:type_name -> rapid.admin_api.v1.Asset
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_rapid_admin_api_v1_api_asset_proto_init() }
@@ -179,13 +685,22 @@ func file_rapid_admin_api_v1_api_asset_proto_init() {
 		return
 	}
 	file_rapid_admin_api_v1_model_asset_proto_init()
+	file_rapid_admin_api_v1_api_asset_proto_msgTypes[4].OneofWrappers = []any{
+		(*UploadAssetRequest_Header)(nil),
+		(*UploadAssetRequest_Chunk)(nil),
+	}
+	file_rapid_admin_api_v1_api_asset_proto_msgTypes[8].OneofWrappers = []any{
+		(*DownloadAssetResponse_Asset)(nil),
+		(*DownloadAssetResponse_Chunk)(nil),
+		(*DownloadAssetResponse_Sha256)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rapid_admin_api_v1_api_asset_proto_rawDesc), len(file_rapid_admin_api_v1_api_asset_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
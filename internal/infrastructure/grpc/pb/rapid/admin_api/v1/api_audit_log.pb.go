@@ -0,0 +1,262 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: rapid/admin_api/v1/api_audit_log.proto
+
+package admin_apiv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// List
+type ListAuditLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	TenantId      *string                `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	ActorType     *AuditLogActorType     `protobuf:"varint,4,opt,name=actor_type,json=actorType,proto3,enum=rapid.admin_api.v1.AuditLogActorType,oneof" json:"actor_type,omitempty"`
+	ActorId       *string                `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	TargetType    *AuditLogTargetType    `protobuf:"varint,6,opt,name=target_type,json=targetType,proto3,enum=rapid.admin_api.v1.AuditLogTargetType,oneof" json:"target_type,omitempty"`
+	TargetId      *string                `protobuf:"bytes,7,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsRequest) Reset() {
+	*x = ListAuditLogsRequest{}
+	mi := &file_rapid_admin_api_v1_api_audit_log_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsRequest) ProtoMessage() {}
+
+func (x *ListAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_audit_log_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_audit_log_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListAuditLogsRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditLogsRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetActorType() AuditLogActorType {
+	if x != nil && x.ActorType != nil {
+		return *x.ActorType
+	}
+	return AuditLogActorType_AUDIT_LOG_ACTOR_TYPE_UNSPECIFIED
+}
+
+func (x *ListAuditLogsRequest) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditLogsRequest) GetTargetType() AuditLogTargetType {
+	if x != nil && x.TargetType != nil {
+		return *x.TargetType
+	}
+	return AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *ListAuditLogsRequest) GetTargetId() string {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return ""
+}
+
+type ListAuditLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditLogs     []*AuditLog            `protobuf:"bytes,1,rep,name=audit_logs,json=auditLogs,proto3" json:"audit_logs,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogsResponse) Reset() {
+	*x = ListAuditLogsResponse{}
+	mi := &file_rapid_admin_api_v1_api_audit_log_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogsResponse) ProtoMessage() {}
+
+func (x *ListAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_audit_log_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_audit_log_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAuditLogsResponse) GetAuditLogs() []*AuditLog {
+	if x != nil {
+		return x.AuditLogs
+	}
+	return nil
+}
+
+func (x *ListAuditLogsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_rapid_admin_api_v1_api_audit_log_proto protoreflect.FileDescriptor
+
+const file_rapid_admin_api_v1_api_audit_log_proto_rawDesc = "" +
+	"\n" +
+	"&rapid/admin_api/v1/api_audit_log.proto\x12\x12rapid.admin_api.v1\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a(rapid/admin_api/v1/model_audit_log.proto\x1a)rapid/admin_api/v1/model_pagination.proto\"\x85\x03\n" +
+	"\x14ListAuditLogsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x04R\x05limit\x12 \n" +
+	"\ttenant_id\x18\x03 \x01(\tH\x00R\btenantId\x88\x01\x01\x12I\n" +
+	"\n" +
+	"actor_type\x18\x04 \x01(\x0e2%.rapid.admin_api.v1.AuditLogActorTypeH\x01R\tactorType\x88\x01\x01\x12\x1e\n" +
+	"\bactor_id\x18\x05 \x01(\tH\x02R\aactorId\x88\x01\x01\x12L\n" +
+	"\vtarget_type\x18\x06 \x01(\x0e2&.rapid.admin_api.v1.AuditLogTargetTypeH\x03R\n" +
+	"targetType\x88\x01\x01\x12 \n" +
+	"\ttarget_id\x18\a \x01(\tH\x04R\btargetId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\r\n" +
+	"\v_actor_typeB\v\n" +
+	"\t_actor_idB\x0e\n" +
+	"\f_target_typeB\f\n" +
+	"\n" +
+	"_target_id\"\xb5\x01\n" +
+	"\x15ListAuditLogsResponse\x12;\n" +
+	"\n" +
+	"audit_logs\x18\x01 \x03(\v2\x1c.rapid.admin_api.v1.AuditLogR\tauditLogs\x12>\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1e.rapid.admin_api.v1.PaginationR\n" +
+	"pagination:\x1f\x92A\x1c\n" +
+	"\x1a\xd2\x01\n" +
+	"audit_logs\xd2\x01\n" +
+	"paginationB\xf2\x01\n" +
+	"\x16com.rapid.admin_api.v1B\x10ApiAuditLogProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1;admin_apiv1\xa2\x02\x03RAX\xaa\x02\x11Rapid.AdminApi.V1\xca\x02\x11Rapid\\AdminApi\\V1\xe2\x02\x1dRapid\\AdminApi\\V1\\GPBMetadata\xea\x02\x13Rapid::AdminApi::V1b\x06proto3"
+
+var (
+	file_rapid_admin_api_v1_api_audit_log_proto_rawDescOnce sync.Once
+	file_rapid_admin_api_v1_api_audit_log_proto_rawDescData []byte
+)
+
+func file_rapid_admin_api_v1_api_audit_log_proto_rawDescGZIP() []byte {
+	file_rapid_admin_api_v1_api_audit_log_proto_rawDescOnce.Do(func() {
+		file_rapid_admin_api_v1_api_audit_log_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rapid_admin_api_v1_api_audit_log_proto_rawDesc), len(file_rapid_admin_api_v1_api_audit_log_proto_rawDesc)))
+	})
+	return file_rapid_admin_api_v1_api_audit_log_proto_rawDescData
+}
+
+var file_rapid_admin_api_v1_api_audit_log_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rapid_admin_api_v1_api_audit_log_proto_goTypes = []any{
+	(*ListAuditLogsRequest)(nil),  // 0: rapid.admin_api.v1.ListAuditLogsRequest
+	(*ListAuditLogsResponse)(nil), // 1: rapid.admin_api.v1.ListAuditLogsResponse
+	(AuditLogActorType)(0),        // 2: rapid.admin_api.v1.AuditLogActorType
+	(AuditLogTargetType)(0),       // 3: rapid.admin_api.v1.AuditLogTargetType
+	(*AuditLog)(nil),              // 4: rapid.admin_api.v1.AuditLog
+	(*Pagination)(nil),            // 5: rapid.admin_api.v1.Pagination
+}
+var file_rapid_admin_api_v1_api_audit_log_proto_depIdxs = []int32{
+	2, // 0: rapid.admin_api.v1.ListAuditLogsRequest.actor_type:type_name -> rapid.admin_api.v1.AuditLogActorType
+	3, // 1: rapid.admin_api.v1.ListAuditLogsRequest.target_type:type_name -> rapid.admin_api.v1.AuditLogTargetType
+	4, // 2: rapid.admin_api.v1.ListAuditLogsResponse.audit_logs:type_name -> rapid.admin_api.v1.AuditLog
+	5, // 3: rapid.admin_api.v1.ListAuditLogsResponse.pagination:type_name -> rapid.admin_api.v1.Pagination
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rapid_admin_api_v1_api_audit_log_proto_init() }
+func file_rapid_admin_api_v1_api_audit_log_proto_init() {
+	if File_rapid_admin_api_v1_api_audit_log_proto != nil {
+		return
+	}
+	file_rapid_admin_api_v1_model_audit_log_proto_init()
+	file_rapid_admin_api_v1_model_pagination_proto_init()
+	file_rapid_admin_api_v1_api_audit_log_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rapid_admin_api_v1_api_audit_log_proto_rawDesc), len(file_rapid_admin_api_v1_api_audit_log_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rapid_admin_api_v1_api_audit_log_proto_goTypes,
+		DependencyIndexes: file_rapid_admin_api_v1_api_audit_log_proto_depIdxs,
+		MessageInfos:      file_rapid_admin_api_v1_api_audit_log_proto_msgTypes,
+	}.Build()
+	File_rapid_admin_api_v1_api_audit_log_proto = out.File
+	file_rapid_admin_api_v1_api_audit_log_proto_goTypes = nil
+	file_rapid_admin_api_v1_api_audit_log_proto_depIdxs = nil
+}
@@ -21,15 +21,26 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	AdminV1Service_CreateAssetPresignedURL_FullMethodName = "/rapid.admin_api.v1.AdminV1Service/CreateAssetPresignedURL"
+	AdminV1Service_ConfirmAssetUpload_FullMethodName      = "/rapid.admin_api.v1.AdminV1Service/ConfirmAssetUpload"
+	AdminV1Service_UploadAsset_FullMethodName             = "/rapid.admin_api.v1.AdminV1Service/UploadAsset"
+	AdminV1Service_DownloadAsset_FullMethodName           = "/rapid.admin_api.v1.AdminV1Service/DownloadAsset"
 	AdminV1Service_GetTenant_FullMethodName               = "/rapid.admin_api.v1.AdminV1Service/GetTenant"
 	AdminV1Service_ListTenants_FullMethodName             = "/rapid.admin_api.v1.AdminV1Service/ListTenants"
 	AdminV1Service_CreateTenant_FullMethodName            = "/rapid.admin_api.v1.AdminV1Service/CreateTenant"
 	AdminV1Service_UpdateTenant_FullMethodName            = "/rapid.admin_api.v1.AdminV1Service/UpdateTenant"
 	AdminV1Service_DeleteTenant_FullMethodName            = "/rapid.admin_api.v1.AdminV1Service/DeleteTenant"
+	AdminV1Service_RestoreTenant_FullMethodName           = "/rapid.admin_api.v1.AdminV1Service/RestoreTenant"
 	AdminV1Service_GetStaff_FullMethodName                = "/rapid.admin_api.v1.AdminV1Service/GetStaff"
 	AdminV1Service_ListStaffs_FullMethodName              = "/rapid.admin_api.v1.AdminV1Service/ListStaffs"
 	AdminV1Service_CreateStaff_FullMethodName             = "/rapid.admin_api.v1.AdminV1Service/CreateStaff"
 	AdminV1Service_UpdateStaff_FullMethodName             = "/rapid.admin_api.v1.AdminV1Service/UpdateStaff"
+	AdminV1Service_GetAdmin_FullMethodName                = "/rapid.admin_api.v1.AdminV1Service/GetAdmin"
+	AdminV1Service_ListAdmins_FullMethodName              = "/rapid.admin_api.v1.AdminV1Service/ListAdmins"
+	AdminV1Service_CreateAdmin_FullMethodName             = "/rapid.admin_api.v1.AdminV1Service/CreateAdmin"
+	AdminV1Service_UpdateAdmin_FullMethodName             = "/rapid.admin_api.v1.AdminV1Service/UpdateAdmin"
+	AdminV1Service_UpdateAdminRole_FullMethodName         = "/rapid.admin_api.v1.AdminV1Service/UpdateAdminRole"
+	AdminV1Service_DeleteAdmin_FullMethodName             = "/rapid.admin_api.v1.AdminV1Service/DeleteAdmin"
+	AdminV1Service_ListAuditLogs_FullMethodName           = "/rapid.admin_api.v1.AdminV1Service/ListAuditLogs"
 )
 
 // AdminV1ServiceClient is the client API for AdminV1Service service.
@@ -37,15 +48,26 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AdminV1ServiceClient interface {
 	CreateAssetPresignedURL(ctx context.Context, in *CreateAssetPresignedURLRequest, opts ...grpc.CallOption) (*CreateAssetPresignedURLResponse, error)
+	ConfirmAssetUpload(ctx context.Context, in *ConfirmAssetUploadRequest, opts ...grpc.CallOption) (*ConfirmAssetUploadResponse, error)
+	UploadAsset(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAssetRequest, UploadAssetResponse], error)
+	DownloadAsset(ctx context.Context, in *DownloadAssetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAssetResponse], error)
 	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error)
 	ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error)
 	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error)
 	UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*UpdateTenantResponse, error)
 	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error)
+	RestoreTenant(ctx context.Context, in *RestoreTenantRequest, opts ...grpc.CallOption) (*RestoreTenantResponse, error)
 	GetStaff(ctx context.Context, in *GetStaffRequest, opts ...grpc.CallOption) (*GetStaffResponse, error)
 	ListStaffs(ctx context.Context, in *ListStaffsRequest, opts ...grpc.CallOption) (*ListStaffsResponse, error)
 	CreateStaff(ctx context.Context, in *CreateStaffRequest, opts ...grpc.CallOption) (*CreateStaffResponse, error)
 	UpdateStaff(ctx context.Context, in *UpdateStaffRequest, opts ...grpc.CallOption) (*UpdateStaffResponse, error)
+	GetAdmin(ctx context.Context, in *GetAdminRequest, opts ...grpc.CallOption) (*GetAdminResponse, error)
+	ListAdmins(ctx context.Context, in *ListAdminsRequest, opts ...grpc.CallOption) (*ListAdminsResponse, error)
+	CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error)
+	UpdateAdmin(ctx context.Context, in *UpdateAdminRequest, opts ...grpc.CallOption) (*UpdateAdminResponse, error)
+	UpdateAdminRole(ctx context.Context, in *UpdateAdminRoleRequest, opts ...grpc.CallOption) (*UpdateAdminRoleResponse, error)
+	DeleteAdmin(ctx context.Context, in *DeleteAdminRequest, opts ...grpc.CallOption) (*DeleteAdminResponse, error)
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
 }
 
 type adminV1ServiceClient struct {
@@ -66,6 +88,48 @@ func (c *adminV1ServiceClient) CreateAssetPresignedURL(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *adminV1ServiceClient) ConfirmAssetUpload(ctx context.Context, in *ConfirmAssetUploadRequest, opts ...grpc.CallOption) (*ConfirmAssetUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmAssetUploadResponse)
+	err := c.cc.Invoke(ctx, AdminV1Service_ConfirmAssetUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1ServiceClient) UploadAsset(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAssetRequest, UploadAssetResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdminV1Service_ServiceDesc.Streams[0], AdminV1Service_UploadAsset_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAssetRequest, UploadAssetResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminV1Service_UploadAssetClient = grpc.ClientStreamingClient[UploadAssetRequest, UploadAssetResponse]
+
+func (c *adminV1ServiceClient) DownloadAsset(ctx context.Context, in *DownloadAssetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAssetResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdminV1Service_ServiceDesc.Streams[1], AdminV1Service_DownloadAsset_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAssetRequest, DownloadAssetResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminV1Service_DownloadAssetClient = grpc.ServerStreamingClient[DownloadAssetResponse]
+
 func (c *adminV1ServiceClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTenantResponse)
@@ -116,6 +180,16 @@ func (c *adminV1ServiceClient) DeleteTenant(ctx context.Context, in *DeleteTenan
 	return out, nil
 }
 
+func (c *adminV1ServiceClient) RestoreTenant(ctx context.Context, in *RestoreTenantRequest, opts ...grpc.CallOption) (*RestoreTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTenantResponse)
+	err := c.cc.Invoke(ctx, AdminV1Service_RestoreTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminV1ServiceClient) GetStaff(ctx context.Context, in *GetStaffRequest, opts ...grpc.CallOption) (*GetStaffResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetStaffResponse)
@@ -156,20 +230,101 @@ func (c *adminV1ServiceClient) UpdateStaff(ctx context.Context, in *UpdateStaffR
 	return out, nil
 }
 
+func (c *adminV1ServiceClient) GetAdmin(ctx context.Context, in *GetAdminRequest, opts ...grpc.CallOption) (*GetAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAdminResponse)
+	err := c.cc.Invoke(ctx, AdminV1Service_GetAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1ServiceClient) ListAdmins(ctx context.Context, in *ListAdminsRequest, opts ...grpc.CallOption) (*ListAdminsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAdminsResponse)
+	err := c.cc.Invoke(ctx, AdminV1Service_ListAdmins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1ServiceClient) CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAdminResponse)
+	err := c.cc.Invoke(ctx, AdminV1Service_CreateAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1ServiceClient) UpdateAdmin(ctx context.Context, in *UpdateAdminRequest, opts ...grpc.CallOption) (*UpdateAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAdminResponse)
+	err := c.cc.Invoke(ctx, AdminV1Service_UpdateAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1ServiceClient) UpdateAdminRole(ctx context.Context, in *UpdateAdminRoleRequest, opts ...grpc.CallOption) (*UpdateAdminRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAdminRoleResponse)
+	err := c.cc.Invoke(ctx, AdminV1Service_UpdateAdminRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1ServiceClient) DeleteAdmin(ctx context.Context, in *DeleteAdminRequest, opts ...grpc.CallOption) (*DeleteAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAdminResponse)
+	err := c.cc.Invoke(ctx, AdminV1Service_DeleteAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminV1ServiceClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditLogsResponse)
+	err := c.cc.Invoke(ctx, AdminV1Service_ListAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminV1ServiceServer is the server API for AdminV1Service service.
 // All implementations should embed UnimplementedAdminV1ServiceServer
 // for forward compatibility.
 type AdminV1ServiceServer interface {
 	CreateAssetPresignedURL(context.Context, *CreateAssetPresignedURLRequest) (*CreateAssetPresignedURLResponse, error)
+	ConfirmAssetUpload(context.Context, *ConfirmAssetUploadRequest) (*ConfirmAssetUploadResponse, error)
+	UploadAsset(grpc.ClientStreamingServer[UploadAssetRequest, UploadAssetResponse]) error
+	DownloadAsset(*DownloadAssetRequest, grpc.ServerStreamingServer[DownloadAssetResponse]) error
 	GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error)
 	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error)
 	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
 	UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantResponse, error)
 	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error)
+	RestoreTenant(context.Context, *RestoreTenantRequest) (*RestoreTenantResponse, error)
 	GetStaff(context.Context, *GetStaffRequest) (*GetStaffResponse, error)
 	ListStaffs(context.Context, *ListStaffsRequest) (*ListStaffsResponse, error)
 	CreateStaff(context.Context, *CreateStaffRequest) (*CreateStaffResponse, error)
 	UpdateStaff(context.Context, *UpdateStaffRequest) (*UpdateStaffResponse, error)
+	GetAdmin(context.Context, *GetAdminRequest) (*GetAdminResponse, error)
+	ListAdmins(context.Context, *ListAdminsRequest) (*ListAdminsResponse, error)
+	CreateAdmin(context.Context, *CreateAdminRequest) (*CreateAdminResponse, error)
+	UpdateAdmin(context.Context, *UpdateAdminRequest) (*UpdateAdminResponse, error)
+	UpdateAdminRole(context.Context, *UpdateAdminRoleRequest) (*UpdateAdminRoleResponse, error)
+	DeleteAdmin(context.Context, *DeleteAdminRequest) (*DeleteAdminResponse, error)
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
 }
 
 // UnimplementedAdminV1ServiceServer should be embedded to have
@@ -182,6 +337,15 @@ type UnimplementedAdminV1ServiceServer struct{}
 func (UnimplementedAdminV1ServiceServer) CreateAssetPresignedURL(context.Context, *CreateAssetPresignedURLRequest) (*CreateAssetPresignedURLResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateAssetPresignedURL not implemented")
 }
+func (UnimplementedAdminV1ServiceServer) ConfirmAssetUpload(context.Context, *ConfirmAssetUploadRequest) (*ConfirmAssetUploadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmAssetUpload not implemented")
+}
+func (UnimplementedAdminV1ServiceServer) UploadAsset(grpc.ClientStreamingServer[UploadAssetRequest, UploadAssetResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadAsset not implemented")
+}
+func (UnimplementedAdminV1ServiceServer) DownloadAsset(*DownloadAssetRequest, grpc.ServerStreamingServer[DownloadAssetResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadAsset not implemented")
+}
 func (UnimplementedAdminV1ServiceServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTenant not implemented")
 }
@@ -197,6 +361,9 @@ func (UnimplementedAdminV1ServiceServer) UpdateTenant(context.Context, *UpdateTe
 func (UnimplementedAdminV1ServiceServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteTenant not implemented")
 }
+func (UnimplementedAdminV1ServiceServer) RestoreTenant(context.Context, *RestoreTenantRequest) (*RestoreTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreTenant not implemented")
+}
 func (UnimplementedAdminV1ServiceServer) GetStaff(context.Context, *GetStaffRequest) (*GetStaffResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetStaff not implemented")
 }
@@ -209,6 +376,27 @@ func (UnimplementedAdminV1ServiceServer) CreateStaff(context.Context, *CreateSta
 func (UnimplementedAdminV1ServiceServer) UpdateStaff(context.Context, *UpdateStaffRequest) (*UpdateStaffResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateStaff not implemented")
 }
+func (UnimplementedAdminV1ServiceServer) GetAdmin(context.Context, *GetAdminRequest) (*GetAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAdmin not implemented")
+}
+func (UnimplementedAdminV1ServiceServer) ListAdmins(context.Context, *ListAdminsRequest) (*ListAdminsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAdmins not implemented")
+}
+func (UnimplementedAdminV1ServiceServer) CreateAdmin(context.Context, *CreateAdminRequest) (*CreateAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAdmin not implemented")
+}
+func (UnimplementedAdminV1ServiceServer) UpdateAdmin(context.Context, *UpdateAdminRequest) (*UpdateAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAdmin not implemented")
+}
+func (UnimplementedAdminV1ServiceServer) UpdateAdminRole(context.Context, *UpdateAdminRoleRequest) (*UpdateAdminRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAdminRole not implemented")
+}
+func (UnimplementedAdminV1ServiceServer) DeleteAdmin(context.Context, *DeleteAdminRequest) (*DeleteAdminResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAdmin not implemented")
+}
+func (UnimplementedAdminV1ServiceServer) ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
 func (UnimplementedAdminV1ServiceServer) testEmbeddedByValue() {}
 
 // UnsafeAdminV1ServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -247,6 +435,42 @@ func _AdminV1Service_CreateAssetPresignedURL_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminV1Service_ConfirmAssetUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmAssetUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1ServiceServer).ConfirmAssetUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1Service_ConfirmAssetUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1ServiceServer).ConfirmAssetUpload(ctx, req.(*ConfirmAssetUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1Service_UploadAsset_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdminV1ServiceServer).UploadAsset(&grpc.GenericServerStream[UploadAssetRequest, UploadAssetResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminV1Service_UploadAssetServer = grpc.ClientStreamingServer[UploadAssetRequest, UploadAssetResponse]
+
+func _AdminV1Service_DownloadAsset_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAssetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminV1ServiceServer).DownloadAsset(m, &grpc.GenericServerStream[DownloadAssetRequest, DownloadAssetResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminV1Service_DownloadAssetServer = grpc.ServerStreamingServer[DownloadAssetResponse]
+
 func _AdminV1Service_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTenantRequest)
 	if err := dec(in); err != nil {
@@ -337,6 +561,24 @@ func _AdminV1Service_DeleteTenant_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminV1Service_RestoreTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1ServiceServer).RestoreTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1Service_RestoreTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1ServiceServer).RestoreTenant(ctx, req.(*RestoreTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminV1Service_GetStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStaffRequest)
 	if err := dec(in); err != nil {
@@ -409,6 +651,132 @@ func _AdminV1Service_UpdateStaff_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminV1Service_GetAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1ServiceServer).GetAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1Service_GetAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1ServiceServer).GetAdmin(ctx, req.(*GetAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1Service_ListAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1ServiceServer).ListAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1Service_ListAdmins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1ServiceServer).ListAdmins(ctx, req.(*ListAdminsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1Service_CreateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1ServiceServer).CreateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1Service_CreateAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1ServiceServer).CreateAdmin(ctx, req.(*CreateAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1Service_UpdateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1ServiceServer).UpdateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1Service_UpdateAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1ServiceServer).UpdateAdmin(ctx, req.(*UpdateAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1Service_UpdateAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAdminRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1ServiceServer).UpdateAdminRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1Service_UpdateAdminRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1ServiceServer).UpdateAdminRole(ctx, req.(*UpdateAdminRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1Service_DeleteAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1ServiceServer).DeleteAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1Service_DeleteAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1ServiceServer).DeleteAdmin(ctx, req.(*DeleteAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminV1Service_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminV1ServiceServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminV1Service_ListAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminV1ServiceServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminV1Service_ServiceDesc is the grpc.ServiceDesc for AdminV1Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -420,6 +788,10 @@ var AdminV1Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateAssetPresignedURL",
 			Handler:    _AdminV1Service_CreateAssetPresignedURL_Handler,
 		},
+		{
+			MethodName: "ConfirmAssetUpload",
+			Handler:    _AdminV1Service_ConfirmAssetUpload_Handler,
+		},
 		{
 			MethodName: "GetTenant",
 			Handler:    _AdminV1Service_GetTenant_Handler,
@@ -440,6 +812,10 @@ var AdminV1Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTenant",
 			Handler:    _AdminV1Service_DeleteTenant_Handler,
 		},
+		{
+			MethodName: "RestoreTenant",
+			Handler:    _AdminV1Service_RestoreTenant_Handler,
+		},
 		{
 			MethodName: "GetStaff",
 			Handler:    _AdminV1Service_GetStaff_Handler,
@@ -456,7 +832,46 @@ var AdminV1Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStaff",
 			Handler:    _AdminV1Service_UpdateStaff_Handler,
 		},
+		{
+			MethodName: "GetAdmin",
+			Handler:    _AdminV1Service_GetAdmin_Handler,
+		},
+		{
+			MethodName: "ListAdmins",
+			Handler:    _AdminV1Service_ListAdmins_Handler,
+		},
+		{
+			MethodName: "CreateAdmin",
+			Handler:    _AdminV1Service_CreateAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateAdmin",
+			Handler:    _AdminV1Service_UpdateAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateAdminRole",
+			Handler:    _AdminV1Service_UpdateAdminRole_Handler,
+		},
+		{
+			MethodName: "DeleteAdmin",
+			Handler:    _AdminV1Service_DeleteAdmin_Handler,
+		},
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _AdminV1Service_ListAuditLogs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAsset",
+			Handler:       _AdminV1Service_UploadAsset_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAsset",
+			Handler:       _AdminV1Service_DownloadAsset_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rapid/admin_api/v1/api.proto",
 }
@@ -14,6 +14,7 @@ import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -79,10 +80,12 @@ func (ListTenantsRequest_ListTenantsSortKey) EnumDescriptor() ([]byte, []int) {
 }
 
 type GetTenantRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Returns the tenant even when it is soft deleted.
+	IncludeDeleted bool `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetTenantRequest) Reset() {
@@ -122,6 +125,13 @@ func (x *GetTenantRequest) GetTenantId() string {
 	return ""
 }
 
+func (x *GetTenantRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type GetTenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
@@ -167,10 +177,24 @@ func (x *GetTenantResponse) GetTenant() *Tenant {
 }
 
 type ListTenantsRequest struct {
-	state         protoimpl.MessageState                 `protogen:"open.v1"`
-	Page          uint64                                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         uint64                                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	SortKey       *ListTenantsRequest_ListTenantsSortKey `protobuf:"varint,3,opt,name=sort_key,json=sortKey,proto3,enum=rapid.admin_api.v1.ListTenantsRequest_ListTenantsSortKey,oneof" json:"sort_key,omitempty"`
+	state   protoimpl.MessageState                 `protogen:"open.v1"`
+	Page    uint64                                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit   uint64                                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	SortKey *ListTenantsRequest_ListTenantsSortKey `protobuf:"varint,3,opt,name=sort_key,json=sortKey,proto3,enum=rapid.admin_api.v1.ListTenantsRequest_ListTenantsSortKey,oneof" json:"sort_key,omitempty"`
+	// Switches to cursor pagination and ignores page. An empty cursor fetches the first page.
+	Cursor *string `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// Counts the total only when set in cursor pagination. Page pagination always counts.
+	IncludeTotalCount bool `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	// Lists soft deleted tenants as well.
+	IncludeDeleted bool `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// Lists only tenants tagged with any of the types.
+	TagTypes []TenantTagType `protobuf:"varint,7,rep,packed,name=tag_types,json=tagTypes,proto3,enum=rapid.admin_api.v1.TenantTagType" json:"tag_types,omitempty"`
+	// Lists only tenants whose name contains the text, ignoring case.
+	Name *string `protobuf:"bytes,8,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// Lists only tenants created at or after the time.
+	CreatedAtFrom *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at_from,json=createdAtFrom,proto3" json:"created_at_from,omitempty"`
+	// Lists only tenants created before the time.
+	CreatedAtTo   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at_to,json=createdAtTo,proto3" json:"created_at_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -226,10 +250,62 @@ func (x *ListTenantsRequest) GetSortKey() ListTenantsRequest_ListTenantsSortKey
 	return ListTenantsRequest_LIST_TENANTS_SORT_KEY_UNSPECIFIED
 }
 
+func (x *ListTenantsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListTenantsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *ListTenantsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ListTenantsRequest) GetTagTypes() []TenantTagType {
+	if x != nil {
+		return x.TagTypes
+	}
+	return nil
+}
+
+func (x *ListTenantsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ListTenantsRequest) GetCreatedAtFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtFrom
+	}
+	return nil
+}
+
+func (x *ListTenantsRequest) GetCreatedAtTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTo
+	}
+	return nil
+}
+
 type ListTenantsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tenants       []*Tenant              `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Tenants []*Tenant              `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	// Omitted in cursor pagination unless include_total_count is set.
+	Pagination *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Set in cursor pagination when more items remain.
+	NextCursor    *string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -278,9 +354,17 @@ func (x *ListTenantsResponse) GetPagination() *Pagination {
 	return nil
 }
 
+func (x *ListTenantsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
 type CreateTenantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tags          []TenantTagType        `protobuf:"varint,2,rep,packed,name=tags,proto3,enum=rapid.admin_api.v1.TenantTagType" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -322,6 +406,13 @@ func (x *CreateTenantRequest) GetName() string {
 	return ""
 }
 
+func (x *CreateTenantRequest) GetTags() []TenantTagType {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type CreateTenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
@@ -367,9 +458,14 @@ func (x *CreateTenantResponse) GetTenant() *Tenant {
 }
 
 type UpdateTenantRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name     *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// Replaces the tags when set. Set empty values to remove all tags.
+	Tags *TenantTagTypes `protobuf:"bytes,3,opt,name=tags,proto3,oneof" json:"tags,omitempty"`
+	// The etag of the version read. The update fails with FAILED_PRECONDITION when it has changed since.
+	// The If-Match header is used when unset.
+	Etag          *string `protobuf:"bytes,4,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -418,6 +514,20 @@ func (x *UpdateTenantRequest) GetName() string {
 	return ""
 }
 
+func (x *UpdateTenantRequest) GetTags() *TenantTagTypes {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateTenantRequest) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
 type UpdateTenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
@@ -542,53 +652,166 @@ func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
 	return file_rapid_admin_api_v1_api_tenant_proto_rawDescGZIP(), []int{9}
 }
 
+type RestoreTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTenantRequest) Reset() {
+	*x = RestoreTenantRequest{}
+	mi := &file_rapid_admin_api_v1_api_tenant_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTenantRequest) ProtoMessage() {}
+
+func (x *RestoreTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_tenant_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTenantRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTenantRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_tenant_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type RestoreTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTenantResponse) Reset() {
+	*x = RestoreTenantResponse{}
+	mi := &file_rapid_admin_api_v1_api_tenant_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTenantResponse) ProtoMessage() {}
+
+func (x *RestoreTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_api_tenant_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTenantResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTenantResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_api_tenant_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
 var File_rapid_admin_api_v1_api_tenant_proto protoreflect.FileDescriptor
 
 const file_rapid_admin_api_v1_api_tenant_proto_rawDesc = "" +
 	"\n" +
-	"#rapid/admin_api/v1/api_tenant.proto\x12\x12rapid.admin_api.v1\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a)rapid/admin_api/v1/model_pagination.proto\x1a%rapid/admin_api/v1/model_tenant.proto\"B\n" +
+	"#rapid/admin_api/v1/api_tenant.proto\x12\x12rapid.admin_api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a)rapid/admin_api/v1/model_pagination.proto\x1a%rapid/admin_api/v1/model_tenant.proto\"k\n" +
 	"\x10GetTenantRequest\x12\x1b\n" +
-	"\ttenant_id\x18\x01 \x01(\tR\btenantId:\x11\x92A\x0e\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted:\x11\x92A\x0e\n" +
 	"\f\xd2\x01\ttenant_id\"W\n" +
 	"\x11GetTenantResponse\x122\n" +
 	"\x06tenant\x18\x01 \x01(\v2\x1a.rapid.admin_api.v1.TenantR\x06tenant:\x0e\x92A\v\n" +
-	"\t\xd2\x01\x06tenant\"\x82\x03\n" +
+	"\t\xd2\x01\x06tenant\"\xe9\x05\n" +
 	"\x12ListTenantsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x04R\x05limit\x12Y\n" +
-	"\bsort_key\x18\x03 \x01(\x0e29.rapid.admin_api.v1.ListTenantsRequest.ListTenantsSortKeyH\x00R\asortKey\x88\x01\x01\"\xd9\x01\n" +
+	"\bsort_key\x18\x03 \x01(\x0e29.rapid.admin_api.v1.ListTenantsRequest.ListTenantsSortKeyH\x00R\asortKey\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x04 \x01(\tH\x01R\x06cursor\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x12'\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bR\x0eincludeDeleted\x12>\n" +
+	"\ttag_types\x18\a \x03(\x0e2!.rapid.admin_api.v1.TenantTagTypeR\btagTypes\x12\x17\n" +
+	"\x04name\x18\b \x01(\tH\x02R\x04name\x88\x01\x01\x12B\n" +
+	"\x0fcreated_at_from\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedAtFrom\x12>\n" +
+	"\rcreated_at_to\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTo\"\xd9\x01\n" +
 	"\x12ListTenantsSortKey\x12%\n" +
 	"!LIST_TENANTS_SORT_KEY_UNSPECIFIED\x10\x00\x12)\n" +
 	"%LIST_TENANTS_SORT_KEY_CREATED_AT_DESC\x10\x01\x12(\n" +
 	"$LIST_TENANTS_SORT_KEY_CREATED_AT_ASC\x10\x02\x12\"\n" +
 	"\x1eLIST_TENANTS_SORT_KEY_NAME_ASC\x10\x03\x12#\n" +
 	"\x1fLIST_TENANTS_SORT_KEY_NAME_DESC\x10\x04B\v\n" +
-	"\t_sort_key\"\xa9\x01\n" +
+	"\t_sort_keyB\t\n" +
+	"\a_cursorB\a\n" +
+	"\x05_name\"\xd2\x01\n" +
 	"\x13ListTenantsResponse\x124\n" +
 	"\atenants\x18\x01 \x03(\v2\x1a.rapid.admin_api.v1.TenantR\atenants\x12>\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1e.rapid.admin_api.v1.PaginationR\n" +
-	"pagination:\x1c\x92A\x19\n" +
-	"\x17\xd2\x01\atenants\xd2\x01\n" +
-	"pagination\"7\n" +
+	"pagination\x12$\n" +
+	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01:\x0f\x92A\f\n" +
+	"\n" +
+	"\xd2\x01\atenantsB\x0e\n" +
+	"\f_next_cursor\"n\n" +
 	"\x13CreateTenantRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name:\f\x92A\t\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\x04tags\x18\x02 \x03(\x0e2!.rapid.admin_api.v1.TenantTagTypeR\x04tags:\f\x92A\t\n" +
 	"\a\xd2\x01\x04name\"Z\n" +
 	"\x14CreateTenantResponse\x122\n" +
 	"\x06tenant\x18\x01 \x01(\v2\x1a.rapid.admin_api.v1.TenantR\x06tenant:\x0e\x92A\v\n" +
-	"\t\xd2\x01\x06tenant\"g\n" +
+	"\t\xd2\x01\x06tenant\"\xcf\x01\n" +
 	"\x13UpdateTenantRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01:\x11\x92A\x0e\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12;\n" +
+	"\x04tags\x18\x03 \x01(\v2\".rapid.admin_api.v1.TenantTagTypesH\x01R\x04tags\x88\x01\x01\x12\x17\n" +
+	"\x04etag\x18\x04 \x01(\tH\x02R\x04etag\x88\x01\x01:\x11\x92A\x0e\n" +
 	"\f\xd2\x01\ttenant_idB\a\n" +
-	"\x05_name\"Z\n" +
+	"\x05_nameB\a\n" +
+	"\x05_tagsB\a\n" +
+	"\x05_etag\"Z\n" +
 	"\x14UpdateTenantResponse\x122\n" +
 	"\x06tenant\x18\x01 \x01(\v2\x1a.rapid.admin_api.v1.TenantR\x06tenant:\x0e\x92A\v\n" +
 	"\t\xd2\x01\x06tenant\"E\n" +
 	"\x13DeleteTenantRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId:\x11\x92A\x0e\n" +
 	"\f\xd2\x01\ttenant_id\"\x16\n" +
-	"\x14DeleteTenantResponseB\xf0\x01\n" +
+	"\x14DeleteTenantResponse\"F\n" +
+	"\x14RestoreTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId:\x11\x92A\x0e\n" +
+	"\f\xd2\x01\ttenant_id\"[\n" +
+	"\x15RestoreTenantResponse\x122\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x1a.rapid.admin_api.v1.TenantR\x06tenant:\x0e\x92A\v\n" +
+	"\t\xd2\x01\x06tenantB\xf0\x01\n" +
 	"\x16com.rapid.admin_api.v1B\x0eApiTenantProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1;admin_apiv1\xa2\x02\x03RAX\xaa\x02\x11Rapid.AdminApi.V1\xca\x02\x11Rapid\\AdminApi\\V1\xe2\x02\x1dRapid\\AdminApi\\V1\\GPBMetadata\xea\x02\x13Rapid::AdminApi::V1b\x06proto3"
 
 var (
@@ -604,7 +827,7 @@ func file_rapid_admin_api_v1_api_tenant_proto_rawDescGZIP() []byte {
 }
 
 var file_rapid_admin_api_v1_api_tenant_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rapid_admin_api_v1_api_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_rapid_admin_api_v1_api_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_rapid_admin_api_v1_api_tenant_proto_goTypes = []any{
 	(ListTenantsRequest_ListTenantsSortKey)(0), // 0: rapid.admin_api.v1.ListTenantsRequest.ListTenantsSortKey
 	(*GetTenantRequest)(nil),                   // 1: rapid.admin_api.v1.GetTenantRequest
@@ -617,21 +840,32 @@ var file_rapid_admin_api_v1_api_tenant_proto_goTypes = []any{
 	(*UpdateTenantResponse)(nil),               // 8: rapid.admin_api.v1.UpdateTenantResponse
 	(*DeleteTenantRequest)(nil),                // 9: rapid.admin_api.v1.DeleteTenantRequest
 	(*DeleteTenantResponse)(nil),               // 10: rapid.admin_api.v1.DeleteTenantResponse
-	(*Tenant)(nil),                             // 11: rapid.admin_api.v1.Tenant
-	(*Pagination)(nil),                         // 12: rapid.admin_api.v1.Pagination
+	(*RestoreTenantRequest)(nil),               // 11: rapid.admin_api.v1.RestoreTenantRequest
+	(*RestoreTenantResponse)(nil),              // 12: rapid.admin_api.v1.RestoreTenantResponse
+	(*Tenant)(nil),                             // 13: rapid.admin_api.v1.Tenant
+	(TenantTagType)(0),                         // 14: rapid.admin_api.v1.TenantTagType
+	(*timestamppb.Timestamp)(nil),              // 15: google.protobuf.Timestamp
+	(*Pagination)(nil),                         // 16: rapid.admin_api.v1.Pagination
+	(*TenantTagTypes)(nil),                     // 17: rapid.admin_api.v1.TenantTagTypes
 }
 var file_rapid_admin_api_v1_api_tenant_proto_depIdxs = []int32{
-	11, // 0: rapid.admin_api.v1.GetTenantResponse.tenant:type_name -> rapid.admin_api.v1.Tenant
+	13, // 0: rapid.admin_api.v1.GetTenantResponse.tenant:type_name -> rapid.admin_api.v1.Tenant
 	0,  // 1: rapid.admin_api.v1.ListTenantsRequest.sort_key:type_name -> rapid.admin_api.v1.ListTenantsRequest.ListTenantsSortKey
-	11, // 2: rapid.admin_api.v1.ListTenantsResponse.tenants:type_name -> rapid.admin_api.v1.Tenant
-	12, // 3: rapid.admin_api.v1.ListTenantsResponse.pagination:type_name -> rapid.admin_api.v1.Pagination
-	11, // 4: rapid.admin_api.v1.CreateTenantResponse.tenant:type_name -> rapid.admin_api.v1.Tenant
-	11, // 5: rapid.admin_api.v1.UpdateTenantResponse.tenant:type_name -> rapid.admin_api.v1.Tenant
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	14, // 2: rapid.admin_api.v1.ListTenantsRequest.tag_types:type_name -> rapid.admin_api.v1.TenantTagType
+	15, // 3: rapid.admin_api.v1.ListTenantsRequest.created_at_from:type_name -> google.protobuf.Timestamp
+	15, // 4: rapid.admin_api.v1.ListTenantsRequest.created_at_to:type_name -> google.protobuf.Timestamp
+	13, // 5: rapid.admin_api.v1.ListTenantsResponse.tenants:type_name -> rapid.admin_api.v1.Tenant
+	16, // 6: rapid.admin_api.v1.ListTenantsResponse.pagination:type_name -> rapid.admin_api.v1.Pagination
+	14, // 7: rapid.admin_api.v1.CreateTenantRequest.tags:type_name -> rapid.admin_api.v1.TenantTagType
+	13, // 8: rapid.admin_api.v1.CreateTenantResponse.tenant:type_name -> rapid.admin_api.v1.Tenant
+	17, // 9: rapid.admin_api.v1.UpdateTenantRequest.tags:type_name -> rapid.admin_api.v1.TenantTagTypes
+	13, // 10: rapid.admin_api.v1.UpdateTenantResponse.tenant:type_name -> rapid.admin_api.v1.Tenant
+	13, // 11: rapid.admin_api.v1.RestoreTenantResponse.tenant:type_name -> rapid.admin_api.v1.Tenant
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_rapid_admin_api_v1_api_tenant_proto_init() }
@@ -642,6 +876,7 @@ func file_rapid_admin_api_v1_api_tenant_proto_init() {
 	file_rapid_admin_api_v1_model_pagination_proto_init()
 	file_rapid_admin_api_v1_model_tenant_proto_init()
 	file_rapid_admin_api_v1_api_tenant_proto_msgTypes[2].OneofWrappers = []any{}
+	file_rapid_admin_api_v1_api_tenant_proto_msgTypes[3].OneofWrappers = []any{}
 	file_rapid_admin_api_v1_api_tenant_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -649,7 +884,7 @@ func file_rapid_admin_api_v1_api_tenant_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rapid_admin_api_v1_api_tenant_proto_rawDesc), len(file_rapid_admin_api_v1_api_tenant_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,245 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: rapid/admin_api/v1/model_admin.proto
+
+package admin_apiv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminRole int32
+
+const (
+	AdminRole_ADMIN_ROLE_UNSPECIFIED AdminRole = 0
+	AdminRole_ADMIN_ROLE_ROOT        AdminRole = 1
+	AdminRole_ADMIN_ROLE_NORMAL      AdminRole = 2
+)
+
+// Enum value maps for AdminRole.
+var (
+	AdminRole_name = map[int32]string{
+		0: "ADMIN_ROLE_UNSPECIFIED",
+		1: "ADMIN_ROLE_ROOT",
+		2: "ADMIN_ROLE_NORMAL",
+	}
+	AdminRole_value = map[string]int32{
+		"ADMIN_ROLE_UNSPECIFIED": 0,
+		"ADMIN_ROLE_ROOT":        1,
+		"ADMIN_ROLE_NORMAL":      2,
+	}
+)
+
+func (x AdminRole) Enum() *AdminRole {
+	p := new(AdminRole)
+	*p = x
+	return p
+}
+
+func (x AdminRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdminRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_rapid_admin_api_v1_model_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (AdminRole) Type() protoreflect.EnumType {
+	return &file_rapid_admin_api_v1_model_admin_proto_enumTypes[0]
+}
+
+func (x AdminRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdminRole.Descriptor instead.
+func (AdminRole) EnumDescriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_model_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type Admin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          AdminRole              `protobuf:"varint,2,opt,name=role,proto3,enum=rapid.admin_api.v1.AdminRole" json:"role,omitempty"`
+	AuthUid       string                 `protobuf:"bytes,3,opt,name=auth_uid,json=authUid,proto3" json:"auth_uid,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Admin) Reset() {
+	*x = Admin{}
+	mi := &file_rapid_admin_api_v1_model_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Admin) ProtoMessage() {}
+
+func (x *Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_model_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Admin.ProtoReflect.Descriptor instead.
+func (*Admin) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_model_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Admin) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Admin) GetRole() AdminRole {
+	if x != nil {
+		return x.Role
+	}
+	return AdminRole_ADMIN_ROLE_UNSPECIFIED
+}
+
+func (x *Admin) GetAuthUid() string {
+	if x != nil {
+		return x.AuthUid
+	}
+	return ""
+}
+
+func (x *Admin) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Admin) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Admin) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Admin) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_rapid_admin_api_v1_model_admin_proto protoreflect.FileDescriptor
+
+const file_rapid_admin_api_v1_model_admin_proto_rawDesc = "" +
+	"\n" +
+	"$rapid/admin_api/v1/model_admin.proto\x12\x12rapid.admin_api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xe3\x02\n" +
+	"\x05Admin\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1d.rapid.admin_api.v1.AdminRoleR\x04role\x12\x19\n" +
+	"\bauth_uid\x18\x03 \x01(\tR\aauthUid\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12!\n" +
+	"\fdisplay_name\x18\x05 \x01(\tR\vdisplayName\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt:M\x92AJ\n" +
+	"H\xd2\x01\x02id\xd2\x01\x04role\xd2\x01\bauth_uid\xd2\x01\x05email\xd2\x01\fdisplay_name\xd2\x01\n" +
+	"created_at\xd2\x01\n" +
+	"updated_at*S\n" +
+	"\tAdminRole\x12\x1a\n" +
+	"\x16ADMIN_ROLE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fADMIN_ROLE_ROOT\x10\x01\x12\x15\n" +
+	"\x11ADMIN_ROLE_NORMAL\x10\x02B\xf1\x01\n" +
+	"\x16com.rapid.admin_api.v1B\x0fModelAdminProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1;admin_apiv1\xa2\x02\x03RAX\xaa\x02\x11Rapid.AdminApi.V1\xca\x02\x11Rapid\\AdminApi\\V1\xe2\x02\x1dRapid\\AdminApi\\V1\\GPBMetadata\xea\x02\x13Rapid::AdminApi::V1b\x06proto3"
+
+var (
+	file_rapid_admin_api_v1_model_admin_proto_rawDescOnce sync.Once
+	file_rapid_admin_api_v1_model_admin_proto_rawDescData []byte
+)
+
+func file_rapid_admin_api_v1_model_admin_proto_rawDescGZIP() []byte {
+	file_rapid_admin_api_v1_model_admin_proto_rawDescOnce.Do(func() {
+		file_rapid_admin_api_v1_model_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rapid_admin_api_v1_model_admin_proto_rawDesc), len(file_rapid_admin_api_v1_model_admin_proto_rawDesc)))
+	})
+	return file_rapid_admin_api_v1_model_admin_proto_rawDescData
+}
+
+var file_rapid_admin_api_v1_model_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rapid_admin_api_v1_model_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rapid_admin_api_v1_model_admin_proto_goTypes = []any{
+	(AdminRole)(0),                // 0: rapid.admin_api.v1.AdminRole
+	(*Admin)(nil),                 // 1: rapid.admin_api.v1.Admin
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_rapid_admin_api_v1_model_admin_proto_depIdxs = []int32{
+	0, // 0: rapid.admin_api.v1.Admin.role:type_name -> rapid.admin_api.v1.AdminRole
+	2, // 1: rapid.admin_api.v1.Admin.created_at:type_name -> google.protobuf.Timestamp
+	2, // 2: rapid.admin_api.v1.Admin.updated_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rapid_admin_api_v1_model_admin_proto_init() }
+func file_rapid_admin_api_v1_model_admin_proto_init() {
+	if File_rapid_admin_api_v1_model_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rapid_admin_api_v1_model_admin_proto_rawDesc), len(file_rapid_admin_api_v1_model_admin_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rapid_admin_api_v1_model_admin_proto_goTypes,
+		DependencyIndexes: file_rapid_admin_api_v1_model_admin_proto_depIdxs,
+		EnumInfos:         file_rapid_admin_api_v1_model_admin_proto_enumTypes,
+		MessageInfos:      file_rapid_admin_api_v1_model_admin_proto_msgTypes,
+	}.Build()
+	File_rapid_admin_api_v1_model_admin_proto = out.File
+	file_rapid_admin_api_v1_model_admin_proto_goTypes = nil
+	file_rapid_admin_api_v1_model_admin_proto_depIdxs = nil
+}
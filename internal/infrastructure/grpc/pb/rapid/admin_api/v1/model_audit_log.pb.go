@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: rapid/admin_api/v1/model_audit_log.proto
+
+package admin_apiv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuditLogActorType int32
+
+const (
+	AuditLogActorType_AUDIT_LOG_ACTOR_TYPE_UNSPECIFIED AuditLogActorType = 0
+	AuditLogActorType_AUDIT_LOG_ACTOR_TYPE_ADMIN       AuditLogActorType = 1
+	AuditLogActorType_AUDIT_LOG_ACTOR_TYPE_STAFF       AuditLogActorType = 2
+)
+
+// Enum value maps for AuditLogActorType.
+var (
+	AuditLogActorType_name = map[int32]string{
+		0: "AUDIT_LOG_ACTOR_TYPE_UNSPECIFIED",
+		1: "AUDIT_LOG_ACTOR_TYPE_ADMIN",
+		2: "AUDIT_LOG_ACTOR_TYPE_STAFF",
+	}
+	AuditLogActorType_value = map[string]int32{
+		"AUDIT_LOG_ACTOR_TYPE_UNSPECIFIED": 0,
+		"AUDIT_LOG_ACTOR_TYPE_ADMIN":       1,
+		"AUDIT_LOG_ACTOR_TYPE_STAFF":       2,
+	}
+)
+
+func (x AuditLogActorType) Enum() *AuditLogActorType {
+	p := new(AuditLogActorType)
+	*p = x
+	return p
+}
+
+func (x AuditLogActorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogActorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rapid_admin_api_v1_model_audit_log_proto_enumTypes[0].Descriptor()
+}
+
+func (AuditLogActorType) Type() protoreflect.EnumType {
+	return &file_rapid_admin_api_v1_model_audit_log_proto_enumTypes[0]
+}
+
+func (x AuditLogActorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLogActorType.Descriptor instead.
+func (AuditLogActorType) EnumDescriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_model_audit_log_proto_rawDescGZIP(), []int{0}
+}
+
+type AuditLogTargetType int32
+
+const (
+	AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_UNSPECIFIED AuditLogTargetType = 0
+	AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_TENANT      AuditLogTargetType = 1
+	AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_STAFF       AuditLogTargetType = 2
+	AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_ADMIN       AuditLogTargetType = 3
+	AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_INVITATION  AuditLogTargetType = 4
+)
+
+// Enum value maps for AuditLogTargetType.
+var (
+	AuditLogTargetType_name = map[int32]string{
+		0: "AUDIT_LOG_TARGET_TYPE_UNSPECIFIED",
+		1: "AUDIT_LOG_TARGET_TYPE_TENANT",
+		2: "AUDIT_LOG_TARGET_TYPE_STAFF",
+		3: "AUDIT_LOG_TARGET_TYPE_ADMIN",
+		4: "AUDIT_LOG_TARGET_TYPE_INVITATION",
+	}
+	AuditLogTargetType_value = map[string]int32{
+		"AUDIT_LOG_TARGET_TYPE_UNSPECIFIED": 0,
+		"AUDIT_LOG_TARGET_TYPE_TENANT":      1,
+		"AUDIT_LOG_TARGET_TYPE_STAFF":       2,
+		"AUDIT_LOG_TARGET_TYPE_ADMIN":       3,
+		"AUDIT_LOG_TARGET_TYPE_INVITATION":  4,
+	}
+)
+
+func (x AuditLogTargetType) Enum() *AuditLogTargetType {
+	p := new(AuditLogTargetType)
+	*p = x
+	return p
+}
+
+func (x AuditLogTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rapid_admin_api_v1_model_audit_log_proto_enumTypes[1].Descriptor()
+}
+
+func (AuditLogTargetType) Type() protoreflect.EnumType {
+	return &file_rapid_admin_api_v1_model_audit_log_proto_enumTypes[1]
+}
+
+func (x AuditLogTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLogTargetType.Descriptor instead.
+func (AuditLogTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_model_audit_log_proto_rawDescGZIP(), []int{1}
+}
+
+type AuditLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      *string                `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	ActorType     AuditLogActorType      `protobuf:"varint,3,opt,name=actor_type,json=actorType,proto3,enum=rapid.admin_api.v1.AuditLogActorType" json:"actor_type,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Method        string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	TargetType    AuditLogTargetType     `protobuf:"varint,6,opt,name=target_type,json=targetType,proto3,enum=rapid.admin_api.v1.AuditLogTargetType" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,7,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Before        *structpb.Struct       `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After         *structpb.Struct       `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_rapid_admin_api_v1_model_audit_log_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_admin_api_v1_model_audit_log_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_rapid_admin_api_v1_model_audit_log_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditLog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLog) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetActorType() AuditLogActorType {
+	if x != nil {
+		return x.ActorType
+	}
+	return AuditLogActorType_AUDIT_LOG_ACTOR_TYPE_UNSPECIFIED
+}
+
+func (x *AuditLog) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditLog) GetTargetType() AuditLogTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return AuditLogTargetType_AUDIT_LOG_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *AuditLog) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetBefore() *structpb.Struct {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditLog) GetAfter() *structpb.Struct {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *AuditLog) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_rapid_admin_api_v1_model_audit_log_proto protoreflect.FileDescriptor
+
+const file_rapid_admin_api_v1_model_audit_log_proto_rawDesc = "" +
+	"\n" +
+	"(rapid/admin_api/v1/model_audit_log.proto\x12\x12rapid.admin_api.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xa9\x04\n" +
+	"\bAuditLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\ttenant_id\x18\x02 \x01(\tH\x00R\btenantId\x88\x01\x01\x12D\n" +
+	"\n" +
+	"actor_type\x18\x03 \x01(\x0e2%.rapid.admin_api.v1.AuditLogActorTypeR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12G\n" +
+	"\vtarget_type\x18\x06 \x01(\x0e2&.rapid.admin_api.v1.AuditLogTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\a \x01(\tR\btargetId\x12/\n" +
+	"\x06before\x18\b \x01(\v2\x17.google.protobuf.StructR\x06before\x12-\n" +
+	"\x05after\x18\t \x01(\v2\x17.google.protobuf.StructR\x05after\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt:c\x92A`\n" +
+	"^\xd2\x01\x02id\xd2\x01\n" +
+	"actor_type\xd2\x01\bactor_id\xd2\x01\x06method\xd2\x01\vtarget_type\xd2\x01\ttarget_id\xd2\x01\x06before\xd2\x01\x05after\xd2\x01\n" +
+	"created_atB\f\n" +
+	"\n" +
+	"_tenant_id*y\n" +
+	"\x11AuditLogActorType\x12$\n" +
+	" AUDIT_LOG_ACTOR_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aAUDIT_LOG_ACTOR_TYPE_ADMIN\x10\x01\x12\x1e\n" +
+	"\x1aAUDIT_LOG_ACTOR_TYPE_STAFF\x10\x02*\xc5\x01\n" +
+	"\x12AuditLogTargetType\x12%\n" +
+	"!AUDIT_LOG_TARGET_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cAUDIT_LOG_TARGET_TYPE_TENANT\x10\x01\x12\x1f\n" +
+	"\x1bAUDIT_LOG_TARGET_TYPE_STAFF\x10\x02\x12\x1f\n" +
+	"\x1bAUDIT_LOG_TARGET_TYPE_ADMIN\x10\x03\x12$\n" +
+	" AUDIT_LOG_TARGET_TYPE_INVITATION\x10\x04B\xf4\x01\n" +
+	"\x16com.rapid.admin_api.v1B\x12ModelAuditLogProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1;admin_apiv1\xa2\x02\x03RAX\xaa\x02\x11Rapid.AdminApi.V1\xca\x02\x11Rapid\\AdminApi\\V1\xe2\x02\x1dRapid\\AdminApi\\V1\\GPBMetadata\xea\x02\x13Rapid::AdminApi::V1b\x06proto3"
+
+var (
+	file_rapid_admin_api_v1_model_audit_log_proto_rawDescOnce sync.Once
+	file_rapid_admin_api_v1_model_audit_log_proto_rawDescData []byte
+)
+
+func file_rapid_admin_api_v1_model_audit_log_proto_rawDescGZIP() []byte {
+	file_rapid_admin_api_v1_model_audit_log_proto_rawDescOnce.Do(func() {
+		file_rapid_admin_api_v1_model_audit_log_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rapid_admin_api_v1_model_audit_log_proto_rawDesc), len(file_rapid_admin_api_v1_model_audit_log_proto_rawDesc)))
+	})
+	return file_rapid_admin_api_v1_model_audit_log_proto_rawDescData
+}
+
+var file_rapid_admin_api_v1_model_audit_log_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_rapid_admin_api_v1_model_audit_log_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rapid_admin_api_v1_model_audit_log_proto_goTypes = []any{
+	(AuditLogActorType)(0),        // 0: rapid.admin_api.v1.AuditLogActorType
+	(AuditLogTargetType)(0),       // 1: rapid.admin_api.v1.AuditLogTargetType
+	(*AuditLog)(nil),              // 2: rapid.admin_api.v1.AuditLog
+	(*structpb.Struct)(nil),       // 3: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_rapid_admin_api_v1_model_audit_log_proto_depIdxs = []int32{
+	0, // 0: rapid.admin_api.v1.AuditLog.actor_type:type_name -> rapid.admin_api.v1.AuditLogActorType
+	1, // 1: rapid.admin_api.v1.AuditLog.target_type:type_name -> rapid.admin_api.v1.AuditLogTargetType
+	3, // 2: rapid.admin_api.v1.AuditLog.before:type_name -> google.protobuf.Struct
+	3, // 3: rapid.admin_api.v1.AuditLog.after:type_name -> google.protobuf.Struct
+	4, // 4: rapid.admin_api.v1.AuditLog.created_at:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_rapid_admin_api_v1_model_audit_log_proto_init() }
+func file_rapid_admin_api_v1_model_audit_log_proto_init() {
+	if File_rapid_admin_api_v1_model_audit_log_proto != nil {
+		return
+	}
+	file_rapid_admin_api_v1_model_audit_log_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rapid_admin_api_v1_model_audit_log_proto_rawDesc), len(file_rapid_admin_api_v1_model_audit_log_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rapid_admin_api_v1_model_audit_log_proto_goTypes,
+		DependencyIndexes: file_rapid_admin_api_v1_model_audit_log_proto_depIdxs,
+		EnumInfos:         file_rapid_admin_api_v1_model_audit_log_proto_enumTypes,
+		MessageInfos:      file_rapid_admin_api_v1_model_audit_log_proto_msgTypes,
+	}.Build()
+	File_rapid_admin_api_v1_model_audit_log_proto = out.File
+	file_rapid_admin_api_v1_model_audit_log_proto_goTypes = nil
+	file_rapid_admin_api_v1_model_audit_log_proto_depIdxs = nil
+}
@@ -26,9 +26,12 @@ var File_rapid_staff_api_v1_api_proto protoreflect.FileDescriptor
 
 const file_rapid_staff_api_v1_api_proto_rawDesc = "" +
 	"\n" +
-	"\x1crapid/staff_api/v1/api.proto\x12\x12rapid.staff_api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\"rapid/staff_api/v1/api_asset.proto\x1a\x1frapid/staff_api/v1/api_me.proto\x1a\"rapid/staff_api/v1/api_staff.proto2\x81\b\n" +
+	"\x1crapid/staff_api/v1/api.proto\x12\x12rapid.staff_api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\"rapid/staff_api/v1/api_asset.proto\x1a'rapid/staff_api/v1/api_invitation.proto\x1a\x1frapid/staff_api/v1/api_me.proto\x1a\"rapid/staff_api/v1/api_staff.proto2\xa7\x10\n" +
 	"\x0eStaffV1Service\x12\xaf\x01\n" +
-	"\x17CreateAssetPresignedURL\x122.rapid.staff_api.v1.CreateAssetPresignedURLRequest\x1a3.rapid.staff_api.v1.CreateAssetPresignedURLResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /staff/v1/assets/-/presigned_url\x12o\n" +
+	"\x17CreateAssetPresignedURL\x122.rapid.staff_api.v1.CreateAssetPresignedURLRequest\x1a3.rapid.staff_api.v1.CreateAssetPresignedURLResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /staff/v1/assets/-/presigned_url\x12\xa3\x01\n" +
+	"\x12ConfirmAssetUpload\x12-.rapid.staff_api.v1.ConfirmAssetUploadRequest\x1a..rapid.staff_api.v1.ConfirmAssetUploadResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/staff/v1/assets/{asset_id}/confirm\x12\x86\x01\n" +
+	"\vUploadAsset\x12&.rapid.staff_api.v1.UploadAssetRequest\x1a'.rapid.staff_api.v1.UploadAssetResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/staff/v1/assets/-/upload(\x01\x12\x94\x01\n" +
+	"\rDownloadAsset\x12(.rapid.staff_api.v1.DownloadAssetRequest\x1a).rapid.staff_api.v1.DownloadAssetResponse\",\x82\xd3\xe4\x93\x02&\x12$/staff/v1/assets/{asset_id}/download0\x01\x12o\n" +
 	"\x06SignUp\x12!.rapid.staff_api.v1.SignUpRequest\x1a\".rapid.staff_api.v1.SignUpResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/staff/v1/me:signup\x12b\n" +
 	"\x05GetMe\x12 .rapid.staff_api.v1.GetMeRequest\x1a!.rapid.staff_api.v1.GetMeResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/staff/v1/me\x12{\n" +
 	"\vGetMeTenant\x12&.rapid.staff_api.v1.GetMeTenantRequest\x1a'.rapid.staff_api.v1.GetMeTenantResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/staff/v1/me/tenant\x12n\n" +
@@ -36,46 +39,78 @@ const file_rapid_staff_api_v1_api_proto_rawDesc = "" +
 	"\x0eUpdateMeTenant\x12).rapid.staff_api.v1.UpdateMeTenantRequest\x1a*.rapid.staff_api.v1.UpdateMeTenantResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/staff/v1/me/tenant\x12z\n" +
 	"\bGetStaff\x12#.rapid.staff_api.v1.GetStaffRequest\x1a$.rapid.staff_api.v1.GetStaffResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/staff/v1/staffs/{staff_id}\x12u\n" +
 	"\n" +
-	"ListStaffs\x12%.rapid.staff_api.v1.ListStaffsRequest\x1a&.rapid.staff_api.v1.ListStaffsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/staff/v1/staffsB\xea\x01\n" +
+	"ListStaffs\x12%.rapid.staff_api.v1.ListStaffsRequest\x1a&.rapid.staff_api.v1.ListStaffsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/staff/v1/staffs\x12\x8f\x01\n" +
+	"\x10CreateInvitation\x12+.rapid.staff_api.v1.CreateInvitationRequest\x1a,.rapid.staff_api.v1.CreateInvitationResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/staff/v1/invitations\x12\x89\x01\n" +
+	"\x0fListInvitations\x12*.rapid.staff_api.v1.ListInvitationsRequest\x1a+.rapid.staff_api.v1.ListInvitationsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/staff/v1/invitations\x12\xa6\x01\n" +
+	"\x10RevokeInvitation\x12+.rapid.staff_api.v1.RevokeInvitationRequest\x1a,.rapid.staff_api.v1.RevokeInvitationResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/staff/v1/invitations/{invitation_id}/revoke\x12\x96\x01\n" +
+	"\x10AcceptInvitation\x12+.rapid.staff_api.v1.AcceptInvitationRequest\x1a,.rapid.staff_api.v1.AcceptInvitationResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/staff/v1/invitations:acceptB\xea\x01\n" +
 	"\x16com.rapid.staff_api.v1B\bApiProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1;staff_apiv1\xa2\x02\x03RSX\xaa\x02\x11Rapid.StaffApi.V1\xca\x02\x11Rapid\\StaffApi\\V1\xe2\x02\x1dRapid\\StaffApi\\V1\\GPBMetadata\xea\x02\x13Rapid::StaffApi::V1b\x06proto3"
 
 var file_rapid_staff_api_v1_api_proto_goTypes = []any{
 	(*CreateAssetPresignedURLRequest)(nil),  // 0: rapid.staff_api.v1.CreateAssetPresignedURLRequest
-	(*SignUpRequest)(nil),                   // 1: rapid.staff_api.v1.SignUpRequest
-	(*GetMeRequest)(nil),                    // 2: rapid.staff_api.v1.GetMeRequest
-	(*GetMeTenantRequest)(nil),              // 3: rapid.staff_api.v1.GetMeTenantRequest
-	(*UpdateMeRequest)(nil),                 // 4: rapid.staff_api.v1.UpdateMeRequest
-	(*UpdateMeTenantRequest)(nil),           // 5: rapid.staff_api.v1.UpdateMeTenantRequest
-	(*GetStaffRequest)(nil),                 // 6: rapid.staff_api.v1.GetStaffRequest
-	(*ListStaffsRequest)(nil),               // 7: rapid.staff_api.v1.ListStaffsRequest
-	(*CreateAssetPresignedURLResponse)(nil), // 8: rapid.staff_api.v1.CreateAssetPresignedURLResponse
-	(*SignUpResponse)(nil),                  // 9: rapid.staff_api.v1.SignUpResponse
-	(*GetMeResponse)(nil),                   // 10: rapid.staff_api.v1.GetMeResponse
-	(*GetMeTenantResponse)(nil),             // 11: rapid.staff_api.v1.GetMeTenantResponse
-	(*UpdateMeResponse)(nil),                // 12: rapid.staff_api.v1.UpdateMeResponse
-	(*UpdateMeTenantResponse)(nil),          // 13: rapid.staff_api.v1.UpdateMeTenantResponse
-	(*GetStaffResponse)(nil),                // 14: rapid.staff_api.v1.GetStaffResponse
-	(*ListStaffsResponse)(nil),              // 15: rapid.staff_api.v1.ListStaffsResponse
+	(*ConfirmAssetUploadRequest)(nil),       // 1: rapid.staff_api.v1.ConfirmAssetUploadRequest
+	(*UploadAssetRequest)(nil),              // 2: rapid.staff_api.v1.UploadAssetRequest
+	(*DownloadAssetRequest)(nil),            // 3: rapid.staff_api.v1.DownloadAssetRequest
+	(*SignUpRequest)(nil),                   // 4: rapid.staff_api.v1.SignUpRequest
+	(*GetMeRequest)(nil),                    // 5: rapid.staff_api.v1.GetMeRequest
+	(*GetMeTenantRequest)(nil),              // 6: rapid.staff_api.v1.GetMeTenantRequest
+	(*UpdateMeRequest)(nil),                 // 7: rapid.staff_api.v1.UpdateMeRequest
+	(*UpdateMeTenantRequest)(nil),           // 8: rapid.staff_api.v1.UpdateMeTenantRequest
+	(*GetStaffRequest)(nil),                 // 9: rapid.staff_api.v1.GetStaffRequest
+	(*ListStaffsRequest)(nil),               // 10: rapid.staff_api.v1.ListStaffsRequest
+	(*CreateInvitationRequest)(nil),         // 11: rapid.staff_api.v1.CreateInvitationRequest
+	(*ListInvitationsRequest)(nil),          // 12: rapid.staff_api.v1.ListInvitationsRequest
+	(*RevokeInvitationRequest)(nil),         // 13: rapid.staff_api.v1.RevokeInvitationRequest
+	(*AcceptInvitationRequest)(nil),         // 14: rapid.staff_api.v1.AcceptInvitationRequest
+	(*CreateAssetPresignedURLResponse)(nil), // 15: rapid.staff_api.v1.CreateAssetPresignedURLResponse
+	(*ConfirmAssetUploadResponse)(nil),      // 16: rapid.staff_api.v1.ConfirmAssetUploadResponse
+	(*UploadAssetResponse)(nil),             // 17: rapid.staff_api.v1.UploadAssetResponse
+	(*DownloadAssetResponse)(nil),           // 18: rapid.staff_api.v1.DownloadAssetResponse
+	(*SignUpResponse)(nil),                  // 19: rapid.staff_api.v1.SignUpResponse
+	(*GetMeResponse)(nil),                   // 20: rapid.staff_api.v1.GetMeResponse
+	(*GetMeTenantResponse)(nil),             // 21: rapid.staff_api.v1.GetMeTenantResponse
+	(*UpdateMeResponse)(nil),                // 22: rapid.staff_api.v1.UpdateMeResponse
+	(*UpdateMeTenantResponse)(nil),          // 23: rapid.staff_api.v1.UpdateMeTenantResponse
+	(*GetStaffResponse)(nil),                // 24: rapid.staff_api.v1.GetStaffResponse
+	(*ListStaffsResponse)(nil),              // 25: rapid.staff_api.v1.ListStaffsResponse
+	(*CreateInvitationResponse)(nil),        // 26: rapid.staff_api.v1.CreateInvitationResponse
+	(*ListInvitationsResponse)(nil),         // 27: rapid.staff_api.v1.ListInvitationsResponse
+	(*RevokeInvitationResponse)(nil),        // 28: rapid.staff_api.v1.RevokeInvitationResponse
+	(*AcceptInvitationResponse)(nil),        // 29: rapid.staff_api.v1.AcceptInvitationResponse
 }
 var file_rapid_staff_api_v1_api_proto_depIdxs = []int32{
 	0,  // 0: rapid.staff_api.v1.StaffV1Service.CreateAssetPresignedURL:input_type -> rapid.staff_api.v1.CreateAssetPresignedURLRequest
-	1,  // 1: rapid.staff_api.v1.StaffV1Service.SignUp:input_type -> rapid.staff_api.v1.SignUpRequest
-	2,  // 2: rapid.staff_api.v1.StaffV1Service.GetMe:input_type -> rapid.staff_api.v1.GetMeRequest
-	3,  // 3: rapid.staff_api.v1.StaffV1Service.GetMeTenant:input_type -> rapid.staff_api.v1.GetMeTenantRequest
-	4,  // 4: rapid.staff_api.v1.StaffV1Service.UpdateMe:input_type -> rapid.staff_api.v1.UpdateMeRequest
-	5,  // 5: rapid.staff_api.v1.StaffV1Service.UpdateMeTenant:input_type -> rapid.staff_api.v1.UpdateMeTenantRequest
-	6,  // 6: rapid.staff_api.v1.StaffV1Service.GetStaff:input_type -> rapid.staff_api.v1.GetStaffRequest
-	7,  // 7: rapid.staff_api.v1.StaffV1Service.ListStaffs:input_type -> rapid.staff_api.v1.ListStaffsRequest
-	8,  // 8: rapid.staff_api.v1.StaffV1Service.CreateAssetPresignedURL:output_type -> rapid.staff_api.v1.CreateAssetPresignedURLResponse
-	9,  // 9: rapid.staff_api.v1.StaffV1Service.SignUp:output_type -> rapid.staff_api.v1.SignUpResponse
-	10, // 10: rapid.staff_api.v1.StaffV1Service.GetMe:output_type -> rapid.staff_api.v1.GetMeResponse
-	11, // 11: rapid.staff_api.v1.StaffV1Service.GetMeTenant:output_type -> rapid.staff_api.v1.GetMeTenantResponse
-	12, // 12: rapid.staff_api.v1.StaffV1Service.UpdateMe:output_type -> rapid.staff_api.v1.UpdateMeResponse
-	13, // 13: rapid.staff_api.v1.StaffV1Service.UpdateMeTenant:output_type -> rapid.staff_api.v1.UpdateMeTenantResponse
-	14, // 14: rapid.staff_api.v1.StaffV1Service.GetStaff:output_type -> rapid.staff_api.v1.GetStaffResponse
-	15, // 15: rapid.staff_api.v1.StaffV1Service.ListStaffs:output_type -> rapid.staff_api.v1.ListStaffsResponse
-	8,  // [8:16] is the sub-list for method output_type
-	0,  // [0:8] is the sub-list for method input_type
+	1,  // 1: rapid.staff_api.v1.StaffV1Service.ConfirmAssetUpload:input_type -> rapid.staff_api.v1.ConfirmAssetUploadRequest
+	2,  // 2: rapid.staff_api.v1.StaffV1Service.UploadAsset:input_type -> rapid.staff_api.v1.UploadAssetRequest
+	3,  // 3: rapid.staff_api.v1.StaffV1Service.DownloadAsset:input_type -> rapid.staff_api.v1.DownloadAssetRequest
+	4,  // 4: rapid.staff_api.v1.StaffV1Service.SignUp:input_type -> rapid.staff_api.v1.SignUpRequest
+	5,  // 5: rapid.staff_api.v1.StaffV1Service.GetMe:input_type -> rapid.staff_api.v1.GetMeRequest
+	6,  // 6: rapid.staff_api.v1.StaffV1Service.GetMeTenant:input_type -> rapid.staff_api.v1.GetMeTenantRequest
+	7,  // 7: rapid.staff_api.v1.StaffV1Service.UpdateMe:input_type -> rapid.staff_api.v1.UpdateMeRequest
+	8,  // 8: rapid.staff_api.v1.StaffV1Service.UpdateMeTenant:input_type -> rapid.staff_api.v1.UpdateMeTenantRequest
+	9,  // 9: rapid.staff_api.v1.StaffV1Service.GetStaff:input_type -> rapid.staff_api.v1.GetStaffRequest
+	10, // 10: rapid.staff_api.v1.StaffV1Service.ListStaffs:input_type -> rapid.staff_api.v1.ListStaffsRequest
+	11, // 11: rapid.staff_api.v1.StaffV1Service.CreateInvitation:input_type -> rapid.staff_api.v1.CreateInvitationRequest
+	12, // 12: rapid.staff_api.v1.StaffV1Service.ListInvitations:input_type -> rapid.staff_api.v1.ListInvitationsRequest
+	13, // 13: rapid.staff_api.v1.StaffV1Service.RevokeInvitation:input_type -> rapid.staff_api.v1.RevokeInvitationRequest
+	14, // 14: rapid.staff_api.v1.StaffV1Service.AcceptInvitation:input_type -> rapid.staff_api.v1.AcceptInvitationRequest
+	15, // 15: rapid.staff_api.v1.StaffV1Service.CreateAssetPresignedURL:output_type -> rapid.staff_api.v1.CreateAssetPresignedURLResponse
+	16, // 16: rapid.staff_api.v1.StaffV1Service.ConfirmAssetUpload:output_type -> rapid.staff_api.v1.ConfirmAssetUploadResponse
+	17, // 17: rapid.staff_api.v1.StaffV1Service.UploadAsset:output_type -> rapid.staff_api.v1.UploadAssetResponse
+	18, // 18: rapid.staff_api.v1.StaffV1Service.DownloadAsset:output_type -> rapid.staff_api.v1.DownloadAssetResponse
+	19, // 19: rapid.staff_api.v1.StaffV1Service.SignUp:output_type -> rapid.staff_api.v1.SignUpResponse
+	20, // 20: rapid.staff_api.v1.StaffV1Service.GetMe:output_type -> rapid.staff_api.v1.GetMeResponse
+	21, // 21: rapid.staff_api.v1.StaffV1Service.GetMeTenant:output_type -> rapid.staff_api.v1.GetMeTenantResponse
+	22, // 22: rapid.staff_api.v1.StaffV1Service.UpdateMe:output_type -> rapid.staff_api.v1.UpdateMeResponse
+	23, // 23: rapid.staff_api.v1.StaffV1Service.UpdateMeTenant:output_type -> rapid.staff_api.v1.UpdateMeTenantResponse
+	24, // 24: rapid.staff_api.v1.StaffV1Service.GetStaff:output_type -> rapid.staff_api.v1.GetStaffResponse
+	25, // 25: rapid.staff_api.v1.StaffV1Service.ListStaffs:output_type -> rapid.staff_api.v1.ListStaffsResponse
+	26, // 26: rapid.staff_api.v1.StaffV1Service.CreateInvitation:output_type -> rapid.staff_api.v1.CreateInvitationResponse
+	27, // 27: rapid.staff_api.v1.StaffV1Service.ListInvitations:output_type -> rapid.staff_api.v1.ListInvitationsResponse
+	28, // 28: rapid.staff_api.v1.StaffV1Service.RevokeInvitation:output_type -> rapid.staff_api.v1.RevokeInvitationResponse
+	29, // 29: rapid.staff_api.v1.StaffV1Service.AcceptInvitation:output_type -> rapid.staff_api.v1.AcceptInvitationResponse
+	15, // [15:30] is the sub-list for method output_type
+	0,  // [0:15] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -87,6 +122,7 @@ func file_rapid_staff_api_v1_api_proto_init() {
 		return
 	}
 	file_rapid_staff_api_v1_api_asset_proto_init()
+	file_rapid_staff_api_v1_api_invitation_proto_init()
 	file_rapid_staff_api_v1_api_me_proto_init()
 	file_rapid_staff_api_v1_api_staff_proto_init()
 	type x struct{}
@@ -21,6 +21,9 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	StaffV1Service_CreateAssetPresignedURL_FullMethodName = "/rapid.staff_api.v1.StaffV1Service/CreateAssetPresignedURL"
+	StaffV1Service_ConfirmAssetUpload_FullMethodName      = "/rapid.staff_api.v1.StaffV1Service/ConfirmAssetUpload"
+	StaffV1Service_UploadAsset_FullMethodName             = "/rapid.staff_api.v1.StaffV1Service/UploadAsset"
+	StaffV1Service_DownloadAsset_FullMethodName           = "/rapid.staff_api.v1.StaffV1Service/DownloadAsset"
 	StaffV1Service_SignUp_FullMethodName                  = "/rapid.staff_api.v1.StaffV1Service/SignUp"
 	StaffV1Service_GetMe_FullMethodName                   = "/rapid.staff_api.v1.StaffV1Service/GetMe"
 	StaffV1Service_GetMeTenant_FullMethodName             = "/rapid.staff_api.v1.StaffV1Service/GetMeTenant"
@@ -28,6 +31,10 @@ const (
 	StaffV1Service_UpdateMeTenant_FullMethodName          = "/rapid.staff_api.v1.StaffV1Service/UpdateMeTenant"
 	StaffV1Service_GetStaff_FullMethodName                = "/rapid.staff_api.v1.StaffV1Service/GetStaff"
 	StaffV1Service_ListStaffs_FullMethodName              = "/rapid.staff_api.v1.StaffV1Service/ListStaffs"
+	StaffV1Service_CreateInvitation_FullMethodName        = "/rapid.staff_api.v1.StaffV1Service/CreateInvitation"
+	StaffV1Service_ListInvitations_FullMethodName         = "/rapid.staff_api.v1.StaffV1Service/ListInvitations"
+	StaffV1Service_RevokeInvitation_FullMethodName        = "/rapid.staff_api.v1.StaffV1Service/RevokeInvitation"
+	StaffV1Service_AcceptInvitation_FullMethodName        = "/rapid.staff_api.v1.StaffV1Service/AcceptInvitation"
 )
 
 // StaffV1ServiceClient is the client API for StaffV1Service service.
@@ -35,6 +42,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type StaffV1ServiceClient interface {
 	CreateAssetPresignedURL(ctx context.Context, in *CreateAssetPresignedURLRequest, opts ...grpc.CallOption) (*CreateAssetPresignedURLResponse, error)
+	ConfirmAssetUpload(ctx context.Context, in *ConfirmAssetUploadRequest, opts ...grpc.CallOption) (*ConfirmAssetUploadResponse, error)
+	UploadAsset(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAssetRequest, UploadAssetResponse], error)
+	DownloadAsset(ctx context.Context, in *DownloadAssetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAssetResponse], error)
 	SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*SignUpResponse, error)
 	GetMe(ctx context.Context, in *GetMeRequest, opts ...grpc.CallOption) (*GetMeResponse, error)
 	GetMeTenant(ctx context.Context, in *GetMeTenantRequest, opts ...grpc.CallOption) (*GetMeTenantResponse, error)
@@ -42,6 +52,10 @@ type StaffV1ServiceClient interface {
 	UpdateMeTenant(ctx context.Context, in *UpdateMeTenantRequest, opts ...grpc.CallOption) (*UpdateMeTenantResponse, error)
 	GetStaff(ctx context.Context, in *GetStaffRequest, opts ...grpc.CallOption) (*GetStaffResponse, error)
 	ListStaffs(ctx context.Context, in *ListStaffsRequest, opts ...grpc.CallOption) (*ListStaffsResponse, error)
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error)
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error)
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error)
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error)
 }
 
 type staffV1ServiceClient struct {
@@ -62,6 +76,48 @@ func (c *staffV1ServiceClient) CreateAssetPresignedURL(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *staffV1ServiceClient) ConfirmAssetUpload(ctx context.Context, in *ConfirmAssetUploadRequest, opts ...grpc.CallOption) (*ConfirmAssetUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmAssetUploadResponse)
+	err := c.cc.Invoke(ctx, StaffV1Service_ConfirmAssetUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffV1ServiceClient) UploadAsset(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAssetRequest, UploadAssetResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StaffV1Service_ServiceDesc.Streams[0], StaffV1Service_UploadAsset_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAssetRequest, UploadAssetResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StaffV1Service_UploadAssetClient = grpc.ClientStreamingClient[UploadAssetRequest, UploadAssetResponse]
+
+func (c *staffV1ServiceClient) DownloadAsset(ctx context.Context, in *DownloadAssetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAssetResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StaffV1Service_ServiceDesc.Streams[1], StaffV1Service_DownloadAsset_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAssetRequest, DownloadAssetResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StaffV1Service_DownloadAssetClient = grpc.ServerStreamingClient[DownloadAssetResponse]
+
 func (c *staffV1ServiceClient) SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*SignUpResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SignUpResponse)
@@ -132,11 +188,54 @@ func (c *staffV1ServiceClient) ListStaffs(ctx context.Context, in *ListStaffsReq
 	return out, nil
 }
 
+func (c *staffV1ServiceClient) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInvitationResponse)
+	err := c.cc.Invoke(ctx, StaffV1Service_CreateInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffV1ServiceClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitationsResponse)
+	err := c.cc.Invoke(ctx, StaffV1Service_ListInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffV1ServiceClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInvitationResponse)
+	err := c.cc.Invoke(ctx, StaffV1Service_RevokeInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffV1ServiceClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptInvitationResponse)
+	err := c.cc.Invoke(ctx, StaffV1Service_AcceptInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StaffV1ServiceServer is the server API for StaffV1Service service.
 // All implementations should embed UnimplementedStaffV1ServiceServer
 // for forward compatibility.
 type StaffV1ServiceServer interface {
 	CreateAssetPresignedURL(context.Context, *CreateAssetPresignedURLRequest) (*CreateAssetPresignedURLResponse, error)
+	ConfirmAssetUpload(context.Context, *ConfirmAssetUploadRequest) (*ConfirmAssetUploadResponse, error)
+	UploadAsset(grpc.ClientStreamingServer[UploadAssetRequest, UploadAssetResponse]) error
+	DownloadAsset(*DownloadAssetRequest, grpc.ServerStreamingServer[DownloadAssetResponse]) error
 	SignUp(context.Context, *SignUpRequest) (*SignUpResponse, error)
 	GetMe(context.Context, *GetMeRequest) (*GetMeResponse, error)
 	GetMeTenant(context.Context, *GetMeTenantRequest) (*GetMeTenantResponse, error)
@@ -144,6 +243,10 @@ type StaffV1ServiceServer interface {
 	UpdateMeTenant(context.Context, *UpdateMeTenantRequest) (*UpdateMeTenantResponse, error)
 	GetStaff(context.Context, *GetStaffRequest) (*GetStaffResponse, error)
 	ListStaffs(context.Context, *ListStaffsRequest) (*ListStaffsResponse, error)
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error)
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error)
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error)
 }
 
 // UnimplementedStaffV1ServiceServer should be embedded to have
@@ -156,6 +259,15 @@ type UnimplementedStaffV1ServiceServer struct{}
 func (UnimplementedStaffV1ServiceServer) CreateAssetPresignedURL(context.Context, *CreateAssetPresignedURLRequest) (*CreateAssetPresignedURLResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateAssetPresignedURL not implemented")
 }
+func (UnimplementedStaffV1ServiceServer) ConfirmAssetUpload(context.Context, *ConfirmAssetUploadRequest) (*ConfirmAssetUploadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmAssetUpload not implemented")
+}
+func (UnimplementedStaffV1ServiceServer) UploadAsset(grpc.ClientStreamingServer[UploadAssetRequest, UploadAssetResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadAsset not implemented")
+}
+func (UnimplementedStaffV1ServiceServer) DownloadAsset(*DownloadAssetRequest, grpc.ServerStreamingServer[DownloadAssetResponse]) error {
+	return status.Error(codes.Unimplemented, "method DownloadAsset not implemented")
+}
 func (UnimplementedStaffV1ServiceServer) SignUp(context.Context, *SignUpRequest) (*SignUpResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SignUp not implemented")
 }
@@ -177,6 +289,18 @@ func (UnimplementedStaffV1ServiceServer) GetStaff(context.Context, *GetStaffRequ
 func (UnimplementedStaffV1ServiceServer) ListStaffs(context.Context, *ListStaffsRequest) (*ListStaffsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListStaffs not implemented")
 }
+func (UnimplementedStaffV1ServiceServer) CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (UnimplementedStaffV1ServiceServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedStaffV1ServiceServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedStaffV1ServiceServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
 func (UnimplementedStaffV1ServiceServer) testEmbeddedByValue() {}
 
 // UnsafeStaffV1ServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -215,6 +339,42 @@ func _StaffV1Service_CreateAssetPresignedURL_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StaffV1Service_ConfirmAssetUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmAssetUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffV1ServiceServer).ConfirmAssetUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffV1Service_ConfirmAssetUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffV1ServiceServer).ConfirmAssetUpload(ctx, req.(*ConfirmAssetUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffV1Service_UploadAsset_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StaffV1ServiceServer).UploadAsset(&grpc.GenericServerStream[UploadAssetRequest, UploadAssetResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StaffV1Service_UploadAssetServer = grpc.ClientStreamingServer[UploadAssetRequest, UploadAssetResponse]
+
+func _StaffV1Service_DownloadAsset_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAssetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StaffV1ServiceServer).DownloadAsset(m, &grpc.GenericServerStream[DownloadAssetRequest, DownloadAssetResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StaffV1Service_DownloadAssetServer = grpc.ServerStreamingServer[DownloadAssetResponse]
+
 func _StaffV1Service_SignUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignUpRequest)
 	if err := dec(in); err != nil {
@@ -341,6 +501,78 @@ func _StaffV1Service_ListStaffs_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StaffV1Service_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffV1ServiceServer).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffV1Service_CreateInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffV1ServiceServer).CreateInvitation(ctx, req.(*CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffV1Service_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffV1ServiceServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffV1Service_ListInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffV1ServiceServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffV1Service_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffV1ServiceServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffV1Service_RevokeInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffV1ServiceServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffV1Service_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffV1ServiceServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffV1Service_AcceptInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffV1ServiceServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StaffV1Service_ServiceDesc is the grpc.ServiceDesc for StaffV1Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -352,6 +584,10 @@ var StaffV1Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateAssetPresignedURL",
 			Handler:    _StaffV1Service_CreateAssetPresignedURL_Handler,
 		},
+		{
+			MethodName: "ConfirmAssetUpload",
+			Handler:    _StaffV1Service_ConfirmAssetUpload_Handler,
+		},
 		{
 			MethodName: "SignUp",
 			Handler:    _StaffV1Service_SignUp_Handler,
@@ -380,7 +616,34 @@ var StaffV1Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListStaffs",
 			Handler:    _StaffV1Service_ListStaffs_Handler,
 		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _StaffV1Service_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _StaffV1Service_ListInvitations_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _StaffV1Service_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _StaffV1Service_AcceptInvitation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAsset",
+			Handler:       _StaffV1Service_UploadAsset_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAsset",
+			Handler:       _StaffV1Service_DownloadAsset_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rapid/staff_api/v1/api.proto",
 }
@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: rapid/staff_api/v1/api_invitation.proto
+
+package staff_apiv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Create
+type CreateInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role          StaffRole              `protobuf:"varint,2,opt,name=role,proto3,enum=rapid.staff_api.v1.StaffRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationRequest) Reset() {
+	*x = CreateInvitationRequest{}
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationRequest) ProtoMessage() {}
+
+func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_api_invitation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateInvitationRequest) GetRole() StaffRole {
+	if x != nil {
+		return x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+type CreateInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationResponse) Reset() {
+	*x = CreateInvitationResponse{}
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationResponse) ProtoMessage() {}
+
+func (x *CreateInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CreateInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_api_invitation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateInvitationResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+// List
+type ListInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint64                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_api_invitation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListInvitationsRequest) GetPage() uint64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListInvitationsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_api_invitation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+func (x *ListInvitationsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Revoke
+type RevokeInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_api_invitation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RevokeInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type RevokeInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationResponse) Reset() {
+	*x = RevokeInvitationResponse{}
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_api_invitation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevokeInvitationResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+// Accept
+type AcceptInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token sent to the invited email address.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	DisplayName   string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_api_invitation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type AcceptInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         *Staff                 `protobuf:"bytes,1,opt,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationResponse) Reset() {
+	*x = AcceptInvitationResponse{}
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_staff_api_v1_api_invitation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_api_invitation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AcceptInvitationResponse) GetStaff() *Staff {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+var File_rapid_staff_api_v1_api_invitation_proto protoreflect.FileDescriptor
+
+const file_rapid_staff_api_v1_api_invitation_proto_rawDesc = "" +
+	"\n" +
+	"'rapid/staff_api/v1/api_invitation.proto\x12\x12rapid.staff_api.v1\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a)rapid/staff_api/v1/model_invitation.proto\x1a)rapid/staff_api/v1/model_pagination.proto\x1a$rapid/staff_api/v1/model_staff.proto\"x\n" +
+	"\x17CreateInvitationRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x121\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1d.rapid.staff_api.v1.StaffRoleR\x04role:\x14\x92A\x11\n" +
+	"\x0f\xd2\x01\x05email\xd2\x01\x04role\"n\n" +
+	"\x18CreateInvitationResponse\x12>\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x1e.rapid.staff_api.v1.InvitationR\n" +
+	"invitation:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\n" +
+	"invitation\"B\n" +
+	"\x16ListInvitationsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x04R\x05limit\"\xbd\x01\n" +
+	"\x17ListInvitationsResponse\x12@\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x1e.rapid.staff_api.v1.InvitationR\vinvitations\x12>\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1e.rapid.staff_api.v1.PaginationR\n" +
+	"pagination: \x92A\x1d\n" +
+	"\x1b\xd2\x01\vinvitations\xd2\x01\n" +
+	"pagination\"U\n" +
+	"\x17RevokeInvitationRequest\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId:\x15\x92A\x12\n" +
+	"\x10\xd2\x01\rinvitation_id\"n\n" +
+	"\x18RevokeInvitationResponse\x12>\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x1e.rapid.staff_api.v1.InvitationR\n" +
+	"invitation:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\n" +
+	"invitation\"p\n" +
+	"\x17AcceptInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName:\x1c\x92A\x19\n" +
+	"\x17\xd2\x01\x05token\xd2\x01\fdisplay_name\"Z\n" +
+	"\x18AcceptInvitationResponse\x12/\n" +
+	"\x05staff\x18\x01 \x01(\v2\x19.rapid.staff_api.v1.StaffR\x05staff:\r\x92A\n" +
+	"\n" +
+	"\b\xd2\x01\x05staffB\xf4\x01\n" +
+	"\x16com.rapid.staff_api.v1B\x12ApiInvitationProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1;staff_apiv1\xa2\x02\x03RSX\xaa\x02\x11Rapid.StaffApi.V1\xca\x02\x11Rapid\\StaffApi\\V1\xe2\x02\x1dRapid\\StaffApi\\V1\\GPBMetadata\xea\x02\x13Rapid::StaffApi::V1b\x06proto3"
+
+var (
+	file_rapid_staff_api_v1_api_invitation_proto_rawDescOnce sync.Once
+	file_rapid_staff_api_v1_api_invitation_proto_rawDescData []byte
+)
+
+func file_rapid_staff_api_v1_api_invitation_proto_rawDescGZIP() []byte {
+	file_rapid_staff_api_v1_api_invitation_proto_rawDescOnce.Do(func() {
+		file_rapid_staff_api_v1_api_invitation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rapid_staff_api_v1_api_invitation_proto_rawDesc), len(file_rapid_staff_api_v1_api_invitation_proto_rawDesc)))
+	})
+	return file_rapid_staff_api_v1_api_invitation_proto_rawDescData
+}
+
+var file_rapid_staff_api_v1_api_invitation_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_rapid_staff_api_v1_api_invitation_proto_goTypes = []any{
+	(*CreateInvitationRequest)(nil),  // 0: rapid.staff_api.v1.CreateInvitationRequest
+	(*CreateInvitationResponse)(nil), // 1: rapid.staff_api.v1.CreateInvitationResponse
+	(*ListInvitationsRequest)(nil),   // 2: rapid.staff_api.v1.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),  // 3: rapid.staff_api.v1.ListInvitationsResponse
+	(*RevokeInvitationRequest)(nil),  // 4: rapid.staff_api.v1.RevokeInvitationRequest
+	(*RevokeInvitationResponse)(nil), // 5: rapid.staff_api.v1.RevokeInvitationResponse
+	(*AcceptInvitationRequest)(nil),  // 6: rapid.staff_api.v1.AcceptInvitationRequest
+	(*AcceptInvitationResponse)(nil), // 7: rapid.staff_api.v1.AcceptInvitationResponse
+	(StaffRole)(0),                   // 8: rapid.staff_api.v1.StaffRole
+	(*Invitation)(nil),               // 9: rapid.staff_api.v1.Invitation
+	(*Pagination)(nil),               // 10: rapid.staff_api.v1.Pagination
+	(*Staff)(nil),                    // 11: rapid.staff_api.v1.Staff
+}
+var file_rapid_staff_api_v1_api_invitation_proto_depIdxs = []int32{
+	8,  // 0: rapid.staff_api.v1.CreateInvitationRequest.role:type_name -> rapid.staff_api.v1.StaffRole
+	9,  // 1: rapid.staff_api.v1.CreateInvitationResponse.invitation:type_name -> rapid.staff_api.v1.Invitation
+	9,  // 2: rapid.staff_api.v1.ListInvitationsResponse.invitations:type_name -> rapid.staff_api.v1.Invitation
+	10, // 3: rapid.staff_api.v1.ListInvitationsResponse.pagination:type_name -> rapid.staff_api.v1.Pagination
+	9,  // 4: rapid.staff_api.v1.RevokeInvitationResponse.invitation:type_name -> rapid.staff_api.v1.Invitation
+	11, // 5: rapid.staff_api.v1.AcceptInvitationResponse.staff:type_name -> rapid.staff_api.v1.Staff
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_rapid_staff_api_v1_api_invitation_proto_init() }
+func file_rapid_staff_api_v1_api_invitation_proto_init() {
+	if File_rapid_staff_api_v1_api_invitation_proto != nil {
+		return
+	}
+	file_rapid_staff_api_v1_model_invitation_proto_init()
+	file_rapid_staff_api_v1_model_pagination_proto_init()
+	file_rapid_staff_api_v1_model_staff_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rapid_staff_api_v1_api_invitation_proto_rawDesc), len(file_rapid_staff_api_v1_api_invitation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rapid_staff_api_v1_api_invitation_proto_goTypes,
+		DependencyIndexes: file_rapid_staff_api_v1_api_invitation_proto_depIdxs,
+		MessageInfos:      file_rapid_staff_api_v1_api_invitation_proto_msgTypes,
+	}.Build()
+	File_rapid_staff_api_v1_api_invitation_proto = out.File
+	file_rapid_staff_api_v1_api_invitation_proto_goTypes = nil
+	file_rapid_staff_api_v1_api_invitation_proto_depIdxs = nil
+}
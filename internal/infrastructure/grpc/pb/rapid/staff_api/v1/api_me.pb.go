@@ -292,9 +292,12 @@ func (x *GetMeTenantResponse) GetTenant() *Tenant {
 
 // UpdateMe
 type UpdateMeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DisplayName   *string                `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
-	ImageAssetId  *string                `protobuf:"bytes,2,opt,name=image_asset_id,json=imageAssetId,proto3,oneof" json:"image_asset_id,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName  *string                `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	ImageAssetId *string                `protobuf:"bytes,2,opt,name=image_asset_id,json=imageAssetId,proto3,oneof" json:"image_asset_id,omitempty"`
+	// The etag of the version read. The update fails with FAILED_PRECONDITION when it has changed since.
+	// The If-Match header is used when unset.
+	Etag          *string `protobuf:"bytes,3,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -343,6 +346,13 @@ func (x *UpdateMeRequest) GetImageAssetId() string {
 	return ""
 }
 
+func (x *UpdateMeRequest) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
 type UpdateMeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Staff         *Staff                 `protobuf:"bytes,1,opt,name=staff,proto3" json:"staff,omitempty"`
@@ -389,8 +399,13 @@ func (x *UpdateMeResponse) GetStaff() *Staff {
 
 // UpdateMeTenant
 type UpdateMeTenantRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// Replaces the tags when set. Set empty values to remove all tags.
+	Tags *TenantTagTypes `protobuf:"bytes,2,opt,name=tags,proto3,oneof" json:"tags,omitempty"`
+	// The etag of the version read. The update fails with FAILED_PRECONDITION when it has changed since.
+	// The If-Match header is used when unset.
+	Etag          *string `protobuf:"bytes,3,opt,name=etag,proto3,oneof" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -432,6 +447,20 @@ func (x *UpdateMeTenantRequest) GetName() string {
 	return ""
 }
 
+func (x *UpdateMeTenantRequest) GetTags() *TenantTagTypes {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateMeTenantRequest) GetEtag() string {
+	if x != nil && x.Etag != nil {
+		return *x.Etag
+	}
+	return ""
+}
+
 type UpdateMeTenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
@@ -499,19 +528,25 @@ const file_rapid_staff_api_v1_api_me_proto_rawDesc = "" +
 	"\x12GetMeTenantRequest\"Y\n" +
 	"\x13GetMeTenantResponse\x122\n" +
 	"\x06tenant\x18\x01 \x01(\v2\x1a.rapid.staff_api.v1.TenantR\x06tenant:\x0e\x92A\v\n" +
-	"\t\xd2\x01\x06tenant\"\x88\x01\n" +
+	"\t\xd2\x01\x06tenant\"\xaa\x01\n" +
 	"\x0fUpdateMeRequest\x12&\n" +
 	"\fdisplay_name\x18\x01 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12)\n" +
-	"\x0eimage_asset_id\x18\x02 \x01(\tH\x01R\fimageAssetId\x88\x01\x01B\x0f\n" +
+	"\x0eimage_asset_id\x18\x02 \x01(\tH\x01R\fimageAssetId\x88\x01\x01\x12\x17\n" +
+	"\x04etag\x18\x03 \x01(\tH\x02R\x04etag\x88\x01\x01B\x0f\n" +
 	"\r_display_nameB\x11\n" +
-	"\x0f_image_asset_id\"R\n" +
+	"\x0f_image_asset_idB\a\n" +
+	"\x05_etag\"R\n" +
 	"\x10UpdateMeResponse\x12/\n" +
 	"\x05staff\x18\x01 \x01(\v2\x19.rapid.staff_api.v1.StaffR\x05staff:\r\x92A\n" +
 	"\n" +
-	"\b\xd2\x01\x05staff\"9\n" +
+	"\b\xd2\x01\x05staff\"\xa1\x01\n" +
 	"\x15UpdateMeTenantRequest\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
-	"\x05_name\"\\\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12;\n" +
+	"\x04tags\x18\x02 \x01(\v2\".rapid.staff_api.v1.TenantTagTypesH\x01R\x04tags\x88\x01\x01\x12\x17\n" +
+	"\x04etag\x18\x03 \x01(\tH\x02R\x04etag\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_tagsB\a\n" +
+	"\x05_etag\"\\\n" +
 	"\x16UpdateMeTenantResponse\x122\n" +
 	"\x06tenant\x18\x01 \x01(\v2\x1a.rapid.staff_api.v1.TenantR\x06tenant:\x0e\x92A\v\n" +
 	"\t\xd2\x01\x06tenantB\xec\x01\n" +
@@ -544,18 +579,20 @@ var file_rapid_staff_api_v1_api_me_proto_goTypes = []any{
 	(*UpdateMeTenantResponse)(nil), // 9: rapid.staff_api.v1.UpdateMeTenantResponse
 	(*Staff)(nil),                  // 10: rapid.staff_api.v1.Staff
 	(*Tenant)(nil),                 // 11: rapid.staff_api.v1.Tenant
+	(*TenantTagTypes)(nil),         // 12: rapid.staff_api.v1.TenantTagTypes
 }
 var file_rapid_staff_api_v1_api_me_proto_depIdxs = []int32{
 	10, // 0: rapid.staff_api.v1.SignUpResponse.staff:type_name -> rapid.staff_api.v1.Staff
 	10, // 1: rapid.staff_api.v1.GetMeResponse.staff:type_name -> rapid.staff_api.v1.Staff
 	11, // 2: rapid.staff_api.v1.GetMeTenantResponse.tenant:type_name -> rapid.staff_api.v1.Tenant
 	10, // 3: rapid.staff_api.v1.UpdateMeResponse.staff:type_name -> rapid.staff_api.v1.Staff
-	11, // 4: rapid.staff_api.v1.UpdateMeTenantResponse.tenant:type_name -> rapid.staff_api.v1.Tenant
-	5,  // [5:5] is the sub-list for method output_type
-	5,  // [5:5] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	12, // 4: rapid.staff_api.v1.UpdateMeTenantRequest.tags:type_name -> rapid.staff_api.v1.TenantTagTypes
+	11, // 5: rapid.staff_api.v1.UpdateMeTenantResponse.tenant:type_name -> rapid.staff_api.v1.Tenant
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_rapid_staff_api_v1_api_me_proto_init() }
@@ -14,6 +14,7 @@ import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -169,10 +170,23 @@ func (x *GetStaffResponse) GetStaff() *Staff {
 
 // List
 type ListStaffsRequest struct {
-	state         protoimpl.MessageState               `protogen:"open.v1"`
-	Page          uint64                               `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         uint64                               `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	SortKey       *ListStaffsRequest_ListStaffsSortKey `protobuf:"varint,3,opt,name=sort_key,json=sortKey,proto3,enum=rapid.staff_api.v1.ListStaffsRequest_ListStaffsSortKey,oneof" json:"sort_key,omitempty"`
+	state   protoimpl.MessageState               `protogen:"open.v1"`
+	Page    uint64                               `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit   uint64                               `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	SortKey *ListStaffsRequest_ListStaffsSortKey `protobuf:"varint,3,opt,name=sort_key,json=sortKey,proto3,enum=rapid.staff_api.v1.ListStaffsRequest_ListStaffsSortKey,oneof" json:"sort_key,omitempty"`
+	// Switches to cursor pagination and ignores page. An empty cursor fetches the first page.
+	Cursor *string `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// Counts the total only when set in cursor pagination. Page pagination always counts.
+	IncludeTotalCount bool `protobuf:"varint,5,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	// Lists only staffs whose display name contains the text, ignoring case.
+	DisplayName *string `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	// Lists only staffs with the email.
+	Email *string    `protobuf:"bytes,7,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Role  *StaffRole `protobuf:"varint,8,opt,name=role,proto3,enum=rapid.staff_api.v1.StaffRole,oneof" json:"role,omitempty"`
+	// Lists only staffs created at or after the time.
+	CreatedAtFrom *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at_from,json=createdAtFrom,proto3" json:"created_at_from,omitempty"`
+	// Lists only staffs created before the time.
+	CreatedAtTo   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at_to,json=createdAtTo,proto3" json:"created_at_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -228,10 +242,62 @@ func (x *ListStaffsRequest) GetSortKey() ListStaffsRequest_ListStaffsSortKey {
 	return ListStaffsRequest_LIST_STAFFS_SORT_KEY_UNSPECIFIED
 }
 
+func (x *ListStaffsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListStaffsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *ListStaffsRequest) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *ListStaffsRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *ListStaffsRequest) GetRole() StaffRole {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+func (x *ListStaffsRequest) GetCreatedAtFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtFrom
+	}
+	return nil
+}
+
+func (x *ListStaffsRequest) GetCreatedAtTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTo
+	}
+	return nil
+}
+
 type ListStaffsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Staffs        []*Staff               `protobuf:"bytes,1,rep,name=staffs,proto3" json:"staffs,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Staffs []*Staff               `protobuf:"bytes,1,rep,name=staffs,proto3" json:"staffs,omitempty"`
+	// Omitted in cursor pagination unless include_total_count is set.
+	Pagination *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Set in cursor pagination when more items remain.
+	NextCursor    *string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -280,36 +346,57 @@ func (x *ListStaffsResponse) GetPagination() *Pagination {
 	return nil
 }
 
+func (x *ListStaffsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
 var File_rapid_staff_api_v1_api_staff_proto protoreflect.FileDescriptor
 
 const file_rapid_staff_api_v1_api_staff_proto_rawDesc = "" +
 	"\n" +
-	"\"rapid/staff_api/v1/api_staff.proto\x12\x12rapid.staff_api.v1\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a)rapid/staff_api/v1/model_pagination.proto\x1a$rapid/staff_api/v1/model_staff.proto\">\n" +
+	"\"rapid/staff_api/v1/api_staff.proto\x12\x12rapid.staff_api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a)rapid/staff_api/v1/model_pagination.proto\x1a$rapid/staff_api/v1/model_staff.proto\">\n" +
 	"\x0fGetStaffRequest\x12\x19\n" +
 	"\bstaff_id\x18\x01 \x01(\tR\astaffId:\x10\x92A\r\n" +
 	"\v\xd2\x01\bstaff_id\"R\n" +
 	"\x10GetStaffResponse\x12/\n" +
 	"\x05staff\x18\x01 \x01(\v2\x19.rapid.staff_api.v1.StaffR\x05staff:\r\x92A\n" +
 	"\n" +
-	"\b\xd2\x01\x05staff\"\x89\x03\n" +
+	"\b\xd2\x01\x05staff\"\x84\x06\n" +
 	"\x11ListStaffsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x04R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x04R\x05limit\x12W\n" +
-	"\bsort_key\x18\x03 \x01(\x0e27.rapid.staff_api.v1.ListStaffsRequest.ListStaffsSortKeyH\x00R\asortKey\x88\x01\x01\"\xe3\x01\n" +
+	"\bsort_key\x18\x03 \x01(\x0e27.rapid.staff_api.v1.ListStaffsRequest.ListStaffsSortKeyH\x00R\asortKey\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x04 \x01(\tH\x01R\x06cursor\x88\x01\x01\x12.\n" +
+	"\x13include_total_count\x18\x05 \x01(\bR\x11includeTotalCount\x12&\n" +
+	"\fdisplay_name\x18\x06 \x01(\tH\x02R\vdisplayName\x88\x01\x01\x12\x19\n" +
+	"\x05email\x18\a \x01(\tH\x03R\x05email\x88\x01\x01\x126\n" +
+	"\x04role\x18\b \x01(\x0e2\x1d.rapid.staff_api.v1.StaffRoleH\x04R\x04role\x88\x01\x01\x12B\n" +
+	"\x0fcreated_at_from\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedAtFrom\x12>\n" +
+	"\rcreated_at_to\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTo\"\xe3\x01\n" +
 	"\x11ListStaffsSortKey\x12$\n" +
 	" LIST_STAFFS_SORT_KEY_UNSPECIFIED\x10\x00\x12(\n" +
 	"$LIST_STAFFS_SORT_KEY_CREATED_AT_DESC\x10\x01\x12'\n" +
 	"#LIST_STAFFS_SORT_KEY_CREATED_AT_ASC\x10\x02\x12)\n" +
 	"%LIST_STAFFS_SORT_KEY_DISPLAY_NAME_ASC\x10\x03\x12*\n" +
 	"&LIST_STAFFS_SORT_KEY_DISPLAY_NAME_DESC\x10\x04B\v\n" +
-	"\t_sort_key\"\xa4\x01\n" +
+	"\t_sort_keyB\t\n" +
+	"\a_cursorB\x0f\n" +
+	"\r_display_nameB\b\n" +
+	"\x06_emailB\a\n" +
+	"\x05_role\"\xcd\x01\n" +
 	"\x12ListStaffsResponse\x121\n" +
 	"\x06staffs\x18\x01 \x03(\v2\x19.rapid.staff_api.v1.StaffR\x06staffs\x12>\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1e.rapid.staff_api.v1.PaginationR\n" +
-	"pagination:\x1b\x92A\x18\n" +
-	"\x16\xd2\x01\x06staffs\xd2\x01\n" +
-	"paginationB\xef\x01\n" +
+	"pagination\x12$\n" +
+	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01:\x0e\x92A\v\n" +
+	"\t\xd2\x01\x06staffsB\x0e\n" +
+	"\f_next_cursorB\xef\x01\n" +
 	"\x16com.rapid.staff_api.v1B\rApiStaffProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1;staff_apiv1\xa2\x02\x03RSX\xaa\x02\x11Rapid.StaffApi.V1\xca\x02\x11Rapid\\StaffApi\\V1\xe2\x02\x1dRapid\\StaffApi\\V1\\GPBMetadata\xea\x02\x13Rapid::StaffApi::V1b\x06proto3"
 
 var (
@@ -333,18 +420,23 @@ var file_rapid_staff_api_v1_api_staff_proto_goTypes = []any{
 	(*ListStaffsRequest)(nil),                // 3: rapid.staff_api.v1.ListStaffsRequest
 	(*ListStaffsResponse)(nil),               // 4: rapid.staff_api.v1.ListStaffsResponse
 	(*Staff)(nil),                            // 5: rapid.staff_api.v1.Staff
-	(*Pagination)(nil),                       // 6: rapid.staff_api.v1.Pagination
+	(StaffRole)(0),                           // 6: rapid.staff_api.v1.StaffRole
+	(*timestamppb.Timestamp)(nil),            // 7: google.protobuf.Timestamp
+	(*Pagination)(nil),                       // 8: rapid.staff_api.v1.Pagination
 }
 var file_rapid_staff_api_v1_api_staff_proto_depIdxs = []int32{
 	5, // 0: rapid.staff_api.v1.GetStaffResponse.staff:type_name -> rapid.staff_api.v1.Staff
 	0, // 1: rapid.staff_api.v1.ListStaffsRequest.sort_key:type_name -> rapid.staff_api.v1.ListStaffsRequest.ListStaffsSortKey
-	5, // 2: rapid.staff_api.v1.ListStaffsResponse.staffs:type_name -> rapid.staff_api.v1.Staff
-	6, // 3: rapid.staff_api.v1.ListStaffsResponse.pagination:type_name -> rapid.staff_api.v1.Pagination
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6, // 2: rapid.staff_api.v1.ListStaffsRequest.role:type_name -> rapid.staff_api.v1.StaffRole
+	7, // 3: rapid.staff_api.v1.ListStaffsRequest.created_at_from:type_name -> google.protobuf.Timestamp
+	7, // 4: rapid.staff_api.v1.ListStaffsRequest.created_at_to:type_name -> google.protobuf.Timestamp
+	5, // 5: rapid.staff_api.v1.ListStaffsResponse.staffs:type_name -> rapid.staff_api.v1.Staff
+	8, // 6: rapid.staff_api.v1.ListStaffsResponse.pagination:type_name -> rapid.staff_api.v1.Pagination
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_rapid_staff_api_v1_api_staff_proto_init() }
@@ -355,6 +447,7 @@ func file_rapid_staff_api_v1_api_staff_proto_init() {
 	file_rapid_staff_api_v1_model_pagination_proto_init()
 	file_rapid_staff_api_v1_model_staff_proto_init()
 	file_rapid_staff_api_v1_api_staff_proto_msgTypes[2].OneofWrappers = []any{}
+	file_rapid_staff_api_v1_api_staff_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -11,8 +11,10 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -126,11 +128,174 @@ func (ContentType) EnumDescriptor() ([]byte, []int) {
 	return file_rapid_staff_api_v1_model_asset_proto_rawDescGZIP(), []int{1}
 }
 
+type AssetStatus int32
+
+const (
+	AssetStatus_ASSET_STATUS_UNSPECIFIED AssetStatus = 0
+	AssetStatus_ASSET_STATUS_PENDING     AssetStatus = 1
+	AssetStatus_ASSET_STATUS_UPLOADED    AssetStatus = 2
+	AssetStatus_ASSET_STATUS_ATTACHED    AssetStatus = 3
+	AssetStatus_ASSET_STATUS_ORPHANED    AssetStatus = 4
+)
+
+// Enum value maps for AssetStatus.
+var (
+	AssetStatus_name = map[int32]string{
+		0: "ASSET_STATUS_UNSPECIFIED",
+		1: "ASSET_STATUS_PENDING",
+		2: "ASSET_STATUS_UPLOADED",
+		3: "ASSET_STATUS_ATTACHED",
+		4: "ASSET_STATUS_ORPHANED",
+	}
+	AssetStatus_value = map[string]int32{
+		"ASSET_STATUS_UNSPECIFIED": 0,
+		"ASSET_STATUS_PENDING":     1,
+		"ASSET_STATUS_UPLOADED":    2,
+		"ASSET_STATUS_ATTACHED":    3,
+		"ASSET_STATUS_ORPHANED":    4,
+	}
+)
+
+func (x AssetStatus) Enum() *AssetStatus {
+	p := new(AssetStatus)
+	*p = x
+	return p
+}
+
+func (x AssetStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rapid_staff_api_v1_model_asset_proto_enumTypes[2].Descriptor()
+}
+
+func (AssetStatus) Type() protoreflect.EnumType {
+	return &file_rapid_staff_api_v1_model_asset_proto_enumTypes[2]
+}
+
+func (x AssetStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetStatus.Descriptor instead.
+func (AssetStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_model_asset_proto_rawDescGZIP(), []int{2}
+}
+
+type Asset struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssetType   AssetType              `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=rapid.staff_api.v1.AssetType" json:"asset_type,omitempty"`
+	ContentType ContentType            `protobuf:"varint,3,opt,name=content_type,json=contentType,proto3,enum=rapid.staff_api.v1.ContentType" json:"content_type,omitempty"`
+	Status      AssetStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=rapid.staff_api.v1.AssetStatus" json:"status,omitempty"`
+	// Size of the uploaded object in bytes, 0 until the upload is confirmed.
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Asset) Reset() {
+	*x = Asset{}
+	mi := &file_rapid_staff_api_v1_model_asset_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Asset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Asset) ProtoMessage() {}
+
+func (x *Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_staff_api_v1_model_asset_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
+func (*Asset) Descriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_model_asset_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Asset) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Asset) GetAssetType() AssetType {
+	if x != nil {
+		return x.AssetType
+	}
+	return AssetType_ASSET_TYPE_UNSPECIFIED
+}
+
+func (x *Asset) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *Asset) GetStatus() AssetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AssetStatus_ASSET_STATUS_UNSPECIFIED
+}
+
+func (x *Asset) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Asset) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Asset) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 var File_rapid_staff_api_v1_model_asset_proto protoreflect.FileDescriptor
 
 const file_rapid_staff_api_v1_model_asset_proto_rawDesc = "" +
 	"\n" +
-	"$rapid/staff_api/v1/model_asset.proto\x12\x12rapid.staff_api.v1*B\n" +
+	"$rapid/staff_api/v1/model_asset.proto\x12\x12rapid.staff_api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xae\x03\n" +
+	"\x05Asset\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12<\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\x0e2\x1d.rapid.staff_api.v1.AssetTypeR\tassetType\x12B\n" +
+	"\fcontent_type\x18\x03 \x01(\x0e2\x1f.rapid.staff_api.v1.ContentTypeR\vcontentType\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.rapid.staff_api.v1.AssetStatusR\x06status\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt:P\x92AM\n" +
+	"K\xd2\x01\x02id\xd2\x01\n" +
+	"asset_type\xd2\x01\fcontent_type\xd2\x01\x06status\xd2\x01\x04size\xd2\x01\n" +
+	"created_at\xd2\x01\n" +
+	"updated_at*B\n" +
 	"\tAssetType\x12\x1a\n" +
 	"\x16ASSET_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15ASSET_TYPE_USER_IMAGE\x10\x01*\xc3\x01\n" +
@@ -140,7 +305,13 @@ const file_rapid_staff_api_v1_model_asset_proto_rawDesc = "" +
 	"\x17CONTENT_TYPE_IMAGE_JPEG\x10\x02\x12 \n" +
 	"\x1cCONTENT_TYPE_APPLICATION_ZIP\x10\x03\x12 \n" +
 	"\x1cCONTENT_TYPE_APPLICATION_PDF\x10\x04\x12\x19\n" +
-	"\x15CONTENT_TYPE_TEXT_CSV\x10\x05B\xf1\x01\n" +
+	"\x15CONTENT_TYPE_TEXT_CSV\x10\x05*\x96\x01\n" +
+	"\vAssetStatus\x12\x1c\n" +
+	"\x18ASSET_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ASSET_STATUS_PENDING\x10\x01\x12\x19\n" +
+	"\x15ASSET_STATUS_UPLOADED\x10\x02\x12\x19\n" +
+	"\x15ASSET_STATUS_ATTACHED\x10\x03\x12\x19\n" +
+	"\x15ASSET_STATUS_ORPHANED\x10\x04B\xf1\x01\n" +
 	"\x16com.rapid.staff_api.v1B\x0fModelAssetProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1;staff_apiv1\xa2\x02\x03RSX\xaa\x02\x11Rapid.StaffApi.V1\xca\x02\x11Rapid\\StaffApi\\V1\xe2\x02\x1dRapid\\StaffApi\\V1\\GPBMetadata\xea\x02\x13Rapid::StaffApi::V1b\x06proto3"
 
 var (
@@ -155,17 +326,26 @@ func file_rapid_staff_api_v1_model_asset_proto_rawDescGZIP() []byte {
 	return file_rapid_staff_api_v1_model_asset_proto_rawDescData
 }
 
-var file_rapid_staff_api_v1_model_asset_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_rapid_staff_api_v1_model_asset_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_rapid_staff_api_v1_model_asset_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_rapid_staff_api_v1_model_asset_proto_goTypes = []any{
-	(AssetType)(0),   // 0: rapid.staff_api.v1.AssetType
-	(ContentType)(0), // 1: rapid.staff_api.v1.ContentType
+	(AssetType)(0),                // 0: rapid.staff_api.v1.AssetType
+	(ContentType)(0),              // 1: rapid.staff_api.v1.ContentType
+	(AssetStatus)(0),              // 2: rapid.staff_api.v1.AssetStatus
+	(*Asset)(nil),                 // 3: rapid.staff_api.v1.Asset
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
 }
 var file_rapid_staff_api_v1_model_asset_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: rapid.staff_api.v1.Asset.asset_type:type_name -> rapid.staff_api.v1.AssetType
+	1, // 1: rapid.staff_api.v1.Asset.content_type:type_name -> rapid.staff_api.v1.ContentType
+	2, // 2: rapid.staff_api.v1.Asset.status:type_name -> rapid.staff_api.v1.AssetStatus
+	4, // 3: rapid.staff_api.v1.Asset.created_at:type_name -> google.protobuf.Timestamp
+	4, // 4: rapid.staff_api.v1.Asset.updated_at:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_rapid_staff_api_v1_model_asset_proto_init() }
@@ -178,14 +358,15 @@ func file_rapid_staff_api_v1_model_asset_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rapid_staff_api_v1_model_asset_proto_rawDesc), len(file_rapid_staff_api_v1_model_asset_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   0,
+			NumEnums:      3,
+			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_rapid_staff_api_v1_model_asset_proto_goTypes,
 		DependencyIndexes: file_rapid_staff_api_v1_model_asset_proto_depIdxs,
 		EnumInfos:         file_rapid_staff_api_v1_model_asset_proto_enumTypes,
+		MessageInfos:      file_rapid_staff_api_v1_model_asset_proto_msgTypes,
 	}.Build()
 	File_rapid_staff_api_v1_model_asset_proto = out.File
 	file_rapid_staff_api_v1_model_asset_proto_goTypes = nil
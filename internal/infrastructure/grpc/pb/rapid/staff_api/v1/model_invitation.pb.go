@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: rapid/staff_api/v1/model_invitation.proto
+
+package staff_apiv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InvitationStatus int32
+
+const (
+	InvitationStatus_INVITATION_STATUS_UNSPECIFIED InvitationStatus = 0
+	InvitationStatus_INVITATION_STATUS_PENDING     InvitationStatus = 1
+	InvitationStatus_INVITATION_STATUS_ACCEPTED    InvitationStatus = 2
+	InvitationStatus_INVITATION_STATUS_REVOKED     InvitationStatus = 3
+	InvitationStatus_INVITATION_STATUS_EXPIRED     InvitationStatus = 4
+)
+
+// Enum value maps for InvitationStatus.
+var (
+	InvitationStatus_name = map[int32]string{
+		0: "INVITATION_STATUS_UNSPECIFIED",
+		1: "INVITATION_STATUS_PENDING",
+		2: "INVITATION_STATUS_ACCEPTED",
+		3: "INVITATION_STATUS_REVOKED",
+		4: "INVITATION_STATUS_EXPIRED",
+	}
+	InvitationStatus_value = map[string]int32{
+		"INVITATION_STATUS_UNSPECIFIED": 0,
+		"INVITATION_STATUS_PENDING":     1,
+		"INVITATION_STATUS_ACCEPTED":    2,
+		"INVITATION_STATUS_REVOKED":     3,
+		"INVITATION_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x InvitationStatus) Enum() *InvitationStatus {
+	p := new(InvitationStatus)
+	*p = x
+	return p
+}
+
+func (x InvitationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvitationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rapid_staff_api_v1_model_invitation_proto_enumTypes[0].Descriptor()
+}
+
+func (InvitationStatus) Type() protoreflect.EnumType {
+	return &file_rapid_staff_api_v1_model_invitation_proto_enumTypes[0]
+}
+
+func (x InvitationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvitationStatus.Descriptor instead.
+func (InvitationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_model_invitation_proto_rawDescGZIP(), []int{0}
+}
+
+type Invitation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId        string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role            StaffRole              `protobuf:"varint,4,opt,name=role,proto3,enum=rapid.staff_api.v1.StaffRole" json:"role,omitempty"`
+	Status          InvitationStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=rapid.staff_api.v1.InvitationStatus" json:"status,omitempty"`
+	InvitedBy       string                 `protobuf:"bytes,6,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	AcceptedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=accepted_at,json=acceptedAt,proto3,oneof" json:"accepted_at,omitempty"`
+	AcceptedStaffId *string                `protobuf:"bytes,9,opt,name=accepted_staff_id,json=acceptedStaffId,proto3,oneof" json:"accepted_staff_id,omitempty"`
+	RevokedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=revoked_at,json=revokedAt,proto3,oneof" json:"revoked_at,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_rapid_staff_api_v1_model_invitation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_staff_api_v1_model_invitation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_model_invitation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invitation) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetRole() StaffRole {
+	if x != nil {
+		return x.Role
+	}
+	return StaffRole_STAFF_ROLE_UNSPECIFIED
+}
+
+func (x *Invitation) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_INVITATION_STATUS_UNSPECIFIED
+}
+
+func (x *Invitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetAcceptedStaffId() string {
+	if x != nil && x.AcceptedStaffId != nil {
+		return *x.AcceptedStaffId
+	}
+	return ""
+}
+
+func (x *Invitation) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_rapid_staff_api_v1_model_invitation_proto protoreflect.FileDescriptor
+
+const file_rapid_staff_api_v1_model_invitation_proto_rawDesc = "" +
+	"\n" +
+	")rapid/staff_api/v1/model_invitation.proto\x12\x12rapid.staff_api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a$rapid/staff_api/v1/model_staff.proto\"\xdc\x05\n" +
+	"\n" +
+	"Invitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x121\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x1d.rapid.staff_api.v1.StaffRoleR\x04role\x12<\n" +
+	"\x06status\x18\x05 \x01(\x0e2$.rapid.staff_api.v1.InvitationStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x06 \x01(\tR\tinvitedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12@\n" +
+	"\vaccepted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"acceptedAt\x88\x01\x01\x12/\n" +
+	"\x11accepted_staff_id\x18\t \x01(\tH\x01R\x0facceptedStaffId\x88\x01\x01\x12>\n" +
+	"\n" +
+	"revoked_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x02R\trevokedAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt:b\x92A_\n" +
+	"]\xd2\x01\x02id\xd2\x01\ttenant_id\xd2\x01\x05email\xd2\x01\x04role\xd2\x01\x06status\xd2\x01\n" +
+	"invited_by\xd2\x01\n" +
+	"expires_at\xd2\x01\n" +
+	"created_at\xd2\x01\n" +
+	"updated_atB\x0e\n" +
+	"\f_accepted_atB\x14\n" +
+	"\x12_accepted_staff_idB\r\n" +
+	"\v_revoked_at*\xb2\x01\n" +
+	"\x10InvitationStatus\x12!\n" +
+	"\x1dINVITATION_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19INVITATION_STATUS_PENDING\x10\x01\x12\x1e\n" +
+	"\x1aINVITATION_STATUS_ACCEPTED\x10\x02\x12\x1d\n" +
+	"\x19INVITATION_STATUS_REVOKED\x10\x03\x12\x1d\n" +
+	"\x19INVITATION_STATUS_EXPIRED\x10\x04B\xf6\x01\n" +
+	"\x16com.rapid.staff_api.v1B\x14ModelInvitationProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1;staff_apiv1\xa2\x02\x03RSX\xaa\x02\x11Rapid.StaffApi.V1\xca\x02\x11Rapid\\StaffApi\\V1\xe2\x02\x1dRapid\\StaffApi\\V1\\GPBMetadata\xea\x02\x13Rapid::StaffApi::V1b\x06proto3"
+
+var (
+	file_rapid_staff_api_v1_model_invitation_proto_rawDescOnce sync.Once
+	file_rapid_staff_api_v1_model_invitation_proto_rawDescData []byte
+)
+
+func file_rapid_staff_api_v1_model_invitation_proto_rawDescGZIP() []byte {
+	file_rapid_staff_api_v1_model_invitation_proto_rawDescOnce.Do(func() {
+		file_rapid_staff_api_v1_model_invitation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rapid_staff_api_v1_model_invitation_proto_rawDesc), len(file_rapid_staff_api_v1_model_invitation_proto_rawDesc)))
+	})
+	return file_rapid_staff_api_v1_model_invitation_proto_rawDescData
+}
+
+var file_rapid_staff_api_v1_model_invitation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rapid_staff_api_v1_model_invitation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rapid_staff_api_v1_model_invitation_proto_goTypes = []any{
+	(InvitationStatus)(0),         // 0: rapid.staff_api.v1.InvitationStatus
+	(*Invitation)(nil),            // 1: rapid.staff_api.v1.Invitation
+	(StaffRole)(0),                // 2: rapid.staff_api.v1.StaffRole
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_rapid_staff_api_v1_model_invitation_proto_depIdxs = []int32{
+	2, // 0: rapid.staff_api.v1.Invitation.role:type_name -> rapid.staff_api.v1.StaffRole
+	0, // 1: rapid.staff_api.v1.Invitation.status:type_name -> rapid.staff_api.v1.InvitationStatus
+	3, // 2: rapid.staff_api.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
+	3, // 3: rapid.staff_api.v1.Invitation.accepted_at:type_name -> google.protobuf.Timestamp
+	3, // 4: rapid.staff_api.v1.Invitation.revoked_at:type_name -> google.protobuf.Timestamp
+	3, // 5: rapid.staff_api.v1.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	3, // 6: rapid.staff_api.v1.Invitation.updated_at:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_rapid_staff_api_v1_model_invitation_proto_init() }
+func file_rapid_staff_api_v1_model_invitation_proto_init() {
+	if File_rapid_staff_api_v1_model_invitation_proto != nil {
+		return
+	}
+	file_rapid_staff_api_v1_model_staff_proto_init()
+	file_rapid_staff_api_v1_model_invitation_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rapid_staff_api_v1_model_invitation_proto_rawDesc), len(file_rapid_staff_api_v1_model_invitation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rapid_staff_api_v1_model_invitation_proto_goTypes,
+		DependencyIndexes: file_rapid_staff_api_v1_model_invitation_proto_depIdxs,
+		EnumInfos:         file_rapid_staff_api_v1_model_invitation_proto_enumTypes,
+		MessageInfos:      file_rapid_staff_api_v1_model_invitation_proto_msgTypes,
+	}.Build()
+	File_rapid_staff_api_v1_model_invitation_proto = out.File
+	file_rapid_staff_api_v1_model_invitation_proto_goTypes = nil
+	file_rapid_staff_api_v1_model_invitation_proto_depIdxs = nil
+}
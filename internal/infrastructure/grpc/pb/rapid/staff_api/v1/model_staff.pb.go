@@ -75,16 +75,20 @@ func (StaffRole) EnumDescriptor() ([]byte, []int) {
 
 // Full - for direct CRUD responses (with timestamps)
 type Staff struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Tenant        *TenantPartial         `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	Role          StaffRole              `protobuf:"varint,3,opt,name=role,proto3,enum=rapid.staff_api.v1.StaffRole" json:"role,omitempty"`
-	AuthUid       string                 `protobuf:"bytes,4,opt,name=auth_uid,json=authUid,proto3" json:"auth_uid,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Email         string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tenant      *TenantPartial         `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Role        StaffRole              `protobuf:"varint,3,opt,name=role,proto3,enum=rapid.staff_api.v1.StaffRole" json:"role,omitempty"`
+	AuthUid     string                 `protobuf:"bytes,4,opt,name=auth_uid,json=authUid,proto3" json:"auth_uid,omitempty"`
+	DisplayName string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	ImageUrl    string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Email       string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Image thumbnails keyed by size (small, medium), served once the image is processed after its upload.
+	ImageVariantUrls map[string]string `protobuf:"bytes,10,rep,name=image_variant_urls,json=imageVariantUrls,proto3" json:"image_variant_urls,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Changes on every update. Send it back with the update to apply it only to this version.
+	Etag          string `protobuf:"bytes,11,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -182,18 +186,34 @@ func (x *Staff) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Staff) GetImageVariantUrls() map[string]string {
+	if x != nil {
+		return x.ImageVariantUrls
+	}
+	return nil
+}
+
+func (x *Staff) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 // Partial - for embedding in other resources (no timestamps)
 type StaffPartial struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Tenant        *TenantPartial         `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	Role          StaffRole              `protobuf:"varint,3,opt,name=role,proto3,enum=rapid.staff_api.v1.StaffRole" json:"role,omitempty"`
-	AuthUid       string                 `protobuf:"bytes,4,opt,name=auth_uid,json=authUid,proto3" json:"auth_uid,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Email         string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tenant      *TenantPartial         `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Role        StaffRole              `protobuf:"varint,3,opt,name=role,proto3,enum=rapid.staff_api.v1.StaffRole" json:"role,omitempty"`
+	AuthUid     string                 `protobuf:"bytes,4,opt,name=auth_uid,json=authUid,proto3" json:"auth_uid,omitempty"`
+	DisplayName string                 `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	ImageUrl    string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Email       string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+	// Image thumbnails keyed by size (small, medium), served once the image is processed after its upload.
+	ImageVariantUrls map[string]string `protobuf:"bytes,8,rep,name=image_variant_urls,json=imageVariantUrls,proto3" json:"image_variant_urls,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *StaffPartial) Reset() {
@@ -275,11 +295,18 @@ func (x *StaffPartial) GetEmail() string {
 	return ""
 }
 
+func (x *StaffPartial) GetImageVariantUrls() map[string]string {
+	if x != nil {
+		return x.ImageVariantUrls
+	}
+	return nil
+}
+
 var File_rapid_staff_api_v1_model_staff_proto protoreflect.FileDescriptor
 
 const file_rapid_staff_api_v1_model_staff_proto_rawDesc = "" +
 	"\n" +
-	"$rapid/staff_api/v1/model_staff.proto\x12\x12rapid.staff_api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a%rapid/staff_api/v1/model_tenant.proto\"\xd0\x03\n" +
+	"$rapid/staff_api/v1/model_staff.proto\x12\x12rapid.staff_api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a%rapid/staff_api/v1/model_tenant.proto\"\xa4\x05\n" +
 	"\x05Staff\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\x06tenant\x18\x02 \x01(\v2!.rapid.staff_api.v1.TenantPartialR\x06tenant\x121\n" +
@@ -291,10 +318,16 @@ const file_rapid_staff_api_v1_model_staff_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt:b\x92A_\n" +
-	"]\xd2\x01\x02id\xd2\x01\x06tenant\xd2\x01\x04role\xd2\x01\bauth_uid\xd2\x01\fdisplay_name\xd2\x01\timage_url\xd2\x01\x05email\xd2\x01\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12]\n" +
+	"\x12image_variant_urls\x18\n" +
+	" \x03(\v2/.rapid.staff_api.v1.Staff.ImageVariantUrlsEntryR\x10imageVariantUrls\x12\x12\n" +
+	"\x04etag\x18\v \x01(\tR\x04etag\x1aC\n" +
+	"\x15ImageVariantUrlsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:~\x92A{\n" +
+	"y\xd2\x01\x02id\xd2\x01\x06tenant\xd2\x01\x04role\xd2\x01\bauth_uid\xd2\x01\fdisplay_name\xd2\x01\timage_url\xd2\x01\x12image_variant_urls\xd2\x01\x05email\xd2\x01\n" +
 	"created_at\xd2\x01\n" +
-	"updated_at\"\xc7\x02\n" +
+	"updated_at\xd2\x01\x04etag\"\x87\x04\n" +
 	"\fStaffPartial\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\x06tenant\x18\x02 \x01(\v2!.rapid.staff_api.v1.TenantPartialR\x06tenant\x121\n" +
@@ -302,8 +335,12 @@ const file_rapid_staff_api_v1_model_staff_proto_rawDesc = "" +
 	"\bauth_uid\x18\x04 \x01(\tR\aauthUid\x12!\n" +
 	"\fdisplay_name\x18\x05 \x01(\tR\vdisplayName\x12\x1b\n" +
 	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x14\n" +
-	"\x05email\x18\a \x01(\tR\x05email:H\x92AE\n" +
-	"C\xd2\x01\x02id\xd2\x01\x06tenant\xd2\x01\x04role\xd2\x01\bauth_uid\xd2\x01\fdisplay_name\xd2\x01\timage_url\xd2\x01\x05email*T\n" +
+	"\x05email\x18\a \x01(\tR\x05email\x12d\n" +
+	"\x12image_variant_urls\x18\b \x03(\v26.rapid.staff_api.v1.StaffPartial.ImageVariantUrlsEntryR\x10imageVariantUrls\x1aC\n" +
+	"\x15ImageVariantUrlsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:]\x92AZ\n" +
+	"X\xd2\x01\x02id\xd2\x01\x06tenant\xd2\x01\x04role\xd2\x01\bauth_uid\xd2\x01\fdisplay_name\xd2\x01\timage_url\xd2\x01\x12image_variant_urls\xd2\x01\x05email*T\n" +
 	"\tStaffRole\x12\x1a\n" +
 	"\x16STAFF_ROLE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11STAFF_ROLE_NORMAL\x10\x01\x12\x14\n" +
@@ -323,26 +360,30 @@ func file_rapid_staff_api_v1_model_staff_proto_rawDescGZIP() []byte {
 }
 
 var file_rapid_staff_api_v1_model_staff_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rapid_staff_api_v1_model_staff_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rapid_staff_api_v1_model_staff_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_rapid_staff_api_v1_model_staff_proto_goTypes = []any{
 	(StaffRole)(0),                // 0: rapid.staff_api.v1.StaffRole
 	(*Staff)(nil),                 // 1: rapid.staff_api.v1.Staff
 	(*StaffPartial)(nil),          // 2: rapid.staff_api.v1.StaffPartial
-	(*TenantPartial)(nil),         // 3: rapid.staff_api.v1.TenantPartial
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	nil,                           // 3: rapid.staff_api.v1.Staff.ImageVariantUrlsEntry
+	nil,                           // 4: rapid.staff_api.v1.StaffPartial.ImageVariantUrlsEntry
+	(*TenantPartial)(nil),         // 5: rapid.staff_api.v1.TenantPartial
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
 }
 var file_rapid_staff_api_v1_model_staff_proto_depIdxs = []int32{
-	3, // 0: rapid.staff_api.v1.Staff.tenant:type_name -> rapid.staff_api.v1.TenantPartial
+	5, // 0: rapid.staff_api.v1.Staff.tenant:type_name -> rapid.staff_api.v1.TenantPartial
 	0, // 1: rapid.staff_api.v1.Staff.role:type_name -> rapid.staff_api.v1.StaffRole
-	4, // 2: rapid.staff_api.v1.Staff.created_at:type_name -> google.protobuf.Timestamp
-	4, // 3: rapid.staff_api.v1.Staff.updated_at:type_name -> google.protobuf.Timestamp
-	3, // 4: rapid.staff_api.v1.StaffPartial.tenant:type_name -> rapid.staff_api.v1.TenantPartial
-	0, // 5: rapid.staff_api.v1.StaffPartial.role:type_name -> rapid.staff_api.v1.StaffRole
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	6, // 2: rapid.staff_api.v1.Staff.created_at:type_name -> google.protobuf.Timestamp
+	6, // 3: rapid.staff_api.v1.Staff.updated_at:type_name -> google.protobuf.Timestamp
+	3, // 4: rapid.staff_api.v1.Staff.image_variant_urls:type_name -> rapid.staff_api.v1.Staff.ImageVariantUrlsEntry
+	5, // 5: rapid.staff_api.v1.StaffPartial.tenant:type_name -> rapid.staff_api.v1.TenantPartial
+	0, // 6: rapid.staff_api.v1.StaffPartial.role:type_name -> rapid.staff_api.v1.StaffRole
+	4, // 7: rapid.staff_api.v1.StaffPartial.image_variant_urls:type_name -> rapid.staff_api.v1.StaffPartial.ImageVariantUrlsEntry
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_rapid_staff_api_v1_model_staff_proto_init() }
@@ -357,7 +398,7 @@ func file_rapid_staff_api_v1_model_staff_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rapid_staff_api_v1_model_staff_proto_rawDesc), len(file_rapid_staff_api_v1_model_staff_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
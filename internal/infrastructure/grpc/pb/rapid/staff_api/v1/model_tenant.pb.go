@@ -24,13 +24,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TenantTagType int32
+
+const (
+	TenantTagType_TENANT_TAG_TYPE_UNSPECIFIED   TenantTagType = 0
+	TenantTagType_TENANT_TAG_TYPE_ENTERTAINMENT TenantTagType = 1
+	TenantTagType_TENANT_TAG_TYPE_EDUCATION     TenantTagType = 2
+	TenantTagType_TENANT_TAG_TYPE_BUSINESS      TenantTagType = 3
+	TenantTagType_TENANT_TAG_TYPE_OTHER         TenantTagType = 4
+)
+
+// Enum value maps for TenantTagType.
+var (
+	TenantTagType_name = map[int32]string{
+		0: "TENANT_TAG_TYPE_UNSPECIFIED",
+		1: "TENANT_TAG_TYPE_ENTERTAINMENT",
+		2: "TENANT_TAG_TYPE_EDUCATION",
+		3: "TENANT_TAG_TYPE_BUSINESS",
+		4: "TENANT_TAG_TYPE_OTHER",
+	}
+	TenantTagType_value = map[string]int32{
+		"TENANT_TAG_TYPE_UNSPECIFIED":   0,
+		"TENANT_TAG_TYPE_ENTERTAINMENT": 1,
+		"TENANT_TAG_TYPE_EDUCATION":     2,
+		"TENANT_TAG_TYPE_BUSINESS":      3,
+		"TENANT_TAG_TYPE_OTHER":         4,
+	}
+)
+
+func (x TenantTagType) Enum() *TenantTagType {
+	p := new(TenantTagType)
+	*p = x
+	return p
+}
+
+func (x TenantTagType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TenantTagType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rapid_staff_api_v1_model_tenant_proto_enumTypes[0].Descriptor()
+}
+
+func (TenantTagType) Type() protoreflect.EnumType {
+	return &file_rapid_staff_api_v1_model_tenant_proto_enumTypes[0]
+}
+
+func (x TenantTagType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TenantTagType.Descriptor instead.
+func (TenantTagType) EnumDescriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_model_tenant_proto_rawDescGZIP(), []int{0}
+}
+
 // Full - for direct CRUD responses (with timestamps)
 type Tenant struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Tags      []TenantTagType        `protobuf:"varint,5,rep,packed,name=tags,proto3,enum=rapid.staff_api.v1.TenantTagType" json:"tags,omitempty"`
+	// Changes on every update. Send it back with the update to apply it only to this version.
+	Etag          string `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -93,6 +151,20 @@ func (x *Tenant) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Tenant) GetTags() []TenantTagType {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Tenant) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 // Partial - for embedding in other resources (no timestamps)
 type TenantPartial struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -146,25 +218,80 @@ func (x *TenantPartial) GetName() string {
 	return ""
 }
 
+// Wraps tag types so that an update can tell a missing field from clearing the tags.
+type TenantTagTypes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []TenantTagType        `protobuf:"varint,1,rep,packed,name=values,proto3,enum=rapid.staff_api.v1.TenantTagType" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantTagTypes) Reset() {
+	*x = TenantTagTypes{}
+	mi := &file_rapid_staff_api_v1_model_tenant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantTagTypes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantTagTypes) ProtoMessage() {}
+
+func (x *TenantTagTypes) ProtoReflect() protoreflect.Message {
+	mi := &file_rapid_staff_api_v1_model_tenant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantTagTypes.ProtoReflect.Descriptor instead.
+func (*TenantTagTypes) Descriptor() ([]byte, []int) {
+	return file_rapid_staff_api_v1_model_tenant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TenantTagTypes) GetValues() []TenantTagType {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 var File_rapid_staff_api_v1_model_tenant_proto protoreflect.FileDescriptor
 
 const file_rapid_staff_api_v1_model_tenant_proto_rawDesc = "" +
 	"\n" +
-	"%rapid/staff_api/v1/model_tenant.proto\x12\x12rapid.staff_api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xcf\x01\n" +
+	"%rapid/staff_api/v1/model_tenant.proto\x12\x12rapid.staff_api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xa8\x02\n" +
 	"\x06Tenant\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt:+\x92A(\n" +
-	"&\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
+	"\x04tags\x18\x05 \x03(\x0e2!.rapid.staff_api.v1.TenantTagTypeR\x04tags\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etag:9\x92A6\n" +
+	"4\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\x04tags\xd2\x01\n" +
 	"created_at\xd2\x01\n" +
-	"updated_at\"F\n" +
+	"updated_at\xd2\x01\x04etag\"F\n" +
 	"\rTenantPartial\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name:\x11\x92A\x0e\n" +
-	"\f\xd2\x01\x02id\xd2\x01\x04nameB\xf2\x01\n" +
+	"\f\xd2\x01\x02id\xd2\x01\x04name\"K\n" +
+	"\x0eTenantTagTypes\x129\n" +
+	"\x06values\x18\x01 \x03(\x0e2!.rapid.staff_api.v1.TenantTagTypeR\x06values*\xab\x01\n" +
+	"\rTenantTagType\x12\x1f\n" +
+	"\x1bTENANT_TAG_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dTENANT_TAG_TYPE_ENTERTAINMENT\x10\x01\x12\x1d\n" +
+	"\x19TENANT_TAG_TYPE_EDUCATION\x10\x02\x12\x1c\n" +
+	"\x18TENANT_TAG_TYPE_BUSINESS\x10\x03\x12\x19\n" +
+	"\x15TENANT_TAG_TYPE_OTHER\x10\x04B\xf2\x01\n" +
 	"\x16com.rapid.staff_api.v1B\x10ModelTenantProtoP\x01Z`github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1;staff_apiv1\xa2\x02\x03RSX\xaa\x02\x11Rapid.StaffApi.V1\xca\x02\x11Rapid\\StaffApi\\V1\xe2\x02\x1dRapid\\StaffApi\\V1\\GPBMetadata\xea\x02\x13Rapid::StaffApi::V1b\x06proto3"
 
 var (
@@ -179,20 +306,25 @@ func file_rapid_staff_api_v1_model_tenant_proto_rawDescGZIP() []byte {
 	return file_rapid_staff_api_v1_model_tenant_proto_rawDescData
 }
 
-var file_rapid_staff_api_v1_model_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rapid_staff_api_v1_model_tenant_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rapid_staff_api_v1_model_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_rapid_staff_api_v1_model_tenant_proto_goTypes = []any{
-	(*Tenant)(nil),                // 0: rapid.staff_api.v1.Tenant
-	(*TenantPartial)(nil),         // 1: rapid.staff_api.v1.TenantPartial
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(TenantTagType)(0),            // 0: rapid.staff_api.v1.TenantTagType
+	(*Tenant)(nil),                // 1: rapid.staff_api.v1.Tenant
+	(*TenantPartial)(nil),         // 2: rapid.staff_api.v1.TenantPartial
+	(*TenantTagTypes)(nil),        // 3: rapid.staff_api.v1.TenantTagTypes
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
 }
 var file_rapid_staff_api_v1_model_tenant_proto_depIdxs = []int32{
-	2, // 0: rapid.staff_api.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
-	2, // 1: rapid.staff_api.v1.Tenant.updated_at:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 0: rapid.staff_api.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	4, // 1: rapid.staff_api.v1.Tenant.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: rapid.staff_api.v1.Tenant.tags:type_name -> rapid.staff_api.v1.TenantTagType
+	0, // 3: rapid.staff_api.v1.TenantTagTypes.values:type_name -> rapid.staff_api.v1.TenantTagType
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_rapid_staff_api_v1_model_tenant_proto_init() }
@@ -205,13 +337,14 @@ func file_rapid_staff_api_v1_model_tenant_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rapid_staff_api_v1_model_tenant_proto_rawDesc), len(file_rapid_staff_api_v1_model_tenant_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_rapid_staff_api_v1_model_tenant_proto_goTypes,
 		DependencyIndexes: file_rapid_staff_api_v1_model_tenant_proto_depIdxs,
+		EnumInfos:         file_rapid_staff_api_v1_model_tenant_proto_enumTypes,
 		MessageInfos:      file_rapid_staff_api_v1_model_tenant_proto_msgTypes,
 	}.Build()
 	File_rapid_staff_api_v1_model_tenant_proto = out.File
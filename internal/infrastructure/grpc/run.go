@@ -13,6 +13,9 @@ import (
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/handler/public"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/handler/staff"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/authorization_interceptor"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/idempotency_interceptor"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/precondition_interceptor"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/rate_limit_interceptor"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/request_interceptor"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/internal/interceptor/session_interceptor"
 	admin_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/admin_api/v1"
@@ -21,6 +24,7 @@ import (
 	staff_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -42,13 +46,33 @@ func NewServer(ctx context.Context,
 	dependency *dependency.Dependency,
 ) *grpc.Server {
 	requestLogInterceptor := request_interceptor.NewRequestLog(logger)
+	requestMetricsInterceptor := request_interceptor.NewRequestMetrics()
 	authFunc := session_interceptor.NewSession(
 		dependency.AuthenticationInteractor,
 		dependency.AdminAuthenticationInteractor,
 	)
-	authorizationInterceptor := authorization_interceptor.NewAuthorization()
+	authorizationInterceptor := authorization_interceptor.NewAuthorization(
+		authorization_interceptor.NewDefaultPolicy(),
+	)
+	rateLimitPolicy := rate_limit_interceptor.NewDefaultPolicy()
+	if !e.RateLimitEnable {
+		rateLimitPolicy = rate_limit_interceptor.NewPolicy(nil)
+	}
+	rateLimitInterceptor := rate_limit_interceptor.NewRateLimit(
+		rateLimitPolicy,
+		dependency.RateLimiter,
+		e.RateLimitTrustedProxyHops,
+	)
+	idempotencyInterceptor := idempotency_interceptor.NewIdempotency(
+		idempotency_interceptor.NewDefaultPolicy(),
+		dependency.IdempotencyKeyCache,
+		e.IdempotencyKeyTTL,
+	)
+	preconditionInterceptor := precondition_interceptor.NewPrecondition()
 
 	server := grpc.NewServer(
+		// the span of each RPC is continued from the trace context sent by the client, such as the gateway
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.KeepaliveEnforcementPolicy(
 			keepalive.EnforcementPolicy{
 				MinTime:             10 * time.Second,
@@ -66,11 +90,26 @@ func NewServer(ctx context.Context,
 		),
 		grpc.ChainUnaryInterceptor(
 			requestLogInterceptor.UnaryServerInterceptor(),
+			requestMetricsInterceptor.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(
 				grpc_recovery.WithRecoveryHandler(recoverFuncFactory(logger)),
 			),
 			grpc_auth.UnaryServerInterceptor(authFunc.Authenticate),
 			authorizationInterceptor.UnaryServerInterceptor(),
+			rateLimitInterceptor.UnaryServerInterceptor(),
+			// the etag is set from the If-Match header before the request is fingerprinted for the idempotency key
+			preconditionInterceptor.UnaryServerInterceptor(),
+			idempotencyInterceptor.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			requestLogInterceptor.StreamServerInterceptor(),
+			requestMetricsInterceptor.StreamServerInterceptor(),
+			grpc_recovery.StreamServerInterceptor(
+				grpc_recovery.WithRecoveryHandler(recoverFuncFactory(logger)),
+			),
+			grpc_auth.StreamServerInterceptor(authFunc.Authenticate),
+			authorizationInterceptor.StreamServerInterceptor(),
+			rateLimitInterceptor.StreamServerInterceptor(),
 		),
 	)
 
@@ -80,6 +119,8 @@ func NewServer(ctx context.Context,
 			dependency.AdminTenantInteractor,
 			dependency.AdminStaffInteractor,
 			dependency.AdminAssetInteractor,
+			dependency.AdminAdminInteractor,
+			dependency.AdminAuditLogInteractor,
 		),
 	)
 	staff_apiv1.RegisterStaffV1ServiceServer(
@@ -89,6 +130,7 @@ func NewServer(ctx context.Context,
 			dependency.StaffMeTenantInteractor,
 			dependency.StaffStaffInteractor,
 			dependency.StaffAssetInteractor,
+			dependency.StaffInvitationInteractor,
 		),
 	)
 	public_apiv1.RegisterPublicV1ServiceServer(
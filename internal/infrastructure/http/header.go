@@ -0,0 +1,43 @@
+package http
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// forwardedHeaders are the response metadata of the gRPC server forwarded as HTTP headers of the same names,
+// which are the headers of the rate limit and the idempotency key.
+var forwardedHeaders = []string{
+	"x-ratelimit-limit",
+	"x-ratelimit-remaining",
+	"x-ratelimit-reset",
+	"retry-after",
+	"idempotent-replayed",
+}
+
+// acceptedHeaders are the HTTP headers forwarded to the gRPC server as the request metadata of the same names,
+// which are the headers of the idempotency key and the etag precondition.
+var acceptedHeaders = []string{
+	"idempotency-key",
+	"if-match",
+}
+
+// incomingHeaderMatcher forwards acceptedHeaders in lower case,
+// and the other headers as the gateway does by default.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if key := strings.ToLower(key); slices.Contains(acceptedHeaders, key) {
+		return key, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher forwards forwardedHeaders as is,
+// and the other response metadata with the Grpc-Metadata- prefix as the gateway does by default.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if slices.Contains(forwardedHeaders, key) {
+		return key, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
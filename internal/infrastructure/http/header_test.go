@@ -0,0 +1,80 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutgoingHeaderMatcher(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		key  string
+		want string
+	}{
+		"rate limit header is forwarded as is": {
+			key:  "x-ratelimit-remaining",
+			want: "x-ratelimit-remaining",
+		},
+		"retry after is forwarded as is": {
+			key:  "retry-after",
+			want: "retry-after",
+		},
+		"idempotent replayed is forwarded as is": {
+			key:  "idempotent-replayed",
+			want: "idempotent-replayed",
+		},
+		"other metadata is prefixed": {
+			key:  "x-request-id",
+			want: "Grpc-Metadata-x-request-id",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := outgoingHeaderMatcher(tc.key)
+			require.True(t, ok)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestIncomingHeaderMatcher(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		key    string
+		want   string
+		wantOK bool
+	}{
+		"idempotency key is forwarded in lower case": {
+			key:    "Idempotency-Key",
+			want:   "idempotency-key",
+			wantOK: true,
+		},
+		"if match is forwarded in lower case": {
+			key:    "If-Match",
+			want:   "if-match",
+			wantOK: true,
+		},
+		"permanent header is prefixed": {
+			key:    "Authorization",
+			want:   "grpcgateway-Authorization",
+			wantOK: true,
+		},
+		"other header is dropped": {
+			key:    "X-Custom",
+			want:   "",
+			wantOK: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := incomingHeaderMatcher(tc.key)
+			require.Equal(t, tc.wantOK, ok)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
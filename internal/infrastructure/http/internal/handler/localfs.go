@@ -0,0 +1,93 @@
+package handler
+
+import (
+	goerrors "errors"
+	"io/fs"
+	"net/http"
+	"strings"
+
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/localfs"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+	"go.uber.org/zap"
+)
+
+// LocalFSPathPattern is the path pattern the LocalFS handlers are mounted at.
+const LocalFSPathPattern = localfs.URLPrefix + "{path=**}"
+
+// LocalFS serves the signed URLs of the local filesystem storage, in place of a bucket.
+type LocalFS struct {
+	storage *localfs.Storage
+	logger  *zap.Logger
+}
+
+func NewLocalFS(
+	storage *localfs.Storage,
+	logger *zap.Logger,
+) *LocalFS {
+	return &LocalFS{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Put stores the body of a request to a signed write URL,
+// rejecting another content type or a body over the max size.
+func (h *LocalFS) Put(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	path := pathParams["path"]
+	signedURL, err := h.storage.Verify(http.MethodPut, path, r.URL.Query(), now.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if r.Header.Get("Content-Type") != signedURL.ContentType {
+		http.Error(w, "content type does not match the signed url", http.StatusUnsupportedMediaType)
+		return
+	}
+	if r.ContentLength > signedURL.MaxSize {
+		http.Error(w, "body is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if _, err := h.storage.Write(path, http.MaxBytesReader(w, r.Body, signedURL.MaxSize)); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if goerrors.As(err, &maxBytesErr) {
+			http.Error(w, "body is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		h.logger.Error("failed to write local file", zap.String("path", path), zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Get serves a file, which needs a signed read URL unless it is public.
+func (h *LocalFS) Get(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	path := pathParams["path"]
+	if !strings.HasPrefix(path, "public/") {
+		if _, err := h.storage.Verify(http.MethodGet, path, r.URL.Query(), now.Now()); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+	}
+
+	f, err := h.storage.Open(path)
+	if err != nil {
+		if goerrors.Is(err, fs.ErrNotExist) {
+			http.NotFound(w, r)
+			return
+		}
+		h.logger.Error("failed to open local file", zap.String("path", path), zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	// the content type is detected from the extension of the path
+	http.ServeContent(w, r, info.Name(), info.ModTime(), f)
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/localfs"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestLocalFS(t *testing.T) {
+	t.Parallel()
+
+	const path = "private/user_images/a.png"
+
+	newServer := func(t *testing.T) (*httptest.Server, *localfs.Storage) {
+		mux := runtime.NewServeMux()
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		storage := localfs.NewStorage(t.TempDir(), "key", server.URL)
+		h := NewLocalFS(storage, zap.NewNop())
+		require.NoError(t, mux.HandlePath(http.MethodPut, LocalFSPathPattern, h.Put))
+		require.NoError(t, mux.HandlePath(http.MethodGet, LocalFSPathPattern, h.Get))
+		return server, storage
+	}
+	writeURL := func(storage *localfs.Storage, maxSize int64) string {
+		return storage.Sign(localfs.SignedURL{
+			Method:      http.MethodPut,
+			Path:        path,
+			ExpiresAt:   time.Now().Add(time.Minute),
+			ContentType: "image/png",
+			MaxSize:     maxSize,
+		})
+	}
+	put := func(t *testing.T, url string, contentType string, body string) int {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodPut, url, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", contentType)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		return res.StatusCode
+	}
+	get := func(t *testing.T, url string) (int, string) {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+		require.NoError(t, err)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res.StatusCode, string(body)
+	}
+
+	tests := map[string]func(t *testing.T){
+		"uploaded file is read through a signed url": func(t *testing.T) {
+			server, storage := newServer(t)
+			require.Equal(t, http.StatusOK, put(t, writeURL(storage, 1024), "image/png", "png"))
+
+			status, body := get(t, storage.Sign(localfs.SignedURL{
+				Method:    http.MethodGet,
+				Path:      path,
+				ExpiresAt: time.Now().Add(time.Minute),
+			}))
+			require.Equal(t, http.StatusOK, status)
+			require.Equal(t, "png", body)
+
+			status, _ = get(t, server.URL+localfs.URLPrefix+path)
+			require.Equal(t, http.StatusForbidden, status)
+		},
+		"another content type is rejected": func(t *testing.T) {
+			_, storage := newServer(t)
+			require.Equal(t, http.StatusUnsupportedMediaType, put(t, writeURL(storage, 1024), "image/jpeg", "jpeg"))
+		},
+		"body over the max size is rejected": func(t *testing.T) {
+			_, storage := newServer(t)
+			require.Equal(t, http.StatusRequestEntityTooLarge, put(t, writeURL(storage, 2), "image/png", "png"))
+			_, ok, err := storage.Stat(path)
+			require.NoError(t, err)
+			require.False(t, ok)
+		},
+		"unsigned upload is rejected": func(t *testing.T) {
+			server, _ := newServer(t)
+			require.Equal(t, http.StatusForbidden, put(t, server.URL+localfs.URLPrefix+path, "image/png", "png"))
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			tc(t)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/metrics"
+)
+
+var metricsHandler = metrics.Handler()
+
+// Metrics serves the metrics of the server for Prometheus to scrape.
+func Metrics(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	metricsHandler.ServeHTTP(w, r)
+}
@@ -19,9 +19,12 @@ import (
 	staff_apiv1 "github.com/abyssparanoia/rapid-go/internal/infrastructure/grpc/pb/rapid/staff_api/v1"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/http/internal/handler"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/http/internal/middlewares"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/tracing"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/logger"
 	"github.com/caarlos0/env/v11"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -48,6 +51,8 @@ func Run() {
 
 	addr := fmt.Sprintf(":%s", e.Port)
 
+	shutdownTracerProvider := tracing.NewTracerProvider(ctx, e)
+
 	d := &dependency.Dependency{}
 	d.Inject(ctx, e)
 
@@ -67,7 +72,7 @@ func Run() {
 		}
 	}()
 
-	grpcGateway := runtime.NewServeMux(runtime.WithMarshalerOption("*", &runtime.HTTPBodyMarshaler{
+	grpcGateway := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher), runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher), runtime.WithMarshalerOption("*", &runtime.HTTPBodyMarshaler{
 		Marshaler: &CustomJSONPb{
 			MarshalOptions: protojson.MarshalOptions{
 				UseProtoNames:   true,
@@ -87,6 +92,8 @@ func Run() {
 		grpc.WithTransportCredentials(
 			insecure.NewCredentials(),
 		),
+		// the trace context of the HTTP request is sent to the gRPC server
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		grpc.WithKeepaliveParams(
 			keepalive.ClientParameters{
 				Time:                30 * time.Millisecond,
@@ -120,10 +127,25 @@ func Run() {
 		panic(err)
 	}
 
+	if err = grpcGateway.HandlePath(http.MethodGet, "/metrics", handler.Metrics); err != nil {
+		panic(err)
+	}
+
+	// The local filesystem asset storage is served in place of a bucket
+	if d.LocalFSStorage != nil {
+		localFS := handler.NewLocalFS(d.LocalFSStorage, logger)
+		if err = grpcGateway.HandlePath(http.MethodPut, handler.LocalFSPathPattern, localFS.Put); err != nil {
+			panic(err)
+		}
+		if err = grpcGateway.HandlePath(http.MethodGet, handler.LocalFSPathPattern, localFS.Get); err != nil {
+			panic(err)
+		}
+	}
+
 	// server
 	server := http.Server{
 		Addr:              addr,
-		Handler:           middlewares.CORS(grpcGateway),
+		Handler:           otelhttp.NewHandler(middlewares.CORS(grpcGateway), "grpc-gateway"),
 		ReadHeaderTimeout: 10 * time.Second,
 	}
 
@@ -144,5 +166,9 @@ func Run() {
 	}
 	grpcServer.GracefulStop()
 
+	if err := shutdownTracerProvider(ctx); err != nil {
+		logger.Error("failed to flush the spans", zap.Error(err)) //nolint:forbidigo
+	}
+
 	logger.Info("server shutdown completed")
 }
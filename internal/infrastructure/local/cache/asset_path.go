@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+)
+
+type assetPathEntry struct {
+	path      string
+	expiresAt time.Time
+}
+
+type assetPath struct {
+	mu sync.Mutex
+	// entries are keyed by asset id, then by auth context
+	entries map[string]map[model.AssetAuthContext]assetPathEntry
+}
+
+// NewAssetPath returns an AssetPath that keeps entries in the process memory.
+// Entries are not shared between processes, so it is meant for local development and tests.
+func NewAssetPath() cache.AssetPath {
+	return &assetPath{
+		mu:      sync.Mutex{},
+		entries: map[string]map[model.AssetAuthContext]assetPathEntry{},
+	}
+}
+
+func (c *assetPath) Get(
+	ctx context.Context,
+	id string,
+	authContext model.AssetAuthContext,
+) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[id][authContext]
+	if !ok {
+		return "", errors.AssetNotFoundErr.New()
+	}
+	if !now.Now().Before(entry.expiresAt) {
+		delete(c.entries[id], authContext)
+		if len(c.entries[id]) == 0 {
+			delete(c.entries, id)
+		}
+		return "", errors.AssetNotFoundErr.New()
+	}
+	return entry.path, nil
+}
+
+func (c *assetPath) Set(
+	ctx context.Context,
+	asset *model.Asset,
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[asset.ID]; !ok {
+		c.entries[asset.ID] = map[model.AssetAuthContext]assetPathEntry{}
+	}
+	c.entries[asset.ID][asset.AuthContext] = assetPathEntry{
+		path:      asset.Path,
+		expiresAt: now.Now().Add(asset.Expiration()),
+	}
+	return nil
+}
+
+func (c *assetPath) Clear(
+	ctx context.Context,
+	id string,
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, id)
+	return nil
+}
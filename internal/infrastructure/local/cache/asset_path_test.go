@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetPath(t *testing.T) {
+	t.Parallel()
+
+	staffContext := model.NewAssetAuthContext("staff:staff-id")
+	adminContext := model.NewAssetAuthContext("admin:admin-id")
+	newAsset := func(authContext model.AssetAuthContext) *model.Asset {
+		return model.NewAsset(
+			model.AssetTypeUserImage,
+			model.ContentTypeImagePNG,
+			authContext,
+			now.Now(),
+		)
+	}
+
+	tests := map[string]func(t *testing.T){
+		"get returns the path set for the auth context": func(t *testing.T) {
+			ctx := context.Background()
+			c := NewAssetPath()
+			asset := newAsset(staffContext)
+			require.NoError(t, c.Set(ctx, asset))
+
+			got, err := c.Get(ctx, asset.ID, staffContext)
+			require.NoError(t, err)
+			require.Equal(t, asset.Path, got)
+
+			_, err = c.Get(ctx, asset.ID, adminContext)
+			require.ErrorIs(t, err, errors.AssetNotFoundErr)
+		},
+		"expired entries are not returned": func(t *testing.T) {
+			ctx := context.Background()
+			c := NewAssetPath()
+			asset := newAsset(staffContext)
+			asset.ExpiresAt = asset.CreatedAt
+			require.NoError(t, c.Set(ctx, asset))
+
+			_, err := c.Get(ctx, asset.ID, staffContext)
+			require.ErrorIs(t, err, errors.AssetNotFoundErr)
+		},
+		"clear removes the entries of every auth context": func(t *testing.T) {
+			ctx := context.Background()
+			c := NewAssetPath()
+			asset := newAsset(staffContext)
+			require.NoError(t, c.Set(ctx, asset))
+			adminAsset := *asset
+			adminAsset.AuthContext = adminContext
+			require.NoError(t, c.Set(ctx, &adminAsset))
+			other := newAsset(staffContext)
+			require.NoError(t, c.Set(ctx, other))
+
+			require.NoError(t, c.Clear(ctx, asset.ID))
+
+			_, err := c.Get(ctx, asset.ID, staffContext)
+			require.ErrorIs(t, err, errors.AssetNotFoundErr)
+			_, err = c.Get(ctx, asset.ID, adminContext)
+			require.ErrorIs(t, err, errors.AssetNotFoundErr)
+			got, err := c.Get(ctx, other.ID, staffContext)
+			require.NoError(t, err)
+			require.Equal(t, other.Path, got)
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			tc(t)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
+type idempotencyKey struct {
+	mu   sync.Mutex
+	keys map[string]model.IdempotencyKey
+}
+
+// NewIdempotencyKey returns an IdempotencyKey that keeps the keys in the process memory.
+// Keys are not shared between processes, so it is meant for local development and tests.
+func NewIdempotencyKey() cache.IdempotencyKey {
+	return &idempotencyKey{
+		mu:   sync.Mutex{},
+		keys: map[string]model.IdempotencyKey{},
+	}
+}
+
+func (c *idempotencyKey) Claim(
+	ctx context.Context,
+	key *model.IdempotencyKey,
+	t time.Time,
+) (*model.IdempotencyKey, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.deleteExpired(t, 0)
+	if stored, ok := c.keys[key.ID]; ok {
+		return &stored, false, nil
+	}
+	c.keys[key.ID] = *key
+	return key, true, nil
+}
+
+func (c *idempotencyKey) Complete(
+	ctx context.Context,
+	key *model.IdempotencyKey,
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.keys[key.ID] = *key
+	return nil
+}
+
+func (c *idempotencyKey) Release(
+	ctx context.Context,
+	id string,
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.keys, id)
+	return nil
+}
+
+func (c *idempotencyKey) DeleteExpired(
+	ctx context.Context,
+	t time.Time,
+	limit uint64,
+) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.deleteExpired(t, limit), nil
+}
+
+// deleteExpired deletes up to limit keys expired at t, or all of them when limit is 0.
+func (c *idempotencyKey) deleteExpired(t time.Time, limit uint64) int {
+	deleted := 0
+	for id, stored := range c.keys {
+		if limit > 0 && uint64(deleted) >= limit { //nolint:gosec
+			break
+		}
+		if stored.Expired(t) {
+			delete(c.keys, id)
+			deleted++
+		}
+	}
+	return deleted
+}
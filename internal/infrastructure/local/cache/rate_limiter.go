@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+)
+
+// rateLimiterSweepInterval is how often the keys whose allowance is replenished are dropped.
+const rateLimiterSweepInterval = time.Minute
+
+type rateLimiter struct {
+	mu  sync.Mutex
+	now func() time.Time
+	// tats are the theoretical arrival times of the next request, keyed by the rate limit key
+	tats      map[string]time.Time
+	nextSweep time.Time
+}
+
+// NewRateLimiter returns a RateLimiter counting in the process memory.
+// The counts are not shared between processes, so the limits apply to each process apart.
+func NewRateLimiter() cache.RateLimiter {
+	return &rateLimiter{
+		mu:        sync.Mutex{},
+		now:       now.Now,
+		tats:      map[string]time.Time{},
+		nextSweep: time.Time{},
+	}
+}
+
+func (l *rateLimiter) Allow(
+	ctx context.Context,
+	key string,
+	limit cache.RateLimit,
+) (*cache.RateLimitResult, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	interval := limit.Interval()
+	tat := l.tats[key]
+	if tat.Before(now) {
+		tat = now
+	}
+	newTAT := tat.Add(interval)
+	allowAt := newTAT.Add(-limit.Period)
+	if allowAt.After(now) {
+		return &cache.RateLimitResult{
+			Allowed:    false,
+			Remaining:  0,
+			RetryAfter: allowAt.Sub(now),
+			ResetAfter: tat.Sub(now),
+		}, nil
+	}
+	l.tats[key] = newTAT
+	return &cache.RateLimitResult{
+		Allowed:    true,
+		Remaining:  int(now.Sub(allowAt) / interval),
+		RetryAfter: 0,
+		ResetAfter: newTAT.Sub(now),
+	}, nil
+}
+
+// sweep drops the keys whose allowance is replenished, which count as new keys.
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Before(l.nextSweep) {
+		return
+	}
+	for key, tat := range l.tats {
+		if !tat.After(now) {
+			delete(l.tats, key)
+		}
+	}
+	l.nextSweep = now.Add(rateLimiterSweepInterval)
+}
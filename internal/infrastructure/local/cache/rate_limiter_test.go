@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	limit := cache.RateLimit{
+		Limit:  3,
+		Period: 3 * time.Second,
+	}
+	newRateLimiter := func(clock *time.Time) *rateLimiter {
+		l, ok := NewRateLimiter().(*rateLimiter)
+		require.True(t, ok)
+		l.now = func() time.Time { return *clock }
+		return l
+	}
+
+	tests := map[string]func(t *testing.T){
+		"the limit is allowed in a burst, then rejected until replenished": func(t *testing.T) {
+			ctx := context.Background()
+			clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			l := newRateLimiter(&clock)
+
+			for _, remaining := range []int{2, 1, 0} {
+				got, err := l.Allow(ctx, "key", limit)
+				require.NoError(t, err)
+				require.True(t, got.Allowed)
+				require.Equal(t, remaining, got.Remaining)
+			}
+
+			got, err := l.Allow(ctx, "key", limit)
+			require.NoError(t, err)
+			require.Equal(t, &cache.RateLimitResult{
+				Allowed:    false,
+				Remaining:  0,
+				RetryAfter: time.Second,
+				ResetAfter: 3 * time.Second,
+			}, got)
+
+			clock = clock.Add(time.Second)
+			got, err = l.Allow(ctx, "key", limit)
+			require.NoError(t, err)
+			require.Equal(t, &cache.RateLimitResult{
+				Allowed:    true,
+				Remaining:  0,
+				RetryAfter: 0,
+				ResetAfter: 3 * time.Second,
+			}, got)
+		},
+		"keys are counted apart": func(t *testing.T) {
+			ctx := context.Background()
+			clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			l := newRateLimiter(&clock)
+
+			for range limit.Limit {
+				_, err := l.Allow(ctx, "key", limit)
+				require.NoError(t, err)
+			}
+
+			got, err := l.Allow(ctx, "other", limit)
+			require.NoError(t, err)
+			require.True(t, got.Allowed)
+			require.Equal(t, 2, got.Remaining)
+		},
+		"replenished keys are swept": func(t *testing.T) {
+			ctx := context.Background()
+			clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			l := newRateLimiter(&clock)
+
+			_, err := l.Allow(ctx, "key", limit)
+			require.NoError(t, err)
+			require.Len(t, l.tats, 1)
+
+			clock = clock.Add(rateLimiterSweepInterval)
+			_, err = l.Allow(ctx, "other", limit)
+			require.NoError(t, err)
+			require.Len(t, l.tats, 1)
+			require.Contains(t, l.tats, "other")
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			tc(t)
+		})
+	}
+}
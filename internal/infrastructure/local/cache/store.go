@@ -0,0 +1,105 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+)
+
+type storeEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+type store struct {
+	mu       sync.Mutex
+	capacity int
+	// order holds the entries from the most recently used to the least recently used one
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+// NewStore returns a Store in the process memory, which evicts the least recently used entry
+// once it holds capacity entries.
+// Entries are not shared between processes, so it is meant for local development and tests.
+func NewStore(
+	capacity int,
+) cache.Store {
+	return &store{
+		mu:       sync.Mutex{},
+		capacity: capacity,
+		order:    list.New(),
+		entries:  map[string]*list.Element{},
+	}
+}
+
+func (s *store) Get(
+	ctx context.Context,
+	key string,
+) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := elem.Value.(*storeEntry)
+	if !now.Now().Before(entry.expiresAt) {
+		s.remove(elem)
+		return nil, false, nil
+	}
+	s.order.MoveToFront(elem)
+	return entry.value, true, nil
+}
+
+func (s *store) Set(
+	ctx context.Context,
+	key string,
+	value []byte,
+	ttl time.Duration,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := &storeEntry{
+		key:       key,
+		value:     value,
+		expiresAt: now.Now().Add(ttl),
+	}
+	if elem, ok := s.entries[key]; ok {
+		elem.Value = entry
+		s.order.MoveToFront(elem)
+		return nil
+	}
+	s.entries[key] = s.order.PushFront(entry)
+	for s.order.Len() > s.capacity {
+		s.remove(s.order.Back())
+	}
+	return nil
+}
+
+func (s *store) Delete(
+	ctx context.Context,
+	keys ...string,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		if elem, ok := s.entries[key]; ok {
+			s.remove(elem)
+		}
+	}
+	return nil
+}
+
+func (s *store) remove(elem *list.Element) {
+	s.order.Remove(elem)
+	delete(s.entries, elem.Value.(*storeEntry).key)
+}
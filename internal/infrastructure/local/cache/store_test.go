@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(t *testing.T){
+		"least recently used entry is evicted over capacity": func(t *testing.T) {
+			ctx := context.Background()
+			s := NewStore(2)
+			require.NoError(t, s.Set(ctx, "a", []byte("1"), time.Minute))
+			require.NoError(t, s.Set(ctx, "b", []byte("2"), time.Minute))
+			_, ok, err := s.Get(ctx, "a")
+			require.NoError(t, err)
+			require.True(t, ok)
+
+			require.NoError(t, s.Set(ctx, "c", []byte("3"), time.Minute))
+
+			_, ok, err = s.Get(ctx, "b")
+			require.NoError(t, err)
+			require.False(t, ok)
+			got, ok, err := s.Get(ctx, "a")
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Equal(t, []byte("1"), got)
+		},
+		"expired entry is missing": func(t *testing.T) {
+			ctx := context.Background()
+			s := NewStore(2)
+			require.NoError(t, s.Set(ctx, "a", []byte("1"), 0))
+			_, ok, err := s.Get(ctx, "a")
+			require.NoError(t, err)
+			require.False(t, ok)
+		},
+		"delete removes the keys": func(t *testing.T) {
+			ctx := context.Background()
+			s := NewStore(2)
+			require.NoError(t, s.Set(ctx, "a", []byte("1"), time.Minute))
+			require.NoError(t, s.Set(ctx, "b", []byte("2"), time.Minute))
+			require.NoError(t, s.Delete(ctx, "a", "b", "c"))
+			_, ok, err := s.Get(ctx, "a")
+			require.NoError(t, err)
+			require.False(t, ok)
+			_, ok, err = s.Get(ctx, "b")
+			require.NoError(t, err)
+			require.False(t, ok)
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			tc(t)
+		})
+	}
+}
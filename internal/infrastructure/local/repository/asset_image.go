@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"golang.org/x/image/draw"
+)
+
+const (
+	// maxImagePixels bounds the memory decoding an image takes,
+	// since a small compressed file can declare a huge canvas.
+	maxImagePixels = 50_000_000
+	jpegQuality    = 90
+)
+
+type assetImage struct{}
+
+// NewAssetImage returns an AssetImage processing the images in the process.
+// The images are encoded again from their pixels, which drops the EXIF and any other metadata.
+func NewAssetImage() repository.AssetImage {
+	return &assetImage{}
+}
+
+func (r *assetImage) Process(
+	ctx context.Context,
+	contentType model.ContentType,
+	data []byte,
+	variants []model.AssetImageVariant,
+) (*repository.ProcessedAssetImage, error) {
+	format := imageFormat(contentType)
+	config, detected, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || detected != format {
+		return nil, errors.AssetInvalidErr.New().
+			WithDetail("asset content does not match the content type").
+			WithValue("content_type", contentType.String()).
+			WithValue("detected_format", detected)
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, errors.AssetInvalidErr.New().
+			WithDetail("image is too large").
+			WithValue("width", config.Width).
+			WithValue("height", config.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.AssetInvalidErr.Wrap(err).
+			WithDetail("image is broken")
+	}
+	// The orientation is applied to the pixels, since it is dropped with the EXIF
+	if contentType == model.ContentTypeImageJPEG {
+		img = orient(img, jpegOrientation(data))
+	}
+
+	original, err := encodeImage(contentType, img)
+	if err != nil {
+		return nil, err
+	}
+	processed := &repository.ProcessedAssetImage{
+		Original: original,
+		Variants: make(map[string][]byte, len(variants)),
+	}
+	for _, variant := range variants {
+		encoded, err := encodeImage(contentType, scaleDown(img, variant.MaxDimension))
+		if err != nil {
+			return nil, err
+		}
+		processed.Variants[variant.Name] = encoded
+	}
+	return processed, nil
+}
+
+// imageFormat returns the format name the image package registers the decoder of contentType with.
+func imageFormat(contentType model.ContentType) string {
+	switch contentType {
+	case model.ContentTypeImagePNG:
+		return "png"
+	case model.ContentTypeImageJPEG:
+		return "jpeg"
+	default:
+		return ""
+	}
+}
+
+func encodeImage(
+	contentType model.ContentType,
+	img image.Image,
+) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch contentType {
+	case model.ContentTypeImagePNG:
+		err = png.Encode(&buf, img)
+	case model.ContentTypeImageJPEG:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	default:
+		return nil, errors.AssetInvalidErr.New().
+			WithDetail("content_type is not an image").
+			WithValue("content_type", contentType.String())
+	}
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
+
+// scaleDown returns img scaled to fit in a square of maxDimension pixels, keeping its aspect ratio.
+// An image already fitting is returned as is.
+func scaleDown(img image.Image, maxDimension int) image.Image {
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	if width <= maxDimension && height <= maxDimension {
+		return img
+	}
+	if width >= height {
+		height = max(height*maxDimension/width, 1)
+		width = maxDimension
+	} else {
+		width = max(width*maxDimension/height, 1)
+		height = maxDimension
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, img.Bounds(), draw.Src, nil)
+	return dst
+}
+
+// jpegOrientation returns the EXIF orientation of a JPEG, which is 1 when it has none.
+func jpegOrientation(data []byte) int {
+	const (
+		markerSOS  = 0xda
+		markerAPP1 = 0xe1
+	)
+	if len(data) < 2 || data[0] != 0xff || data[1] != 0xd8 {
+		return 1
+	}
+	for i := 2; i+4 <= len(data) && data[i] == 0xff; {
+		marker := data[i+1]
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if marker == markerSOS || i+2+length > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == markerAPP1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 1
+}
+
+// exifOrientation reads the orientation tag from the first IFD of a TIFF header.
+func exifOrientation(tiff []byte) int {
+	const tagOrientation = 0x0112
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 0 || offset+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := range count {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == tagOrientation {
+			if orientation := int(order.Uint16(tiff[entry+8:])); orientation >= 1 && orientation <= 8 {
+				return orientation
+			}
+			return 1
+		}
+	}
+	return 1
+}
+
+// orient returns img transformed as the EXIF orientation says it is displayed.
+func orient(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	dstWidth, dstHeight := width, height
+	// 5 to 8 rotate the image by 90 degrees
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := range dstHeight {
+		for x := range dstWidth {
+			var srcX, srcY int
+			switch orientation {
+			case 2: // flipped horizontally
+				srcX, srcY = width-1-x, y
+			case 3: // rotated by 180 degrees
+				srcX, srcY = width-1-x, height-1-y
+			case 4: // flipped vertically
+				srcX, srcY = x, height-1-y
+			case 5: // transposed
+				srcX, srcY = y, x
+			case 6: // rotated by 90 degrees clockwise
+				srcX, srcY = y, height-1-x
+			case 7: // transversed
+				srcX, srcY = width-1-y, height-1-x
+			case 8: // rotated by 90 degrees counterclockwise
+				srcX, srcY = width-1-y, x
+			}
+			dst.Set(x, y, img.At(bounds.Min.X+srcX, bounds.Min.Y+srcY))
+		}
+	}
+	return dst
+}
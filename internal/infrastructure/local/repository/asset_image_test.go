@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestImage(width, height int) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 0, A: 255}) //nolint:gosec // wraps around on purpose
+		}
+	}
+	return img
+}
+
+func encodeTestPNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// encodeTestJPEG encodes img with an EXIF segment holding orientation right after the SOI marker.
+func encodeTestJPEG(t *testing.T, img image.Image, orientation uint16) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	data := buf.Bytes()
+
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08\x00\x01")
+	tiff = binary.BigEndian.AppendUint16(tiff, 0x0112)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+
+	app1 := []byte{0xff, 0xe1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(segment)+2)) //nolint:gosec // the segment is small
+	app1 = append(app1, segment...)
+	return append(append(append([]byte{}, data[:2]...), app1...), data[2:]...)
+}
+
+func decodeTestImage(t *testing.T, data []byte) image.Config {
+	t.Helper()
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	require.NoError(t, err)
+	return config
+}
+
+func TestAssetImage_Process(t *testing.T) {
+	t.Parallel()
+
+	variants := []model.AssetImageVariant{
+		{Name: "small", MaxDimension: 32},
+		{Name: "large", MaxDimension: 1024},
+	}
+
+	tests := map[string]func(t *testing.T){
+		"png is scaled down keeping the aspect ratio": func(t *testing.T) {
+			got, err := NewAssetImage().Process(
+				context.Background(),
+				model.ContentTypeImagePNG,
+				encodeTestPNG(t, newTestImage(200, 100)),
+				variants,
+			)
+			require.NoError(t, err)
+			original := decodeTestImage(t, got.Original)
+			require.Equal(t, 200, original.Width)
+			require.Equal(t, 100, original.Height)
+			small := decodeTestImage(t, got.Variants["small"])
+			require.Equal(t, 32, small.Width)
+			require.Equal(t, 16, small.Height)
+			// a variant larger than the image is not scaled up
+			large := decodeTestImage(t, got.Variants["large"])
+			require.Equal(t, 200, large.Width)
+		},
+		"jpeg is rotated by its orientation and the exif is stripped": func(t *testing.T) {
+			data := encodeTestJPEG(t, newTestImage(60, 20), 6)
+			require.Equal(t, 6, jpegOrientation(data))
+
+			got, err := NewAssetImage().Process(
+				context.Background(),
+				model.ContentTypeImageJPEG,
+				data,
+				variants,
+			)
+			require.NoError(t, err)
+			require.NotContains(t, string(got.Original), "Exif")
+			require.Equal(t, 1, jpegOrientation(got.Original))
+			original := decodeTestImage(t, got.Original)
+			require.Equal(t, 20, original.Width)
+			require.Equal(t, 60, original.Height)
+			small := decodeTestImage(t, got.Variants["small"])
+			require.Equal(t, 10, small.Width)
+			require.Equal(t, 32, small.Height)
+		},
+		"png declared as jpeg is invalid": func(t *testing.T) {
+			_, err := NewAssetImage().Process(
+				context.Background(),
+				model.ContentTypeImageJPEG,
+				encodeTestPNG(t, newTestImage(10, 10)),
+				variants,
+			)
+			require.ErrorIs(t, err, errors.AssetInvalidErr)
+		},
+		"bytes not being an image are invalid": func(t *testing.T) {
+			_, err := NewAssetImage().Process(
+				context.Background(),
+				model.ContentTypeImagePNG,
+				[]byte("<html></html>"),
+				variants,
+			)
+			require.ErrorIs(t, err, errors.AssetInvalidErr)
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			tc(t)
+		})
+	}
+}
+
+func TestOrient(t *testing.T) {
+	t.Parallel()
+
+	// a 2x1 image of a red pixel on the left of a blue one
+	red := color.NRGBA{R: 255, G: 0, B: 0, A: 255}
+	blue := color.NRGBA{R: 0, G: 0, B: 255, A: 255}
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, red)
+	img.Set(1, 0, blue)
+
+	tests := map[string]struct {
+		orientation int
+		// want holds the pixels of the oriented image row by row
+		want [][]color.NRGBA
+	}{
+		"normal":                      {orientation: 1, want: [][]color.NRGBA{{red, blue}}},
+		"flipped horizontally":        {orientation: 2, want: [][]color.NRGBA{{blue, red}}},
+		"rotated by 180 degrees":      {orientation: 3, want: [][]color.NRGBA{{blue, red}}},
+		"flipped vertically":          {orientation: 4, want: [][]color.NRGBA{{red, blue}}},
+		"transposed":                  {orientation: 5, want: [][]color.NRGBA{{red}, {blue}}},
+		"rotated clockwise":           {orientation: 6, want: [][]color.NRGBA{{red}, {blue}}},
+		"transversed":                 {orientation: 7, want: [][]color.NRGBA{{blue}, {red}}},
+		"rotated counterclockwise":    {orientation: 8, want: [][]color.NRGBA{{blue}, {red}}},
+		"unknown orientation is kept": {orientation: 9, want: [][]color.NRGBA{{red, blue}}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got := orient(img, tc.orientation)
+			require.Equal(t, len(tc.want), got.Bounds().Dy())
+			for y, row := range tc.want {
+				require.Equal(t, len(row), got.Bounds().Dx())
+				for x, want := range row {
+					require.Equal(t, want, color.NRGBAModel.Convert(got.At(x, y)))
+				}
+			}
+		})
+	}
+}
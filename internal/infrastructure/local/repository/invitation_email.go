@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type invitationEmail struct{}
+
+// NewInvitationEmail returns an InvitationEmail that only writes the email to the log.
+// It is meant for local development, where the token is picked up from the log.
+func NewInvitationEmail() repository.InvitationEmail {
+	return &invitationEmail{}
+}
+
+func (r *invitationEmail) Send(
+	ctx context.Context,
+	param repository.InvitationEmailSendParam,
+) error {
+	logger.L(ctx).Info(
+		"send invitation email",
+		zap.String("email", param.Email),
+		zap.String("tenant_name", param.TenantName),
+		zap.String("role", param.Role.String()),
+		zap.String("token", param.Token),
+		zap.Time("expires_at", param.ExpiresAt),
+	)
+	return nil
+}
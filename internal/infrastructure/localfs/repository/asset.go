@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	goerrors "errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/localfs"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+)
+
+const (
+	// urlRoundingDuration is the time rounding unit for presigned URL cache optimization
+	urlRoundingDuration = 5 * time.Minute
+)
+
+type asset struct {
+	storage *localfs.Storage
+}
+
+// NewAsset returns an Asset storing the objects in the local filesystem,
+// which is uploaded to and read from through the signed URLs of the file handler.
+func NewAsset(
+	storage *localfs.Storage,
+) repository.Asset {
+	return &asset{
+		storage: storage,
+	}
+}
+
+func (r *asset) GenerateWritePresignedURL(
+	ctx context.Context,
+	asset *model.Asset,
+) (*model.AssetPresignedUpload, error) {
+	contentType := asset.ContentType.String()
+	signedURL := r.storage.Sign(localfs.SignedURL{
+		Method:      http.MethodPut,
+		Path:        asset.Path,
+		ExpiresAt:   now.Now().Add(asset.Expiration()),
+		ContentType: contentType,
+		MaxSize:     asset.Type.Policy().MaxSize,
+	})
+	return &model.AssetPresignedUpload{
+		Method: http.MethodPut,
+		URL:    signedURL,
+		Fields: map[string]string{},
+		Headers: map[string]string{
+			"Content-Type": contentType,
+		},
+	}, nil
+}
+
+func (r *asset) GenerateReadURL(
+	ctx context.Context,
+	path string,
+	requestTime time.Time,
+) (string, error) {
+	// Public: served without signing, like the public bucket
+	if strings.HasPrefix(path, "public/") {
+		return r.storage.URL(path), nil
+	}
+
+	// Private: round request time to 5-minute intervals so same URL is generated within window
+	roundedTime := requestTime.Truncate(urlRoundingDuration)
+	return r.storage.Sign(localfs.SignedURL{
+		Method:    http.MethodGet,
+		Path:      path,
+		ExpiresAt: roundedTime.Add(urlRoundingDuration * 2),
+	}), nil
+}
+
+func (r *asset) Head(
+	ctx context.Context,
+	path string,
+) (*model.AssetObject, error) {
+	size, ok, err := r.storage.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &model.AssetObject{
+		Path: path,
+		Size: size,
+	}, nil
+}
+
+func (r *asset) NewReader(
+	ctx context.Context,
+	path string,
+) (io.ReadCloser, error) {
+	f, err := r.storage.Open(path)
+	if err != nil {
+		if goerrors.Is(err, fs.ErrNotExist) {
+			return nil, errors.AssetNotFoundErr.New().
+				WithValue("path", path)
+		}
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return f, nil
+}
+
+func (r *asset) NewWriter(
+	ctx context.Context,
+	path string,
+	contentType model.ContentType,
+) (io.WriteCloser, error) {
+	// The file handler serves a file with the content type detected from its extension
+	w, err := r.storage.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return &fileWriter{
+		ctx: ctx,
+		w:   w,
+	}, nil
+}
+
+func (r *asset) Delete(
+	ctx context.Context,
+	path string,
+) error {
+	return r.storage.Remove(path)
+}
+
+// fileWriter commits the file on close unless ctx has been cancelled,
+// which abandons the write like the writers of the buckets.
+type fileWriter struct {
+	ctx context.Context
+	w   *localfs.FileWriter
+}
+
+func (w *fileWriter) Write(p []byte) (int, error) {
+	return w.w.Write(p)
+}
+
+func (w *fileWriter) Close() error {
+	if err := w.ctx.Err(); err != nil {
+		_ = w.w.Abort()
+		return errors.InternalErr.Wrap(err)
+	}
+	return w.w.Commit()
+}
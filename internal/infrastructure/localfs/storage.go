@@ -0,0 +1,244 @@
+package localfs
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+)
+
+// URLPrefix is the path the file handler is mounted at on the HTTP server.
+const URLPrefix = "/localfs/"
+
+const (
+	queryExpires     = "expires"
+	queryContentType = "content_type"
+	queryMaxSize     = "max_size"
+	querySignature   = "signature"
+)
+
+var (
+	// ErrInvalidSignature is returned for a URL not signed by the storage, or signed for another method.
+	ErrInvalidSignature = goerrors.New("localfs: invalid signature")
+	// ErrExpired is returned for a signed URL used after its expiry.
+	ErrExpired = goerrors.New("localfs: signed url expired")
+)
+
+// Storage stores the objects of the assets as files under a directory,
+// and signs the URLs the file handler serves them at in place of the presigned URLs of a bucket.
+// It is meant for local development and tests, where no storage emulator is running.
+type Storage struct {
+	dir        string
+	signingKey []byte
+	baseURL    string
+}
+
+func NewStorage(
+	dir string,
+	signingKey string,
+	baseURL string,
+) *Storage {
+	return &Storage{
+		dir:        dir,
+		signingKey: []byte(signingKey),
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// SignedURL is the URL a request signed by the storage is made to,
+// with the conditions the file handler enforces on it.
+type SignedURL struct {
+	Method    string
+	Path      string
+	ExpiresAt time.Time
+	// ContentType and MaxSize are only set for a write.
+	ContentType string
+	MaxSize     int64
+}
+
+// Sign returns the absolute URL of the file handler for u.
+func (s *Storage) Sign(u SignedURL) string {
+	query := url.Values{}
+	query.Set(queryExpires, strconv.FormatInt(u.ExpiresAt.Unix(), 10))
+	if u.ContentType != "" {
+		query.Set(queryContentType, u.ContentType)
+		query.Set(queryMaxSize, strconv.FormatInt(u.MaxSize, 10))
+	}
+	query.Set(querySignature, s.signature(u))
+	return fmt.Sprintf("%s?%s", s.URL(u.Path), query.Encode())
+}
+
+// URL returns the absolute URL of the file handler for path, without a signature.
+func (s *Storage) URL(path string) string {
+	return fmt.Sprintf("%s%s%s", s.baseURL, URLPrefix, path)
+}
+
+// Verify returns the signed URL of a request made with method to path at t.
+func (s *Storage) Verify(
+	method string,
+	path string,
+	query url.Values,
+	t time.Time,
+) (*SignedURL, error) {
+	expires, err := strconv.ParseInt(query.Get(queryExpires), 10, 64)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	u := SignedURL{
+		Method:      method,
+		Path:        path,
+		ExpiresAt:   time.Unix(expires, 0),
+		ContentType: query.Get(queryContentType),
+	}
+	if u.ContentType != "" {
+		if u.MaxSize, err = strconv.ParseInt(query.Get(queryMaxSize), 10, 64); err != nil {
+			return nil, ErrInvalidSignature
+		}
+	}
+	if !hmac.Equal([]byte(query.Get(querySignature)), []byte(s.signature(u))) {
+		return nil, ErrInvalidSignature
+	}
+	if !t.Before(u.ExpiresAt) {
+		return nil, ErrExpired
+	}
+	return &u, nil
+}
+
+func (s *Storage) signature(u SignedURL) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	_, _ = fmt.Fprintf(mac, "%s\n%s\n%d\n%s\n%d", u.Method, u.Path, u.ExpiresAt.Unix(), u.ContentType, u.MaxSize)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Write stores r at path, replacing the file there once r is fully read.
+// The error of reading r is returned as is.
+func (s *Storage) Write(
+	path string,
+	r io.Reader,
+) (int64, error) {
+	w, err := s.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	size, err := io.Copy(w, r)
+	if err != nil {
+		_ = w.Abort()
+		return 0, err
+	}
+	if err := w.Commit(); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// Create returns a writer to a temporary file, which replaces the file at path once committed.
+func (s *Storage) Create(path string) (*FileWriter, error) {
+	name, err := s.name(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return &FileWriter{
+		f:    f,
+		name: name,
+	}, nil
+}
+
+// FileWriter writes a file, which is only visible at its path once committed.
+type FileWriter struct {
+	f    *os.File
+	name string
+}
+
+func (w *FileWriter) Write(p []byte) (int, error) {
+	n, err := w.f.Write(p)
+	if err != nil {
+		return n, errors.InternalErr.Wrap(err)
+	}
+	return n, nil
+}
+
+// Commit replaces the file at the path with the written one.
+func (w *FileWriter) Commit() error {
+	defer os.Remove(w.f.Name())
+	if err := w.f.Close(); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	if err := os.Rename(w.f.Name(), w.name); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+// Abort discards the written file.
+func (w *FileWriter) Abort() error {
+	_ = w.f.Close()
+	if err := os.Remove(w.f.Name()); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+// Open returns the file at path, or fs.ErrNotExist.
+func (s *Storage) Open(path string) (*os.File, error) {
+	name, err := s.name(path)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(name)
+}
+
+// Stat returns the size of the file at path, or false when there is none.
+func (s *Storage) Stat(path string) (int64, bool, error) {
+	name, err := s.name(path)
+	if err != nil {
+		return 0, false, err
+	}
+	info, err := os.Stat(name)
+	if err != nil {
+		if goerrors.Is(err, fs.ErrNotExist) {
+			return 0, false, nil
+		}
+		return 0, false, errors.InternalErr.Wrap(err)
+	}
+	return info.Size(), true, nil
+}
+
+// Remove removes the file at path, succeeding when there is none.
+func (s *Storage) Remove(path string) error {
+	name, err := s.name(path)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil && !goerrors.Is(err, fs.ErrNotExist) {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+// name returns the file name of path, which must stay under the directory.
+func (s *Storage) name(path string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(path)) {
+		return "", errors.AssetInvalidErr.New().
+			WithDetail("path is invalid").
+			WithValue("path", path)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(path)), nil
+}
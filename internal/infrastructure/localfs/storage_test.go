@@ -0,0 +1,111 @@
+package localfs
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_Verify(t *testing.T) {
+	t.Parallel()
+
+	signedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	signedURL := SignedURL{
+		Method:      http.MethodPut,
+		Path:        "private/user_images/a.png",
+		ExpiresAt:   signedAt.Add(15 * time.Minute),
+		ContentType: "image/png",
+		MaxSize:     1024,
+	}
+	query := func(t *testing.T, s *Storage) url.Values {
+		u, err := url.Parse(s.Sign(signedURL))
+		require.NoError(t, err)
+		require.Equal(t, "/localfs/private/user_images/a.png", u.Path)
+		return u.Query()
+	}
+
+	tests := map[string]func(t *testing.T){
+		"signed url is verified": func(t *testing.T) {
+			s := NewStorage(t.TempDir(), "key", "http://localhost:8080/")
+			got, err := s.Verify(http.MethodPut, signedURL.Path, query(t, s), signedAt)
+			require.NoError(t, err)
+			require.Equal(t, signedURL.ContentType, got.ContentType)
+			require.Equal(t, signedURL.MaxSize, got.MaxSize)
+		},
+		"expired": func(t *testing.T) {
+			s := NewStorage(t.TempDir(), "key", "http://localhost:8080")
+			_, err := s.Verify(http.MethodPut, signedURL.Path, query(t, s), signedURL.ExpiresAt)
+			require.ErrorIs(t, err, ErrExpired)
+		},
+		"another method": func(t *testing.T) {
+			s := NewStorage(t.TempDir(), "key", "http://localhost:8080")
+			_, err := s.Verify(http.MethodGet, signedURL.Path, query(t, s), signedAt)
+			require.ErrorIs(t, err, ErrInvalidSignature)
+		},
+		"another path": func(t *testing.T) {
+			s := NewStorage(t.TempDir(), "key", "http://localhost:8080")
+			_, err := s.Verify(http.MethodPut, "private/user_images/b.png", query(t, s), signedAt)
+			require.ErrorIs(t, err, ErrInvalidSignature)
+		},
+		"tampered max size": func(t *testing.T) {
+			s := NewStorage(t.TempDir(), "key", "http://localhost:8080")
+			q := query(t, s)
+			q.Set("max_size", "2048")
+			_, err := s.Verify(http.MethodPut, signedURL.Path, q, signedAt)
+			require.ErrorIs(t, err, ErrInvalidSignature)
+		},
+		"signed with another key": func(t *testing.T) {
+			q := query(t, NewStorage(t.TempDir(), "another", "http://localhost:8080"))
+			_, err := NewStorage(t.TempDir(), "key", "http://localhost:8080").Verify(http.MethodPut, signedURL.Path, q, signedAt)
+			require.ErrorIs(t, err, ErrInvalidSignature)
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			tc(t)
+		})
+	}
+}
+
+func TestStorage_Write(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(t *testing.T){
+		"written file is stat and removed": func(t *testing.T) {
+			s := NewStorage(t.TempDir(), "key", "http://localhost:8080")
+			size, err := s.Write("private/user_images/a.png", strings.NewReader("png"))
+			require.NoError(t, err)
+			require.Equal(t, int64(3), size)
+
+			got, ok, err := s.Stat("private/user_images/a.png")
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Equal(t, int64(3), got)
+
+			require.NoError(t, s.Remove("private/user_images/a.png"))
+			require.NoError(t, s.Remove("private/user_images/a.png"))
+			_, ok, err = s.Stat("private/user_images/a.png")
+			require.NoError(t, err)
+			require.False(t, ok)
+		},
+		"path out of the directory is rejected": func(t *testing.T) {
+			s := NewStorage(t.TempDir(), "key", "http://localhost:8080")
+			_, err := s.Write("../a.png", strings.NewReader("png"))
+			require.ErrorIs(t, err, errors.AssetInvalidErr)
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			tc(t)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"context"
+	goerrors "errors"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	cacheResultHit   = "hit"
+	cacheResultMiss  = "miss"
+	cacheResultError = "error"
+)
+
+var assetPathCacheRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{ //nolint:exhaustruct
+	Namespace: namespace,
+	Subsystem: "asset_path_cache",
+	Name:      "requests_total",
+	Help:      "Total number of lookups of the asset path cache, by backend and result (hit, miss or error).",
+}, []string{"backend", "result"})
+
+type assetPath struct {
+	cache.AssetPath
+	backend string
+}
+
+// NewAssetPath returns c counting its lookups by hit and miss.
+// backend is the label of the series, e.g. the CACHE_BACKEND of c.
+func NewAssetPath(
+	c cache.AssetPath,
+	backend string,
+) cache.AssetPath {
+	return &assetPath{
+		AssetPath: c,
+		backend:   backend,
+	}
+}
+
+func (c *assetPath) Get(
+	ctx context.Context,
+	id string,
+	authContext model.AssetAuthContext,
+) (string, error) {
+	path, err := c.AssetPath.Get(ctx, id, authContext)
+	switch {
+	case err == nil:
+		assetPathCacheRequestsTotal.WithLabelValues(c.backend, cacheResultHit).Inc()
+	case goerrors.Is(err, errors.AssetNotFoundErr):
+		assetPathCacheRequestsTotal.WithLabelValues(c.backend, cacheResultMiss).Inc()
+	default:
+		assetPathCacheRequestsTotal.WithLabelValues(c.backend, cacheResultError).Inc()
+	}
+	return path, err
+}
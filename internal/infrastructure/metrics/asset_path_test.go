@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	mock_cache "github.com/abyssparanoia/rapid-go/internal/domain/cache/mock"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAssetPath_Get(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		err        error
+		wantResult string
+	}{
+		"hit": {
+			err:        nil,
+			wantResult: cacheResultHit,
+		},
+		"miss": {
+			err:        errors.AssetNotFoundErr.New(),
+			wantResult: cacheResultMiss,
+		},
+		"error": {
+			err:        errors.InternalErr.Wrap(fmt.Errorf("connection refused")),
+			wantResult: cacheResultError,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+			authContext := model.NewStaffAssetAuthContext("staffID")
+
+			// the backend is unique to the case, so that the cases running in parallel count apart
+			backend := "test_" + name
+			mockAssetPath := mock_cache.NewMockAssetPath(ctrl)
+			mockAssetPath.EXPECT().
+				Get(gomock.Any(), "assetID", authContext).
+				Return("path", tc.err)
+
+			c := NewAssetPath(mockAssetPath, backend)
+			_, err := c.Get(ctx, "assetID", authContext)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			for _, result := range []string{cacheResultHit, cacheResultMiss, cacheResultError} {
+				want := 0.0
+				if result == tc.wantResult {
+					want = 1
+				}
+				metric := &dto.Metric{}
+				require.NoError(t, assetPathCacheRequestsTotal.WithLabelValues(backend, result).Write(metric))
+				require.InDelta(t, want, metric.GetCounter().GetValue(), 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	verificationResultValid   = "valid"
+	verificationResultInvalid = "invalid"
+)
+
+var tokenVerificationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{ //nolint:exhaustruct
+	Namespace: namespace,
+	Subsystem: "auth",
+	Name:      "token_verification_seconds",
+	Help:      "Latency of the verification of the ID tokens, by role and result (valid or invalid).",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"role", "result"})
+
+func observeTokenVerification(role string, start time.Time, err error) {
+	result := verificationResultValid
+	if err != nil {
+		result = verificationResultInvalid
+	}
+	tokenVerificationSeconds.WithLabelValues(role, result).Observe(time.Since(start).Seconds())
+}
+
+type staffAuthentication struct {
+	repository.StaffAuthentication
+}
+
+// NewStaffAuthentication returns r observing the latency of its ID token verification.
+func NewStaffAuthentication(
+	r repository.StaffAuthentication,
+) repository.StaffAuthentication {
+	return &staffAuthentication{
+		StaffAuthentication: r,
+	}
+}
+
+func (r *staffAuthentication) VerifyIDToken(
+	ctx context.Context,
+	idToken string,
+) (*model.StaffClaims, error) {
+	start := time.Now()
+	claims, err := r.StaffAuthentication.VerifyIDToken(ctx, idToken)
+	observeTokenVerification("staff", start, err)
+	return claims, err
+}
+
+type adminAuthentication struct {
+	repository.AdminAuthentication
+}
+
+// NewAdminAuthentication returns r observing the latency of its ID token verification.
+func NewAdminAuthentication(
+	r repository.AdminAuthentication,
+) repository.AdminAuthentication {
+	return &adminAuthentication{
+		AdminAuthentication: r,
+	}
+}
+
+func (r *adminAuthentication) VerifyIDToken(
+	ctx context.Context,
+	idToken string,
+) (*model.AdminClaims, error) {
+	start := time.Now()
+	claims, err := r.AdminAuthentication.VerifyIDToken(ctx, idToken)
+	observeTokenVerification("admin", start, err)
+	return claims, err
+}
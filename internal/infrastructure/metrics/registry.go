@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes the names of the series of this application.
+const namespace = "rapid"
+
+// registry holds the series exposed by Handler, along with the runtime of the process.
+var registry = newRegistry()
+
+func newRegistry() *prometheus.Registry {
+	r := prometheus.NewRegistry()
+	r.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}), //nolint:exhaustruct
+		rpcHandledTotal,
+		rpcHandlingSeconds,
+		transactionRetriesTotal,
+		assetPathCacheRequestsTotal,
+		tokenVerificationSeconds,
+	)
+	return r
+}
+
+// Handler serves the series in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{}) //nolint:exhaustruct
+}
+
+// RegisterDBStats exposes the sql.DBStats of db, such as the open and idle connections of the pool.
+// dbName tells the pools apart, and must be unique in the process.
+func RegisterDBStats(db *sql.DB, dbName string) {
+	registry.MustRegister(collectors.NewDBStatsCollector(db, dbName))
+}
@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	rpcHandledTotal = prometheus.NewCounterVec(prometheus.CounterOpts{ //nolint:exhaustruct
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "handled_total",
+		Help:      "Total number of RPCs completed on the server, by method and gRPC code.",
+	}, []string{"method", "code"})
+	rpcHandlingSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{ //nolint:exhaustruct
+		Namespace: namespace,
+		Subsystem: "grpc_server",
+		Name:      "handling_seconds",
+		Help:      "Latency of the RPCs completed on the server, by method and gRPC code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+)
+
+// ObserveRPC records an RPC of fullMethod completed with code after duration.
+func ObserveRPC(fullMethod string, code codes.Code, duration time.Duration) {
+	rpcHandledTotal.WithLabelValues(fullMethod, code.String()).Inc()
+	rpcHandlingSeconds.WithLabelValues(fullMethod, code.String()).Observe(duration.Seconds())
+}
@@ -0,0 +1,20 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var transactionRetriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{ //nolint:exhaustruct
+	Namespace: namespace,
+	Subsystem: "database",
+	Name:      "transaction_retries_total",
+	Help:      "Total number of transactions retried after a transient error, by database system.",
+}, []string{"db_system"})
+
+// AddTransactionRetries records the retries of a transaction, which ran attempts times in total.
+func AddTransactionRetries(dbSystem string, attempts int) {
+	if attempts <= 1 {
+		return
+	}
+	transactionRetriesTotal.WithLabelValues(dbSystem).Add(float64(attempts - 1))
+}
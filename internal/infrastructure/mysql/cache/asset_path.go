@@ -2,19 +2,20 @@ package cache
 
 import (
 	"context"
+	"database/sql"
 
-	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
 	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/dbmodel"
-	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/marshaller"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/transactable"
 )
 
 type assetPath struct{}
 
+// NewAssetPath returns an AssetPath reading the assets table, which already holds the status of the assets,
+// so there is nothing to set or clear.
 func NewAssetPath() cache.AssetPath {
 	return &assetPath{}
 }
@@ -27,10 +28,16 @@ func (c *assetPath) Get(
 	mods := []qm.QueryMod{}
 	mods = append(mods, dbmodel.AssetWhere.ID.EQ(id))
 	mods = append(mods, dbmodel.AssetWhere.AuthContext.EQ(authContext.String()))
+	mods = append(mods, dbmodel.AssetWhere.Status.EQ(model.AssetStatusUploaded.String()))
 	dbAsset, err := dbmodel.Assets(
 		mods...,
 	).One(ctx, transactable.GetContextExecutor(ctx))
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", errors.AssetNotFoundErr.New().
+				WithDetail("asset path is not found").
+				WithValue("asset_id", id)
+		}
 		return "", errors.InternalErr.Wrap(err)
 	}
 	return dbAsset.Path, nil
@@ -40,10 +47,6 @@ func (c *assetPath) Set(
 	ctx context.Context,
 	asset *model.Asset,
 ) error {
-	dst := marshaller.AssetToDBModel(asset)
-	if err := dst.Insert(ctx, transactable.GetContextExecutor(ctx), boil.Infer()); err != nil {
-		return errors.InternalErr.Wrap(err)
-	}
 	return nil
 }
 
@@ -51,11 +54,5 @@ func (c *assetPath) Clear(
 	ctx context.Context,
 	id string,
 ) error {
-	dst := &dbmodel.Asset{ //nolint:exhaustruct
-		ID: id,
-	}
-	if _, err := dst.Delete(ctx, transactable.GetContextExecutor(ctx)); err != nil {
-		return errors.InternalErr.Wrap(err)
-	}
 	return nil
 }
@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	// the database of sqlboiler is global, so the cases do not run in parallel
+
+	requestTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{"id", "fingerprint", "response", "expires_at", "created_at", "updated_at"}
+	newKey := func() *model.IdempotencyKey {
+		return model.NewIdempotencyKey("id", "fingerprint", requestTime)
+	}
+
+	tests := map[string]func(t *testing.T, mock sqlmock.Sqlmock){
+		"new key is claimed after the expired one is deleted": func(t *testing.T, mock sqlmock.Sqlmock) {
+			key := newKey()
+			mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `idempotency_keys` WHERE (`idempotency_keys`.`id` = ?) AND (`idempotency_keys`.`expires_at` <= ?)")).
+				WithArgs(key.ID, requestTime).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `idempotency_keys`")).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			got, claimed, err := NewIdempotencyKey().Claim(context.Background(), key, requestTime)
+			require.NoError(t, err)
+			require.True(t, claimed)
+			require.Equal(t, key, got)
+		},
+		"stored key is returned on a duplicate entry": func(t *testing.T, mock sqlmock.Sqlmock) {
+			key := newKey()
+			stored := newKey()
+			stored.Complete([]byte("response"), time.Hour, requestTime)
+			mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `idempotency_keys`")).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `idempotency_keys`")).
+				WillReturnError(&mysql.MySQLError{Number: mysqlErrDuplicateEntry}) //nolint:exhaustruct
+			mock.ExpectQuery(regexp.QuoteMeta("select * from `idempotency_keys` where `id`=?")).
+				WithArgs(key.ID).
+				WillReturnRows(sqlmock.NewRows(columns).AddRow(
+					stored.ID, stored.Fingerprint, stored.Response, stored.ExpiresAt, stored.CreatedAt, stored.UpdatedAt,
+				))
+
+			got, claimed, err := NewIdempotencyKey().Claim(context.Background(), key, requestTime)
+			require.NoError(t, err)
+			require.False(t, claimed)
+			require.Equal(t, stored, got)
+		},
+		"response is stored on complete": func(t *testing.T, mock sqlmock.Sqlmock) {
+			key := newKey()
+			key.Complete([]byte("response"), time.Hour, requestTime)
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE `idempotency_keys` SET")).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			require.NoError(t, NewIdempotencyKey().Complete(context.Background(), key))
+		},
+		"released key is deleted": func(t *testing.T, mock sqlmock.Sqlmock) {
+			mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `idempotency_keys` WHERE (`idempotency_keys`.`id` = ?)")).
+				WithArgs("id").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			require.NoError(t, NewIdempotencyKey().Release(context.Background(), "id"))
+		},
+		"expired keys are deleted up to the limit": func(t *testing.T, mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `idempotency_keys` WHERE (`idempotency_keys`.`expires_at` <= ?) LIMIT 2")).
+				WithArgs(requestTime).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("id1").AddRow("id2"))
+			mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `idempotency_keys` WHERE (`idempotency_keys`.`id` IN (?,?))")).
+				WithArgs("id1", "id2").
+				WillReturnResult(sqlmock.NewResult(0, 2))
+
+			deleted, err := NewIdempotencyKey().DeleteExpired(context.Background(), requestTime, 2)
+			require.NoError(t, err)
+			require.Equal(t, 2, deleted)
+		},
+		"nothing is deleted without expired keys": func(t *testing.T, mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `idempotency_keys`")).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			deleted, err := NewIdempotencyKey().DeleteExpired(context.Background(), requestTime, 2)
+			require.NoError(t, err)
+			require.Equal(t, 0, deleted)
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func() { _ = db.Close() }()
+			boil.SetDB(db)
+
+			tc(t, mock)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,1534 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodel
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// AuditLogActorType is an object representing the database table.
+type AuditLogActorType struct {
+	// id
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+
+	R *auditLogActorTypeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L auditLogActorTypeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AuditLogActorTypeColumns = struct {
+	ID string
+}{
+	ID: "id",
+}
+
+var AuditLogActorTypeTableColumns = struct {
+	ID string
+}{
+	ID: "audit_log_actor_types.id",
+}
+
+// Generated where
+
+var AuditLogActorTypeWhere = struct {
+	ID whereHelperstring
+}{
+	ID: whereHelperstring{field: "`audit_log_actor_types`.`id`"},
+}
+
+// AuditLogActorTypeRels is where relationship names are stored.
+var AuditLogActorTypeRels = struct {
+	ActorTypeAuditLogs string
+}{
+	ActorTypeAuditLogs: "ActorTypeAuditLogs",
+}
+
+// auditLogActorTypeR is where relationships are stored.
+type auditLogActorTypeR struct {
+	ActorTypeAuditLogs AuditLogSlice `boil:"ActorTypeAuditLogs" json:"ActorTypeAuditLogs" toml:"ActorTypeAuditLogs" yaml:"ActorTypeAuditLogs"`
+}
+
+// NewStruct creates a new relationship struct
+func (*auditLogActorTypeR) NewStruct() *auditLogActorTypeR {
+	return &auditLogActorTypeR{}
+}
+
+func (o *AuditLogActorType) GetActorTypeAuditLogs() AuditLogSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetActorTypeAuditLogs()
+}
+
+func (r *auditLogActorTypeR) GetActorTypeAuditLogs() AuditLogSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ActorTypeAuditLogs
+}
+
+// auditLogActorTypeL is where Load methods for each relationship are stored.
+type auditLogActorTypeL struct{}
+
+var (
+	auditLogActorTypeAllColumns            = []string{"id"}
+	auditLogActorTypeColumnsWithoutDefault = []string{"id"}
+	auditLogActorTypeColumnsWithDefault    = []string{}
+	auditLogActorTypePrimaryKeyColumns     = []string{"id"}
+	auditLogActorTypeGeneratedColumns      = []string{}
+)
+
+type (
+	// AuditLogActorTypeSlice is an alias for a slice of pointers to AuditLogActorType.
+	// This should almost always be used instead of []AuditLogActorType.
+	AuditLogActorTypeSlice []*AuditLogActorType
+
+	auditLogActorTypeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	auditLogActorTypeType                 = reflect.TypeOf(&AuditLogActorType{})
+	auditLogActorTypeMapping              = queries.MakeStructMapping(auditLogActorTypeType)
+	auditLogActorTypePrimaryKeyMapping, _ = queries.BindMapping(auditLogActorTypeType, auditLogActorTypeMapping, auditLogActorTypePrimaryKeyColumns)
+	auditLogActorTypeInsertCacheMut       sync.RWMutex
+	auditLogActorTypeInsertCache          = make(map[string]insertCache)
+	auditLogActorTypeUpdateCacheMut       sync.RWMutex
+	auditLogActorTypeUpdateCache          = make(map[string]updateCache)
+	auditLogActorTypeUpsertCacheMut       sync.RWMutex
+	auditLogActorTypeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single auditLogActorType record from the query using the global executor.
+func (q auditLogActorTypeQuery) OneG(ctx context.Context) (*AuditLogActorType, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// OneGP returns a single auditLogActorType record from the query using the global executor, and panics on error.
+func (q auditLogActorTypeQuery) OneGP(ctx context.Context) *AuditLogActorType {
+	o, err := q.One(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single auditLogActorType record from the query, and panics on error.
+func (q auditLogActorTypeQuery) OneP(ctx context.Context, exec boil.ContextExecutor) *AuditLogActorType {
+	o, err := q.One(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single auditLogActorType record from the query.
+func (q auditLogActorTypeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AuditLogActorType, error) {
+	o := &AuditLogActorType{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: failed to execute a one query for audit_log_actor_types")
+	}
+
+	return o, nil
+}
+
+// AllG returns all AuditLogActorType records from the query using the global executor.
+func (q auditLogActorTypeQuery) AllG(ctx context.Context) (AuditLogActorTypeSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// AllGP returns all AuditLogActorType records from the query using the global executor, and panics on error.
+func (q auditLogActorTypeQuery) AllGP(ctx context.Context) AuditLogActorTypeSlice {
+	o, err := q.All(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all AuditLogActorType records from the query, and panics on error.
+func (q auditLogActorTypeQuery) AllP(ctx context.Context, exec boil.ContextExecutor) AuditLogActorTypeSlice {
+	o, err := q.All(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all AuditLogActorType records from the query.
+func (q auditLogActorTypeQuery) All(ctx context.Context, exec boil.ContextExecutor) (AuditLogActorTypeSlice, error) {
+	var o []*AuditLogActorType
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodel: failed to assign all query results to AuditLogActorType slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AuditLogActorType records in the query using the global executor
+func (q auditLogActorTypeQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// CountGP returns the count of all AuditLogActorType records in the query using the global executor, and panics on error.
+func (q auditLogActorTypeQuery) CountGP(ctx context.Context) int64 {
+	c, err := q.Count(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all AuditLogActorType records in the query, and panics on error.
+func (q auditLogActorTypeQuery) CountP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	c, err := q.Count(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all AuditLogActorType records in the query.
+func (q auditLogActorTypeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to count audit_log_actor_types rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q auditLogActorTypeQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q auditLogActorTypeQuery) ExistsGP(ctx context.Context) bool {
+	e, err := q.Exists(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q auditLogActorTypeQuery) ExistsP(ctx context.Context, exec boil.ContextExecutor) bool {
+	e, err := q.Exists(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q auditLogActorTypeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: failed to check if audit_log_actor_types exists")
+	}
+
+	return count > 0, nil
+}
+
+// ActorTypeAuditLogs retrieves all the audit_log's AuditLogs with an executor via actor_type column.
+func (o *AuditLogActorType) ActorTypeAuditLogs(mods ...qm.QueryMod) auditLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`audit_logs`.`actor_type`=?", o.ID),
+	)
+
+	return AuditLogs(queryMods...)
+}
+
+// LoadActorTypeAuditLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (auditLogActorTypeL) LoadActorTypeAuditLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuditLogActorType any, mods queries.Applicator) error {
+	var slice []*AuditLogActorType
+	var object *AuditLogActorType
+
+	if singular {
+		var ok bool
+		object, ok = maybeAuditLogActorType.(*AuditLogActorType)
+		if !ok {
+			object = new(AuditLogActorType)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAuditLogActorType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAuditLogActorType))
+			}
+		}
+	} else {
+		s, ok := maybeAuditLogActorType.(*[]*AuditLogActorType)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAuditLogActorType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAuditLogActorType))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &auditLogActorTypeR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &auditLogActorTypeR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`audit_logs`),
+		qm.WhereIn(`audit_logs.actor_type in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load audit_logs")
+	}
+
+	var resultSlice []*AuditLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_logs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on audit_logs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_logs")
+	}
+
+	if singular {
+		object.R.ActorTypeAuditLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &auditLogR{}
+			}
+			foreign.R.ActorTypeAuditLogActorType = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ActorType {
+				local.R.ActorTypeAuditLogs = append(local.R.ActorTypeAuditLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &auditLogR{}
+				}
+				foreign.R.ActorTypeAuditLogActorType = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddActorTypeAuditLogsG adds the given related objects to the existing relationships
+// of the audit_log_actor_type, optionally inserting them as new records.
+// Appends related to o.R.ActorTypeAuditLogs.
+// Sets related.R.ActorTypeAuditLogActorType appropriately.
+// Uses the global database handle.
+func (o *AuditLogActorType) AddActorTypeAuditLogsG(ctx context.Context, insert bool, related ...*AuditLog) error {
+	return o.AddActorTypeAuditLogs(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddActorTypeAuditLogsP adds the given related objects to the existing relationships
+// of the audit_log_actor_type, optionally inserting them as new records.
+// Appends related to o.R.ActorTypeAuditLogs.
+// Sets related.R.ActorTypeAuditLogActorType appropriately.
+// Panics on error.
+func (o *AuditLogActorType) AddActorTypeAuditLogsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditLog) {
+	if err := o.AddActorTypeAuditLogs(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddActorTypeAuditLogsGP adds the given related objects to the existing relationships
+// of the audit_log_actor_type, optionally inserting them as new records.
+// Appends related to o.R.ActorTypeAuditLogs.
+// Sets related.R.ActorTypeAuditLogActorType appropriately.
+// Uses the global database handle and panics on error.
+func (o *AuditLogActorType) AddActorTypeAuditLogsGP(ctx context.Context, insert bool, related ...*AuditLog) {
+	if err := o.AddActorTypeAuditLogs(ctx, boil.GetContextDB(), insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddActorTypeAuditLogs adds the given related objects to the existing relationships
+// of the audit_log_actor_type, optionally inserting them as new records.
+// Appends related to o.R.ActorTypeAuditLogs.
+// Sets related.R.ActorTypeAuditLogActorType appropriately.
+func (o *AuditLogActorType) AddActorTypeAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ActorType = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `audit_logs` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"actor_type"}),
+				strmangle.WhereClause("`", "`", 0, auditLogPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ActorType = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &auditLogActorTypeR{
+			ActorTypeAuditLogs: related,
+		}
+	} else {
+		o.R.ActorTypeAuditLogs = append(o.R.ActorTypeAuditLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditLogR{
+				ActorTypeAuditLogActorType: o,
+			}
+		} else {
+			rel.R.ActorTypeAuditLogActorType = o
+		}
+	}
+	return nil
+}
+
+// AuditLogActorTypes retrieves all the records using an executor.
+func AuditLogActorTypes(mods ...qm.QueryMod) auditLogActorTypeQuery {
+	mods = append(mods, qm.From("`audit_log_actor_types`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`audit_log_actor_types`.*"})
+	}
+
+	return auditLogActorTypeQuery{q}
+}
+
+// FindAuditLogActorTypeG retrieves a single record by ID.
+func FindAuditLogActorTypeG(ctx context.Context, iD string, selectCols ...string) (*AuditLogActorType, error) {
+	return FindAuditLogActorType(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindAuditLogActorTypeP retrieves a single record by ID with an executor, and panics on error.
+func FindAuditLogActorTypeP(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) *AuditLogActorType {
+	retobj, err := FindAuditLogActorType(ctx, exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindAuditLogActorTypeGP retrieves a single record by ID, and panics on error.
+func FindAuditLogActorTypeGP(ctx context.Context, iD string, selectCols ...string) *AuditLogActorType {
+	retobj, err := FindAuditLogActorType(ctx, boil.GetContextDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindAuditLogActorType retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAuditLogActorType(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*AuditLogActorType, error) {
+	auditLogActorTypeObj := &AuditLogActorType{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `audit_log_actor_types` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, auditLogActorTypeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: unable to select from audit_log_actor_types")
+	}
+
+	return auditLogActorTypeObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AuditLogActorType) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *AuditLogActorType) InsertP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) {
+	if err := o.Insert(ctx, exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *AuditLogActorType) InsertGP(ctx context.Context, columns boil.Columns) {
+	if err := o.Insert(ctx, boil.GetContextDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AuditLogActorType) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no audit_log_actor_types provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(auditLogActorTypeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	auditLogActorTypeInsertCacheMut.RLock()
+	cache, cached := auditLogActorTypeInsertCache[key]
+	auditLogActorTypeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			auditLogActorTypeAllColumns,
+			auditLogActorTypeColumnsWithDefault,
+			auditLogActorTypeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(auditLogActorTypeType, auditLogActorTypeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(auditLogActorTypeType, auditLogActorTypeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `audit_log_actor_types` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `audit_log_actor_types` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `audit_log_actor_types` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, auditLogActorTypePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to insert into audit_log_actor_types")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to populate default values for audit_log_actor_types")
+	}
+
+CacheNoHooks:
+	if !cached {
+		auditLogActorTypeInsertCacheMut.Lock()
+		auditLogActorTypeInsertCache[key] = cache
+		auditLogActorTypeInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single AuditLogActorType record using the global executor.
+// See Update for more documentation.
+func (o *AuditLogActorType) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// UpdateP uses an executor to update the AuditLogActorType, and panics on error.
+// See Update for more documentation.
+func (o *AuditLogActorType) UpdateP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateGP a single AuditLogActorType record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *AuditLogActorType) UpdateGP(ctx context.Context, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, boil.GetContextDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Update uses an executor to update the AuditLogActorType.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AuditLogActorType) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	auditLogActorTypeUpdateCacheMut.RLock()
+	cache, cached := auditLogActorTypeUpdateCache[key]
+	auditLogActorTypeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			auditLogActorTypeAllColumns,
+			auditLogActorTypePrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodel: unable to update audit_log_actor_types, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `audit_log_actor_types` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, auditLogActorTypePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(auditLogActorTypeType, auditLogActorTypeMapping, append(wl, auditLogActorTypePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update audit_log_actor_types row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by update for audit_log_actor_types")
+	}
+
+	if !cached {
+		auditLogActorTypeUpdateCacheMut.Lock()
+		auditLogActorTypeUpdateCache[key] = cache
+		auditLogActorTypeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q auditLogActorTypeQuery) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q auditLogActorTypeQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q auditLogActorTypeQuery) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q auditLogActorTypeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all for audit_log_actor_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected for audit_log_actor_types")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AuditLogActorTypeSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o AuditLogActorTypeSlice) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o AuditLogActorTypeSlice) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AuditLogActorTypeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodel: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogActorTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `audit_log_actor_types` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogActorTypePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all in auditLogActorType slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected all in update all auditLogActorType")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AuditLogActorType) UpsertG(ctx context.Context, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *AuditLogActorType) UpsertGP(ctx context.Context, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(ctx, boil.GetContextDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *AuditLogActorType) UpsertP(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(ctx, exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLAuditLogActorTypeUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AuditLogActorType) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no audit_log_actor_types provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(auditLogActorTypeColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLAuditLogActorTypeUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	auditLogActorTypeUpsertCacheMut.RLock()
+	cache, cached := auditLogActorTypeUpsertCache[key]
+	auditLogActorTypeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			auditLogActorTypeAllColumns,
+			auditLogActorTypeColumnsWithDefault,
+			auditLogActorTypeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			auditLogActorTypeAllColumns,
+			auditLogActorTypePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodel: unable to upsert audit_log_actor_types, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(auditLogActorTypeAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`audit_log_actor_types`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `audit_log_actor_types` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(auditLogActorTypeType, auditLogActorTypeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(auditLogActorTypeType, auditLogActorTypeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to upsert for audit_log_actor_types")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(auditLogActorTypeType, auditLogActorTypeMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to retrieve unique values for audit_log_actor_types")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to populate default values for audit_log_actor_types")
+	}
+
+CacheNoHooks:
+	if !cached {
+		auditLogActorTypeUpsertCacheMut.Lock()
+		auditLogActorTypeUpsertCache[key] = cache
+		auditLogActorTypeUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single AuditLogActorType record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AuditLogActorType) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// DeleteP deletes a single AuditLogActorType record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *AuditLogActorType) DeleteP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.Delete(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteGP deletes a single AuditLogActorType record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *AuditLogActorType) DeleteGP(ctx context.Context) int64 {
+	rowsAff, err := o.Delete(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Delete deletes a single AuditLogActorType record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AuditLogActorType) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodel: no AuditLogActorType provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), auditLogActorTypePrimaryKeyMapping)
+	sql := "DELETE FROM `audit_log_actor_types` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete from audit_log_actor_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by delete for audit_log_actor_types")
+	}
+
+	return rowsAff, nil
+}
+
+func (q auditLogActorTypeQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q auditLogActorTypeQuery) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := q.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q auditLogActorTypeQuery) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := q.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all matching rows.
+func (q auditLogActorTypeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodel: no auditLogActorTypeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from audit_log_actor_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for audit_log_actor_types")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AuditLogActorTypeSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o AuditLogActorTypeSlice) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o AuditLogActorTypeSlice) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := o.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AuditLogActorTypeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogActorTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `audit_log_actor_types` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogActorTypePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from auditLogActorType slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for audit_log_actor_types")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AuditLogActorType) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: no AuditLogActorType provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *AuditLogActorType) ReloadP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.Reload(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *AuditLogActorType) ReloadGP(ctx context.Context) {
+	if err := o.Reload(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AuditLogActorType) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAuditLogActorType(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuditLogActorTypeSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: empty AuditLogActorTypeSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *AuditLogActorTypeSlice) ReloadAllP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.ReloadAll(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *AuditLogActorTypeSlice) ReloadAllGP(ctx context.Context) {
+	if err := o.ReloadAll(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuditLogActorTypeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AuditLogActorTypeSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogActorTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `audit_log_actor_types`.* FROM `audit_log_actor_types` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogActorTypePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to reload all in AuditLogActorTypeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AuditLogActorTypeExistsG checks if the AuditLogActorType row exists.
+func AuditLogActorTypeExistsG(ctx context.Context, iD string) (bool, error) {
+	return AuditLogActorTypeExists(ctx, boil.GetContextDB(), iD)
+}
+
+// AuditLogActorTypeExistsP checks if the AuditLogActorType row exists. Panics on error.
+func AuditLogActorTypeExistsP(ctx context.Context, exec boil.ContextExecutor, iD string) bool {
+	e, err := AuditLogActorTypeExists(ctx, exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// AuditLogActorTypeExistsGP checks if the AuditLogActorType row exists. Panics on error.
+func AuditLogActorTypeExistsGP(ctx context.Context, iD string) bool {
+	e, err := AuditLogActorTypeExists(ctx, boil.GetContextDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// AuditLogActorTypeExists checks if the AuditLogActorType row exists.
+func AuditLogActorTypeExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `audit_log_actor_types` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: unable to check if audit_log_actor_types exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AuditLogActorType row exists.
+func (o *AuditLogActorType) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AuditLogActorTypeExists(ctx, exec, o.ID)
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o AuditLogActorTypeSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			auditLogActorTypeAllColumns,
+			auditLogActorTypeColumnsWithDefault,
+			auditLogActorTypeColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(auditLogActorTypeColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+		if len(wlCols) == len(auditLogActorTypeAllColumns) {
+			break
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range auditLogActorTypeAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+
+		if i == 0 {
+			sql = "INSERT INTO `audit_log_actor_types` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(auditLogActorTypeType, auditLogActorTypeMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to insert all from auditLogActorType slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by insertall for audit_log_actor_types")
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o AuditLogActorTypeSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows.
+// Currently it doesn't support "NoContext" and "NoRowsAffected".
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values.
+// IMPORTANT: any AUTO_INCREMENT column should be excluded from `updateColumns` and `insertColumns` including PK.
+func (o AuditLogActorTypeSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, nil, updateColumns, insertColumns)
+}
+
+// upsertAllOnConflictColumns upserts multiple rows with passing custom conflict columns to allow bypassing
+// single column conflict check (see bug https://github.com/aarondl/sqlboiler/issues/328).
+// SQLBoiler only checks column conflict on single column only which is not correct as MySQL PK or UNIQUE index
+// can include multiple columns.
+// This function allows passing multiple conflict columns, but it cannot check whether they are correct or not.
+// So use it at your own risk.
+func (o AuditLogActorTypeSlice) UpsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, conflictColumns, updateColumns, insertColumns)
+}
+
+func (o AuditLogActorTypeSlice) upsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	checkNZUniques := len(conflictColumns) == 0
+	if len(conflictColumns) > 0 {
+		mapConflictColumns := make(map[string]struct{}, len(conflictColumns))
+		for _, col := range conflictColumns {
+			for _, existCol := range auditLogActorTypeAllColumns {
+				if col == existCol {
+					mapConflictColumns[col] = struct{}{}
+					break
+				}
+			}
+		}
+		if len(mapConflictColumns) <= 1 {
+			return 0, errors.New("custom conflict columns must be 2 columns or more")
+		}
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		if checkNZUniques {
+			nzUniques := queries.NonZeroDefaultSet(mySQLAuditLogActorTypeUniqueColumns, row)
+			if len(nzUniques) == 0 {
+				return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+			}
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			auditLogActorTypeAllColumns,
+			auditLogActorTypeColumnsWithDefault,
+			auditLogActorTypeColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(auditLogActorTypeColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+		if len(insertCols) == len(auditLogActorTypeAllColumns) || (insertColumns.IsWhitelist() && len(insertCols) == len(insertColumns.Cols)) {
+			break
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range auditLogActorTypeAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		auditLogActorTypeAllColumns,
+		auditLogActorTypePrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("dbmodel: unable to upsert audit_log_actor_types, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `audit_log_actor_types`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `audit_log_actor_types`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(auditLogActorTypeType, auditLogActorTypeMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to upsert for audit_log_actor_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by upsert for audit_log_actor_types")
+	}
+
+	return rowsAff, nil
+}
@@ -0,0 +1,1534 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodel
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// AuditLogTargetType is an object representing the database table.
+type AuditLogTargetType struct {
+	// id
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+
+	R *auditLogTargetTypeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L auditLogTargetTypeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AuditLogTargetTypeColumns = struct {
+	ID string
+}{
+	ID: "id",
+}
+
+var AuditLogTargetTypeTableColumns = struct {
+	ID string
+}{
+	ID: "audit_log_target_types.id",
+}
+
+// Generated where
+
+var AuditLogTargetTypeWhere = struct {
+	ID whereHelperstring
+}{
+	ID: whereHelperstring{field: "`audit_log_target_types`.`id`"},
+}
+
+// AuditLogTargetTypeRels is where relationship names are stored.
+var AuditLogTargetTypeRels = struct {
+	TargetTypeAuditLogs string
+}{
+	TargetTypeAuditLogs: "TargetTypeAuditLogs",
+}
+
+// auditLogTargetTypeR is where relationships are stored.
+type auditLogTargetTypeR struct {
+	TargetTypeAuditLogs AuditLogSlice `boil:"TargetTypeAuditLogs" json:"TargetTypeAuditLogs" toml:"TargetTypeAuditLogs" yaml:"TargetTypeAuditLogs"`
+}
+
+// NewStruct creates a new relationship struct
+func (*auditLogTargetTypeR) NewStruct() *auditLogTargetTypeR {
+	return &auditLogTargetTypeR{}
+}
+
+func (o *AuditLogTargetType) GetTargetTypeAuditLogs() AuditLogSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTargetTypeAuditLogs()
+}
+
+func (r *auditLogTargetTypeR) GetTargetTypeAuditLogs() AuditLogSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TargetTypeAuditLogs
+}
+
+// auditLogTargetTypeL is where Load methods for each relationship are stored.
+type auditLogTargetTypeL struct{}
+
+var (
+	auditLogTargetTypeAllColumns            = []string{"id"}
+	auditLogTargetTypeColumnsWithoutDefault = []string{"id"}
+	auditLogTargetTypeColumnsWithDefault    = []string{}
+	auditLogTargetTypePrimaryKeyColumns     = []string{"id"}
+	auditLogTargetTypeGeneratedColumns      = []string{}
+)
+
+type (
+	// AuditLogTargetTypeSlice is an alias for a slice of pointers to AuditLogTargetType.
+	// This should almost always be used instead of []AuditLogTargetType.
+	AuditLogTargetTypeSlice []*AuditLogTargetType
+
+	auditLogTargetTypeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	auditLogTargetTypeType                 = reflect.TypeOf(&AuditLogTargetType{})
+	auditLogTargetTypeMapping              = queries.MakeStructMapping(auditLogTargetTypeType)
+	auditLogTargetTypePrimaryKeyMapping, _ = queries.BindMapping(auditLogTargetTypeType, auditLogTargetTypeMapping, auditLogTargetTypePrimaryKeyColumns)
+	auditLogTargetTypeInsertCacheMut       sync.RWMutex
+	auditLogTargetTypeInsertCache          = make(map[string]insertCache)
+	auditLogTargetTypeUpdateCacheMut       sync.RWMutex
+	auditLogTargetTypeUpdateCache          = make(map[string]updateCache)
+	auditLogTargetTypeUpsertCacheMut       sync.RWMutex
+	auditLogTargetTypeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single auditLogTargetType record from the query using the global executor.
+func (q auditLogTargetTypeQuery) OneG(ctx context.Context) (*AuditLogTargetType, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// OneGP returns a single auditLogTargetType record from the query using the global executor, and panics on error.
+func (q auditLogTargetTypeQuery) OneGP(ctx context.Context) *AuditLogTargetType {
+	o, err := q.One(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single auditLogTargetType record from the query, and panics on error.
+func (q auditLogTargetTypeQuery) OneP(ctx context.Context, exec boil.ContextExecutor) *AuditLogTargetType {
+	o, err := q.One(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single auditLogTargetType record from the query.
+func (q auditLogTargetTypeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AuditLogTargetType, error) {
+	o := &AuditLogTargetType{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: failed to execute a one query for audit_log_target_types")
+	}
+
+	return o, nil
+}
+
+// AllG returns all AuditLogTargetType records from the query using the global executor.
+func (q auditLogTargetTypeQuery) AllG(ctx context.Context) (AuditLogTargetTypeSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// AllGP returns all AuditLogTargetType records from the query using the global executor, and panics on error.
+func (q auditLogTargetTypeQuery) AllGP(ctx context.Context) AuditLogTargetTypeSlice {
+	o, err := q.All(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all AuditLogTargetType records from the query, and panics on error.
+func (q auditLogTargetTypeQuery) AllP(ctx context.Context, exec boil.ContextExecutor) AuditLogTargetTypeSlice {
+	o, err := q.All(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all AuditLogTargetType records from the query.
+func (q auditLogTargetTypeQuery) All(ctx context.Context, exec boil.ContextExecutor) (AuditLogTargetTypeSlice, error) {
+	var o []*AuditLogTargetType
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodel: failed to assign all query results to AuditLogTargetType slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AuditLogTargetType records in the query using the global executor
+func (q auditLogTargetTypeQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// CountGP returns the count of all AuditLogTargetType records in the query using the global executor, and panics on error.
+func (q auditLogTargetTypeQuery) CountGP(ctx context.Context) int64 {
+	c, err := q.Count(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all AuditLogTargetType records in the query, and panics on error.
+func (q auditLogTargetTypeQuery) CountP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	c, err := q.Count(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all AuditLogTargetType records in the query.
+func (q auditLogTargetTypeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to count audit_log_target_types rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q auditLogTargetTypeQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q auditLogTargetTypeQuery) ExistsGP(ctx context.Context) bool {
+	e, err := q.Exists(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q auditLogTargetTypeQuery) ExistsP(ctx context.Context, exec boil.ContextExecutor) bool {
+	e, err := q.Exists(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q auditLogTargetTypeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: failed to check if audit_log_target_types exists")
+	}
+
+	return count > 0, nil
+}
+
+// TargetTypeAuditLogs retrieves all the audit_log's AuditLogs with an executor via target_type column.
+func (o *AuditLogTargetType) TargetTypeAuditLogs(mods ...qm.QueryMod) auditLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`audit_logs`.`target_type`=?", o.ID),
+	)
+
+	return AuditLogs(queryMods...)
+}
+
+// LoadTargetTypeAuditLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (auditLogTargetTypeL) LoadTargetTypeAuditLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuditLogTargetType any, mods queries.Applicator) error {
+	var slice []*AuditLogTargetType
+	var object *AuditLogTargetType
+
+	if singular {
+		var ok bool
+		object, ok = maybeAuditLogTargetType.(*AuditLogTargetType)
+		if !ok {
+			object = new(AuditLogTargetType)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAuditLogTargetType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAuditLogTargetType))
+			}
+		}
+	} else {
+		s, ok := maybeAuditLogTargetType.(*[]*AuditLogTargetType)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAuditLogTargetType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAuditLogTargetType))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &auditLogTargetTypeR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &auditLogTargetTypeR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`audit_logs`),
+		qm.WhereIn(`audit_logs.target_type in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load audit_logs")
+	}
+
+	var resultSlice []*AuditLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice audit_logs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on audit_logs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_logs")
+	}
+
+	if singular {
+		object.R.TargetTypeAuditLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &auditLogR{}
+			}
+			foreign.R.TargetTypeAuditLogTargetType = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TargetType {
+				local.R.TargetTypeAuditLogs = append(local.R.TargetTypeAuditLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &auditLogR{}
+				}
+				foreign.R.TargetTypeAuditLogTargetType = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddTargetTypeAuditLogsG adds the given related objects to the existing relationships
+// of the audit_log_target_type, optionally inserting them as new records.
+// Appends related to o.R.TargetTypeAuditLogs.
+// Sets related.R.TargetTypeAuditLogTargetType appropriately.
+// Uses the global database handle.
+func (o *AuditLogTargetType) AddTargetTypeAuditLogsG(ctx context.Context, insert bool, related ...*AuditLog) error {
+	return o.AddTargetTypeAuditLogs(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddTargetTypeAuditLogsP adds the given related objects to the existing relationships
+// of the audit_log_target_type, optionally inserting them as new records.
+// Appends related to o.R.TargetTypeAuditLogs.
+// Sets related.R.TargetTypeAuditLogTargetType appropriately.
+// Panics on error.
+func (o *AuditLogTargetType) AddTargetTypeAuditLogsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditLog) {
+	if err := o.AddTargetTypeAuditLogs(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddTargetTypeAuditLogsGP adds the given related objects to the existing relationships
+// of the audit_log_target_type, optionally inserting them as new records.
+// Appends related to o.R.TargetTypeAuditLogs.
+// Sets related.R.TargetTypeAuditLogTargetType appropriately.
+// Uses the global database handle and panics on error.
+func (o *AuditLogTargetType) AddTargetTypeAuditLogsGP(ctx context.Context, insert bool, related ...*AuditLog) {
+	if err := o.AddTargetTypeAuditLogs(ctx, boil.GetContextDB(), insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddTargetTypeAuditLogs adds the given related objects to the existing relationships
+// of the audit_log_target_type, optionally inserting them as new records.
+// Appends related to o.R.TargetTypeAuditLogs.
+// Sets related.R.TargetTypeAuditLogTargetType appropriately.
+func (o *AuditLogTargetType) AddTargetTypeAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AuditLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TargetType = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `audit_logs` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"target_type"}),
+				strmangle.WhereClause("`", "`", 0, auditLogPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TargetType = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &auditLogTargetTypeR{
+			TargetTypeAuditLogs: related,
+		}
+	} else {
+		o.R.TargetTypeAuditLogs = append(o.R.TargetTypeAuditLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &auditLogR{
+				TargetTypeAuditLogTargetType: o,
+			}
+		} else {
+			rel.R.TargetTypeAuditLogTargetType = o
+		}
+	}
+	return nil
+}
+
+// AuditLogTargetTypes retrieves all the records using an executor.
+func AuditLogTargetTypes(mods ...qm.QueryMod) auditLogTargetTypeQuery {
+	mods = append(mods, qm.From("`audit_log_target_types`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`audit_log_target_types`.*"})
+	}
+
+	return auditLogTargetTypeQuery{q}
+}
+
+// FindAuditLogTargetTypeG retrieves a single record by ID.
+func FindAuditLogTargetTypeG(ctx context.Context, iD string, selectCols ...string) (*AuditLogTargetType, error) {
+	return FindAuditLogTargetType(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindAuditLogTargetTypeP retrieves a single record by ID with an executor, and panics on error.
+func FindAuditLogTargetTypeP(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) *AuditLogTargetType {
+	retobj, err := FindAuditLogTargetType(ctx, exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindAuditLogTargetTypeGP retrieves a single record by ID, and panics on error.
+func FindAuditLogTargetTypeGP(ctx context.Context, iD string, selectCols ...string) *AuditLogTargetType {
+	retobj, err := FindAuditLogTargetType(ctx, boil.GetContextDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindAuditLogTargetType retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAuditLogTargetType(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*AuditLogTargetType, error) {
+	auditLogTargetTypeObj := &AuditLogTargetType{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `audit_log_target_types` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, auditLogTargetTypeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: unable to select from audit_log_target_types")
+	}
+
+	return auditLogTargetTypeObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AuditLogTargetType) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *AuditLogTargetType) InsertP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) {
+	if err := o.Insert(ctx, exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *AuditLogTargetType) InsertGP(ctx context.Context, columns boil.Columns) {
+	if err := o.Insert(ctx, boil.GetContextDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AuditLogTargetType) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no audit_log_target_types provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(auditLogTargetTypeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	auditLogTargetTypeInsertCacheMut.RLock()
+	cache, cached := auditLogTargetTypeInsertCache[key]
+	auditLogTargetTypeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			auditLogTargetTypeAllColumns,
+			auditLogTargetTypeColumnsWithDefault,
+			auditLogTargetTypeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(auditLogTargetTypeType, auditLogTargetTypeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(auditLogTargetTypeType, auditLogTargetTypeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `audit_log_target_types` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `audit_log_target_types` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `audit_log_target_types` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, auditLogTargetTypePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to insert into audit_log_target_types")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to populate default values for audit_log_target_types")
+	}
+
+CacheNoHooks:
+	if !cached {
+		auditLogTargetTypeInsertCacheMut.Lock()
+		auditLogTargetTypeInsertCache[key] = cache
+		auditLogTargetTypeInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single AuditLogTargetType record using the global executor.
+// See Update for more documentation.
+func (o *AuditLogTargetType) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// UpdateP uses an executor to update the AuditLogTargetType, and panics on error.
+// See Update for more documentation.
+func (o *AuditLogTargetType) UpdateP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateGP a single AuditLogTargetType record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *AuditLogTargetType) UpdateGP(ctx context.Context, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, boil.GetContextDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Update uses an executor to update the AuditLogTargetType.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AuditLogTargetType) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	auditLogTargetTypeUpdateCacheMut.RLock()
+	cache, cached := auditLogTargetTypeUpdateCache[key]
+	auditLogTargetTypeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			auditLogTargetTypeAllColumns,
+			auditLogTargetTypePrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodel: unable to update audit_log_target_types, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `audit_log_target_types` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, auditLogTargetTypePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(auditLogTargetTypeType, auditLogTargetTypeMapping, append(wl, auditLogTargetTypePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update audit_log_target_types row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by update for audit_log_target_types")
+	}
+
+	if !cached {
+		auditLogTargetTypeUpdateCacheMut.Lock()
+		auditLogTargetTypeUpdateCache[key] = cache
+		auditLogTargetTypeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q auditLogTargetTypeQuery) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q auditLogTargetTypeQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q auditLogTargetTypeQuery) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q auditLogTargetTypeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all for audit_log_target_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected for audit_log_target_types")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AuditLogTargetTypeSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o AuditLogTargetTypeSlice) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o AuditLogTargetTypeSlice) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AuditLogTargetTypeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodel: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogTargetTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `audit_log_target_types` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogTargetTypePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all in auditLogTargetType slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected all in update all auditLogTargetType")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AuditLogTargetType) UpsertG(ctx context.Context, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *AuditLogTargetType) UpsertGP(ctx context.Context, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(ctx, boil.GetContextDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *AuditLogTargetType) UpsertP(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(ctx, exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLAuditLogTargetTypeUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AuditLogTargetType) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no audit_log_target_types provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(auditLogTargetTypeColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLAuditLogTargetTypeUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	auditLogTargetTypeUpsertCacheMut.RLock()
+	cache, cached := auditLogTargetTypeUpsertCache[key]
+	auditLogTargetTypeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			auditLogTargetTypeAllColumns,
+			auditLogTargetTypeColumnsWithDefault,
+			auditLogTargetTypeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			auditLogTargetTypeAllColumns,
+			auditLogTargetTypePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodel: unable to upsert audit_log_target_types, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(auditLogTargetTypeAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`audit_log_target_types`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `audit_log_target_types` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(auditLogTargetTypeType, auditLogTargetTypeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(auditLogTargetTypeType, auditLogTargetTypeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to upsert for audit_log_target_types")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(auditLogTargetTypeType, auditLogTargetTypeMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to retrieve unique values for audit_log_target_types")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to populate default values for audit_log_target_types")
+	}
+
+CacheNoHooks:
+	if !cached {
+		auditLogTargetTypeUpsertCacheMut.Lock()
+		auditLogTargetTypeUpsertCache[key] = cache
+		auditLogTargetTypeUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single AuditLogTargetType record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AuditLogTargetType) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// DeleteP deletes a single AuditLogTargetType record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *AuditLogTargetType) DeleteP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.Delete(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteGP deletes a single AuditLogTargetType record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *AuditLogTargetType) DeleteGP(ctx context.Context) int64 {
+	rowsAff, err := o.Delete(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Delete deletes a single AuditLogTargetType record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AuditLogTargetType) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodel: no AuditLogTargetType provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), auditLogTargetTypePrimaryKeyMapping)
+	sql := "DELETE FROM `audit_log_target_types` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete from audit_log_target_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by delete for audit_log_target_types")
+	}
+
+	return rowsAff, nil
+}
+
+func (q auditLogTargetTypeQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q auditLogTargetTypeQuery) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := q.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q auditLogTargetTypeQuery) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := q.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all matching rows.
+func (q auditLogTargetTypeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodel: no auditLogTargetTypeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from audit_log_target_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for audit_log_target_types")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AuditLogTargetTypeSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o AuditLogTargetTypeSlice) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o AuditLogTargetTypeSlice) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := o.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AuditLogTargetTypeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogTargetTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `audit_log_target_types` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogTargetTypePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from auditLogTargetType slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for audit_log_target_types")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AuditLogTargetType) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: no AuditLogTargetType provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *AuditLogTargetType) ReloadP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.Reload(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *AuditLogTargetType) ReloadGP(ctx context.Context) {
+	if err := o.Reload(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AuditLogTargetType) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAuditLogTargetType(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuditLogTargetTypeSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: empty AuditLogTargetTypeSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *AuditLogTargetTypeSlice) ReloadAllP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.ReloadAll(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *AuditLogTargetTypeSlice) ReloadAllGP(ctx context.Context) {
+	if err := o.ReloadAll(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuditLogTargetTypeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AuditLogTargetTypeSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogTargetTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `audit_log_target_types`.* FROM `audit_log_target_types` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogTargetTypePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to reload all in AuditLogTargetTypeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AuditLogTargetTypeExistsG checks if the AuditLogTargetType row exists.
+func AuditLogTargetTypeExistsG(ctx context.Context, iD string) (bool, error) {
+	return AuditLogTargetTypeExists(ctx, boil.GetContextDB(), iD)
+}
+
+// AuditLogTargetTypeExistsP checks if the AuditLogTargetType row exists. Panics on error.
+func AuditLogTargetTypeExistsP(ctx context.Context, exec boil.ContextExecutor, iD string) bool {
+	e, err := AuditLogTargetTypeExists(ctx, exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// AuditLogTargetTypeExistsGP checks if the AuditLogTargetType row exists. Panics on error.
+func AuditLogTargetTypeExistsGP(ctx context.Context, iD string) bool {
+	e, err := AuditLogTargetTypeExists(ctx, boil.GetContextDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// AuditLogTargetTypeExists checks if the AuditLogTargetType row exists.
+func AuditLogTargetTypeExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `audit_log_target_types` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: unable to check if audit_log_target_types exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AuditLogTargetType row exists.
+func (o *AuditLogTargetType) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AuditLogTargetTypeExists(ctx, exec, o.ID)
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o AuditLogTargetTypeSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			auditLogTargetTypeAllColumns,
+			auditLogTargetTypeColumnsWithDefault,
+			auditLogTargetTypeColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(auditLogTargetTypeColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+		if len(wlCols) == len(auditLogTargetTypeAllColumns) {
+			break
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range auditLogTargetTypeAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+
+		if i == 0 {
+			sql = "INSERT INTO `audit_log_target_types` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(auditLogTargetTypeType, auditLogTargetTypeMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to insert all from auditLogTargetType slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by insertall for audit_log_target_types")
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o AuditLogTargetTypeSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows.
+// Currently it doesn't support "NoContext" and "NoRowsAffected".
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values.
+// IMPORTANT: any AUTO_INCREMENT column should be excluded from `updateColumns` and `insertColumns` including PK.
+func (o AuditLogTargetTypeSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, nil, updateColumns, insertColumns)
+}
+
+// upsertAllOnConflictColumns upserts multiple rows with passing custom conflict columns to allow bypassing
+// single column conflict check (see bug https://github.com/aarondl/sqlboiler/issues/328).
+// SQLBoiler only checks column conflict on single column only which is not correct as MySQL PK or UNIQUE index
+// can include multiple columns.
+// This function allows passing multiple conflict columns, but it cannot check whether they are correct or not.
+// So use it at your own risk.
+func (o AuditLogTargetTypeSlice) UpsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, conflictColumns, updateColumns, insertColumns)
+}
+
+func (o AuditLogTargetTypeSlice) upsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	checkNZUniques := len(conflictColumns) == 0
+	if len(conflictColumns) > 0 {
+		mapConflictColumns := make(map[string]struct{}, len(conflictColumns))
+		for _, col := range conflictColumns {
+			for _, existCol := range auditLogTargetTypeAllColumns {
+				if col == existCol {
+					mapConflictColumns[col] = struct{}{}
+					break
+				}
+			}
+		}
+		if len(mapConflictColumns) <= 1 {
+			return 0, errors.New("custom conflict columns must be 2 columns or more")
+		}
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		if checkNZUniques {
+			nzUniques := queries.NonZeroDefaultSet(mySQLAuditLogTargetTypeUniqueColumns, row)
+			if len(nzUniques) == 0 {
+				return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+			}
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			auditLogTargetTypeAllColumns,
+			auditLogTargetTypeColumnsWithDefault,
+			auditLogTargetTypeColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(auditLogTargetTypeColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+		if len(insertCols) == len(auditLogTargetTypeAllColumns) || (insertColumns.IsWhitelist() && len(insertCols) == len(insertColumns.Cols)) {
+			break
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range auditLogTargetTypeAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		auditLogTargetTypeAllColumns,
+		auditLogTargetTypePrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("dbmodel: unable to upsert audit_log_target_types, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `audit_log_target_types`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `audit_log_target_types`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(auditLogTargetTypeType, auditLogTargetTypeMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to upsert for audit_log_target_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by upsert for audit_log_target_types")
+	}
+
+	return rowsAff, nil
+}
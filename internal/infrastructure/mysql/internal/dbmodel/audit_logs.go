@@ -0,0 +1,1854 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodel
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// AuditLog is an object representing the database table.
+type AuditLog struct {
+	// id
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// tenant_id
+	TenantID null.String `boil:"tenant_id" json:"tenant_id,omitempty" toml:"tenant_id" yaml:"tenant_id,omitempty"`
+	// actor_type
+	ActorType string `boil:"actor_type" json:"actor_type" toml:"actor_type" yaml:"actor_type"`
+	// actor_id
+	ActorID string `boil:"actor_id" json:"actor_id" toml:"actor_id" yaml:"actor_id"`
+	// rpc method
+	Method string `boil:"method" json:"method" toml:"method" yaml:"method"`
+	// target_type
+	TargetType string `boil:"target_type" json:"target_type" toml:"target_type" yaml:"target_type"`
+	// target_id
+	TargetID string `boil:"target_id" json:"target_id" toml:"target_id" yaml:"target_id"`
+	// before/after diff
+	Diff string `boil:"diff" json:"diff" toml:"diff" yaml:"diff"`
+	// created date
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *auditLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L auditLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AuditLogColumns = struct {
+	ID         string
+	TenantID   string
+	ActorType  string
+	ActorID    string
+	Method     string
+	TargetType string
+	TargetID   string
+	Diff       string
+	CreatedAt  string
+}{
+	ID:         "id",
+	TenantID:   "tenant_id",
+	ActorType:  "actor_type",
+	ActorID:    "actor_id",
+	Method:     "method",
+	TargetType: "target_type",
+	TargetID:   "target_id",
+	Diff:       "diff",
+	CreatedAt:  "created_at",
+}
+
+var AuditLogTableColumns = struct {
+	ID         string
+	TenantID   string
+	ActorType  string
+	ActorID    string
+	Method     string
+	TargetType string
+	TargetID   string
+	Diff       string
+	CreatedAt  string
+}{
+	ID:         "audit_logs.id",
+	TenantID:   "audit_logs.tenant_id",
+	ActorType:  "audit_logs.actor_type",
+	ActorID:    "audit_logs.actor_id",
+	Method:     "audit_logs.method",
+	TargetType: "audit_logs.target_type",
+	TargetID:   "audit_logs.target_id",
+	Diff:       "audit_logs.diff",
+	CreatedAt:  "audit_logs.created_at",
+}
+
+// Generated where
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var AuditLogWhere = struct {
+	ID         whereHelperstring
+	TenantID   whereHelpernull_String
+	ActorType  whereHelperstring
+	ActorID    whereHelperstring
+	Method     whereHelperstring
+	TargetType whereHelperstring
+	TargetID   whereHelperstring
+	Diff       whereHelperstring
+	CreatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperstring{field: "`audit_logs`.`id`"},
+	TenantID:   whereHelpernull_String{field: "`audit_logs`.`tenant_id`"},
+	ActorType:  whereHelperstring{field: "`audit_logs`.`actor_type`"},
+	ActorID:    whereHelperstring{field: "`audit_logs`.`actor_id`"},
+	Method:     whereHelperstring{field: "`audit_logs`.`method`"},
+	TargetType: whereHelperstring{field: "`audit_logs`.`target_type`"},
+	TargetID:   whereHelperstring{field: "`audit_logs`.`target_id`"},
+	Diff:       whereHelperstring{field: "`audit_logs`.`diff`"},
+	CreatedAt:  whereHelpertime_Time{field: "`audit_logs`.`created_at`"},
+}
+
+// AuditLogRels is where relationship names are stored.
+var AuditLogRels = struct {
+	ActorTypeAuditLogActorType   string
+	TargetTypeAuditLogTargetType string
+}{
+	ActorTypeAuditLogActorType:   "ActorTypeAuditLogActorType",
+	TargetTypeAuditLogTargetType: "TargetTypeAuditLogTargetType",
+}
+
+// auditLogR is where relationships are stored.
+type auditLogR struct {
+	ActorTypeAuditLogActorType   *AuditLogActorType  `boil:"ActorTypeAuditLogActorType" json:"ActorTypeAuditLogActorType" toml:"ActorTypeAuditLogActorType" yaml:"ActorTypeAuditLogActorType"`
+	TargetTypeAuditLogTargetType *AuditLogTargetType `boil:"TargetTypeAuditLogTargetType" json:"TargetTypeAuditLogTargetType" toml:"TargetTypeAuditLogTargetType" yaml:"TargetTypeAuditLogTargetType"`
+}
+
+// NewStruct creates a new relationship struct
+func (*auditLogR) NewStruct() *auditLogR {
+	return &auditLogR{}
+}
+
+func (o *AuditLog) GetActorTypeAuditLogActorType() *AuditLogActorType {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetActorTypeAuditLogActorType()
+}
+
+func (r *auditLogR) GetActorTypeAuditLogActorType() *AuditLogActorType {
+	if r == nil {
+		return nil
+	}
+
+	return r.ActorTypeAuditLogActorType
+}
+
+func (o *AuditLog) GetTargetTypeAuditLogTargetType() *AuditLogTargetType {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTargetTypeAuditLogTargetType()
+}
+
+func (r *auditLogR) GetTargetTypeAuditLogTargetType() *AuditLogTargetType {
+	if r == nil {
+		return nil
+	}
+
+	return r.TargetTypeAuditLogTargetType
+}
+
+// auditLogL is where Load methods for each relationship are stored.
+type auditLogL struct{}
+
+var (
+	auditLogAllColumns            = []string{"id", "tenant_id", "actor_type", "actor_id", "method", "target_type", "target_id", "diff", "created_at"}
+	auditLogColumnsWithoutDefault = []string{"id", "tenant_id", "actor_type", "actor_id", "method", "target_type", "target_id", "diff", "created_at"}
+	auditLogColumnsWithDefault    = []string{}
+	auditLogPrimaryKeyColumns     = []string{"id"}
+	auditLogGeneratedColumns      = []string{}
+)
+
+type (
+	// AuditLogSlice is an alias for a slice of pointers to AuditLog.
+	// This should almost always be used instead of []AuditLog.
+	AuditLogSlice []*AuditLog
+
+	auditLogQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	auditLogType                 = reflect.TypeOf(&AuditLog{})
+	auditLogMapping              = queries.MakeStructMapping(auditLogType)
+	auditLogPrimaryKeyMapping, _ = queries.BindMapping(auditLogType, auditLogMapping, auditLogPrimaryKeyColumns)
+	auditLogInsertCacheMut       sync.RWMutex
+	auditLogInsertCache          = make(map[string]insertCache)
+	auditLogUpdateCacheMut       sync.RWMutex
+	auditLogUpdateCache          = make(map[string]updateCache)
+	auditLogUpsertCacheMut       sync.RWMutex
+	auditLogUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single auditLog record from the query using the global executor.
+func (q auditLogQuery) OneG(ctx context.Context) (*AuditLog, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// OneGP returns a single auditLog record from the query using the global executor, and panics on error.
+func (q auditLogQuery) OneGP(ctx context.Context) *AuditLog {
+	o, err := q.One(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single auditLog record from the query, and panics on error.
+func (q auditLogQuery) OneP(ctx context.Context, exec boil.ContextExecutor) *AuditLog {
+	o, err := q.One(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single auditLog record from the query.
+func (q auditLogQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AuditLog, error) {
+	o := &AuditLog{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: failed to execute a one query for audit_logs")
+	}
+
+	return o, nil
+}
+
+// AllG returns all AuditLog records from the query using the global executor.
+func (q auditLogQuery) AllG(ctx context.Context) (AuditLogSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// AllGP returns all AuditLog records from the query using the global executor, and panics on error.
+func (q auditLogQuery) AllGP(ctx context.Context) AuditLogSlice {
+	o, err := q.All(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all AuditLog records from the query, and panics on error.
+func (q auditLogQuery) AllP(ctx context.Context, exec boil.ContextExecutor) AuditLogSlice {
+	o, err := q.All(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all AuditLog records from the query.
+func (q auditLogQuery) All(ctx context.Context, exec boil.ContextExecutor) (AuditLogSlice, error) {
+	var o []*AuditLog
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodel: failed to assign all query results to AuditLog slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AuditLog records in the query using the global executor
+func (q auditLogQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// CountGP returns the count of all AuditLog records in the query using the global executor, and panics on error.
+func (q auditLogQuery) CountGP(ctx context.Context) int64 {
+	c, err := q.Count(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all AuditLog records in the query, and panics on error.
+func (q auditLogQuery) CountP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	c, err := q.Count(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all AuditLog records in the query.
+func (q auditLogQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to count audit_logs rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q auditLogQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q auditLogQuery) ExistsGP(ctx context.Context) bool {
+	e, err := q.Exists(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q auditLogQuery) ExistsP(ctx context.Context, exec boil.ContextExecutor) bool {
+	e, err := q.Exists(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q auditLogQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: failed to check if audit_logs exists")
+	}
+
+	return count > 0, nil
+}
+
+// ActorTypeAuditLogActorType pointed to by the foreign key.
+func (o *AuditLog) ActorTypeAuditLogActorType(mods ...qm.QueryMod) auditLogActorTypeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ActorType),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return AuditLogActorTypes(queryMods...)
+}
+
+// TargetTypeAuditLogTargetType pointed to by the foreign key.
+func (o *AuditLog) TargetTypeAuditLogTargetType(mods ...qm.QueryMod) auditLogTargetTypeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TargetType),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return AuditLogTargetTypes(queryMods...)
+}
+
+// LoadActorTypeAuditLogActorType allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (auditLogL) LoadActorTypeAuditLogActorType(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuditLog any, mods queries.Applicator) error {
+	var slice []*AuditLog
+	var object *AuditLog
+
+	if singular {
+		var ok bool
+		object, ok = maybeAuditLog.(*AuditLog)
+		if !ok {
+			object = new(AuditLog)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAuditLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAuditLog))
+			}
+		}
+	} else {
+		s, ok := maybeAuditLog.(*[]*AuditLog)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAuditLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAuditLog))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &auditLogR{}
+		}
+		args[object.ActorType] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &auditLogR{}
+			}
+
+			args[obj.ActorType] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`audit_log_actor_types`),
+		qm.WhereIn(`audit_log_actor_types.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load AuditLogActorType")
+	}
+
+	var resultSlice []*AuditLogActorType
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice AuditLogActorType")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for audit_log_actor_types")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_log_actor_types")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ActorTypeAuditLogActorType = foreign
+		if foreign.R == nil {
+			foreign.R = &auditLogActorTypeR{}
+		}
+		foreign.R.ActorTypeAuditLogs = append(foreign.R.ActorTypeAuditLogs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ActorType == foreign.ID {
+				local.R.ActorTypeAuditLogActorType = foreign
+				if foreign.R == nil {
+					foreign.R = &auditLogActorTypeR{}
+				}
+				foreign.R.ActorTypeAuditLogs = append(foreign.R.ActorTypeAuditLogs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTargetTypeAuditLogTargetType allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (auditLogL) LoadTargetTypeAuditLogTargetType(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuditLog any, mods queries.Applicator) error {
+	var slice []*AuditLog
+	var object *AuditLog
+
+	if singular {
+		var ok bool
+		object, ok = maybeAuditLog.(*AuditLog)
+		if !ok {
+			object = new(AuditLog)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAuditLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAuditLog))
+			}
+		}
+	} else {
+		s, ok := maybeAuditLog.(*[]*AuditLog)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAuditLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAuditLog))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &auditLogR{}
+		}
+		args[object.TargetType] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &auditLogR{}
+			}
+
+			args[obj.TargetType] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`audit_log_target_types`),
+		qm.WhereIn(`audit_log_target_types.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load AuditLogTargetType")
+	}
+
+	var resultSlice []*AuditLogTargetType
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice AuditLogTargetType")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for audit_log_target_types")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for audit_log_target_types")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TargetTypeAuditLogTargetType = foreign
+		if foreign.R == nil {
+			foreign.R = &auditLogTargetTypeR{}
+		}
+		foreign.R.TargetTypeAuditLogs = append(foreign.R.TargetTypeAuditLogs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TargetType == foreign.ID {
+				local.R.TargetTypeAuditLogTargetType = foreign
+				if foreign.R == nil {
+					foreign.R = &auditLogTargetTypeR{}
+				}
+				foreign.R.TargetTypeAuditLogs = append(foreign.R.TargetTypeAuditLogs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetActorTypeAuditLogActorTypeG of the auditLog to the related item.
+// Sets o.R.ActorTypeAuditLogActorType to related.
+// Adds o to related.R.ActorTypeAuditLogs.
+// Uses the global database handle.
+func (o *AuditLog) SetActorTypeAuditLogActorTypeG(ctx context.Context, insert bool, related *AuditLogActorType) error {
+	return o.SetActorTypeAuditLogActorType(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetActorTypeAuditLogActorTypeP of the auditLog to the related item.
+// Sets o.R.ActorTypeAuditLogActorType to related.
+// Adds o to related.R.ActorTypeAuditLogs.
+// Panics on error.
+func (o *AuditLog) SetActorTypeAuditLogActorTypeP(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AuditLogActorType) {
+	if err := o.SetActorTypeAuditLogActorType(ctx, exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetActorTypeAuditLogActorTypeGP of the auditLog to the related item.
+// Sets o.R.ActorTypeAuditLogActorType to related.
+// Adds o to related.R.ActorTypeAuditLogs.
+// Uses the global database handle and panics on error.
+func (o *AuditLog) SetActorTypeAuditLogActorTypeGP(ctx context.Context, insert bool, related *AuditLogActorType) {
+	if err := o.SetActorTypeAuditLogActorType(ctx, boil.GetContextDB(), insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetActorTypeAuditLogActorType of the auditLog to the related item.
+// Sets o.R.ActorTypeAuditLogActorType to related.
+// Adds o to related.R.ActorTypeAuditLogs.
+func (o *AuditLog) SetActorTypeAuditLogActorType(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AuditLogActorType) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `audit_logs` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"actor_type"}),
+		strmangle.WhereClause("`", "`", 0, auditLogPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ActorType = related.ID
+	if o.R == nil {
+		o.R = &auditLogR{
+			ActorTypeAuditLogActorType: related,
+		}
+	} else {
+		o.R.ActorTypeAuditLogActorType = related
+	}
+
+	if related.R == nil {
+		related.R = &auditLogActorTypeR{
+			ActorTypeAuditLogs: AuditLogSlice{o},
+		}
+	} else {
+		related.R.ActorTypeAuditLogs = append(related.R.ActorTypeAuditLogs, o)
+	}
+
+	return nil
+}
+
+// SetTargetTypeAuditLogTargetTypeG of the auditLog to the related item.
+// Sets o.R.TargetTypeAuditLogTargetType to related.
+// Adds o to related.R.TargetTypeAuditLogs.
+// Uses the global database handle.
+func (o *AuditLog) SetTargetTypeAuditLogTargetTypeG(ctx context.Context, insert bool, related *AuditLogTargetType) error {
+	return o.SetTargetTypeAuditLogTargetType(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetTargetTypeAuditLogTargetTypeP of the auditLog to the related item.
+// Sets o.R.TargetTypeAuditLogTargetType to related.
+// Adds o to related.R.TargetTypeAuditLogs.
+// Panics on error.
+func (o *AuditLog) SetTargetTypeAuditLogTargetTypeP(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AuditLogTargetType) {
+	if err := o.SetTargetTypeAuditLogTargetType(ctx, exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetTargetTypeAuditLogTargetTypeGP of the auditLog to the related item.
+// Sets o.R.TargetTypeAuditLogTargetType to related.
+// Adds o to related.R.TargetTypeAuditLogs.
+// Uses the global database handle and panics on error.
+func (o *AuditLog) SetTargetTypeAuditLogTargetTypeGP(ctx context.Context, insert bool, related *AuditLogTargetType) {
+	if err := o.SetTargetTypeAuditLogTargetType(ctx, boil.GetContextDB(), insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetTargetTypeAuditLogTargetType of the auditLog to the related item.
+// Sets o.R.TargetTypeAuditLogTargetType to related.
+// Adds o to related.R.TargetTypeAuditLogs.
+func (o *AuditLog) SetTargetTypeAuditLogTargetType(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AuditLogTargetType) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `audit_logs` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"target_type"}),
+		strmangle.WhereClause("`", "`", 0, auditLogPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TargetType = related.ID
+	if o.R == nil {
+		o.R = &auditLogR{
+			TargetTypeAuditLogTargetType: related,
+		}
+	} else {
+		o.R.TargetTypeAuditLogTargetType = related
+	}
+
+	if related.R == nil {
+		related.R = &auditLogTargetTypeR{
+			TargetTypeAuditLogs: AuditLogSlice{o},
+		}
+	} else {
+		related.R.TargetTypeAuditLogs = append(related.R.TargetTypeAuditLogs, o)
+	}
+
+	return nil
+}
+
+// AuditLogs retrieves all the records using an executor.
+func AuditLogs(mods ...qm.QueryMod) auditLogQuery {
+	mods = append(mods, qm.From("`audit_logs`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`audit_logs`.*"})
+	}
+
+	return auditLogQuery{q}
+}
+
+// FindAuditLogG retrieves a single record by ID.
+func FindAuditLogG(ctx context.Context, iD string, selectCols ...string) (*AuditLog, error) {
+	return FindAuditLog(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindAuditLogP retrieves a single record by ID with an executor, and panics on error.
+func FindAuditLogP(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) *AuditLog {
+	retobj, err := FindAuditLog(ctx, exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindAuditLogGP retrieves a single record by ID, and panics on error.
+func FindAuditLogGP(ctx context.Context, iD string, selectCols ...string) *AuditLog {
+	retobj, err := FindAuditLog(ctx, boil.GetContextDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindAuditLog retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAuditLog(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*AuditLog, error) {
+	auditLogObj := &AuditLog{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `audit_logs` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, auditLogObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: unable to select from audit_logs")
+	}
+
+	return auditLogObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AuditLog) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *AuditLog) InsertP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) {
+	if err := o.Insert(ctx, exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *AuditLog) InsertGP(ctx context.Context, columns boil.Columns) {
+	if err := o.Insert(ctx, boil.GetContextDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AuditLog) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no audit_logs provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(auditLogColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	auditLogInsertCacheMut.RLock()
+	cache, cached := auditLogInsertCache[key]
+	auditLogInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			auditLogAllColumns,
+			auditLogColumnsWithDefault,
+			auditLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(auditLogType, auditLogMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(auditLogType, auditLogMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `audit_logs` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `audit_logs` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `audit_logs` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, auditLogPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to insert into audit_logs")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to populate default values for audit_logs")
+	}
+
+CacheNoHooks:
+	if !cached {
+		auditLogInsertCacheMut.Lock()
+		auditLogInsertCache[key] = cache
+		auditLogInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single AuditLog record using the global executor.
+// See Update for more documentation.
+func (o *AuditLog) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// UpdateP uses an executor to update the AuditLog, and panics on error.
+// See Update for more documentation.
+func (o *AuditLog) UpdateP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateGP a single AuditLog record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *AuditLog) UpdateGP(ctx context.Context, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, boil.GetContextDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Update uses an executor to update the AuditLog.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AuditLog) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	auditLogUpdateCacheMut.RLock()
+	cache, cached := auditLogUpdateCache[key]
+	auditLogUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			auditLogAllColumns,
+			auditLogPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodel: unable to update audit_logs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `audit_logs` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, auditLogPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(auditLogType, auditLogMapping, append(wl, auditLogPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update audit_logs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by update for audit_logs")
+	}
+
+	if !cached {
+		auditLogUpdateCacheMut.Lock()
+		auditLogUpdateCache[key] = cache
+		auditLogUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q auditLogQuery) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q auditLogQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q auditLogQuery) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q auditLogQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all for audit_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected for audit_logs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AuditLogSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o AuditLogSlice) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o AuditLogSlice) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AuditLogSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodel: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `audit_logs` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all in auditLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected all in update all auditLog")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AuditLog) UpsertG(ctx context.Context, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *AuditLog) UpsertGP(ctx context.Context, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(ctx, boil.GetContextDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *AuditLog) UpsertP(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(ctx, exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLAuditLogUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AuditLog) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no audit_logs provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(auditLogColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLAuditLogUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	auditLogUpsertCacheMut.RLock()
+	cache, cached := auditLogUpsertCache[key]
+	auditLogUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			auditLogAllColumns,
+			auditLogColumnsWithDefault,
+			auditLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			auditLogAllColumns,
+			auditLogPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodel: unable to upsert audit_logs, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(auditLogAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`audit_logs`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `audit_logs` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(auditLogType, auditLogMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(auditLogType, auditLogMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to upsert for audit_logs")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(auditLogType, auditLogMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to retrieve unique values for audit_logs")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to populate default values for audit_logs")
+	}
+
+CacheNoHooks:
+	if !cached {
+		auditLogUpsertCacheMut.Lock()
+		auditLogUpsertCache[key] = cache
+		auditLogUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single AuditLog record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AuditLog) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// DeleteP deletes a single AuditLog record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *AuditLog) DeleteP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.Delete(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteGP deletes a single AuditLog record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *AuditLog) DeleteGP(ctx context.Context) int64 {
+	rowsAff, err := o.Delete(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Delete deletes a single AuditLog record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AuditLog) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodel: no AuditLog provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), auditLogPrimaryKeyMapping)
+	sql := "DELETE FROM `audit_logs` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete from audit_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by delete for audit_logs")
+	}
+
+	return rowsAff, nil
+}
+
+func (q auditLogQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q auditLogQuery) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := q.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q auditLogQuery) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := q.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all matching rows.
+func (q auditLogQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodel: no auditLogQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from audit_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for audit_logs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AuditLogSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o AuditLogSlice) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o AuditLogSlice) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := o.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AuditLogSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `audit_logs` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from auditLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for audit_logs")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AuditLog) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: no AuditLog provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *AuditLog) ReloadP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.Reload(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *AuditLog) ReloadGP(ctx context.Context) {
+	if err := o.Reload(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AuditLog) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAuditLog(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuditLogSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: empty AuditLogSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *AuditLogSlice) ReloadAllP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.ReloadAll(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *AuditLogSlice) ReloadAllGP(ctx context.Context) {
+	if err := o.ReloadAll(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AuditLogSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AuditLogSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), auditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `audit_logs`.* FROM `audit_logs` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, auditLogPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to reload all in AuditLogSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AuditLogExistsG checks if the AuditLog row exists.
+func AuditLogExistsG(ctx context.Context, iD string) (bool, error) {
+	return AuditLogExists(ctx, boil.GetContextDB(), iD)
+}
+
+// AuditLogExistsP checks if the AuditLog row exists. Panics on error.
+func AuditLogExistsP(ctx context.Context, exec boil.ContextExecutor, iD string) bool {
+	e, err := AuditLogExists(ctx, exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// AuditLogExistsGP checks if the AuditLog row exists. Panics on error.
+func AuditLogExistsGP(ctx context.Context, iD string) bool {
+	e, err := AuditLogExists(ctx, boil.GetContextDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// AuditLogExists checks if the AuditLog row exists.
+func AuditLogExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `audit_logs` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: unable to check if audit_logs exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AuditLog row exists.
+func (o *AuditLog) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AuditLogExists(ctx, exec, o.ID)
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o AuditLogSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			auditLogAllColumns,
+			auditLogColumnsWithDefault,
+			auditLogColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(auditLogColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+		if len(wlCols) == len(auditLogAllColumns) {
+			break
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range auditLogAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+
+		if i == 0 {
+			sql = "INSERT INTO `audit_logs` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(auditLogType, auditLogMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to insert all from auditLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by insertall for audit_logs")
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o AuditLogSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows.
+// Currently it doesn't support "NoContext" and "NoRowsAffected".
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values.
+// IMPORTANT: any AUTO_INCREMENT column should be excluded from `updateColumns` and `insertColumns` including PK.
+func (o AuditLogSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, nil, updateColumns, insertColumns)
+}
+
+// upsertAllOnConflictColumns upserts multiple rows with passing custom conflict columns to allow bypassing
+// single column conflict check (see bug https://github.com/aarondl/sqlboiler/issues/328).
+// SQLBoiler only checks column conflict on single column only which is not correct as MySQL PK or UNIQUE index
+// can include multiple columns.
+// This function allows passing multiple conflict columns, but it cannot check whether they are correct or not.
+// So use it at your own risk.
+func (o AuditLogSlice) UpsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, conflictColumns, updateColumns, insertColumns)
+}
+
+func (o AuditLogSlice) upsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	checkNZUniques := len(conflictColumns) == 0
+	if len(conflictColumns) > 0 {
+		mapConflictColumns := make(map[string]struct{}, len(conflictColumns))
+		for _, col := range conflictColumns {
+			for _, existCol := range auditLogAllColumns {
+				if col == existCol {
+					mapConflictColumns[col] = struct{}{}
+					break
+				}
+			}
+		}
+		if len(mapConflictColumns) <= 1 {
+			return 0, errors.New("custom conflict columns must be 2 columns or more")
+		}
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		if checkNZUniques {
+			nzUniques := queries.NonZeroDefaultSet(mySQLAuditLogUniqueColumns, row)
+			if len(nzUniques) == 0 {
+				return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+			}
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			auditLogAllColumns,
+			auditLogColumnsWithDefault,
+			auditLogColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(auditLogColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+		if len(insertCols) == len(auditLogAllColumns) || (insertColumns.IsWhitelist() && len(insertCols) == len(insertColumns.Cols)) {
+			break
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range auditLogAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		auditLogAllColumns,
+		auditLogPrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("dbmodel: unable to upsert audit_logs, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `audit_logs`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `audit_logs`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(auditLogType, auditLogMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to upsert for audit_logs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by upsert for audit_logs")
+	}
+
+	return rowsAff, nil
+}
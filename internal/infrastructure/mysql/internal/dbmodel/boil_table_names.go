@@ -4,19 +4,39 @@
 package dbmodel
 
 var TableNames = struct {
-	AdminRoles     string
-	Admins         string
-	Assets         string
-	Staffs         string
-	TenantTagTypes string
-	TenantTags     string
-	Tenants        string
+	AdminRoles          string
+	Admins              string
+	AssetStatuses       string
+	Assets              string
+	AuditLogActorTypes  string
+	AuditLogTargetTypes string
+	AuditLogs           string
+	IdempotencyKeys     string
+	Invitations         string
+	JobStatuses         string
+	Jobs                string
+	OutboxEventStatuses string
+	OutboxEvents        string
+	Staffs              string
+	TenantTagTypes      string
+	TenantTags          string
+	Tenants             string
 }{
-	AdminRoles:     "admin_roles",
-	Admins:         "admins",
-	Assets:         "assets",
-	Staffs:         "staffs",
-	TenantTagTypes: "tenant_tag_types",
-	TenantTags:     "tenant_tags",
-	Tenants:        "tenants",
+	AdminRoles:          "admin_roles",
+	Admins:              "admins",
+	AssetStatuses:       "asset_statuses",
+	Assets:              "assets",
+	AuditLogActorTypes:  "audit_log_actor_types",
+	AuditLogTargetTypes: "audit_log_target_types",
+	AuditLogs:           "audit_logs",
+	IdempotencyKeys:     "idempotency_keys",
+	Invitations:         "invitations",
+	JobStatuses:         "job_statuses",
+	Jobs:                "jobs",
+	OutboxEventStatuses: "outbox_event_statuses",
+	OutboxEvents:        "outbox_events",
+	Staffs:              "staffs",
+	TenantTagTypes:      "tenant_tag_types",
+	TenantTags:          "tenant_tags",
+	Tenants:             "tenants",
 }
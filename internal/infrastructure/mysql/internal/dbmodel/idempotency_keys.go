@@ -0,0 +1,1375 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodel
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// IdempotencyKey is an object representing the database table.
+type IdempotencyKey struct {
+	// id
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// fingerprint of the request
+	Fingerprint string `boil:"fingerprint" json:"fingerprint" toml:"fingerprint" yaml:"fingerprint"`
+	// serialized response
+	Response null.Bytes `boil:"response" json:"response,omitempty" toml:"response" yaml:"response,omitempty"`
+	// expiry date
+	ExpiresAt time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	// created date
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	// update date
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *idempotencyKeyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L idempotencyKeyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var IdempotencyKeyColumns = struct {
+	ID          string
+	Fingerprint string
+	Response    string
+	ExpiresAt   string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	Fingerprint: "fingerprint",
+	Response:    "response",
+	ExpiresAt:   "expires_at",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var IdempotencyKeyTableColumns = struct {
+	ID          string
+	Fingerprint string
+	Response    string
+	ExpiresAt   string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "idempotency_keys.id",
+	Fingerprint: "idempotency_keys.fingerprint",
+	Response:    "idempotency_keys.response",
+	ExpiresAt:   "idempotency_keys.expires_at",
+	CreatedAt:   "idempotency_keys.created_at",
+	UpdatedAt:   "idempotency_keys.updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_Bytes struct{ field string }
+
+func (w whereHelpernull_Bytes) EQ(x null.Bytes) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Bytes) NEQ(x null.Bytes) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Bytes) LT(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Bytes) LTE(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Bytes) GT(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Bytes) GTE(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Bytes) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Bytes) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var IdempotencyKeyWhere = struct {
+	ID          whereHelperstring
+	Fingerprint whereHelperstring
+	Response    whereHelpernull_Bytes
+	ExpiresAt   whereHelpertime_Time
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "`idempotency_keys`.`id`"},
+	Fingerprint: whereHelperstring{field: "`idempotency_keys`.`fingerprint`"},
+	Response:    whereHelpernull_Bytes{field: "`idempotency_keys`.`response`"},
+	ExpiresAt:   whereHelpertime_Time{field: "`idempotency_keys`.`expires_at`"},
+	CreatedAt:   whereHelpertime_Time{field: "`idempotency_keys`.`created_at`"},
+	UpdatedAt:   whereHelpertime_Time{field: "`idempotency_keys`.`updated_at`"},
+}
+
+// IdempotencyKeyRels is where relationship names are stored.
+var IdempotencyKeyRels = struct {
+}{}
+
+// idempotencyKeyR is where relationships are stored.
+type idempotencyKeyR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*idempotencyKeyR) NewStruct() *idempotencyKeyR {
+	return &idempotencyKeyR{}
+}
+
+// idempotencyKeyL is where Load methods for each relationship are stored.
+type idempotencyKeyL struct{}
+
+var (
+	idempotencyKeyAllColumns            = []string{"id", "fingerprint", "response", "expires_at", "created_at", "updated_at"}
+	idempotencyKeyColumnsWithoutDefault = []string{"id", "fingerprint", "response", "expires_at", "created_at", "updated_at"}
+	idempotencyKeyColumnsWithDefault    = []string{}
+	idempotencyKeyPrimaryKeyColumns     = []string{"id"}
+	idempotencyKeyGeneratedColumns      = []string{}
+)
+
+type (
+	// IdempotencyKeySlice is an alias for a slice of pointers to IdempotencyKey.
+	// This should almost always be used instead of []IdempotencyKey.
+	IdempotencyKeySlice []*IdempotencyKey
+
+	idempotencyKeyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	idempotencyKeyType                 = reflect.TypeOf(&IdempotencyKey{})
+	idempotencyKeyMapping              = queries.MakeStructMapping(idempotencyKeyType)
+	idempotencyKeyPrimaryKeyMapping, _ = queries.BindMapping(idempotencyKeyType, idempotencyKeyMapping, idempotencyKeyPrimaryKeyColumns)
+	idempotencyKeyInsertCacheMut       sync.RWMutex
+	idempotencyKeyInsertCache          = make(map[string]insertCache)
+	idempotencyKeyUpdateCacheMut       sync.RWMutex
+	idempotencyKeyUpdateCache          = make(map[string]updateCache)
+	idempotencyKeyUpsertCacheMut       sync.RWMutex
+	idempotencyKeyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single idempotencyKey record from the query using the global executor.
+func (q idempotencyKeyQuery) OneG(ctx context.Context) (*IdempotencyKey, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// OneGP returns a single idempotencyKey record from the query using the global executor, and panics on error.
+func (q idempotencyKeyQuery) OneGP(ctx context.Context) *IdempotencyKey {
+	o, err := q.One(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single idempotencyKey record from the query, and panics on error.
+func (q idempotencyKeyQuery) OneP(ctx context.Context, exec boil.ContextExecutor) *IdempotencyKey {
+	o, err := q.One(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single idempotencyKey record from the query.
+func (q idempotencyKeyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*IdempotencyKey, error) {
+	o := &IdempotencyKey{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: failed to execute a one query for idempotency_keys")
+	}
+
+	return o, nil
+}
+
+// AllG returns all IdempotencyKey records from the query using the global executor.
+func (q idempotencyKeyQuery) AllG(ctx context.Context) (IdempotencyKeySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// AllGP returns all IdempotencyKey records from the query using the global executor, and panics on error.
+func (q idempotencyKeyQuery) AllGP(ctx context.Context) IdempotencyKeySlice {
+	o, err := q.All(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all IdempotencyKey records from the query, and panics on error.
+func (q idempotencyKeyQuery) AllP(ctx context.Context, exec boil.ContextExecutor) IdempotencyKeySlice {
+	o, err := q.All(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all IdempotencyKey records from the query.
+func (q idempotencyKeyQuery) All(ctx context.Context, exec boil.ContextExecutor) (IdempotencyKeySlice, error) {
+	var o []*IdempotencyKey
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodel: failed to assign all query results to IdempotencyKey slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all IdempotencyKey records in the query using the global executor
+func (q idempotencyKeyQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// CountGP returns the count of all IdempotencyKey records in the query using the global executor, and panics on error.
+func (q idempotencyKeyQuery) CountGP(ctx context.Context) int64 {
+	c, err := q.Count(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all IdempotencyKey records in the query, and panics on error.
+func (q idempotencyKeyQuery) CountP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	c, err := q.Count(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all IdempotencyKey records in the query.
+func (q idempotencyKeyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to count idempotency_keys rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q idempotencyKeyQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q idempotencyKeyQuery) ExistsGP(ctx context.Context) bool {
+	e, err := q.Exists(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q idempotencyKeyQuery) ExistsP(ctx context.Context, exec boil.ContextExecutor) bool {
+	e, err := q.Exists(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q idempotencyKeyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: failed to check if idempotency_keys exists")
+	}
+
+	return count > 0, nil
+}
+
+// IdempotencyKeys retrieves all the records using an executor.
+func IdempotencyKeys(mods ...qm.QueryMod) idempotencyKeyQuery {
+	mods = append(mods, qm.From("`idempotency_keys`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`idempotency_keys`.*"})
+	}
+
+	return idempotencyKeyQuery{q}
+}
+
+// FindIdempotencyKeyG retrieves a single record by ID.
+func FindIdempotencyKeyG(ctx context.Context, iD string, selectCols ...string) (*IdempotencyKey, error) {
+	return FindIdempotencyKey(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindIdempotencyKeyP retrieves a single record by ID with an executor, and panics on error.
+func FindIdempotencyKeyP(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) *IdempotencyKey {
+	retobj, err := FindIdempotencyKey(ctx, exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindIdempotencyKeyGP retrieves a single record by ID, and panics on error.
+func FindIdempotencyKeyGP(ctx context.Context, iD string, selectCols ...string) *IdempotencyKey {
+	retobj, err := FindIdempotencyKey(ctx, boil.GetContextDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindIdempotencyKey retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindIdempotencyKey(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*IdempotencyKey, error) {
+	idempotencyKeyObj := &IdempotencyKey{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `idempotency_keys` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, idempotencyKeyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: unable to select from idempotency_keys")
+	}
+
+	return idempotencyKeyObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *IdempotencyKey) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *IdempotencyKey) InsertP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) {
+	if err := o.Insert(ctx, exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *IdempotencyKey) InsertGP(ctx context.Context, columns boil.Columns) {
+	if err := o.Insert(ctx, boil.GetContextDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *IdempotencyKey) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no idempotency_keys provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(idempotencyKeyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	idempotencyKeyInsertCacheMut.RLock()
+	cache, cached := idempotencyKeyInsertCache[key]
+	idempotencyKeyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			idempotencyKeyAllColumns,
+			idempotencyKeyColumnsWithDefault,
+			idempotencyKeyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(idempotencyKeyType, idempotencyKeyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(idempotencyKeyType, idempotencyKeyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `idempotency_keys` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `idempotency_keys` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `idempotency_keys` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, idempotencyKeyPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to insert into idempotency_keys")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to populate default values for idempotency_keys")
+	}
+
+CacheNoHooks:
+	if !cached {
+		idempotencyKeyInsertCacheMut.Lock()
+		idempotencyKeyInsertCache[key] = cache
+		idempotencyKeyInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single IdempotencyKey record using the global executor.
+// See Update for more documentation.
+func (o *IdempotencyKey) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// UpdateP uses an executor to update the IdempotencyKey, and panics on error.
+// See Update for more documentation.
+func (o *IdempotencyKey) UpdateP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateGP a single IdempotencyKey record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *IdempotencyKey) UpdateGP(ctx context.Context, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, boil.GetContextDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Update uses an executor to update the IdempotencyKey.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *IdempotencyKey) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	idempotencyKeyUpdateCacheMut.RLock()
+	cache, cached := idempotencyKeyUpdateCache[key]
+	idempotencyKeyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			idempotencyKeyAllColumns,
+			idempotencyKeyPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodel: unable to update idempotency_keys, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `idempotency_keys` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, idempotencyKeyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(idempotencyKeyType, idempotencyKeyMapping, append(wl, idempotencyKeyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update idempotency_keys row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by update for idempotency_keys")
+	}
+
+	if !cached {
+		idempotencyKeyUpdateCacheMut.Lock()
+		idempotencyKeyUpdateCache[key] = cache
+		idempotencyKeyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q idempotencyKeyQuery) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q idempotencyKeyQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q idempotencyKeyQuery) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q idempotencyKeyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all for idempotency_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected for idempotency_keys")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o IdempotencyKeySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o IdempotencyKeySlice) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o IdempotencyKeySlice) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o IdempotencyKeySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodel: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), idempotencyKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `idempotency_keys` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, idempotencyKeyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all in idempotencyKey slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected all in update all idempotencyKey")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *IdempotencyKey) UpsertG(ctx context.Context, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *IdempotencyKey) UpsertGP(ctx context.Context, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(ctx, boil.GetContextDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *IdempotencyKey) UpsertP(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(ctx, exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLIdempotencyKeyUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *IdempotencyKey) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no idempotency_keys provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(idempotencyKeyColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLIdempotencyKeyUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	idempotencyKeyUpsertCacheMut.RLock()
+	cache, cached := idempotencyKeyUpsertCache[key]
+	idempotencyKeyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			idempotencyKeyAllColumns,
+			idempotencyKeyColumnsWithDefault,
+			idempotencyKeyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			idempotencyKeyAllColumns,
+			idempotencyKeyPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodel: unable to upsert idempotency_keys, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(idempotencyKeyAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`idempotency_keys`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `idempotency_keys` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(idempotencyKeyType, idempotencyKeyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(idempotencyKeyType, idempotencyKeyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to upsert for idempotency_keys")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(idempotencyKeyType, idempotencyKeyMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to retrieve unique values for idempotency_keys")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to populate default values for idempotency_keys")
+	}
+
+CacheNoHooks:
+	if !cached {
+		idempotencyKeyUpsertCacheMut.Lock()
+		idempotencyKeyUpsertCache[key] = cache
+		idempotencyKeyUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single IdempotencyKey record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *IdempotencyKey) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// DeleteP deletes a single IdempotencyKey record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *IdempotencyKey) DeleteP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.Delete(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteGP deletes a single IdempotencyKey record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *IdempotencyKey) DeleteGP(ctx context.Context) int64 {
+	rowsAff, err := o.Delete(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Delete deletes a single IdempotencyKey record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *IdempotencyKey) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodel: no IdempotencyKey provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), idempotencyKeyPrimaryKeyMapping)
+	sql := "DELETE FROM `idempotency_keys` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete from idempotency_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by delete for idempotency_keys")
+	}
+
+	return rowsAff, nil
+}
+
+func (q idempotencyKeyQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q idempotencyKeyQuery) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := q.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q idempotencyKeyQuery) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := q.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all matching rows.
+func (q idempotencyKeyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodel: no idempotencyKeyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from idempotency_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for idempotency_keys")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o IdempotencyKeySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o IdempotencyKeySlice) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o IdempotencyKeySlice) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := o.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o IdempotencyKeySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), idempotencyKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `idempotency_keys` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, idempotencyKeyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from idempotencyKey slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for idempotency_keys")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *IdempotencyKey) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: no IdempotencyKey provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *IdempotencyKey) ReloadP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.Reload(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *IdempotencyKey) ReloadGP(ctx context.Context) {
+	if err := o.Reload(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *IdempotencyKey) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindIdempotencyKey(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *IdempotencyKeySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: empty IdempotencyKeySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *IdempotencyKeySlice) ReloadAllP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.ReloadAll(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *IdempotencyKeySlice) ReloadAllGP(ctx context.Context) {
+	if err := o.ReloadAll(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *IdempotencyKeySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := IdempotencyKeySlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), idempotencyKeyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `idempotency_keys`.* FROM `idempotency_keys` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, idempotencyKeyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to reload all in IdempotencyKeySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// IdempotencyKeyExistsG checks if the IdempotencyKey row exists.
+func IdempotencyKeyExistsG(ctx context.Context, iD string) (bool, error) {
+	return IdempotencyKeyExists(ctx, boil.GetContextDB(), iD)
+}
+
+// IdempotencyKeyExistsP checks if the IdempotencyKey row exists. Panics on error.
+func IdempotencyKeyExistsP(ctx context.Context, exec boil.ContextExecutor, iD string) bool {
+	e, err := IdempotencyKeyExists(ctx, exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// IdempotencyKeyExistsGP checks if the IdempotencyKey row exists. Panics on error.
+func IdempotencyKeyExistsGP(ctx context.Context, iD string) bool {
+	e, err := IdempotencyKeyExists(ctx, boil.GetContextDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// IdempotencyKeyExists checks if the IdempotencyKey row exists.
+func IdempotencyKeyExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `idempotency_keys` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: unable to check if idempotency_keys exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the IdempotencyKey row exists.
+func (o *IdempotencyKey) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return IdempotencyKeyExists(ctx, exec, o.ID)
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o IdempotencyKeySlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			idempotencyKeyAllColumns,
+			idempotencyKeyColumnsWithDefault,
+			idempotencyKeyColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(idempotencyKeyColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+		if len(wlCols) == len(idempotencyKeyAllColumns) {
+			break
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range idempotencyKeyAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+
+		if i == 0 {
+			sql = "INSERT INTO `idempotency_keys` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(idempotencyKeyType, idempotencyKeyMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to insert all from idempotencyKey slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by insertall for idempotency_keys")
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o IdempotencyKeySlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows.
+// Currently it doesn't support "NoContext" and "NoRowsAffected".
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values.
+// IMPORTANT: any AUTO_INCREMENT column should be excluded from `updateColumns` and `insertColumns` including PK.
+func (o IdempotencyKeySlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, nil, updateColumns, insertColumns)
+}
+
+// upsertAllOnConflictColumns upserts multiple rows with passing custom conflict columns to allow bypassing
+// single column conflict check (see bug https://github.com/aarondl/sqlboiler/issues/328).
+// SQLBoiler only checks column conflict on single column only which is not correct as MySQL PK or UNIQUE index
+// can include multiple columns.
+// This function allows passing multiple conflict columns, but it cannot check whether they are correct or not.
+// So use it at your own risk.
+func (o IdempotencyKeySlice) UpsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, conflictColumns, updateColumns, insertColumns)
+}
+
+func (o IdempotencyKeySlice) upsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	checkNZUniques := len(conflictColumns) == 0
+	if len(conflictColumns) > 0 {
+		mapConflictColumns := make(map[string]struct{}, len(conflictColumns))
+		for _, col := range conflictColumns {
+			for _, existCol := range idempotencyKeyAllColumns {
+				if col == existCol {
+					mapConflictColumns[col] = struct{}{}
+					break
+				}
+			}
+		}
+		if len(mapConflictColumns) <= 1 {
+			return 0, errors.New("custom conflict columns must be 2 columns or more")
+		}
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		if checkNZUniques {
+			nzUniques := queries.NonZeroDefaultSet(mySQLIdempotencyKeyUniqueColumns, row)
+			if len(nzUniques) == 0 {
+				return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+			}
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			idempotencyKeyAllColumns,
+			idempotencyKeyColumnsWithDefault,
+			idempotencyKeyColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(idempotencyKeyColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+		if len(insertCols) == len(idempotencyKeyAllColumns) || (insertColumns.IsWhitelist() && len(insertCols) == len(insertColumns.Cols)) {
+			break
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range idempotencyKeyAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		idempotencyKeyAllColumns,
+		idempotencyKeyPrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("dbmodel: unable to upsert idempotency_keys, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `idempotency_keys`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `idempotency_keys`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(idempotencyKeyType, idempotencyKeyMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to upsert for idempotency_keys")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by upsert for idempotency_keys")
+	}
+
+	return rowsAff, nil
+}
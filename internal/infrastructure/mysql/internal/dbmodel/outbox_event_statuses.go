@@ -0,0 +1,1534 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodel
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// OutboxEventStatus is an object representing the database table.
+type OutboxEventStatus struct {
+	// id
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+
+	R *outboxEventStatusR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L outboxEventStatusL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OutboxEventStatusColumns = struct {
+	ID string
+}{
+	ID: "id",
+}
+
+var OutboxEventStatusTableColumns = struct {
+	ID string
+}{
+	ID: "outbox_event_statuses.id",
+}
+
+// Generated where
+
+var OutboxEventStatusWhere = struct {
+	ID whereHelperstring
+}{
+	ID: whereHelperstring{field: "`outbox_event_statuses`.`id`"},
+}
+
+// OutboxEventStatusRels is where relationship names are stored.
+var OutboxEventStatusRels = struct {
+	StatusOutboxEvents string
+}{
+	StatusOutboxEvents: "StatusOutboxEvents",
+}
+
+// outboxEventStatusR is where relationships are stored.
+type outboxEventStatusR struct {
+	StatusOutboxEvents OutboxEventSlice `boil:"StatusOutboxEvents" json:"StatusOutboxEvents" toml:"StatusOutboxEvents" yaml:"StatusOutboxEvents"`
+}
+
+// NewStruct creates a new relationship struct
+func (*outboxEventStatusR) NewStruct() *outboxEventStatusR {
+	return &outboxEventStatusR{}
+}
+
+func (o *OutboxEventStatus) GetStatusOutboxEvents() OutboxEventSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetStatusOutboxEvents()
+}
+
+func (r *outboxEventStatusR) GetStatusOutboxEvents() OutboxEventSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.StatusOutboxEvents
+}
+
+// outboxEventStatusL is where Load methods for each relationship are stored.
+type outboxEventStatusL struct{}
+
+var (
+	outboxEventStatusAllColumns            = []string{"id"}
+	outboxEventStatusColumnsWithoutDefault = []string{"id"}
+	outboxEventStatusColumnsWithDefault    = []string{}
+	outboxEventStatusPrimaryKeyColumns     = []string{"id"}
+	outboxEventStatusGeneratedColumns      = []string{}
+)
+
+type (
+	// OutboxEventStatusSlice is an alias for a slice of pointers to OutboxEventStatus.
+	// This should almost always be used instead of []OutboxEventStatus.
+	OutboxEventStatusSlice []*OutboxEventStatus
+
+	outboxEventStatusQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	outboxEventStatusType                 = reflect.TypeOf(&OutboxEventStatus{})
+	outboxEventStatusMapping              = queries.MakeStructMapping(outboxEventStatusType)
+	outboxEventStatusPrimaryKeyMapping, _ = queries.BindMapping(outboxEventStatusType, outboxEventStatusMapping, outboxEventStatusPrimaryKeyColumns)
+	outboxEventStatusInsertCacheMut       sync.RWMutex
+	outboxEventStatusInsertCache          = make(map[string]insertCache)
+	outboxEventStatusUpdateCacheMut       sync.RWMutex
+	outboxEventStatusUpdateCache          = make(map[string]updateCache)
+	outboxEventStatusUpsertCacheMut       sync.RWMutex
+	outboxEventStatusUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single outboxEventStatus record from the query using the global executor.
+func (q outboxEventStatusQuery) OneG(ctx context.Context) (*OutboxEventStatus, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// OneGP returns a single outboxEventStatus record from the query using the global executor, and panics on error.
+func (q outboxEventStatusQuery) OneGP(ctx context.Context) *OutboxEventStatus {
+	o, err := q.One(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single outboxEventStatus record from the query, and panics on error.
+func (q outboxEventStatusQuery) OneP(ctx context.Context, exec boil.ContextExecutor) *OutboxEventStatus {
+	o, err := q.One(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single outboxEventStatus record from the query.
+func (q outboxEventStatusQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OutboxEventStatus, error) {
+	o := &OutboxEventStatus{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: failed to execute a one query for outbox_event_statuses")
+	}
+
+	return o, nil
+}
+
+// AllG returns all OutboxEventStatus records from the query using the global executor.
+func (q outboxEventStatusQuery) AllG(ctx context.Context) (OutboxEventStatusSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// AllGP returns all OutboxEventStatus records from the query using the global executor, and panics on error.
+func (q outboxEventStatusQuery) AllGP(ctx context.Context) OutboxEventStatusSlice {
+	o, err := q.All(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all OutboxEventStatus records from the query, and panics on error.
+func (q outboxEventStatusQuery) AllP(ctx context.Context, exec boil.ContextExecutor) OutboxEventStatusSlice {
+	o, err := q.All(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all OutboxEventStatus records from the query.
+func (q outboxEventStatusQuery) All(ctx context.Context, exec boil.ContextExecutor) (OutboxEventStatusSlice, error) {
+	var o []*OutboxEventStatus
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodel: failed to assign all query results to OutboxEventStatus slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all OutboxEventStatus records in the query using the global executor
+func (q outboxEventStatusQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// CountGP returns the count of all OutboxEventStatus records in the query using the global executor, and panics on error.
+func (q outboxEventStatusQuery) CountGP(ctx context.Context) int64 {
+	c, err := q.Count(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all OutboxEventStatus records in the query, and panics on error.
+func (q outboxEventStatusQuery) CountP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	c, err := q.Count(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all OutboxEventStatus records in the query.
+func (q outboxEventStatusQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to count outbox_event_statuses rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q outboxEventStatusQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q outboxEventStatusQuery) ExistsGP(ctx context.Context) bool {
+	e, err := q.Exists(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q outboxEventStatusQuery) ExistsP(ctx context.Context, exec boil.ContextExecutor) bool {
+	e, err := q.Exists(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q outboxEventStatusQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: failed to check if outbox_event_statuses exists")
+	}
+
+	return count > 0, nil
+}
+
+// StatusOutboxEvents retrieves all the outbox_event's OutboxEvents with an executor via status column.
+func (o *OutboxEventStatus) StatusOutboxEvents(mods ...qm.QueryMod) outboxEventQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`outbox_events`.`status`=?", o.ID),
+	)
+
+	return OutboxEvents(queryMods...)
+}
+
+// LoadStatusOutboxEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (outboxEventStatusL) LoadStatusOutboxEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOutboxEventStatus any, mods queries.Applicator) error {
+	var slice []*OutboxEventStatus
+	var object *OutboxEventStatus
+
+	if singular {
+		var ok bool
+		object, ok = maybeOutboxEventStatus.(*OutboxEventStatus)
+		if !ok {
+			object = new(OutboxEventStatus)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOutboxEventStatus)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOutboxEventStatus))
+			}
+		}
+	} else {
+		s, ok := maybeOutboxEventStatus.(*[]*OutboxEventStatus)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOutboxEventStatus)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOutboxEventStatus))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &outboxEventStatusR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &outboxEventStatusR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`outbox_events`),
+		qm.WhereIn(`outbox_events.status in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load outbox_events")
+	}
+
+	var resultSlice []*OutboxEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice outbox_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on outbox_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for outbox_events")
+	}
+
+	if singular {
+		object.R.StatusOutboxEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &outboxEventR{}
+			}
+			foreign.R.StatusOutboxEventStatus = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Status {
+				local.R.StatusOutboxEvents = append(local.R.StatusOutboxEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &outboxEventR{}
+				}
+				foreign.R.StatusOutboxEventStatus = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddStatusOutboxEventsG adds the given related objects to the existing relationships
+// of the outbox_event_status, optionally inserting them as new records.
+// Appends related to o.R.StatusOutboxEvents.
+// Sets related.R.StatusOutboxEventStatus appropriately.
+// Uses the global database handle.
+func (o *OutboxEventStatus) AddStatusOutboxEventsG(ctx context.Context, insert bool, related ...*OutboxEvent) error {
+	return o.AddStatusOutboxEvents(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddStatusOutboxEventsP adds the given related objects to the existing relationships
+// of the outbox_event_status, optionally inserting them as new records.
+// Appends related to o.R.StatusOutboxEvents.
+// Sets related.R.StatusOutboxEventStatus appropriately.
+// Panics on error.
+func (o *OutboxEventStatus) AddStatusOutboxEventsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OutboxEvent) {
+	if err := o.AddStatusOutboxEvents(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddStatusOutboxEventsGP adds the given related objects to the existing relationships
+// of the outbox_event_status, optionally inserting them as new records.
+// Appends related to o.R.StatusOutboxEvents.
+// Sets related.R.StatusOutboxEventStatus appropriately.
+// Uses the global database handle and panics on error.
+func (o *OutboxEventStatus) AddStatusOutboxEventsGP(ctx context.Context, insert bool, related ...*OutboxEvent) {
+	if err := o.AddStatusOutboxEvents(ctx, boil.GetContextDB(), insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddStatusOutboxEvents adds the given related objects to the existing relationships
+// of the outbox_event_status, optionally inserting them as new records.
+// Appends related to o.R.StatusOutboxEvents.
+// Sets related.R.StatusOutboxEventStatus appropriately.
+func (o *OutboxEventStatus) AddStatusOutboxEvents(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OutboxEvent) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Status = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `outbox_events` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"status"}),
+				strmangle.WhereClause("`", "`", 0, outboxEventPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Status = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &outboxEventStatusR{
+			StatusOutboxEvents: related,
+		}
+	} else {
+		o.R.StatusOutboxEvents = append(o.R.StatusOutboxEvents, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &outboxEventR{
+				StatusOutboxEventStatus: o,
+			}
+		} else {
+			rel.R.StatusOutboxEventStatus = o
+		}
+	}
+	return nil
+}
+
+// OutboxEventStatuses retrieves all the records using an executor.
+func OutboxEventStatuses(mods ...qm.QueryMod) outboxEventStatusQuery {
+	mods = append(mods, qm.From("`outbox_event_statuses`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`outbox_event_statuses`.*"})
+	}
+
+	return outboxEventStatusQuery{q}
+}
+
+// FindOutboxEventStatusG retrieves a single record by ID.
+func FindOutboxEventStatusG(ctx context.Context, iD string, selectCols ...string) (*OutboxEventStatus, error) {
+	return FindOutboxEventStatus(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindOutboxEventStatusP retrieves a single record by ID with an executor, and panics on error.
+func FindOutboxEventStatusP(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) *OutboxEventStatus {
+	retobj, err := FindOutboxEventStatus(ctx, exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindOutboxEventStatusGP retrieves a single record by ID, and panics on error.
+func FindOutboxEventStatusGP(ctx context.Context, iD string, selectCols ...string) *OutboxEventStatus {
+	retobj, err := FindOutboxEventStatus(ctx, boil.GetContextDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindOutboxEventStatus retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOutboxEventStatus(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*OutboxEventStatus, error) {
+	outboxEventStatusObj := &OutboxEventStatus{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `outbox_event_statuses` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, outboxEventStatusObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: unable to select from outbox_event_statuses")
+	}
+
+	return outboxEventStatusObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *OutboxEventStatus) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *OutboxEventStatus) InsertP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) {
+	if err := o.Insert(ctx, exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *OutboxEventStatus) InsertGP(ctx context.Context, columns boil.Columns) {
+	if err := o.Insert(ctx, boil.GetContextDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OutboxEventStatus) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no outbox_event_statuses provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(outboxEventStatusColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	outboxEventStatusInsertCacheMut.RLock()
+	cache, cached := outboxEventStatusInsertCache[key]
+	outboxEventStatusInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			outboxEventStatusAllColumns,
+			outboxEventStatusColumnsWithDefault,
+			outboxEventStatusColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(outboxEventStatusType, outboxEventStatusMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(outboxEventStatusType, outboxEventStatusMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `outbox_event_statuses` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `outbox_event_statuses` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `outbox_event_statuses` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, outboxEventStatusPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to insert into outbox_event_statuses")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to populate default values for outbox_event_statuses")
+	}
+
+CacheNoHooks:
+	if !cached {
+		outboxEventStatusInsertCacheMut.Lock()
+		outboxEventStatusInsertCache[key] = cache
+		outboxEventStatusInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single OutboxEventStatus record using the global executor.
+// See Update for more documentation.
+func (o *OutboxEventStatus) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// UpdateP uses an executor to update the OutboxEventStatus, and panics on error.
+// See Update for more documentation.
+func (o *OutboxEventStatus) UpdateP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateGP a single OutboxEventStatus record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *OutboxEventStatus) UpdateGP(ctx context.Context, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, boil.GetContextDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Update uses an executor to update the OutboxEventStatus.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OutboxEventStatus) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	outboxEventStatusUpdateCacheMut.RLock()
+	cache, cached := outboxEventStatusUpdateCache[key]
+	outboxEventStatusUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			outboxEventStatusAllColumns,
+			outboxEventStatusPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodel: unable to update outbox_event_statuses, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `outbox_event_statuses` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, outboxEventStatusPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(outboxEventStatusType, outboxEventStatusMapping, append(wl, outboxEventStatusPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update outbox_event_statuses row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by update for outbox_event_statuses")
+	}
+
+	if !cached {
+		outboxEventStatusUpdateCacheMut.Lock()
+		outboxEventStatusUpdateCache[key] = cache
+		outboxEventStatusUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q outboxEventStatusQuery) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q outboxEventStatusQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q outboxEventStatusQuery) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q outboxEventStatusQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all for outbox_event_statuses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected for outbox_event_statuses")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o OutboxEventStatusSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o OutboxEventStatusSlice) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o OutboxEventStatusSlice) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OutboxEventStatusSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodel: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxEventStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `outbox_event_statuses` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, outboxEventStatusPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all in outboxEventStatus slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected all in update all outboxEventStatus")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *OutboxEventStatus) UpsertG(ctx context.Context, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *OutboxEventStatus) UpsertGP(ctx context.Context, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(ctx, boil.GetContextDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *OutboxEventStatus) UpsertP(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(ctx, exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLOutboxEventStatusUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OutboxEventStatus) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no outbox_event_statuses provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(outboxEventStatusColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLOutboxEventStatusUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	outboxEventStatusUpsertCacheMut.RLock()
+	cache, cached := outboxEventStatusUpsertCache[key]
+	outboxEventStatusUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			outboxEventStatusAllColumns,
+			outboxEventStatusColumnsWithDefault,
+			outboxEventStatusColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			outboxEventStatusAllColumns,
+			outboxEventStatusPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodel: unable to upsert outbox_event_statuses, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(outboxEventStatusAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`outbox_event_statuses`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `outbox_event_statuses` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(outboxEventStatusType, outboxEventStatusMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(outboxEventStatusType, outboxEventStatusMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to upsert for outbox_event_statuses")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(outboxEventStatusType, outboxEventStatusMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to retrieve unique values for outbox_event_statuses")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to populate default values for outbox_event_statuses")
+	}
+
+CacheNoHooks:
+	if !cached {
+		outboxEventStatusUpsertCacheMut.Lock()
+		outboxEventStatusUpsertCache[key] = cache
+		outboxEventStatusUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single OutboxEventStatus record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *OutboxEventStatus) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// DeleteP deletes a single OutboxEventStatus record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *OutboxEventStatus) DeleteP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.Delete(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteGP deletes a single OutboxEventStatus record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *OutboxEventStatus) DeleteGP(ctx context.Context) int64 {
+	rowsAff, err := o.Delete(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Delete deletes a single OutboxEventStatus record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OutboxEventStatus) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodel: no OutboxEventStatus provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), outboxEventStatusPrimaryKeyMapping)
+	sql := "DELETE FROM `outbox_event_statuses` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete from outbox_event_statuses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by delete for outbox_event_statuses")
+	}
+
+	return rowsAff, nil
+}
+
+func (q outboxEventStatusQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q outboxEventStatusQuery) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := q.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q outboxEventStatusQuery) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := q.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all matching rows.
+func (q outboxEventStatusQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodel: no outboxEventStatusQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from outbox_event_statuses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for outbox_event_statuses")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o OutboxEventStatusSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o OutboxEventStatusSlice) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o OutboxEventStatusSlice) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := o.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OutboxEventStatusSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxEventStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `outbox_event_statuses` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, outboxEventStatusPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from outboxEventStatus slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for outbox_event_statuses")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *OutboxEventStatus) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: no OutboxEventStatus provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *OutboxEventStatus) ReloadP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.Reload(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *OutboxEventStatus) ReloadGP(ctx context.Context) {
+	if err := o.Reload(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OutboxEventStatus) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOutboxEventStatus(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OutboxEventStatusSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: empty OutboxEventStatusSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *OutboxEventStatusSlice) ReloadAllP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.ReloadAll(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *OutboxEventStatusSlice) ReloadAllGP(ctx context.Context) {
+	if err := o.ReloadAll(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OutboxEventStatusSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OutboxEventStatusSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxEventStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `outbox_event_statuses`.* FROM `outbox_event_statuses` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, outboxEventStatusPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to reload all in OutboxEventStatusSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OutboxEventStatusExistsG checks if the OutboxEventStatus row exists.
+func OutboxEventStatusExistsG(ctx context.Context, iD string) (bool, error) {
+	return OutboxEventStatusExists(ctx, boil.GetContextDB(), iD)
+}
+
+// OutboxEventStatusExistsP checks if the OutboxEventStatus row exists. Panics on error.
+func OutboxEventStatusExistsP(ctx context.Context, exec boil.ContextExecutor, iD string) bool {
+	e, err := OutboxEventStatusExists(ctx, exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// OutboxEventStatusExistsGP checks if the OutboxEventStatus row exists. Panics on error.
+func OutboxEventStatusExistsGP(ctx context.Context, iD string) bool {
+	e, err := OutboxEventStatusExists(ctx, boil.GetContextDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// OutboxEventStatusExists checks if the OutboxEventStatus row exists.
+func OutboxEventStatusExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `outbox_event_statuses` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: unable to check if outbox_event_statuses exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OutboxEventStatus row exists.
+func (o *OutboxEventStatus) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OutboxEventStatusExists(ctx, exec, o.ID)
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o OutboxEventStatusSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			outboxEventStatusAllColumns,
+			outboxEventStatusColumnsWithDefault,
+			outboxEventStatusColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(outboxEventStatusColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+		if len(wlCols) == len(outboxEventStatusAllColumns) {
+			break
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range outboxEventStatusAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+
+		if i == 0 {
+			sql = "INSERT INTO `outbox_event_statuses` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(outboxEventStatusType, outboxEventStatusMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to insert all from outboxEventStatus slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by insertall for outbox_event_statuses")
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o OutboxEventStatusSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows.
+// Currently it doesn't support "NoContext" and "NoRowsAffected".
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values.
+// IMPORTANT: any AUTO_INCREMENT column should be excluded from `updateColumns` and `insertColumns` including PK.
+func (o OutboxEventStatusSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, nil, updateColumns, insertColumns)
+}
+
+// upsertAllOnConflictColumns upserts multiple rows with passing custom conflict columns to allow bypassing
+// single column conflict check (see bug https://github.com/aarondl/sqlboiler/issues/328).
+// SQLBoiler only checks column conflict on single column only which is not correct as MySQL PK or UNIQUE index
+// can include multiple columns.
+// This function allows passing multiple conflict columns, but it cannot check whether they are correct or not.
+// So use it at your own risk.
+func (o OutboxEventStatusSlice) UpsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, conflictColumns, updateColumns, insertColumns)
+}
+
+func (o OutboxEventStatusSlice) upsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	checkNZUniques := len(conflictColumns) == 0
+	if len(conflictColumns) > 0 {
+		mapConflictColumns := make(map[string]struct{}, len(conflictColumns))
+		for _, col := range conflictColumns {
+			for _, existCol := range outboxEventStatusAllColumns {
+				if col == existCol {
+					mapConflictColumns[col] = struct{}{}
+					break
+				}
+			}
+		}
+		if len(mapConflictColumns) <= 1 {
+			return 0, errors.New("custom conflict columns must be 2 columns or more")
+		}
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		if checkNZUniques {
+			nzUniques := queries.NonZeroDefaultSet(mySQLOutboxEventStatusUniqueColumns, row)
+			if len(nzUniques) == 0 {
+				return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+			}
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			outboxEventStatusAllColumns,
+			outboxEventStatusColumnsWithDefault,
+			outboxEventStatusColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(outboxEventStatusColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+		if len(insertCols) == len(outboxEventStatusAllColumns) || (insertColumns.IsWhitelist() && len(insertCols) == len(insertColumns.Cols)) {
+			break
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range outboxEventStatusAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		outboxEventStatusAllColumns,
+		outboxEventStatusPrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("dbmodel: unable to upsert outbox_event_statuses, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `outbox_event_statuses`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `outbox_event_statuses`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(outboxEventStatusType, outboxEventStatusMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to upsert for outbox_event_statuses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by upsert for outbox_event_statuses")
+	}
+
+	return rowsAff, nil
+}
@@ -0,0 +1,1625 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodel
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// OutboxEvent is an object representing the database table.
+type OutboxEvent struct {
+	// id
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// domain event type
+	EventType string `boil:"event_type" json:"event_type" toml:"event_type" yaml:"event_type"`
+	// tenant_id
+	TenantID null.String `boil:"tenant_id" json:"tenant_id,omitempty" toml:"tenant_id" yaml:"tenant_id,omitempty"`
+	// id of the entity the event is about
+	AggregateID string `boil:"aggregate_id" json:"aggregate_id" toml:"aggregate_id" yaml:"aggregate_id"`
+	// event payload as json
+	Payload string `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	// occurred date
+	OccurredAt time.Time `boil:"occurred_at" json:"occurred_at" toml:"occurred_at" yaml:"occurred_at"`
+	// delivery status
+	Status string `boil:"status" json:"status" toml:"status" yaml:"status"`
+	// number of delivery attempts
+	Attempts uint `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	// next delivery date
+	NextAttemptAt time.Time `boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	// last delivery error
+	LastError null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	// delivered date
+	DeliveredAt null.Time `boil:"delivered_at" json:"delivered_at,omitempty" toml:"delivered_at" yaml:"delivered_at,omitempty"`
+	// created date
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	// update date
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *outboxEventR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L outboxEventL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OutboxEventColumns = struct {
+	ID            string
+	EventType     string
+	TenantID      string
+	AggregateID   string
+	Payload       string
+	OccurredAt    string
+	Status        string
+	Attempts      string
+	NextAttemptAt string
+	LastError     string
+	DeliveredAt   string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "id",
+	EventType:     "event_type",
+	TenantID:      "tenant_id",
+	AggregateID:   "aggregate_id",
+	Payload:       "payload",
+	OccurredAt:    "occurred_at",
+	Status:        "status",
+	Attempts:      "attempts",
+	NextAttemptAt: "next_attempt_at",
+	LastError:     "last_error",
+	DeliveredAt:   "delivered_at",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+}
+
+var OutboxEventTableColumns = struct {
+	ID            string
+	EventType     string
+	TenantID      string
+	AggregateID   string
+	Payload       string
+	OccurredAt    string
+	Status        string
+	Attempts      string
+	NextAttemptAt string
+	LastError     string
+	DeliveredAt   string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "outbox_events.id",
+	EventType:     "outbox_events.event_type",
+	TenantID:      "outbox_events.tenant_id",
+	AggregateID:   "outbox_events.aggregate_id",
+	Payload:       "outbox_events.payload",
+	OccurredAt:    "outbox_events.occurred_at",
+	Status:        "outbox_events.status",
+	Attempts:      "outbox_events.attempts",
+	NextAttemptAt: "outbox_events.next_attempt_at",
+	LastError:     "outbox_events.last_error",
+	DeliveredAt:   "outbox_events.delivered_at",
+	CreatedAt:     "outbox_events.created_at",
+	UpdatedAt:     "outbox_events.updated_at",
+}
+
+// Generated where
+
+var OutboxEventWhere = struct {
+	ID            whereHelperstring
+	EventType     whereHelperstring
+	TenantID      whereHelpernull_String
+	AggregateID   whereHelperstring
+	Payload       whereHelperstring
+	OccurredAt    whereHelpertime_Time
+	Status        whereHelperstring
+	Attempts      whereHelperuint
+	NextAttemptAt whereHelpertime_Time
+	LastError     whereHelpernull_String
+	DeliveredAt   whereHelpernull_Time
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "`outbox_events`.`id`"},
+	EventType:     whereHelperstring{field: "`outbox_events`.`event_type`"},
+	TenantID:      whereHelpernull_String{field: "`outbox_events`.`tenant_id`"},
+	AggregateID:   whereHelperstring{field: "`outbox_events`.`aggregate_id`"},
+	Payload:       whereHelperstring{field: "`outbox_events`.`payload`"},
+	OccurredAt:    whereHelpertime_Time{field: "`outbox_events`.`occurred_at`"},
+	Status:        whereHelperstring{field: "`outbox_events`.`status`"},
+	Attempts:      whereHelperuint{field: "`outbox_events`.`attempts`"},
+	NextAttemptAt: whereHelpertime_Time{field: "`outbox_events`.`next_attempt_at`"},
+	LastError:     whereHelpernull_String{field: "`outbox_events`.`last_error`"},
+	DeliveredAt:   whereHelpernull_Time{field: "`outbox_events`.`delivered_at`"},
+	CreatedAt:     whereHelpertime_Time{field: "`outbox_events`.`created_at`"},
+	UpdatedAt:     whereHelpertime_Time{field: "`outbox_events`.`updated_at`"},
+}
+
+// OutboxEventRels is where relationship names are stored.
+var OutboxEventRels = struct {
+	StatusOutboxEventStatus string
+}{
+	StatusOutboxEventStatus: "StatusOutboxEventStatus",
+}
+
+// outboxEventR is where relationships are stored.
+type outboxEventR struct {
+	StatusOutboxEventStatus *OutboxEventStatus `boil:"StatusOutboxEventStatus" json:"StatusOutboxEventStatus" toml:"StatusOutboxEventStatus" yaml:"StatusOutboxEventStatus"`
+}
+
+// NewStruct creates a new relationship struct
+func (*outboxEventR) NewStruct() *outboxEventR {
+	return &outboxEventR{}
+}
+
+func (o *OutboxEvent) GetStatusOutboxEventStatus() *OutboxEventStatus {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetStatusOutboxEventStatus()
+}
+
+func (r *outboxEventR) GetStatusOutboxEventStatus() *OutboxEventStatus {
+	if r == nil {
+		return nil
+	}
+
+	return r.StatusOutboxEventStatus
+}
+
+// outboxEventL is where Load methods for each relationship are stored.
+type outboxEventL struct{}
+
+var (
+	outboxEventAllColumns            = []string{"id", "event_type", "tenant_id", "aggregate_id", "payload", "occurred_at", "status", "attempts", "next_attempt_at", "last_error", "delivered_at", "created_at", "updated_at"}
+	outboxEventColumnsWithoutDefault = []string{"id", "event_type", "tenant_id", "aggregate_id", "payload", "occurred_at", "status", "attempts", "next_attempt_at", "last_error", "delivered_at", "created_at", "updated_at"}
+	outboxEventColumnsWithDefault    = []string{}
+	outboxEventPrimaryKeyColumns     = []string{"id"}
+	outboxEventGeneratedColumns      = []string{}
+)
+
+type (
+	// OutboxEventSlice is an alias for a slice of pointers to OutboxEvent.
+	// This should almost always be used instead of []OutboxEvent.
+	OutboxEventSlice []*OutboxEvent
+
+	outboxEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	outboxEventType                 = reflect.TypeOf(&OutboxEvent{})
+	outboxEventMapping              = queries.MakeStructMapping(outboxEventType)
+	outboxEventPrimaryKeyMapping, _ = queries.BindMapping(outboxEventType, outboxEventMapping, outboxEventPrimaryKeyColumns)
+	outboxEventInsertCacheMut       sync.RWMutex
+	outboxEventInsertCache          = make(map[string]insertCache)
+	outboxEventUpdateCacheMut       sync.RWMutex
+	outboxEventUpdateCache          = make(map[string]updateCache)
+	outboxEventUpsertCacheMut       sync.RWMutex
+	outboxEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single outboxEvent record from the query using the global executor.
+func (q outboxEventQuery) OneG(ctx context.Context) (*OutboxEvent, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// OneGP returns a single outboxEvent record from the query using the global executor, and panics on error.
+func (q outboxEventQuery) OneGP(ctx context.Context) *OutboxEvent {
+	o, err := q.One(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single outboxEvent record from the query, and panics on error.
+func (q outboxEventQuery) OneP(ctx context.Context, exec boil.ContextExecutor) *OutboxEvent {
+	o, err := q.One(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single outboxEvent record from the query.
+func (q outboxEventQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OutboxEvent, error) {
+	o := &OutboxEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: failed to execute a one query for outbox_events")
+	}
+
+	return o, nil
+}
+
+// AllG returns all OutboxEvent records from the query using the global executor.
+func (q outboxEventQuery) AllG(ctx context.Context) (OutboxEventSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// AllGP returns all OutboxEvent records from the query using the global executor, and panics on error.
+func (q outboxEventQuery) AllGP(ctx context.Context) OutboxEventSlice {
+	o, err := q.All(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all OutboxEvent records from the query, and panics on error.
+func (q outboxEventQuery) AllP(ctx context.Context, exec boil.ContextExecutor) OutboxEventSlice {
+	o, err := q.All(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all OutboxEvent records from the query.
+func (q outboxEventQuery) All(ctx context.Context, exec boil.ContextExecutor) (OutboxEventSlice, error) {
+	var o []*OutboxEvent
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodel: failed to assign all query results to OutboxEvent slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all OutboxEvent records in the query using the global executor
+func (q outboxEventQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// CountGP returns the count of all OutboxEvent records in the query using the global executor, and panics on error.
+func (q outboxEventQuery) CountGP(ctx context.Context) int64 {
+	c, err := q.Count(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all OutboxEvent records in the query, and panics on error.
+func (q outboxEventQuery) CountP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	c, err := q.Count(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all OutboxEvent records in the query.
+func (q outboxEventQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to count outbox_events rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q outboxEventQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q outboxEventQuery) ExistsGP(ctx context.Context) bool {
+	e, err := q.Exists(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q outboxEventQuery) ExistsP(ctx context.Context, exec boil.ContextExecutor) bool {
+	e, err := q.Exists(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q outboxEventQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: failed to check if outbox_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// StatusOutboxEventStatus pointed to by the foreign key.
+func (o *OutboxEvent) StatusOutboxEventStatus(mods ...qm.QueryMod) outboxEventStatusQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.Status),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return OutboxEventStatuses(queryMods...)
+}
+
+// LoadStatusOutboxEventStatus allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (outboxEventL) LoadStatusOutboxEventStatus(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOutboxEvent any, mods queries.Applicator) error {
+	var slice []*OutboxEvent
+	var object *OutboxEvent
+
+	if singular {
+		var ok bool
+		object, ok = maybeOutboxEvent.(*OutboxEvent)
+		if !ok {
+			object = new(OutboxEvent)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOutboxEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOutboxEvent))
+			}
+		}
+	} else {
+		s, ok := maybeOutboxEvent.(*[]*OutboxEvent)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOutboxEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOutboxEvent))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &outboxEventR{}
+		}
+		args[object.Status] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &outboxEventR{}
+			}
+
+			args[obj.Status] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`outbox_event_statuses`),
+		qm.WhereIn(`outbox_event_statuses.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load OutboxEventStatus")
+	}
+
+	var resultSlice []*OutboxEventStatus
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice OutboxEventStatus")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for outbox_event_statuses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for outbox_event_statuses")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.StatusOutboxEventStatus = foreign
+		if foreign.R == nil {
+			foreign.R = &outboxEventStatusR{}
+		}
+		foreign.R.StatusOutboxEvents = append(foreign.R.StatusOutboxEvents, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Status == foreign.ID {
+				local.R.StatusOutboxEventStatus = foreign
+				if foreign.R == nil {
+					foreign.R = &outboxEventStatusR{}
+				}
+				foreign.R.StatusOutboxEvents = append(foreign.R.StatusOutboxEvents, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetStatusOutboxEventStatusG of the outboxEvent to the related item.
+// Sets o.R.StatusOutboxEventStatus to related.
+// Adds o to related.R.StatusOutboxEvents.
+// Uses the global database handle.
+func (o *OutboxEvent) SetStatusOutboxEventStatusG(ctx context.Context, insert bool, related *OutboxEventStatus) error {
+	return o.SetStatusOutboxEventStatus(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetStatusOutboxEventStatusP of the outboxEvent to the related item.
+// Sets o.R.StatusOutboxEventStatus to related.
+// Adds o to related.R.StatusOutboxEvents.
+// Panics on error.
+func (o *OutboxEvent) SetStatusOutboxEventStatusP(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OutboxEventStatus) {
+	if err := o.SetStatusOutboxEventStatus(ctx, exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetStatusOutboxEventStatusGP of the outboxEvent to the related item.
+// Sets o.R.StatusOutboxEventStatus to related.
+// Adds o to related.R.StatusOutboxEvents.
+// Uses the global database handle and panics on error.
+func (o *OutboxEvent) SetStatusOutboxEventStatusGP(ctx context.Context, insert bool, related *OutboxEventStatus) {
+	if err := o.SetStatusOutboxEventStatus(ctx, boil.GetContextDB(), insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetStatusOutboxEventStatus of the outboxEvent to the related item.
+// Sets o.R.StatusOutboxEventStatus to related.
+// Adds o to related.R.StatusOutboxEvents.
+func (o *OutboxEvent) SetStatusOutboxEventStatus(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OutboxEventStatus) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `outbox_events` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"status"}),
+		strmangle.WhereClause("`", "`", 0, outboxEventPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Status = related.ID
+	if o.R == nil {
+		o.R = &outboxEventR{
+			StatusOutboxEventStatus: related,
+		}
+	} else {
+		o.R.StatusOutboxEventStatus = related
+	}
+
+	if related.R == nil {
+		related.R = &outboxEventStatusR{
+			StatusOutboxEvents: OutboxEventSlice{o},
+		}
+	} else {
+		related.R.StatusOutboxEvents = append(related.R.StatusOutboxEvents, o)
+	}
+
+	return nil
+}
+
+// OutboxEvents retrieves all the records using an executor.
+func OutboxEvents(mods ...qm.QueryMod) outboxEventQuery {
+	mods = append(mods, qm.From("`outbox_events`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`outbox_events`.*"})
+	}
+
+	return outboxEventQuery{q}
+}
+
+// FindOutboxEventG retrieves a single record by ID.
+func FindOutboxEventG(ctx context.Context, iD string, selectCols ...string) (*OutboxEvent, error) {
+	return FindOutboxEvent(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindOutboxEventP retrieves a single record by ID with an executor, and panics on error.
+func FindOutboxEventP(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) *OutboxEvent {
+	retobj, err := FindOutboxEvent(ctx, exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindOutboxEventGP retrieves a single record by ID, and panics on error.
+func FindOutboxEventGP(ctx context.Context, iD string, selectCols ...string) *OutboxEvent {
+	retobj, err := FindOutboxEvent(ctx, boil.GetContextDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindOutboxEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOutboxEvent(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*OutboxEvent, error) {
+	outboxEventObj := &OutboxEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `outbox_events` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, outboxEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: unable to select from outbox_events")
+	}
+
+	return outboxEventObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *OutboxEvent) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *OutboxEvent) InsertP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) {
+	if err := o.Insert(ctx, exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *OutboxEvent) InsertGP(ctx context.Context, columns boil.Columns) {
+	if err := o.Insert(ctx, boil.GetContextDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OutboxEvent) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no outbox_events provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(outboxEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	outboxEventInsertCacheMut.RLock()
+	cache, cached := outboxEventInsertCache[key]
+	outboxEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			outboxEventAllColumns,
+			outboxEventColumnsWithDefault,
+			outboxEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(outboxEventType, outboxEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(outboxEventType, outboxEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `outbox_events` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `outbox_events` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `outbox_events` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, outboxEventPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to insert into outbox_events")
+	}
+
+	var identifierCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []any{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to populate default values for outbox_events")
+	}
+
+CacheNoHooks:
+	if !cached {
+		outboxEventInsertCacheMut.Lock()
+		outboxEventInsertCache[key] = cache
+		outboxEventInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single OutboxEvent record using the global executor.
+// See Update for more documentation.
+func (o *OutboxEvent) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// UpdateP uses an executor to update the OutboxEvent, and panics on error.
+// See Update for more documentation.
+func (o *OutboxEvent) UpdateP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateGP a single OutboxEvent record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *OutboxEvent) UpdateGP(ctx context.Context, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, boil.GetContextDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Update uses an executor to update the OutboxEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OutboxEvent) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	outboxEventUpdateCacheMut.RLock()
+	cache, cached := outboxEventUpdateCache[key]
+	outboxEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			outboxEventAllColumns,
+			outboxEventPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodel: unable to update outbox_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `outbox_events` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, outboxEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(outboxEventType, outboxEventMapping, append(wl, outboxEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update outbox_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by update for outbox_events")
+	}
+
+	if !cached {
+		outboxEventUpdateCacheMut.Lock()
+		outboxEventUpdateCache[key] = cache
+		outboxEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q outboxEventQuery) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q outboxEventQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q outboxEventQuery) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q outboxEventQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all for outbox_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected for outbox_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o OutboxEventSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o OutboxEventSlice) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o OutboxEventSlice) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OutboxEventSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodel: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `outbox_events` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, outboxEventPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all in outboxEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected all in update all outboxEvent")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *OutboxEvent) UpsertG(ctx context.Context, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateColumns, insertColumns)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *OutboxEvent) UpsertGP(ctx context.Context, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(ctx, boil.GetContextDB(), updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *OutboxEvent) UpsertP(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert(ctx, exec, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+var mySQLOutboxEventUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OutboxEvent) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no outbox_events provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(outboxEventColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLOutboxEventUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	outboxEventUpsertCacheMut.RLock()
+	cache, cached := outboxEventUpsertCache[key]
+	outboxEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			outboxEventAllColumns,
+			outboxEventColumnsWithDefault,
+			outboxEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			outboxEventAllColumns,
+			outboxEventPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dbmodel: unable to upsert outbox_events, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(outboxEventAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`outbox_events`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `outbox_events` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(outboxEventType, outboxEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(outboxEventType, outboxEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to upsert for outbox_events")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []any
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(outboxEventType, outboxEventMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to retrieve unique values for outbox_events")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to populate default values for outbox_events")
+	}
+
+CacheNoHooks:
+	if !cached {
+		outboxEventUpsertCacheMut.Lock()
+		outboxEventUpsertCache[key] = cache
+		outboxEventUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single OutboxEvent record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *OutboxEvent) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// DeleteP deletes a single OutboxEvent record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *OutboxEvent) DeleteP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.Delete(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteGP deletes a single OutboxEvent record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *OutboxEvent) DeleteGP(ctx context.Context) int64 {
+	rowsAff, err := o.Delete(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Delete deletes a single OutboxEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OutboxEvent) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodel: no OutboxEvent provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), outboxEventPrimaryKeyMapping)
+	sql := "DELETE FROM `outbox_events` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete from outbox_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by delete for outbox_events")
+	}
+
+	return rowsAff, nil
+}
+
+func (q outboxEventQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q outboxEventQuery) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := q.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q outboxEventQuery) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := q.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all matching rows.
+func (q outboxEventQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodel: no outboxEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from outbox_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for outbox_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o OutboxEventSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o OutboxEventSlice) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o OutboxEventSlice) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := o.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OutboxEventSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `outbox_events` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, outboxEventPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from outboxEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for outbox_events")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *OutboxEvent) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: no OutboxEvent provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *OutboxEvent) ReloadP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.Reload(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *OutboxEvent) ReloadGP(ctx context.Context) {
+	if err := o.Reload(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OutboxEvent) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOutboxEvent(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OutboxEventSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: empty OutboxEventSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *OutboxEventSlice) ReloadAllP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.ReloadAll(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *OutboxEventSlice) ReloadAllGP(ctx context.Context) {
+	if err := o.ReloadAll(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OutboxEventSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OutboxEventSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `outbox_events`.* FROM `outbox_events` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, outboxEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to reload all in OutboxEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OutboxEventExistsG checks if the OutboxEvent row exists.
+func OutboxEventExistsG(ctx context.Context, iD string) (bool, error) {
+	return OutboxEventExists(ctx, boil.GetContextDB(), iD)
+}
+
+// OutboxEventExistsP checks if the OutboxEvent row exists. Panics on error.
+func OutboxEventExistsP(ctx context.Context, exec boil.ContextExecutor, iD string) bool {
+	e, err := OutboxEventExists(ctx, exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// OutboxEventExistsGP checks if the OutboxEvent row exists. Panics on error.
+func OutboxEventExistsGP(ctx context.Context, iD string) bool {
+	e, err := OutboxEventExists(ctx, boil.GetContextDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// OutboxEventExists checks if the OutboxEvent row exists.
+func OutboxEventExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `outbox_events` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: unable to check if outbox_events exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OutboxEvent row exists.
+func (o *OutboxEvent) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OutboxEventExists(ctx, exec, o.ID)
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o OutboxEventSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			outboxEventAllColumns,
+			outboxEventColumnsWithDefault,
+			outboxEventColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(outboxEventColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+		if len(wlCols) == len(outboxEventAllColumns) {
+			break
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range outboxEventAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+
+		if i == 0 {
+			sql = "INSERT INTO `outbox_events` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(outboxEventType, outboxEventMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to insert all from outboxEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by insertall for outbox_events")
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o OutboxEventSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows.
+// Currently it doesn't support "NoContext" and "NoRowsAffected".
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values.
+// IMPORTANT: any AUTO_INCREMENT column should be excluded from `updateColumns` and `insertColumns` including PK.
+func (o OutboxEventSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, nil, updateColumns, insertColumns)
+}
+
+// upsertAllOnConflictColumns upserts multiple rows with passing custom conflict columns to allow bypassing
+// single column conflict check (see bug https://github.com/aarondl/sqlboiler/issues/328).
+// SQLBoiler only checks column conflict on single column only which is not correct as MySQL PK or UNIQUE index
+// can include multiple columns.
+// This function allows passing multiple conflict columns, but it cannot check whether they are correct or not.
+// So use it at your own risk.
+func (o OutboxEventSlice) UpsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, conflictColumns, updateColumns, insertColumns)
+}
+
+func (o OutboxEventSlice) upsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	checkNZUniques := len(conflictColumns) == 0
+	if len(conflictColumns) > 0 {
+		mapConflictColumns := make(map[string]struct{}, len(conflictColumns))
+		for _, col := range conflictColumns {
+			for _, existCol := range outboxEventAllColumns {
+				if col == existCol {
+					mapConflictColumns[col] = struct{}{}
+					break
+				}
+			}
+		}
+		if len(mapConflictColumns) <= 1 {
+			return 0, errors.New("custom conflict columns must be 2 columns or more")
+		}
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		if checkNZUniques {
+			nzUniques := queries.NonZeroDefaultSet(mySQLOutboxEventUniqueColumns, row)
+			if len(nzUniques) == 0 {
+				return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+			}
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			outboxEventAllColumns,
+			outboxEventColumnsWithDefault,
+			outboxEventColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(outboxEventColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+		if len(insertCols) == len(outboxEventAllColumns) || (insertColumns.IsWhitelist() && len(insertCols) == len(insertColumns.Cols)) {
+			break
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range outboxEventAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		outboxEventAllColumns,
+		outboxEventPrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("dbmodel: unable to upsert outbox_events, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `outbox_events`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `outbox_events`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(outboxEventType, outboxEventMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to upsert for outbox_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by upsert for outbox_events")
+	}
+
+	return rowsAff, nil
+}
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -41,6 +42,10 @@ type Staff struct {
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	// update date
 	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	// deleted date
+	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	// version incremented on every update
+	Version int64 `boil:"version" json:"version" toml:"version" yaml:"version"`
 
 	R *staffR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L staffL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -56,6 +61,8 @@ var StaffColumns = struct {
 	Email       string
 	CreatedAt   string
 	UpdatedAt   string
+	DeletedAt   string
+	Version     string
 }{
 	ID:          "id",
 	TenantID:    "tenant_id",
@@ -66,6 +73,8 @@ var StaffColumns = struct {
 	Email:       "email",
 	CreatedAt:   "created_at",
 	UpdatedAt:   "updated_at",
+	DeletedAt:   "deleted_at",
+	Version:     "version",
 }
 
 var StaffTableColumns = struct {
@@ -78,6 +87,8 @@ var StaffTableColumns = struct {
 	Email       string
 	CreatedAt   string
 	UpdatedAt   string
+	DeletedAt   string
+	Version     string
 }{
 	ID:          "staffs.id",
 	TenantID:    "staffs.tenant_id",
@@ -88,10 +99,35 @@ var StaffTableColumns = struct {
 	Email:       "staffs.email",
 	CreatedAt:   "staffs.created_at",
 	UpdatedAt:   "staffs.updated_at",
+	DeletedAt:   "staffs.deleted_at",
+	Version:     "staffs.version",
 }
 
 // Generated where
 
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var StaffWhere = struct {
 	ID          whereHelperstring
 	TenantID    whereHelperstring
@@ -102,6 +138,8 @@ var StaffWhere = struct {
 	Email       whereHelperstring
 	CreatedAt   whereHelpertime_Time
 	UpdatedAt   whereHelpertime_Time
+	DeletedAt   whereHelpernull_Time
+	Version     whereHelperint64
 }{
 	ID:          whereHelperstring{field: "`staffs`.`id`"},
 	TenantID:    whereHelperstring{field: "`staffs`.`tenant_id`"},
@@ -112,6 +150,8 @@ var StaffWhere = struct {
 	Email:       whereHelperstring{field: "`staffs`.`email`"},
 	CreatedAt:   whereHelpertime_Time{field: "`staffs`.`created_at`"},
 	UpdatedAt:   whereHelpertime_Time{field: "`staffs`.`updated_at`"},
+	DeletedAt:   whereHelpernull_Time{field: "`staffs`.`deleted_at`"},
+	Version:     whereHelperint64{field: "`staffs`.`version`"},
 }
 
 // StaffRels is where relationship names are stored.
@@ -151,9 +191,9 @@ func (r *staffR) GetTenant() *Tenant {
 type staffL struct{}
 
 var (
-	staffAllColumns            = []string{"id", "tenant_id", "role", "auth_uid", "display_name", "image_path", "email", "created_at", "updated_at"}
-	staffColumnsWithoutDefault = []string{"id", "tenant_id", "role", "auth_uid", "display_name", "image_path", "email", "created_at", "updated_at"}
-	staffColumnsWithDefault    = []string{}
+	staffAllColumns            = []string{"id", "tenant_id", "role", "auth_uid", "display_name", "image_path", "email", "created_at", "updated_at", "deleted_at", "version"}
+	staffColumnsWithoutDefault = []string{"id", "tenant_id", "role", "auth_uid", "display_name", "image_path", "email", "created_at", "updated_at", "deleted_at"}
+	staffColumnsWithDefault    = []string{"version"}
 	staffPrimaryKeyColumns     = []string{"id"}
 	staffGeneratedColumns      = []string{}
 )
@@ -0,0 +1,49 @@
+package marshaller
+
+import (
+	"encoding/json"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/dbmodel"
+)
+
+func AuditLogToModel(e *dbmodel.AuditLog) *model.AuditLog {
+	diff := model.AuditLogDiff{} //nolint:exhaustruct
+	_ = json.Unmarshal([]byte(e.Diff), &diff)
+	return &model.AuditLog{
+		ID:         e.ID,
+		TenantID:   e.TenantID,
+		ActorType:  model.NewAuditLogActorType(e.ActorType),
+		ActorID:    e.ActorID,
+		Method:     e.Method,
+		TargetType: model.NewAuditLogTargetType(e.TargetType),
+		TargetID:   e.TargetID,
+		Diff:       diff,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func AuditLogsToModel(slice dbmodel.AuditLogSlice) model.AuditLogs {
+	dsts := make(model.AuditLogs, len(slice))
+	for idx, e := range slice {
+		dsts[idx] = AuditLogToModel(e)
+	}
+	return dsts
+}
+
+func AuditLogToDBModel(m *model.AuditLog) *dbmodel.AuditLog {
+	diff, _ := json.Marshal(m.Diff) //nolint:errchkjson
+	return &dbmodel.AuditLog{
+		ID:         m.ID,
+		TenantID:   m.TenantID,
+		ActorType:  m.ActorType.String(),
+		ActorID:    m.ActorID,
+		Method:     m.Method,
+		TargetType: m.TargetType.String(),
+		TargetID:   m.TargetID,
+		Diff:       string(diff),
+		CreatedAt:  m.CreatedAt,
+		R:          nil,
+		L:          struct{}{},
+	}
+}
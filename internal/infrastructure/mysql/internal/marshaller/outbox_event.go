@@ -0,0 +1,68 @@
+package marshaller
+
+import (
+	"encoding/json"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/dbmodel"
+)
+
+func OutboxEventToModel(e *dbmodel.OutboxEvent) *model.OutboxEvent {
+	payload := model.DomainEventPayload{}
+	_ = json.Unmarshal([]byte(e.Payload), &payload)
+	return &model.OutboxEvent{
+		ID: e.ID,
+		Event: &model.DomainEvent{
+			ID:          e.ID,
+			Type:        model.NewDomainEventType(e.EventType),
+			TenantID:    e.TenantID,
+			AggregateID: e.AggregateID,
+			Payload:     payload,
+			OccurredAt:  e.OccurredAt,
+		},
+		Status:        model.NewOutboxEventStatus(e.Status),
+		Attempts:      uint64(e.Attempts),
+		NextAttemptAt: e.NextAttemptAt,
+		LastError:     e.LastError,
+		DeliveredAt:   e.DeliveredAt,
+		CreatedAt:     e.CreatedAt,
+		UpdatedAt:     e.UpdatedAt,
+	}
+}
+
+func OutboxEventsToModel(slice dbmodel.OutboxEventSlice) model.OutboxEvents {
+	dsts := make(model.OutboxEvents, len(slice))
+	for idx, e := range slice {
+		dsts[idx] = OutboxEventToModel(e)
+	}
+	return dsts
+}
+
+func OutboxEventToDBModel(m *model.OutboxEvent) *dbmodel.OutboxEvent {
+	payload, _ := json.Marshal(m.Event.Payload) //nolint:errchkjson
+	return &dbmodel.OutboxEvent{
+		ID:            m.ID,
+		EventType:     m.Event.Type.String(),
+		TenantID:      m.Event.TenantID,
+		AggregateID:   m.Event.AggregateID,
+		Payload:       string(payload),
+		OccurredAt:    m.Event.OccurredAt,
+		Status:        m.Status.String(),
+		Attempts:      uint(m.Attempts), //nolint:gosec
+		NextAttemptAt: m.NextAttemptAt,
+		LastError:     m.LastError,
+		DeliveredAt:   m.DeliveredAt,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+		R:             nil,
+		L:             struct{}{},
+	}
+}
+
+func OutboxEventsToDBModel(slice model.OutboxEvents) dbmodel.OutboxEventSlice {
+	dsts := make(dbmodel.OutboxEventSlice, len(slice))
+	for idx, m := range slice {
+		dsts[idx] = OutboxEventToDBModel(m)
+	}
+	return dsts
+}
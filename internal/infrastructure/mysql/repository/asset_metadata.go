@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/marshaller"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/transactable"
+)
+
+type assetMetadata struct{}
+
+func NewAssetMetadata() repository.AssetMetadata {
+	return &assetMetadata{}
+}
+
+func (r *assetMetadata) Get(
+	ctx context.Context,
+	query repository.GetAssetMetadataQuery,
+) (*model.Asset, error) {
+	mods := []qm.QueryMod{}
+	if query.ID.Valid {
+		mods = append(mods, dbmodel.AssetWhere.ID.EQ(query.ID.String))
+	}
+	if query.AuthContext.Valid {
+		mods = append(mods, dbmodel.AssetWhere.AuthContext.EQ(query.AuthContext.Value().String()))
+	}
+	mods = addForUpdateFromBaseGetOptions(mods, query.BaseGetOptions)
+	dbAsset, err := dbmodel.Assets(
+		mods...,
+	).One(ctx, transactable.GetContextExecutor(ctx))
+	if err != nil {
+		if err == sql.ErrNoRows && !query.OrFail {
+			return nil, nil
+		} else if err == sql.ErrNoRows {
+			return nil, errors.AssetNotFoundErr.New().
+				WithDetail("asset is not found").
+				WithValue("query", query)
+		}
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return marshaller.AssetToModel(dbAsset), nil
+}
+
+func (r *assetMetadata) List(
+	ctx context.Context,
+	query repository.ListAssetMetadataQuery,
+) (model.Assets, error) {
+	mods := []qm.QueryMod{}
+	if query.Status.Valid && query.Status.Value().Valid() {
+		mods = append(mods, dbmodel.AssetWhere.Status.EQ(query.Status.Value().String()))
+	}
+	if query.ExpiresBefore.Valid {
+		mods = append(mods, dbmodel.AssetWhere.ExpiresAt.LTE(query.ExpiresBefore.Time))
+	}
+	if query.UploadedBefore.Valid {
+		mods = append(mods, dbmodel.AssetWhere.UploadedAt.LTE(query.UploadedBefore))
+	}
+
+	// Sorting (BEFORE pagination)
+	mods = append(mods, qm.OrderBy("`created_at` ASC, `id` ASC"))
+
+	// Pagination (AFTER sorting)
+	mods = addPaginationFromBaseListOptions(mods, query.BaseListOptions)
+	mods = addForUpdateFromBaseListOptions(mods, query.BaseListOptions)
+	dbAssets, err := dbmodel.Assets(
+		mods...,
+	).All(ctx, transactable.GetContextExecutor(ctx))
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return marshaller.AssetsToModel(dbAssets), nil
+}
+
+func (r *assetMetadata) Create(
+	ctx context.Context,
+	asset *model.Asset,
+) error {
+	dst := marshaller.AssetToDBModel(asset)
+	if err := dst.Insert(ctx, transactable.GetContextExecutor(ctx), boil.Infer()); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *assetMetadata) Update(
+	ctx context.Context,
+	asset *model.Asset,
+) error {
+	dst := marshaller.AssetToDBModel(asset)
+	if _, err := dst.Update(ctx, transactable.GetContextExecutor(ctx), boil.Infer()); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *assetMetadata) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	dst := marshaller.AssetToDBModel(&model.Asset{ID: id}) //nolint:exhaustruct
+	if _, err := dst.Delete(ctx, transactable.GetContextExecutor(ctx)); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
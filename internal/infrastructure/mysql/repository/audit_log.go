@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/marshaller"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/transactable"
+)
+
+type auditLog struct{}
+
+func NewAuditLog() repository.AuditLog {
+	return &auditLog{}
+}
+
+func (r *auditLog) List(
+	ctx context.Context,
+	query repository.ListAuditLogsQuery,
+) (model.AuditLogs, error) {
+	mods := r.buildListQuery(query)
+
+	// Sorting (BEFORE pagination)
+	mods = append(mods, qm.OrderBy("`created_at` DESC, `id` DESC"))
+
+	// Pagination (AFTER sorting)
+	mods = addPaginationFromBaseListOptions(mods, query.BaseListOptions)
+	mods = addForUpdateFromBaseListOptions(mods, query.BaseListOptions)
+	dbAuditLogs, err := dbmodel.AuditLogs(
+		mods...,
+	).All(ctx, transactable.GetContextExecutor(ctx))
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return marshaller.AuditLogsToModel(dbAuditLogs), nil
+}
+
+func (r *auditLog) Count(
+	ctx context.Context,
+	query repository.ListAuditLogsQuery,
+) (uint64, error) {
+	mods := r.buildListQuery(query)
+	ttl, err := dbmodel.AuditLogs(
+		mods...,
+	).Count(ctx, transactable.GetContextExecutor(ctx))
+	if err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	return uint64(ttl), nil
+}
+
+func (r *auditLog) buildListQuery(query repository.ListAuditLogsQuery) []qm.QueryMod {
+	mods := []qm.QueryMod{}
+	if query.TenantID.Valid {
+		mods = append(mods, dbmodel.AuditLogWhere.TenantID.EQ(query.TenantID))
+	}
+	if query.ActorType.Valid && query.ActorType.Value().Valid() {
+		mods = append(mods, dbmodel.AuditLogWhere.ActorType.EQ(query.ActorType.Value().String()))
+	}
+	if query.ActorID.Valid {
+		mods = append(mods, dbmodel.AuditLogWhere.ActorID.EQ(query.ActorID.String))
+	}
+	if query.TargetType.Valid && query.TargetType.Value().Valid() {
+		mods = append(mods, dbmodel.AuditLogWhere.TargetType.EQ(query.TargetType.Value().String()))
+	}
+	if query.TargetID.Valid {
+		mods = append(mods, dbmodel.AuditLogWhere.TargetID.EQ(query.TargetID.String))
+	}
+	return mods
+}
+
+func (r *auditLog) Create(
+	ctx context.Context,
+	auditLog *model.AuditLog,
+) error {
+	dst := marshaller.AuditLogToDBModel(auditLog)
+	if err := dst.Insert(ctx, transactable.GetContextExecutor(ctx), boil.Infer()); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
 )
@@ -26,3 +29,49 @@ func addForUpdateFromBaseListOptions(mods []qm.QueryMod, query repository.BaseLi
 	}
 	return mods
 }
+
+// addPaginationFromBaseListOptions limits the list by offset when Page is set,
+// and to a bare Limit only for keyset pagination, where the rows after the cursor are restricted by the caller.
+func addPaginationFromBaseListOptions(mods []qm.QueryMod, query repository.BaseListOptions) []qm.QueryMod {
+	if query.Page.Valid && query.Limit.Valid {
+		return append(mods,
+			qm.Limit(int(query.Limit.Uint64)),
+			qm.Offset(int(query.Limit.Uint64*(query.Page.Uint64-1))),
+		)
+	}
+	if query.Keyset && query.Limit.Valid {
+		mods = append(mods, qm.Limit(int(query.Limit.Uint64)))
+	}
+	return mods
+}
+
+// newKeysetQueryMod restricts a list ordered by column and then idColumn to the rows after the cursor position.
+func newKeysetQueryMod(
+	column string,
+	idColumn string,
+	desc bool,
+	value interface{},
+	id string,
+) qm.QueryMod {
+	op := ">"
+	if desc {
+		op = "<"
+	}
+	return qm.Where(
+		fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", column, op, column, idColumn, op),
+		value, value, id,
+	)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// newContainsQueryMod restricts the list to the rows whose column contains text, ignoring case.
+func newContainsQueryMod(
+	column string,
+	text string,
+) qm.QueryMod {
+	return qm.Where(
+		fmt.Sprintf("LOWER(%s) LIKE ?", column),
+		"%"+likeEscaper.Replace(strings.ToLower(text))+"%",
+	)
+}
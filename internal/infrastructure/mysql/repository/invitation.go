@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/marshaller"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/transactable"
+)
+
+type invitation struct{}
+
+func NewInvitation() repository.Invitation {
+	return &invitation{}
+}
+
+func (r *invitation) Get(
+	ctx context.Context,
+	query repository.GetInvitationQuery,
+) (*model.Invitation, error) {
+	mods := []qm.QueryMod{}
+	if query.ID.Valid {
+		mods = append(mods, dbmodel.InvitationWhere.ID.EQ(query.ID.String))
+	}
+	if query.TenantID.Valid {
+		mods = append(mods, dbmodel.InvitationWhere.TenantID.EQ(query.TenantID.String))
+	}
+	if query.TokenHash.Valid {
+		mods = append(mods, dbmodel.InvitationWhere.TokenHash.EQ(query.TokenHash.String))
+	}
+	mods = addForUpdateFromBaseGetOptions(mods, query.BaseGetOptions)
+	dbInvitation, err := dbmodel.Invitations(
+		mods...,
+	).One(ctx, transactable.GetContextExecutor(ctx))
+	if err != nil {
+		if err == sql.ErrNoRows && !query.OrFail {
+			return nil, nil
+		} else if err == sql.ErrNoRows {
+			return nil, errors.InvitationNotFoundErr.New().
+				WithDetail("invitation is not found").
+				WithValue("query", query)
+		}
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return marshaller.InvitationToModel(dbInvitation), nil
+}
+
+func (r *invitation) List(
+	ctx context.Context,
+	query repository.ListInvitationsQuery,
+) (model.Invitations, error) {
+	mods := r.buildListQuery(query)
+
+	// Sorting (BEFORE pagination)
+	mods = append(mods, qm.OrderBy("`created_at` DESC, `id` DESC"))
+
+	// Pagination (AFTER sorting)
+	mods = addPaginationFromBaseListOptions(mods, query.BaseListOptions)
+	mods = addForUpdateFromBaseListOptions(mods, query.BaseListOptions)
+	dbInvitations, err := dbmodel.Invitations(
+		mods...,
+	).All(ctx, transactable.GetContextExecutor(ctx))
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return marshaller.InvitationsToModel(dbInvitations), nil
+}
+
+func (r *invitation) Count(
+	ctx context.Context,
+	query repository.ListInvitationsQuery,
+) (uint64, error) {
+	mods := r.buildListQuery(query)
+	ttl, err := dbmodel.Invitations(
+		mods...,
+	).Count(ctx, transactable.GetContextExecutor(ctx))
+	if err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	return uint64(ttl), nil
+}
+
+func (r *invitation) buildListQuery(query repository.ListInvitationsQuery) []qm.QueryMod {
+	mods := []qm.QueryMod{}
+	if query.TenantID.Valid {
+		mods = append(mods, dbmodel.InvitationWhere.TenantID.EQ(query.TenantID.String))
+	}
+	return mods
+}
+
+func (r *invitation) Create(
+	ctx context.Context,
+	invitation *model.Invitation,
+) error {
+	dst := marshaller.InvitationToDBModel(invitation)
+	if err := dst.Insert(ctx, transactable.GetContextExecutor(ctx), boil.Infer()); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *invitation) Update(
+	ctx context.Context,
+	invitation *model.Invitation,
+) error {
+	dst := marshaller.InvitationToDBModel(invitation)
+	if _, err := dst.Update(ctx, transactable.GetContextExecutor(ctx), boil.Infer()); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
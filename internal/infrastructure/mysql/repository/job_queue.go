@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/marshaller"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/transactable"
+)
+
+type jobQueue struct{}
+
+func NewJobQueue() repository.JobQueue {
+	return &jobQueue{}
+}
+
+func (r *jobQueue) Enqueue(
+	ctx context.Context,
+	job *model.Job,
+) error {
+	dst := marshaller.JobToDBModel(job)
+	if err := dst.Insert(ctx, transactable.GetContextExecutor(ctx), boil.Infer()); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *jobQueue) Dequeue(
+	ctx context.Context,
+	query repository.DequeueJobsQuery,
+) (model.Jobs, error) {
+	mods := []qm.QueryMod{
+		qm.Expr(
+			dbmodel.JobWhere.Status.EQ(model.JobStatusPending.String()),
+			dbmodel.JobWhere.RunAt.LTE(query.RequestTime),
+		),
+		// take over jobs whose worker died before finishing them
+		qm.Or2(qm.Expr(
+			dbmodel.JobWhere.Status.EQ(model.JobStatusRunning.String()),
+			dbmodel.JobWhere.LockedUntil.LTE(null.TimeFrom(query.RequestTime)),
+		)),
+		qm.OrderBy("`run_at` ASC, `id` ASC"),
+		qm.Limit(int(query.Limit)), //nolint:gosec
+		// let concurrent workers claim different jobs
+		qm.For("UPDATE SKIP LOCKED"),
+	}
+	dbJobs, err := dbmodel.Jobs(
+		mods...,
+	).All(ctx, transactable.GetContextExecutor(ctx))
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	jobs := marshaller.JobsToModel(dbJobs)
+	lockedUntil := query.RequestTime.Add(query.LeaseDuration)
+	for _, job := range jobs {
+		job.Start(lockedUntil, query.RequestTime)
+		if err := r.update(ctx, job); err != nil {
+			return nil, err
+		}
+	}
+	return jobs, nil
+}
+
+// Update records the result of the job only while the job is still running the attempt it was claimed for,
+// and returns JobLeaseExpiredErr once its lease has expired and another worker has claimed it again.
+func (r *jobQueue) Update(
+	ctx context.Context,
+	job *model.Job,
+) error {
+	dst := marshaller.JobToDBModel(job)
+	rowsAff, err := dbmodel.Jobs(
+		dbmodel.JobWhere.ID.EQ(job.ID),
+		dbmodel.JobWhere.Status.EQ(model.JobStatusRunning.String()),
+		dbmodel.JobWhere.Attempts.EQ(uint(job.Attempts)), //nolint:gosec
+	).UpdateAll(ctx, transactable.GetContextExecutor(ctx), dbmodel.M{
+		dbmodel.JobColumns.Status:      dst.Status,
+		dbmodel.JobColumns.RunAt:       dst.RunAt,
+		dbmodel.JobColumns.LockedUntil: dst.LockedUntil,
+		dbmodel.JobColumns.LastError:   dst.LastError,
+		dbmodel.JobColumns.FinishedAt:  dst.FinishedAt,
+		dbmodel.JobColumns.UpdatedAt:   dst.UpdatedAt,
+	})
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	if rowsAff == 0 {
+		return errors.JobLeaseExpiredErr.New().
+			WithDetail("job has been claimed again").
+			WithValue("job_id", job.ID)
+	}
+	return nil
+}
+
+func (r *jobQueue) update(
+	ctx context.Context,
+	job *model.Job,
+) error {
+	dst := marshaller.JobToDBModel(job)
+	if _, err := dst.Update(ctx, transactable.GetContextExecutor(ctx), boil.Infer()); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
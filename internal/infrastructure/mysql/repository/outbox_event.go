@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/marshaller"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/transactable"
+)
+
+type outboxEvent struct{}
+
+func NewOutboxEvent() repository.OutboxEvent {
+	return &outboxEvent{}
+}
+
+func (r *outboxEvent) List(
+	ctx context.Context,
+	query repository.ListOutboxEventsQuery,
+) (model.OutboxEvents, error) {
+	mods := []qm.QueryMod{}
+	if query.Status.Valid && query.Status.Value().Valid() {
+		mods = append(mods, dbmodel.OutboxEventWhere.Status.EQ(query.Status.Value().String()))
+	}
+	if query.NextAttemptBefore.Valid {
+		mods = append(mods, dbmodel.OutboxEventWhere.NextAttemptAt.LTE(query.NextAttemptBefore.Time))
+	}
+
+	// Sorting (BEFORE pagination)
+	mods = append(mods, qm.OrderBy("`created_at` ASC, `id` ASC"))
+
+	// Pagination (AFTER sorting)
+	mods = addPaginationFromBaseListOptions(mods, query.BaseListOptions)
+	mods = addForUpdateFromBaseListOptions(mods, query.BaseListOptions)
+	dbOutboxEvents, err := dbmodel.OutboxEvents(
+		mods...,
+	).All(ctx, transactable.GetContextExecutor(ctx))
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return marshaller.OutboxEventsToModel(dbOutboxEvents), nil
+}
+
+func (r *outboxEvent) BatchCreate(
+	ctx context.Context,
+	outboxEvents model.OutboxEvents,
+) error {
+	if len(outboxEvents) == 0 {
+		return nil
+	}
+	dsts := marshaller.OutboxEventsToDBModel(outboxEvents)
+	if _, err := dsts.InsertAll(ctx, transactable.GetContextExecutor(ctx), boil.Infer()); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *outboxEvent) Update(
+	ctx context.Context,
+	outboxEvent *model.OutboxEvent,
+) error {
+	dst := marshaller.OutboxEventToDBModel(outboxEvent)
+	if _, err := dst.Update(ctx, transactable.GetContextExecutor(ctx), boil.Infer()); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
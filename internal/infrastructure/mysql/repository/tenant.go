@@ -12,6 +12,7 @@ import (
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/dbmodel"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/internal/marshaller"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/mysql/transactable"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
 )
 
 type tenant struct{}
@@ -28,6 +29,9 @@ func (r *tenant) Get(
 	if query.ID.Valid {
 		mods = append(mods, dbmodel.TenantWhere.ID.EQ(query.ID.String))
 	}
+	if !query.IncludeDeleted {
+		mods = append(mods, dbmodel.TenantWhere.DeletedAt.IsNull())
+	}
 	mods = append(mods, r.buildPreload(query.Preload)...)
 	mods = addForUpdateFromBaseGetOptions(mods, query.BaseGetOptions)
 	dbTenant, err := dbmodel.Tenants(
@@ -51,30 +55,35 @@ func (r *tenant) List(
 	query repository.ListTenantsQuery,
 ) (model.Tenants, error) {
 	mods := []qm.QueryMod{}
+	mods = append(mods, r.buildListQuery(query)...)
 
 	// Sorting (BEFORE pagination)
 	if query.SortKey.Valid && query.SortKey.Value().Valid() {
 		switch query.SortKey.Value() {
 		case model.TenantSortKeyCreatedAtDesc:
-			mods = append(mods, qm.OrderBy("`created_at` DESC"))
+			mods = append(mods, qm.OrderBy("`created_at` DESC, `id` DESC"))
 		case model.TenantSortKeyCreatedAtAsc:
-			mods = append(mods, qm.OrderBy("`created_at` ASC"))
+			mods = append(mods, qm.OrderBy("`created_at` ASC, `id` ASC"))
 		case model.TenantSortKeyNameAsc:
-			mods = append(mods, qm.OrderBy("`name` ASC"))
+			mods = append(mods, qm.OrderBy("`name` ASC, `id` ASC"))
 		case model.TenantSortKeyNameDesc:
-			mods = append(mods, qm.OrderBy("`name` DESC"))
+			mods = append(mods, qm.OrderBy("`name` DESC, `id` DESC"))
 		case model.TenantSortKeyUnknown:
 			return nil, errors.InternalErr.Errorf("invalid sort key: %s", query.SortKey.Value())
 		}
 	}
 
-	// Pagination
-	if query.Page.Valid && query.Limit.Valid {
-		mods = append(mods,
-			qm.Limit(int(query.Limit.Uint64)),
-			qm.Offset(int(query.Limit.Uint64*(query.Page.Uint64-1))),
-		)
+	// Keyset pagination
+	if query.Cursor.Valid {
+		mod, err := r.buildCursorQuery(query.SortKey, query.Cursor.Value())
+		if err != nil {
+			return nil, err
+		}
+		mods = append(mods, mod)
 	}
+
+	// Pagination
+	mods = addPaginationFromBaseListOptions(mods, query.BaseListOptions)
 	mods = append(mods, r.buildPreload(query.Preload)...)
 	mods = addForUpdateFromBaseListOptions(mods, query.BaseListOptions)
 	dbTenants, err := dbmodel.Tenants(
@@ -86,6 +95,41 @@ func (r *tenant) List(
 	return marshaller.TenantsToModel(dbTenants), nil
 }
 
+func (r *tenant) buildCursorQuery(
+	sortKey nullable.Type[model.TenantSortKey],
+	cursor model.Cursor,
+) (qm.QueryMod, error) {
+	if !sortKey.Valid {
+		return nil, errors.InternalErr.Errorf("cursor requires sort key")
+	}
+	switch sortKey.Value() {
+	case model.TenantSortKeyCreatedAtDesc, model.TenantSortKeyCreatedAtAsc:
+		createdAt, err := cursor.TimeValue()
+		if err != nil {
+			return nil, err
+		}
+		return newKeysetQueryMod(
+			"`created_at`",
+			"`id`",
+			sortKey.Value() == model.TenantSortKeyCreatedAtDesc,
+			createdAt,
+			cursor.ID,
+		), nil
+	case model.TenantSortKeyNameAsc, model.TenantSortKeyNameDesc:
+		return newKeysetQueryMod(
+			"`name`",
+			"`id`",
+			sortKey.Value() == model.TenantSortKeyNameDesc,
+			cursor.Value,
+			cursor.ID,
+		), nil
+	case model.TenantSortKeyUnknown:
+		fallthrough
+	default:
+		return nil, errors.InternalErr.Errorf("invalid sort key: %s", sortKey.Value())
+	}
+}
+
 func (r *tenant) Count(
 	ctx context.Context,
 	query repository.ListTenantsQuery,
@@ -100,8 +144,34 @@ func (r *tenant) Count(
 	return uint64(ttl), nil
 }
 
-func (r *tenant) buildListQuery(_ repository.ListTenantsQuery) []qm.QueryMod {
-	return []qm.QueryMod{}
+func (r *tenant) buildListQuery(query repository.ListTenantsQuery) []qm.QueryMod {
+	mods := []qm.QueryMod{}
+	if !query.IncludeDeleted {
+		mods = append(mods, dbmodel.TenantWhere.DeletedAt.IsNull())
+	}
+	if query.DeletedBefore.Valid {
+		mods = append(mods, dbmodel.TenantWhere.DeletedAt.LT(query.DeletedBefore))
+	}
+	if query.Name.Valid {
+		mods = append(mods, newContainsQueryMod("`name`", query.Name.String))
+	}
+	if query.CreatedAtFrom.Valid {
+		mods = append(mods, dbmodel.TenantWhere.CreatedAt.GTE(query.CreatedAtFrom.Time))
+	}
+	if query.CreatedAtTo.Valid {
+		mods = append(mods, dbmodel.TenantWhere.CreatedAt.LT(query.CreatedAtTo.Time))
+	}
+	if len(query.TagTypes) > 0 {
+		tagTypes := make([]interface{}, 0, len(query.TagTypes))
+		for _, tagType := range query.TagTypes {
+			tagTypes = append(tagTypes, tagType.String())
+		}
+		mods = append(mods, qm.WhereIn(
+			"`tenants`.`id` IN (SELECT `tenant_id` FROM `tenant_tags` WHERE `type` IN ?)",
+			tagTypes...,
+		))
+	}
+	return mods
 }
 
 func (r *tenant) buildPreload(_ bool) []qm.QueryMod {
@@ -214,6 +284,17 @@ func (r *tenant) Delete(
 	ctx context.Context,
 	id string,
 ) error {
+	if _, err := dbmodel.TenantTags(
+		dbmodel.TenantTagWhere.TenantID.EQ(id),
+	).DeleteAll(ctx, transactable.GetContextExecutor(ctx)); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	if _, err := dbmodel.Invitations(
+		dbmodel.InvitationWhere.TenantID.EQ(id),
+	).DeleteAll(ctx, transactable.GetContextExecutor(ctx)); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+
 	dst := &dbmodel.Tenant{ //nolint:exhaustruct
 		ID: id,
 	}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenant_List(t *testing.T) {
+	// the database of sqlboiler is global, so the cases do not run in parallel
+
+	selectTenants := "SELECT `tenants`.* FROM `tenants` WHERE (`tenants`.`deleted_at` is null) ORDER BY `created_at` DESC, `id` DESC"
+	cursor := model.NewTimeCursor(model.TenantSortKeyCreatedAtDesc.String(), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), "tenant_id")
+
+	tests := map[string]struct {
+		options repository.BaseListOptions
+		want    string
+	}{
+		"page is limited by offset": {
+			options: repository.BaseListOptions{
+				Page:  null.Uint64From(3),
+				Limit: null.Uint64From(10),
+			},
+			want: selectTenants + " LIMIT 10 OFFSET 20;",
+		},
+		"limit without page is ignored": {
+			options: repository.BaseListOptions{
+				Limit: null.Uint64From(10),
+			},
+			want: selectTenants + ";",
+		},
+		"first keyset page is limited without offset": {
+			options: repository.BaseListOptions{
+				Limit:  null.Uint64From(10),
+				Keyset: true,
+			},
+			want: selectTenants + " LIMIT 10;",
+		},
+		"keyset page after cursor is limited without offset": {
+			options: repository.BaseListOptions{
+				Limit:  null.Uint64From(10),
+				Keyset: true,
+				Cursor: nullable.TypeFrom(*cursor),
+			},
+			want: "SELECT `tenants`.* FROM `tenants` WHERE (`tenants`.`deleted_at` is null) AND " +
+				"((`created_at` < ? OR (`created_at` = ? AND `id` < ?))) ORDER BY `created_at` DESC, `id` DESC LIMIT 10;",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			require.NoError(t, err)
+			defer func() { _ = db.Close() }()
+			boil.SetDB(db)
+
+			mock.ExpectQuery(tc.want).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			got, err := NewTenant().List(context.Background(), repository.ListTenantsQuery{
+				BaseListOptions: tc.options,
+				SortKey:         nullable.TypeFrom(model.TenantSortKeyCreatedAtDesc),
+			})
+			require.NoError(t, err)
+			require.Empty(t, got)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
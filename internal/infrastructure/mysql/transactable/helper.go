@@ -4,37 +4,89 @@ import (
 	"context"
 	"database/sql"
 	std_errors "errors"
-	"time"
+	"fmt"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
-	"github.com/avast/retry-go"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/metrics"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/tracing"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/backoff"
 	"github.com/go-sql-driver/mysql"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
 )
 
 var ctxTxKey = struct{}{}
 
-// GetContextExecutor :.
+// txState is the transaction in the context.
+// depth counts the savepoints of nested read-write transactions.
+type txState struct {
+	tx       *sql.Tx
+	readOnly bool
+	depth    int
+}
+
+func getTxState(ctx context.Context) (*txState, bool) {
+	state, ok := ctx.Value(&ctxTxKey).(*txState)
+	return state, ok
+}
+
+// GetContextExecutor returns the transaction in ctx, or the database outside a transaction.
+// The queries run with it are traced.
 func GetContextExecutor(ctx context.Context) boil.ContextExecutor {
-	if tx, ok := ctx.Value(&ctxTxKey).(*sql.Tx); ok {
-		return tx
+	if state, ok := getTxState(ctx); ok {
+		return tracing.NewContextExecutor(state.tx, semconv.DBSystemNameMySQL)
+	}
+	return tracing.NewContextExecutor(boil.GetContextDB(), semconv.DBSystemNameMySQL)
+}
+
+// RunTx runs fn in a read-write transaction.
+// Nested in another read-write transaction, fn runs in a savepoint of it,
+// so an error only rolls back the changes made by fn.
+var RunTx = func(ctx context.Context, retryPolicy backoff.RetryPolicy, fn func(context.Context) error) error {
+	if state, ok := getTxState(ctx); ok {
+		if state.readOnly {
+			return errors.InternalErr.New().
+				WithDetail("read-write transaction cannot be nested in a read-only transaction")
+		}
+		return runSavepoint(ctx, state, fn)
+	}
+	db, ok := boil.GetContextDB().(boil.ContextBeginner)
+	if !ok {
+		panic("The database in the context does not support boil.ContextBeginner")
 	}
-	return boil.GetContextDB()
+	return runTxWithDB(ctx, db, retryPolicy, nil, fn)
 }
 
-// RunTx :.
-var RunTx = func(ctx context.Context, fn func(context.Context) error) error {
-	db, ok := GetContextExecutor(ctx).(boil.ContextBeginner)
+// RunROTx runs fn in a read-only REPEATABLE READ transaction. InnoDB takes the snapshot at the first read
+// rather than at the start as START TRANSACTION WITH CONSISTENT SNAPSHOT would, which database/sql cannot issue,
+// so every read of fn sees the data committed before its first read.
+// Nested in another transaction, fn joins it.
+var RunROTx = func(ctx context.Context, retryPolicy backoff.RetryPolicy, fn func(context.Context) error) error {
+	if _, ok := getTxState(ctx); ok {
+		return fn(ctx)
+	}
+	db, ok := boil.GetContextDB().(boil.ContextBeginner)
 	if !ok {
 		panic("The database in the context does not support boil.ContextBeginner")
 	}
-	return runTxWithDB(ctx, db, fn)
+	return runTxWithDB(ctx, db, retryPolicy, &sql.TxOptions{
+		Isolation: sql.LevelRepeatableRead,
+		ReadOnly:  true,
+	}, fn)
 }
 
 // RunTxWithDB :.
-func runTxWithDB(ctx context.Context, db boil.ContextBeginner, fn func(context.Context) error) error {
+func runTxWithDB(
+	ctx context.Context,
+	db boil.ContextBeginner,
+	retryPolicy backoff.RetryPolicy,
+	opts *sql.TxOptions,
+	fn func(context.Context) error,
+) error {
+	attempts := 0
 	txFn := func() error {
-		tx, err := db.BeginTx(ctx, nil)
+		attempts++
+		tx, err := db.BeginTx(ctx, opts)
 		if err != nil {
 			return errors.InternalErr.Wrap(err)
 		}
@@ -47,7 +99,11 @@ func runTxWithDB(ctx context.Context, db boil.ContextBeginner, fn func(context.C
 			}
 		}()
 
-		ctxWithTx := context.WithValue(ctx, &ctxTxKey, tx)
+		ctxWithTx := context.WithValue(ctx, &ctxTxKey, &txState{
+			tx:       tx,
+			readOnly: opts != nil && opts.ReadOnly,
+			depth:    0,
+		})
 		if err := fn(ctxWithTx); err != nil {
 			if rollbackErr := tx.Rollback(); rollbackErr != nil {
 				return errors.InternalErr.Wrap(rollbackErr)
@@ -63,35 +119,57 @@ func runTxWithDB(ctx context.Context, db boil.ContextBeginner, fn func(context.C
 		return nil
 	}
 
-	if err := retry.Do(
-		txFn,
-		retry.RetryIf(isDeadLock),
-		retry.DelayType(func(n uint, err error, config *retry.Config) time.Duration {
-			return time.Duration(n) * time.Second
-		}),
-		retry.Attempts(4),
-		retry.LastErrorOnly(true),
-	); err != nil {
+	err := retryPolicy.Do(ctx, "mysql transaction", isRetryable, txFn)
+	metrics.AddTransactionRetries("mysql", attempts)
+	if err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func isDeadLock(err error) bool {
-	if err == nil {
-		return false
+// runSavepoint runs fn in a savepoint of the outer transaction.
+// It is not retried on its own, a transient error aborts the outer transaction which retries as a whole.
+func runSavepoint(ctx context.Context, state *txState, fn func(context.Context) error) error {
+	name := fmt.Sprintf("sp_%d", state.depth+1)
+	if _, err := state.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return errors.InternalErr.Wrap(err)
 	}
-
-	deadlockErr := &mysql.MySQLError{Number: 1213}
-	if std_errors.As(err, &deadlockErr) {
-		for err != nil {
-			err = std_errors.Unwrap(err)
-			if std_errors.Is(err, deadlockErr) {
-				return true
-			}
+	defer func() {
+		if err := recover(); err != nil {
+			_, _ = state.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			panic(err)
 		}
+	}()
+
+	ctxWithSavepoint := context.WithValue(ctx, &ctxTxKey, &txState{
+		tx:       state.tx,
+		readOnly: false,
+		depth:    state.depth + 1,
+	})
+	if err := fn(ctxWithSavepoint); err != nil {
+		// When the savepoint is gone, e.g. after a deadlock, the outer transaction is rolled back
+		// anyway, so the original error is returned for the retry to see it.
+		_, _ = state.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+		return err
+	}
+	if _, err := state.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return errors.InternalErr.Wrap(err)
 	}
+	return nil
+}
 
-	return false
+// isRetryable reports whether err is a transient lock conflict that succeeds on retry:
+// a deadlock (1213) or a lock wait timeout (1205).
+func isRetryable(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if !std_errors.As(err, &mysqlErr) {
+		return false
+	}
+	switch mysqlErr.Number {
+	case 1213, 1205:
+		return true
+	default:
+		return false
+	}
 }
@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	goerrors "errors"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/postgresql/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/postgresql/transactable"
+	"github.com/lib/pq"
+)
+
+// pqErrUniqueViolation is the error code of a primary key violation.
+const pqErrUniqueViolation = "23505"
+
+type idempotencyKey struct{}
+
+// NewIdempotencyKey returns an IdempotencyKey storing the keys in the idempotency_keys table.
+// Expired keys are deleted when they are claimed again, and swept by the worker with DeleteExpired.
+func NewIdempotencyKey() cache.IdempotencyKey {
+	return &idempotencyKey{}
+}
+
+func (c *idempotencyKey) Claim(
+	ctx context.Context,
+	key *model.IdempotencyKey,
+	t time.Time,
+) (*model.IdempotencyKey, bool, error) {
+	exec := transactable.GetContextExecutor(ctx)
+	if _, err := dbmodel.IdempotencyKeys(
+		dbmodel.IdempotencyKeyWhere.ID.EQ(key.ID),
+		dbmodel.IdempotencyKeyWhere.ExpiresAt.LTE(t),
+	).DeleteAll(ctx, exec); err != nil {
+		return nil, false, errors.InternalErr.Wrap(err)
+	}
+
+	dst := idempotencyKeyToDBModel(key)
+	if err := dst.Insert(ctx, exec, boil.Infer()); err != nil {
+		var pqErr *pq.Error
+		if !goerrors.As(err, &pqErr) || string(pqErr.Code) != pqErrUniqueViolation {
+			return nil, false, errors.InternalErr.Wrap(err)
+		}
+		dbKey, err := dbmodel.FindIdempotencyKey(ctx, exec, key.ID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				// released in the meantime
+				return c.Claim(ctx, key, t)
+			}
+			return nil, false, errors.InternalErr.Wrap(err)
+		}
+		return idempotencyKeyToModel(dbKey), false, nil
+	}
+	return key, true, nil
+}
+
+func (c *idempotencyKey) Complete(
+	ctx context.Context,
+	key *model.IdempotencyKey,
+) error {
+	dst := idempotencyKeyToDBModel(key)
+	if _, err := dst.Update(ctx, transactable.GetContextExecutor(ctx), boil.Infer()); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (c *idempotencyKey) Release(
+	ctx context.Context,
+	id string,
+) error {
+	if _, err := dbmodel.IdempotencyKeys(
+		dbmodel.IdempotencyKeyWhere.ID.EQ(id),
+	).DeleteAll(ctx, transactable.GetContextExecutor(ctx)); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (c *idempotencyKey) DeleteExpired(
+	ctx context.Context,
+	t time.Time,
+	limit uint64,
+) (int, error) {
+	exec := transactable.GetContextExecutor(ctx)
+	dbKeys, err := dbmodel.IdempotencyKeys(
+		qm.Select(dbmodel.IdempotencyKeyColumns.ID),
+		dbmodel.IdempotencyKeyWhere.ExpiresAt.LTE(t),
+		qm.Limit(int(limit)), //nolint:gosec
+	).All(ctx, exec)
+	if err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	if len(dbKeys) == 0 {
+		return 0, nil
+	}
+	ids := make([]string, 0, len(dbKeys))
+	for _, dbKey := range dbKeys {
+		ids = append(ids, dbKey.ID)
+	}
+	deleted, err := dbmodel.IdempotencyKeys(
+		dbmodel.IdempotencyKeyWhere.ID.IN(ids),
+	).DeleteAll(ctx, exec)
+	if err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	return int(deleted), nil
+}
+
+func idempotencyKeyToDBModel(m *model.IdempotencyKey) *dbmodel.IdempotencyKey {
+	return &dbmodel.IdempotencyKey{
+		ID:          m.ID,
+		Fingerprint: m.Fingerprint,
+		Response:    null.NewBytes(m.Response, m.Response != nil),
+		ExpiresAt:   m.ExpiresAt,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+		R:           nil,
+		L:           struct{}{},
+	}
+}
+
+func idempotencyKeyToModel(e *dbmodel.IdempotencyKey) *model.IdempotencyKey {
+	return &model.IdempotencyKey{
+		ID:          e.ID,
+		Fingerprint: e.Fingerprint,
+		Response:    e.Response.Bytes,
+		ExpiresAt:   e.ExpiresAt,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
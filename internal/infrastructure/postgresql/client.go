@@ -1,6 +1,7 @@
 package postgresql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"time"
@@ -50,3 +51,8 @@ func NewClient(
 		DB: db,
 	}
 }
+
+// Ping ... check the connection to the database.
+func (c *Client) Ping(ctx context.Context) error {
+	return c.DB.PingContext(ctx)
+}
@@ -0,0 +1,1499 @@
+// Code generated by SQLBoiler 4.19.7 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodel
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// AssetStatus is an object representing the database table.
+type AssetStatus struct {
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+
+	R *assetStatusR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L assetStatusL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AssetStatusColumns = struct {
+	ID string
+}{
+	ID: "id",
+}
+
+var AssetStatusTableColumns = struct {
+	ID string
+}{
+	ID: "asset_statuses.id",
+}
+
+// Generated where
+
+var AssetStatusWhere = struct {
+	ID whereHelperstring
+}{
+	ID: whereHelperstring{field: "\"asset_statuses\".\"id\""},
+}
+
+// AssetStatusRels is where relationship names are stored.
+var AssetStatusRels = struct {
+	StatusAssets string
+}{
+	StatusAssets: "StatusAssets",
+}
+
+// assetStatusR is where relationships are stored.
+type assetStatusR struct {
+	StatusAssets AssetSlice `boil:"StatusAssets" json:"StatusAssets" toml:"StatusAssets" yaml:"StatusAssets"`
+}
+
+// NewStruct creates a new relationship struct
+func (*assetStatusR) NewStruct() *assetStatusR {
+	return &assetStatusR{}
+}
+
+func (o *AssetStatus) GetStatusAssets() AssetSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetStatusAssets()
+}
+
+func (r *assetStatusR) GetStatusAssets() AssetSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.StatusAssets
+}
+
+// assetStatusL is where Load methods for each relationship are stored.
+type assetStatusL struct{}
+
+var (
+	assetStatusAllColumns            = []string{"id"}
+	assetStatusColumnsWithoutDefault = []string{"id"}
+	assetStatusColumnsWithDefault    = []string{}
+	assetStatusPrimaryKeyColumns     = []string{"id"}
+	assetStatusGeneratedColumns      = []string{}
+)
+
+type (
+	// AssetStatusSlice is an alias for a slice of pointers to AssetStatus.
+	// This should almost always be used instead of []AssetStatus.
+	AssetStatusSlice []*AssetStatus
+
+	assetStatusQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	assetStatusType                 = reflect.TypeOf(&AssetStatus{})
+	assetStatusMapping              = queries.MakeStructMapping(assetStatusType)
+	assetStatusPrimaryKeyMapping, _ = queries.BindMapping(assetStatusType, assetStatusMapping, assetStatusPrimaryKeyColumns)
+	assetStatusInsertCacheMut       sync.RWMutex
+	assetStatusInsertCache          = make(map[string]insertCache)
+	assetStatusUpdateCacheMut       sync.RWMutex
+	assetStatusUpdateCache          = make(map[string]updateCache)
+	assetStatusUpsertCacheMut       sync.RWMutex
+	assetStatusUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// OneG returns a single assetStatus record from the query using the global executor.
+func (q assetStatusQuery) OneG(ctx context.Context) (*AssetStatus, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// OneGP returns a single assetStatus record from the query using the global executor, and panics on error.
+func (q assetStatusQuery) OneGP(ctx context.Context) *AssetStatus {
+	o, err := q.One(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// OneP returns a single assetStatus record from the query, and panics on error.
+func (q assetStatusQuery) OneP(ctx context.Context, exec boil.ContextExecutor) *AssetStatus {
+	o, err := q.One(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// One returns a single assetStatus record from the query.
+func (q assetStatusQuery) One(ctx context.Context, exec boil.ContextExecutor) (*AssetStatus, error) {
+	o := &AssetStatus{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: failed to execute a one query for asset_statuses")
+	}
+
+	return o, nil
+}
+
+// AllG returns all AssetStatus records from the query using the global executor.
+func (q assetStatusQuery) AllG(ctx context.Context) (AssetStatusSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// AllGP returns all AssetStatus records from the query using the global executor, and panics on error.
+func (q assetStatusQuery) AllGP(ctx context.Context) AssetStatusSlice {
+	o, err := q.All(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// AllP returns all AssetStatus records from the query, and panics on error.
+func (q assetStatusQuery) AllP(ctx context.Context, exec boil.ContextExecutor) AssetStatusSlice {
+	o, err := q.All(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return o
+}
+
+// All returns all AssetStatus records from the query.
+func (q assetStatusQuery) All(ctx context.Context, exec boil.ContextExecutor) (AssetStatusSlice, error) {
+	var o []*AssetStatus
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodel: failed to assign all query results to AssetStatus slice")
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all AssetStatus records in the query using the global executor
+func (q assetStatusQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// CountGP returns the count of all AssetStatus records in the query using the global executor, and panics on error.
+func (q assetStatusQuery) CountGP(ctx context.Context) int64 {
+	c, err := q.Count(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// CountP returns the count of all AssetStatus records in the query, and panics on error.
+func (q assetStatusQuery) CountP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	c, err := q.Count(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return c
+}
+
+// Count returns the count of all AssetStatus records in the query.
+func (q assetStatusQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to count asset_statuses rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q assetStatusQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// ExistsGP checks if the row exists in the table using the global executor, and panics on error.
+func (q assetStatusQuery) ExistsGP(ctx context.Context) bool {
+	e, err := q.Exists(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// ExistsP checks if the row exists in the table, and panics on error.
+func (q assetStatusQuery) ExistsP(ctx context.Context, exec boil.ContextExecutor) bool {
+	e, err := q.Exists(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// Exists checks if the row exists in the table.
+func (q assetStatusQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: failed to check if asset_statuses exists")
+	}
+
+	return count > 0, nil
+}
+
+// StatusAssets retrieves all the asset's Assets with an executor via status column.
+func (o *AssetStatus) StatusAssets(mods ...qm.QueryMod) assetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"assets\".\"status\"=?", o.ID),
+	)
+
+	return Assets(queryMods...)
+}
+
+// LoadStatusAssets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (assetStatusL) LoadStatusAssets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAssetStatus any, mods queries.Applicator) error {
+	var slice []*AssetStatus
+	var object *AssetStatus
+
+	if singular {
+		var ok bool
+		object, ok = maybeAssetStatus.(*AssetStatus)
+		if !ok {
+			object = new(AssetStatus)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAssetStatus)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAssetStatus))
+			}
+		}
+	} else {
+		s, ok := maybeAssetStatus.(*[]*AssetStatus)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAssetStatus)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAssetStatus))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &assetStatusR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetStatusR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`assets`),
+		qm.WhereIn(`assets.status in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load assets")
+	}
+
+	var resultSlice []*Asset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice assets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on assets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for assets")
+	}
+
+	if singular {
+		object.R.StatusAssets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &assetR{}
+			}
+			foreign.R.StatusAssetStatus = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Status {
+				local.R.StatusAssets = append(local.R.StatusAssets, foreign)
+				if foreign.R == nil {
+					foreign.R = &assetR{}
+				}
+				foreign.R.StatusAssetStatus = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddStatusAssetsG adds the given related objects to the existing relationships
+// of the asset_status, optionally inserting them as new records.
+// Appends related to o.R.StatusAssets.
+// Sets related.R.StatusAssetStatus appropriately.
+// Uses the global database handle.
+func (o *AssetStatus) AddStatusAssetsG(ctx context.Context, insert bool, related ...*Asset) error {
+	return o.AddStatusAssets(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddStatusAssetsP adds the given related objects to the existing relationships
+// of the asset_status, optionally inserting them as new records.
+// Appends related to o.R.StatusAssets.
+// Sets related.R.StatusAssetStatus appropriately.
+// Panics on error.
+func (o *AssetStatus) AddStatusAssetsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Asset) {
+	if err := o.AddStatusAssets(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddStatusAssetsGP adds the given related objects to the existing relationships
+// of the asset_status, optionally inserting them as new records.
+// Appends related to o.R.StatusAssets.
+// Sets related.R.StatusAssetStatus appropriately.
+// Uses the global database handle and panics on error.
+func (o *AssetStatus) AddStatusAssetsGP(ctx context.Context, insert bool, related ...*Asset) {
+	if err := o.AddStatusAssets(ctx, boil.GetContextDB(), insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddStatusAssets adds the given related objects to the existing relationships
+// of the asset_status, optionally inserting them as new records.
+// Appends related to o.R.StatusAssets.
+// Sets related.R.StatusAssetStatus appropriately.
+func (o *AssetStatus) AddStatusAssets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Asset) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Status = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"assets\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"status"}),
+				strmangle.WhereClause("\"", "\"", 2, assetPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Status = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &assetStatusR{
+			StatusAssets: related,
+		}
+	} else {
+		o.R.StatusAssets = append(o.R.StatusAssets, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &assetR{
+				StatusAssetStatus: o,
+			}
+		} else {
+			rel.R.StatusAssetStatus = o
+		}
+	}
+	return nil
+}
+
+// AssetStatuses retrieves all the records using an executor.
+func AssetStatuses(mods ...qm.QueryMod) assetStatusQuery {
+	mods = append(mods, qm.From("\"asset_statuses\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"asset_statuses\".*"})
+	}
+
+	return assetStatusQuery{q}
+}
+
+// FindAssetStatusG retrieves a single record by ID.
+func FindAssetStatusG(ctx context.Context, iD string, selectCols ...string) (*AssetStatus, error) {
+	return FindAssetStatus(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindAssetStatusP retrieves a single record by ID with an executor, and panics on error.
+func FindAssetStatusP(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) *AssetStatus {
+	retobj, err := FindAssetStatus(ctx, exec, iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindAssetStatusGP retrieves a single record by ID, and panics on error.
+func FindAssetStatusGP(ctx context.Context, iD string, selectCols ...string) *AssetStatus {
+	retobj, err := FindAssetStatus(ctx, boil.GetContextDB(), iD, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+// FindAssetStatus retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAssetStatus(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*AssetStatus, error) {
+	assetStatusObj := &AssetStatus{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"asset_statuses\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, assetStatusObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodel: unable to select from asset_statuses")
+	}
+
+	return assetStatusObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *AssetStatus) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// InsertP a single record using an executor, and panics on error. See Insert
+// for whitelist behavior description.
+func (o *AssetStatus) InsertP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) {
+	if err := o.Insert(ctx, exec, columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// InsertGP a single record, and panics on error. See Insert for whitelist
+// behavior description.
+func (o *AssetStatus) InsertGP(ctx context.Context, columns boil.Columns) {
+	if err := o.Insert(ctx, boil.GetContextDB(), columns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AssetStatus) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodel: no asset_statuses provided for insertion")
+	}
+
+	var err error
+
+	nzDefaults := queries.NonZeroDefaultSet(assetStatusColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	assetStatusInsertCacheMut.RLock()
+	cache, cached := assetStatusInsertCache[key]
+	assetStatusInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			assetStatusAllColumns,
+			assetStatusColumnsWithDefault,
+			assetStatusColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(assetStatusType, assetStatusMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(assetStatusType, assetStatusMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"asset_statuses\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"asset_statuses\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to insert into asset_statuses")
+	}
+
+	if !cached {
+		assetStatusInsertCacheMut.Lock()
+		assetStatusInsertCache[key] = cache
+		assetStatusInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// UpdateG a single AssetStatus record using the global executor.
+// See Update for more documentation.
+func (o *AssetStatus) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// UpdateP uses an executor to update the AssetStatus, and panics on error.
+// See Update for more documentation.
+func (o *AssetStatus) UpdateP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, exec, columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateGP a single AssetStatus record using the global executor. Panics on error.
+// See Update for more documentation.
+func (o *AssetStatus) UpdateGP(ctx context.Context, columns boil.Columns) int64 {
+	rowsAff, err := o.Update(ctx, boil.GetContextDB(), columns)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Update uses an executor to update the AssetStatus.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AssetStatus) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	key := makeCacheKey(columns, nil)
+	assetStatusUpdateCacheMut.RLock()
+	cache, cached := assetStatusUpdateCache[key]
+	assetStatusUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			assetStatusAllColumns,
+			assetStatusPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodel: unable to update asset_statuses, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"asset_statuses\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, assetStatusPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(assetStatusType, assetStatusMapping, append(wl, assetStatusPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update asset_statuses row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by update for asset_statuses")
+	}
+
+	if !cached {
+		assetStatusUpdateCacheMut.Lock()
+		assetStatusUpdateCache[key] = cache
+		assetStatusUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllP updates all rows with matching column names, and panics on error.
+func (q assetStatusQuery) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q assetStatusQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (q assetStatusQuery) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := q.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q assetStatusQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all for asset_statuses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected for asset_statuses")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o AssetStatusSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAllGP updates all rows with the specified column values, and panics on error.
+func (o AssetStatusSlice) UpdateAllGP(ctx context.Context, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, boil.GetContextDB(), cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAllP updates all rows with the specified column values, and panics on error.
+func (o AssetStatusSlice) UpdateAllP(ctx context.Context, exec boil.ContextExecutor, cols M) int64 {
+	rowsAff, err := o.UpdateAll(ctx, exec, cols)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AssetStatusSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodel: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]any, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"asset_statuses\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, assetStatusPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to update all in assetStatus slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to retrieve rows affected all in update all assetStatus")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *AssetStatus) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...)
+}
+
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *AssetStatus) UpsertGP(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) {
+	if err := o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *AssetStatus) UpsertP(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) {
+	if err := o.Upsert(ctx, exec, updateOnConflict, conflictColumns, updateColumns, insertColumns, opts...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AssetStatus) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("dbmodel: no asset_statuses provided for upsert")
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(assetStatusColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	assetStatusUpsertCacheMut.RLock()
+	cache, cached := assetStatusUpsertCache[key]
+	assetStatusUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			assetStatusAllColumns,
+			assetStatusColumnsWithDefault,
+			assetStatusColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			assetStatusAllColumns,
+			assetStatusPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodel: unable to upsert asset_statuses, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(assetStatusAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(assetStatusPrimaryKeyColumns) == 0 {
+				return errors.New("dbmodel: unable to upsert asset_statuses, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(assetStatusPrimaryKeyColumns))
+			copy(conflict, assetStatusPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"asset_statuses\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(assetStatusType, assetStatusMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(assetStatusType, assetStatusMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []any
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to upsert asset_statuses")
+	}
+
+	if !cached {
+		assetStatusUpsertCacheMut.Lock()
+		assetStatusUpsertCache[key] = cache
+		assetStatusUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// DeleteG deletes a single AssetStatus record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *AssetStatus) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// DeleteP deletes a single AssetStatus record with an executor.
+// DeleteP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *AssetStatus) DeleteP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.Delete(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteGP deletes a single AssetStatus record.
+// DeleteGP will match against the primary key column to find the record to delete.
+// Panics on error.
+func (o *AssetStatus) DeleteGP(ctx context.Context) int64 {
+	rowsAff, err := o.Delete(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// Delete deletes a single AssetStatus record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AssetStatus) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodel: no AssetStatus provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), assetStatusPrimaryKeyMapping)
+	sql := "DELETE FROM \"asset_statuses\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete from asset_statuses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by delete for asset_statuses")
+	}
+
+	return rowsAff, nil
+}
+
+func (q assetStatusQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows, and panics on error.
+func (q assetStatusQuery) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := q.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows, and panics on error.
+func (q assetStatusQuery) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := q.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all matching rows.
+func (q assetStatusQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodel: no assetStatusQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from asset_statuses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for asset_statuses")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o AssetStatusSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAllP deletes all rows in the slice, using an executor, and panics on error.
+func (o AssetStatusSlice) DeleteAllP(ctx context.Context, exec boil.ContextExecutor) int64 {
+	rowsAff, err := o.DeleteAll(ctx, exec)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAllGP deletes all rows in the slice, and panics on error.
+func (o AssetStatusSlice) DeleteAllGP(ctx context.Context) int64 {
+	rowsAff, err := o.DeleteAll(ctx, boil.GetContextDB())
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return rowsAff
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AssetStatusSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []any
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"asset_statuses\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetStatusPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to delete all from assetStatus slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by deleteall for asset_statuses")
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *AssetStatus) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: no AssetStatus provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// ReloadP refetches the object from the database with an executor. Panics on error.
+func (o *AssetStatus) ReloadP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.Reload(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadGP refetches the object from the database and panics on error.
+func (o *AssetStatus) ReloadGP(ctx context.Context) {
+	if err := o.Reload(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AssetStatus) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindAssetStatus(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AssetStatusSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodel: empty AssetStatusSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAllP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *AssetStatusSlice) ReloadAllP(ctx context.Context, exec boil.ContextExecutor) {
+	if err := o.ReloadAll(ctx, exec); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAllGP refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+// Panics on error.
+func (o *AssetStatusSlice) ReloadAllGP(ctx context.Context) {
+	if err := o.ReloadAll(ctx, boil.GetContextDB()); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AssetStatusSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AssetStatusSlice{}
+	var args []any
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), assetStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"asset_statuses\".* FROM \"asset_statuses\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, assetStatusPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodel: unable to reload all in AssetStatusSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AssetStatusExistsG checks if the AssetStatus row exists.
+func AssetStatusExistsG(ctx context.Context, iD string) (bool, error) {
+	return AssetStatusExists(ctx, boil.GetContextDB(), iD)
+}
+
+// AssetStatusExistsP checks if the AssetStatus row exists. Panics on error.
+func AssetStatusExistsP(ctx context.Context, exec boil.ContextExecutor, iD string) bool {
+	e, err := AssetStatusExists(ctx, exec, iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// AssetStatusExistsGP checks if the AssetStatus row exists. Panics on error.
+func AssetStatusExistsGP(ctx context.Context, iD string) bool {
+	e, err := AssetStatusExists(ctx, boil.GetContextDB(), iD)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return e
+}
+
+// AssetStatusExists checks if the AssetStatus row exists.
+func AssetStatusExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"asset_statuses\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodel: unable to check if asset_statuses exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the AssetStatus row exists.
+func (o *AssetStatus) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return AssetStatusExists(ctx, exec, o.ID)
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o AssetStatusSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			assetStatusAllColumns,
+			assetStatusColumnsWithDefault,
+			assetStatusColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(assetStatusColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+		if len(wlCols) == len(assetStatusAllColumns) {
+			break
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range assetStatusAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+
+		if i == 0 {
+			sql = "INSERT INTO \"asset_statuses\" " + "(\"" + strings.Join(wl, "\",\"") + "\")" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(assetStatusType, assetStatusMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to insert all from assetStatus slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by insertall for asset_statuses")
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o AssetStatusSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows.
+// Currently it doesn't support "NoContext" and "NoRowsAffected".
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values.
+// IMPORTANT: any AUTO_INCREMENT column should be excluded from `updateColumns` and `insertColumns` including PK.
+func (o AssetStatusSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, nil, updateColumns, insertColumns)
+}
+
+// upsertAllOnConflictColumns upserts multiple rows with passing custom conflict columns to allow bypassing
+// single column conflict check (see bug https://github.com/aarondl/sqlboiler/issues/328).
+// SQLBoiler only checks column conflict on a single column which is insufficient when a UNIQUE index
+// spans multiple columns.
+// This function allows passing multiple conflict columns, but it cannot check whether they are correct or not.
+// So use it at your own risk.
+func (o AssetStatusSlice) UpsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	return o.upsertAllOnConflictColumns(ctx, exec, conflictColumns, updateColumns, insertColumns)
+}
+
+func (o AssetStatusSlice) upsertAllOnConflictColumns(ctx context.Context, exec boil.ContextExecutor, conflictColumns []string, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		insert, _ := insertColumns.InsertColumnSet(
+			assetStatusAllColumns,
+			assetStatusColumnsWithDefault,
+			assetStatusColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(assetStatusColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+		if len(insertCols) == len(assetStatusAllColumns) || (insertColumns.IsWhitelist() && len(insertCols) == len(insertColumns.Cols)) {
+			break
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range assetStatusAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+	if len(insert) == 0 {
+		return 0, errors.New("dbmodel: unable to upsert asset_statuses, could not build insert column list")
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		assetStatusAllColumns,
+		assetStatusPrimaryKeyColumns,
+	)
+
+	updateRequired := !updateColumns.IsNone() && len(update) != 0
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("dbmodel: unable to upsert asset_statuses, could not build update column list")
+	}
+
+	conflict := conflictColumns
+	if len(conflict) == 0 && updateRequired {
+		conflict = make([]string, len(assetStatusPrimaryKeyColumns))
+		copy(conflict, assetStatusPrimaryKeyColumns)
+	}
+	if updateRequired && len(conflict) == 0 {
+		return 0, errors.New("dbmodel: unable to upsert asset_statuses, could not build conflict column list")
+	}
+
+	quotedInsert := strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert)
+	placeholders := strmangle.Placeholders(dialect.UseIndexPlaceholders, len(insert)*len(o), 1, len(insert))
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	fmt.Fprintf(
+		buf,
+		"INSERT INTO \"asset_statuses\"(%s) VALUES %s",
+		strings.Join(quotedInsert, ","),
+		placeholders,
+	)
+
+	buf.WriteString(" ON CONFLICT")
+	if len(conflict) != 0 {
+		quotedConflict := strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, conflict)
+		buf.WriteString(" (")
+		buf.WriteString(strings.Join(quotedConflict, ","))
+		buf.WriteString(")")
+	}
+	buf.WriteByte(' ')
+
+	if !updateRequired {
+		buf.WriteString("DO NOTHING")
+	} else {
+		buf.WriteString("DO UPDATE SET ")
+		for i, col := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, col)
+			buf.WriteString(quoted)
+			buf.WriteString(" = EXCLUDED.")
+			buf.WriteString(quoted)
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(assetStatusType, assetStatusMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: unable to upsert for asset_statuses")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodel: failed to get rows affected by upsert for asset_statuses")
+	}
+
+	return rowsAff, nil
+}
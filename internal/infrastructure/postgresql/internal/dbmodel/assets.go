@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,14 +24,17 @@ import (
 
 // Asset is an object representing the database table.
 type Asset struct {
-	ID          string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	AuthContext string    `boil:"auth_context" json:"auth_context" toml:"auth_context" yaml:"auth_context"`
-	ContentType string    `boil:"content_type" json:"content_type" toml:"content_type" yaml:"content_type"`
-	Type        string    `boil:"type" json:"type" toml:"type" yaml:"type"`
-	Path        string    `boil:"path" json:"path" toml:"path" yaml:"path"`
-	ExpiresAt   time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
-	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID          string     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	AuthContext string     `boil:"auth_context" json:"auth_context" toml:"auth_context" yaml:"auth_context"`
+	ContentType string     `boil:"content_type" json:"content_type" toml:"content_type" yaml:"content_type"`
+	Type        string     `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Path        string     `boil:"path" json:"path" toml:"path" yaml:"path"`
+	ExpiresAt   time.Time  `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt   time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Status      string     `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Size        null.Int64 `boil:"size" json:"size,omitempty" toml:"size" yaml:"size,omitempty"`
+	UploadedAt  null.Time  `boil:"uploaded_at" json:"uploaded_at,omitempty" toml:"uploaded_at" yaml:"uploaded_at,omitempty"`
 
 	R *assetR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L assetL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -45,6 +49,9 @@ var AssetColumns = struct {
 	ExpiresAt   string
 	CreatedAt   string
 	UpdatedAt   string
+	Status      string
+	Size        string
+	UploadedAt  string
 }{
 	ID:          "id",
 	AuthContext: "auth_context",
@@ -54,6 +61,9 @@ var AssetColumns = struct {
 	ExpiresAt:   "expires_at",
 	CreatedAt:   "created_at",
 	UpdatedAt:   "updated_at",
+	Status:      "status",
+	Size:        "size",
+	UploadedAt:  "uploaded_at",
 }
 
 var AssetTableColumns = struct {
@@ -65,6 +75,9 @@ var AssetTableColumns = struct {
 	ExpiresAt   string
 	CreatedAt   string
 	UpdatedAt   string
+	Status      string
+	Size        string
+	UploadedAt  string
 }{
 	ID:          "assets.id",
 	AuthContext: "assets.auth_context",
@@ -74,10 +87,75 @@ var AssetTableColumns = struct {
 	ExpiresAt:   "assets.expires_at",
 	CreatedAt:   "assets.created_at",
 	UpdatedAt:   "assets.updated_at",
+	Status:      "assets.status",
+	Size:        "assets.size",
+	UploadedAt:  "assets.uploaded_at",
 }
 
 // Generated where
 
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
+	values := make([]any, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var AssetWhere = struct {
 	ID          whereHelperstring
 	AuthContext whereHelperstring
@@ -87,6 +165,9 @@ var AssetWhere = struct {
 	ExpiresAt   whereHelpertime_Time
 	CreatedAt   whereHelpertime_Time
 	UpdatedAt   whereHelpertime_Time
+	Status      whereHelperstring
+	Size        whereHelpernull_Int64
+	UploadedAt  whereHelpernull_Time
 }{
 	ID:          whereHelperstring{field: "\"assets\".\"id\""},
 	AuthContext: whereHelperstring{field: "\"assets\".\"auth_context\""},
@@ -96,14 +177,21 @@ var AssetWhere = struct {
 	ExpiresAt:   whereHelpertime_Time{field: "\"assets\".\"expires_at\""},
 	CreatedAt:   whereHelpertime_Time{field: "\"assets\".\"created_at\""},
 	UpdatedAt:   whereHelpertime_Time{field: "\"assets\".\"updated_at\""},
+	Status:      whereHelperstring{field: "\"assets\".\"status\""},
+	Size:        whereHelpernull_Int64{field: "\"assets\".\"size\""},
+	UploadedAt:  whereHelpernull_Time{field: "\"assets\".\"uploaded_at\""},
 }
 
 // AssetRels is where relationship names are stored.
 var AssetRels = struct {
-}{}
+	StatusAssetStatus string
+}{
+	StatusAssetStatus: "StatusAssetStatus",
+}
 
 // assetR is where relationships are stored.
 type assetR struct {
+	StatusAssetStatus *AssetStatus `boil:"StatusAssetStatus" json:"StatusAssetStatus" toml:"StatusAssetStatus" yaml:"StatusAssetStatus"`
 }
 
 // NewStruct creates a new relationship struct
@@ -111,13 +199,29 @@ func (*assetR) NewStruct() *assetR {
 	return &assetR{}
 }
 
+func (o *Asset) GetStatusAssetStatus() *AssetStatus {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetStatusAssetStatus()
+}
+
+func (r *assetR) GetStatusAssetStatus() *AssetStatus {
+	if r == nil {
+		return nil
+	}
+
+	return r.StatusAssetStatus
+}
+
 // assetL is where Load methods for each relationship are stored.
 type assetL struct{}
 
 var (
-	assetAllColumns            = []string{"id", "auth_context", "content_type", "type", "path", "expires_at", "created_at", "updated_at"}
+	assetAllColumns            = []string{"id", "auth_context", "content_type", "type", "path", "expires_at", "created_at", "updated_at", "status", "size", "uploaded_at"}
 	assetColumnsWithoutDefault = []string{"id", "auth_context", "content_type", "type", "path", "expires_at", "created_at", "updated_at"}
-	assetColumnsWithDefault    = []string{}
+	assetColumnsWithDefault    = []string{"status", "size", "uploaded_at"}
 	assetPrimaryKeyColumns     = []string{"id"}
 	assetGeneratedColumns      = []string{}
 )
@@ -313,6 +417,204 @@ func (q assetQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool
 	return count > 0, nil
 }
 
+// StatusAssetStatus pointed to by the foreign key.
+func (o *Asset) StatusAssetStatus(mods ...qm.QueryMod) assetStatusQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Status),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return AssetStatuses(queryMods...)
+}
+
+// LoadStatusAssetStatus allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (assetL) LoadStatusAssetStatus(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAsset any, mods queries.Applicator) error {
+	var slice []*Asset
+	var object *Asset
+
+	if singular {
+		var ok bool
+		object, ok = maybeAsset.(*Asset)
+		if !ok {
+			object = new(Asset)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAsset)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAsset))
+			}
+		}
+	} else {
+		s, ok := maybeAsset.(*[]*Asset)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAsset)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAsset))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &assetR{}
+		}
+		args[object.Status] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &assetR{}
+			}
+
+			args[obj.Status] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`asset_statuses`),
+		qm.WhereIn(`asset_statuses.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load AssetStatus")
+	}
+
+	var resultSlice []*AssetStatus
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice AssetStatus")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for asset_statuses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for asset_statuses")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.StatusAssetStatus = foreign
+		if foreign.R == nil {
+			foreign.R = &assetStatusR{}
+		}
+		foreign.R.StatusAssets = append(foreign.R.StatusAssets, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Status == foreign.ID {
+				local.R.StatusAssetStatus = foreign
+				if foreign.R == nil {
+					foreign.R = &assetStatusR{}
+				}
+				foreign.R.StatusAssets = append(foreign.R.StatusAssets, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetStatusAssetStatusG of the asset to the related item.
+// Sets o.R.StatusAssetStatus to related.
+// Adds o to related.R.StatusAssets.
+// Uses the global database handle.
+func (o *Asset) SetStatusAssetStatusG(ctx context.Context, insert bool, related *AssetStatus) error {
+	return o.SetStatusAssetStatus(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetStatusAssetStatusP of the asset to the related item.
+// Sets o.R.StatusAssetStatus to related.
+// Adds o to related.R.StatusAssets.
+// Panics on error.
+func (o *Asset) SetStatusAssetStatusP(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AssetStatus) {
+	if err := o.SetStatusAssetStatus(ctx, exec, insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetStatusAssetStatusGP of the asset to the related item.
+// Sets o.R.StatusAssetStatus to related.
+// Adds o to related.R.StatusAssets.
+// Uses the global database handle and panics on error.
+func (o *Asset) SetStatusAssetStatusGP(ctx context.Context, insert bool, related *AssetStatus) {
+	if err := o.SetStatusAssetStatus(ctx, boil.GetContextDB(), insert, related); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// SetStatusAssetStatus of the asset to the related item.
+// Sets o.R.StatusAssetStatus to related.
+// Adds o to related.R.StatusAssets.
+func (o *Asset) SetStatusAssetStatus(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AssetStatus) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"assets\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"status"}),
+		strmangle.WhereClause("\"", "\"", 2, assetPrimaryKeyColumns),
+	)
+	values := []any{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Status = related.ID
+	if o.R == nil {
+		o.R = &assetR{
+			StatusAssetStatus: related,
+		}
+	} else {
+		o.R.StatusAssetStatus = related
+	}
+
+	if related.R == nil {
+		related.R = &assetStatusR{
+			StatusAssets: AssetSlice{o},
+		}
+	} else {
+		related.R.StatusAssets = append(related.R.StatusAssets, o)
+	}
+
+	return nil
+}
+
 // Assets retrieves all the records using an executor.
 func Assets(mods ...qm.QueryMod) assetQuery {
 	mods = append(mods, qm.From("\"assets\""))
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -27,6 +28,8 @@ type Tenant struct {
 	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	Version   int64     `boil:"version" json:"version" toml:"version" yaml:"version"`
 
 	R *tenantR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L tenantL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -37,11 +40,15 @@ var TenantColumns = struct {
 	Name      string
 	CreatedAt string
 	UpdatedAt string
+	DeletedAt string
+	Version   string
 }{
 	ID:        "id",
 	Name:      "name",
 	CreatedAt: "created_at",
 	UpdatedAt: "updated_at",
+	DeletedAt: "deleted_at",
+	Version:   "version",
 }
 
 var TenantTableColumns = struct {
@@ -49,11 +56,15 @@ var TenantTableColumns = struct {
 	Name      string
 	CreatedAt string
 	UpdatedAt string
+	DeletedAt string
+	Version   string
 }{
 	ID:        "tenants.id",
 	Name:      "tenants.name",
 	CreatedAt: "tenants.created_at",
 	UpdatedAt: "tenants.updated_at",
+	DeletedAt: "tenants.deleted_at",
+	Version:   "tenants.version",
 }
 
 // Generated where
@@ -63,26 +74,33 @@ var TenantWhere = struct {
 	Name      whereHelperstring
 	CreatedAt whereHelpertime_Time
 	UpdatedAt whereHelpertime_Time
+	DeletedAt whereHelpernull_Time
+	Version   whereHelperint64
 }{
 	ID:        whereHelperstring{field: "\"tenants\".\"id\""},
 	Name:      whereHelperstring{field: "\"tenants\".\"name\""},
 	CreatedAt: whereHelpertime_Time{field: "\"tenants\".\"created_at\""},
 	UpdatedAt: whereHelpertime_Time{field: "\"tenants\".\"updated_at\""},
+	DeletedAt: whereHelpernull_Time{field: "\"tenants\".\"deleted_at\""},
+	Version:   whereHelperint64{field: "\"tenants\".\"version\""},
 }
 
 // TenantRels is where relationship names are stored.
 var TenantRels = struct {
-	Staffs     string
-	TenantTags string
+	Invitations string
+	Staffs      string
+	TenantTags  string
 }{
-	Staffs:     "Staffs",
-	TenantTags: "TenantTags",
+	Invitations: "Invitations",
+	Staffs:      "Staffs",
+	TenantTags:  "TenantTags",
 }
 
 // tenantR is where relationships are stored.
 type tenantR struct {
-	Staffs     StaffSlice     `boil:"Staffs" json:"Staffs" toml:"Staffs" yaml:"Staffs"`
-	TenantTags TenantTagSlice `boil:"TenantTags" json:"TenantTags" toml:"TenantTags" yaml:"TenantTags"`
+	Invitations InvitationSlice `boil:"Invitations" json:"Invitations" toml:"Invitations" yaml:"Invitations"`
+	Staffs      StaffSlice      `boil:"Staffs" json:"Staffs" toml:"Staffs" yaml:"Staffs"`
+	TenantTags  TenantTagSlice  `boil:"TenantTags" json:"TenantTags" toml:"TenantTags" yaml:"TenantTags"`
 }
 
 // NewStruct creates a new relationship struct
@@ -90,6 +108,22 @@ func (*tenantR) NewStruct() *tenantR {
 	return &tenantR{}
 }
 
+func (o *Tenant) GetInvitations() InvitationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetInvitations()
+}
+
+func (r *tenantR) GetInvitations() InvitationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Invitations
+}
+
 func (o *Tenant) GetStaffs() StaffSlice {
 	if o == nil {
 		return nil
@@ -126,9 +160,9 @@ func (r *tenantR) GetTenantTags() TenantTagSlice {
 type tenantL struct{}
 
 var (
-	tenantAllColumns            = []string{"id", "name", "created_at", "updated_at"}
+	tenantAllColumns            = []string{"id", "name", "created_at", "updated_at", "deleted_at", "version"}
 	tenantColumnsWithoutDefault = []string{"id", "name", "created_at", "updated_at"}
-	tenantColumnsWithDefault    = []string{}
+	tenantColumnsWithDefault    = []string{"deleted_at", "version"}
 	tenantPrimaryKeyColumns     = []string{"id"}
 	tenantGeneratedColumns      = []string{}
 )
@@ -324,6 +358,20 @@ func (q tenantQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (boo
 	return count > 0, nil
 }
 
+// Invitations retrieves all the invitation's Invitations with an executor.
+func (o *Tenant) Invitations(mods ...qm.QueryMod) invitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"invitations\".\"tenant_id\"=?", o.ID),
+	)
+
+	return Invitations(queryMods...)
+}
+
 // Staffs retrieves all the staff's Staffs with an executor.
 func (o *Tenant) Staffs(mods ...qm.QueryMod) staffQuery {
 	var queryMods []qm.QueryMod
@@ -352,6 +400,112 @@ func (o *Tenant) TenantTags(mods ...qm.QueryMod) tenantTagQuery {
 	return TenantTags(queryMods...)
 }
 
+// LoadInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tenantL) LoadInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant any, mods queries.Applicator) error {
+	var slice []*Tenant
+	var object *Tenant
+
+	if singular {
+		var ok bool
+		object, ok = maybeTenant.(*Tenant)
+		if !ok {
+			object = new(Tenant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTenant))
+			}
+		}
+	} else {
+		s, ok := maybeTenant.(*[]*Tenant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTenant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTenant))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tenantR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tenantR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`invitations`),
+		qm.WhereIn(`invitations.tenant_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load invitations")
+	}
+
+	var resultSlice []*Invitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for invitations")
+	}
+
+	if singular {
+		object.R.Invitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &invitationR{}
+			}
+			foreign.R.Tenant = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TenantID {
+				local.R.Invitations = append(local.R.Invitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &invitationR{}
+				}
+				foreign.R.Tenant = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadStaffs allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (tenantL) LoadStaffs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTenant any, mods queries.Applicator) error {
@@ -564,6 +718,90 @@ func (tenantL) LoadTenantTags(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
+// AddInvitationsG adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Invitations.
+// Sets related.R.Tenant appropriately.
+// Uses the global database handle.
+func (o *Tenant) AddInvitationsG(ctx context.Context, insert bool, related ...*Invitation) error {
+	return o.AddInvitations(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddInvitationsP adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Invitations.
+// Sets related.R.Tenant appropriately.
+// Panics on error.
+func (o *Tenant) AddInvitationsP(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invitation) {
+	if err := o.AddInvitations(ctx, exec, insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddInvitationsGP adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Invitations.
+// Sets related.R.Tenant appropriately.
+// Uses the global database handle and panics on error.
+func (o *Tenant) AddInvitationsGP(ctx context.Context, insert bool, related ...*Invitation) {
+	if err := o.AddInvitations(ctx, boil.GetContextDB(), insert, related...); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+// AddInvitations adds the given related objects to the existing relationships
+// of the tenant, optionally inserting them as new records.
+// Appends related to o.R.Invitations.
+// Sets related.R.Tenant appropriately.
+func (o *Tenant) AddInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TenantID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"tenant_id"}),
+				strmangle.WhereClause("\"", "\"", 2, invitationPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TenantID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tenantR{
+			Invitations: related,
+		}
+	} else {
+		o.R.Invitations = append(o.R.Invitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &invitationR{
+				Tenant: o,
+			}
+		} else {
+			rel.R.Tenant = o
+		}
+	}
+	return nil
+}
+
 // AddStaffsG adds the given related objects to the existing relationships
 // of the tenant, optionally inserting them as new records.
 // Appends related to o.R.Staffs.
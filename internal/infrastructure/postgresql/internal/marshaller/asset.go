@@ -12,7 +12,10 @@ func AssetToModel(e *dbmodel.Asset) *model.Asset {
 		Type:        model.NewAssetType(e.Type),
 		Path:        e.Path,
 		AuthContext: model.NewAssetAuthContext(e.AuthContext),
+		Status:      model.NewAssetStatus(e.Status),
+		Size:        e.Size,
 		ExpiresAt:   e.ExpiresAt,
+		UploadedAt:  e.UploadedAt,
 		CreatedAt:   e.CreatedAt,
 		UpdatedAt:   e.UpdatedAt,
 	}
@@ -33,7 +36,10 @@ func AssetToDBModel(m *model.Asset) *dbmodel.Asset {
 		Type:        m.Type.String(),
 		Path:        m.Path,
 		AuthContext: m.AuthContext.String(),
+		Status:      m.Status.String(),
+		Size:        m.Size,
 		ExpiresAt:   m.ExpiresAt,
+		UploadedAt:  m.UploadedAt,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
 
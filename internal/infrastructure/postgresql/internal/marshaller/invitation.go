@@ -0,0 +1,50 @@
+package marshaller
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/postgresql/internal/dbmodel"
+)
+
+func InvitationToModel(e *dbmodel.Invitation) *model.Invitation {
+	return &model.Invitation{
+		ID:              e.ID,
+		TenantID:        e.TenantID,
+		Email:           e.Email,
+		Role:            model.NewStaffRole(e.Role),
+		TokenHash:       e.TokenHash,
+		InvitedBy:       e.InvitedBy,
+		ExpiresAt:       e.ExpiresAt,
+		AcceptedAt:      e.AcceptedAt,
+		AcceptedStaffID: e.AcceptedStaffID,
+		RevokedAt:       e.RevokedAt,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+}
+
+func InvitationsToModel(slice dbmodel.InvitationSlice) model.Invitations {
+	dsts := make(model.Invitations, len(slice))
+	for idx, e := range slice {
+		dsts[idx] = InvitationToModel(e)
+	}
+	return dsts
+}
+
+func InvitationToDBModel(m *model.Invitation) *dbmodel.Invitation {
+	return &dbmodel.Invitation{
+		ID:              m.ID,
+		TenantID:        m.TenantID,
+		Email:           m.Email,
+		Role:            m.Role.String(),
+		TokenHash:       m.TokenHash,
+		InvitedBy:       m.InvitedBy,
+		ExpiresAt:       m.ExpiresAt,
+		AcceptedAt:      m.AcceptedAt,
+		AcceptedStaffID: m.AcceptedStaffID,
+		RevokedAt:       m.RevokedAt,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+		R:               nil,
+		L:               struct{}{},
+	}
+}
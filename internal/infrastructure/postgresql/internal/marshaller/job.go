@@ -0,0 +1,55 @@
+package marshaller
+
+import (
+	"encoding/json"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/postgresql/internal/dbmodel"
+)
+
+func JobToModel(e *dbmodel.Job) *model.Job {
+	payload := model.JobPayload{}
+	_ = json.Unmarshal([]byte(e.Payload), &payload)
+	return &model.Job{
+		ID:          e.ID,
+		Type:        model.NewJobType(e.Type),
+		Payload:     payload,
+		Status:      model.NewJobStatus(e.Status),
+		Attempts:    uint64(e.Attempts),    //nolint:gosec
+		MaxAttempts: uint64(e.MaxAttempts), //nolint:gosec
+		RunAt:       e.RunAt,
+		LockedUntil: e.LockedUntil,
+		LastError:   e.LastError,
+		FinishedAt:  e.FinishedAt,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+func JobsToModel(slice dbmodel.JobSlice) model.Jobs {
+	dsts := make(model.Jobs, len(slice))
+	for idx, e := range slice {
+		dsts[idx] = JobToModel(e)
+	}
+	return dsts
+}
+
+func JobToDBModel(m *model.Job) *dbmodel.Job {
+	payload, _ := json.Marshal(m.Payload) //nolint:errchkjson
+	return &dbmodel.Job{
+		ID:          m.ID,
+		Type:        m.Type.String(),
+		Payload:     string(payload),
+		Status:      m.Status.String(),
+		Attempts:    int(m.Attempts),    //nolint:gosec
+		MaxAttempts: int(m.MaxAttempts), //nolint:gosec
+		RunAt:       m.RunAt,
+		LockedUntil: m.LockedUntil,
+		LastError:   m.LastError,
+		FinishedAt:  m.FinishedAt,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+		R:           nil,
+		L:           struct{}{},
+	}
+}
@@ -17,8 +17,11 @@ func StaffToModel(e *dbmodel.Staff) *model.Staff {
 		Email:       e.Email,
 		CreatedAt:   e.CreatedAt,
 		UpdatedAt:   e.UpdatedAt,
+		DeletedAt:   e.DeletedAt,
+		Version:     e.Version,
 
 		ImageURL:          null.String{},
+		ImageVariantURLs:  nil,
 		ReadonlyReference: nil,
 	}
 
@@ -59,6 +62,8 @@ func StaffToDBModel(m *model.Staff) *dbmodel.Staff {
 		Email:       m.Email,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
+		DeletedAt:   m.DeletedAt,
+		Version:     m.Version,
 		// R and L are likely relationship fields, initialize as nil if not needed
 		R: nil,
 		L: struct{}{},
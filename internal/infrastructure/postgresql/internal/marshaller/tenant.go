@@ -12,6 +12,8 @@ func TenantToModel(e *dbmodel.Tenant) *model.Tenant {
 		Tags:      make(model.TenantTags, 0),
 		CreatedAt: e.CreatedAt,
 		UpdatedAt: e.UpdatedAt,
+		DeletedAt: e.DeletedAt,
+		Version:   e.Version,
 	}
 
 	if e.R != nil && e.R.TenantTags != nil {
@@ -35,6 +37,8 @@ func TenantToDBModel(m *model.Tenant) *dbmodel.Tenant {
 		Name:      m.Name,
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
+		DeletedAt: m.DeletedAt,
+		Version:   m.Version,
 		// Initialize R and L if they exist in dbmodel.Tenant
 		R: nil,
 		L: struct{}{},
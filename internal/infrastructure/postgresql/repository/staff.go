@@ -12,6 +12,7 @@ import (
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/postgresql/internal/dbmodel"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/postgresql/internal/marshaller"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/postgresql/transactable"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
 )
 
 type staff struct{}
@@ -31,6 +32,9 @@ func (r *staff) Get(
 	if query.AuthUID.Valid {
 		mods = append(mods, dbmodel.StaffWhere.AuthUID.EQ(query.AuthUID.String))
 	}
+	if !query.IncludeDeleted {
+		mods = append(mods, dbmodel.StaffWhere.DeletedAt.IsNull())
+	}
 	mods = append(mods, r.buildPreload(query.Preload)...)
 	mods = addForUpdateFromBaseGetOptions(mods, query.BaseGetOptions)
 	dbStaff, err := dbmodel.Staffs(
@@ -60,25 +64,29 @@ func (r *staff) List(
 	if query.SortKey.Valid && query.SortKey.Value().Valid() {
 		switch query.SortKey.Value() {
 		case model.StaffSortKeyCreatedAtDesc:
-			mods = append(mods, qm.OrderBy("\""+dbmodel.StaffColumns.CreatedAt+"\" DESC"))
+			mods = append(mods, qm.OrderBy("\""+dbmodel.StaffColumns.CreatedAt+"\" DESC, \""+dbmodel.StaffColumns.ID+"\" DESC"))
 		case model.StaffSortKeyCreatedAtAsc:
-			mods = append(mods, qm.OrderBy("\""+dbmodel.StaffColumns.CreatedAt+"\" ASC"))
+			mods = append(mods, qm.OrderBy("\""+dbmodel.StaffColumns.CreatedAt+"\" ASC, \""+dbmodel.StaffColumns.ID+"\" ASC"))
 		case model.StaffSortKeyDisplayNameAsc:
-			mods = append(mods, qm.OrderBy("\""+dbmodel.StaffColumns.DisplayName+"\" ASC"))
+			mods = append(mods, qm.OrderBy("\""+dbmodel.StaffColumns.DisplayName+"\" ASC, \""+dbmodel.StaffColumns.ID+"\" ASC"))
 		case model.StaffSortKeyDisplayNameDesc:
-			mods = append(mods, qm.OrderBy("\""+dbmodel.StaffColumns.DisplayName+"\" DESC"))
+			mods = append(mods, qm.OrderBy("\""+dbmodel.StaffColumns.DisplayName+"\" DESC, \""+dbmodel.StaffColumns.ID+"\" DESC"))
 		case model.StaffSortKeyUnknown:
 			return nil, errors.InternalErr.Errorf("invalid sort key: %s", query.SortKey.Value())
 		}
 	}
 
-	// Pagination
-	if query.Page.Valid && query.Limit.Valid {
-		mods = append(mods,
-			qm.Limit(int(query.Limit.Uint64)),
-			qm.Offset(int(query.Limit.Uint64*(query.Page.Uint64-1))),
-		)
+	// Keyset pagination
+	if query.Cursor.Valid {
+		mod, err := r.buildCursorQuery(query.SortKey, query.Cursor.Value())
+		if err != nil {
+			return nil, err
+		}
+		mods = append(mods, mod)
 	}
+
+	// Pagination
+	mods = addPaginationFromBaseListOptions(mods, query.BaseListOptions)
 	mods = append(mods, r.buildPreload(query.Preload)...)
 	mods = addForUpdateFromBaseListOptions(mods, query.BaseListOptions)
 	dbStaffs, err := dbmodel.Staffs(
@@ -90,6 +98,41 @@ func (r *staff) List(
 	return marshaller.StaffsToModel(dbStaffs), nil
 }
 
+func (r *staff) buildCursorQuery(
+	sortKey nullable.Type[model.StaffSortKey],
+	cursor model.Cursor,
+) (qm.QueryMod, error) {
+	if !sortKey.Valid {
+		return nil, errors.InternalErr.Errorf("cursor requires sort key")
+	}
+	switch sortKey.Value() {
+	case model.StaffSortKeyCreatedAtDesc, model.StaffSortKeyCreatedAtAsc:
+		createdAt, err := cursor.TimeValue()
+		if err != nil {
+			return nil, err
+		}
+		return newKeysetQueryMod(
+			"\""+dbmodel.StaffColumns.CreatedAt+"\"",
+			"\""+dbmodel.StaffColumns.ID+"\"",
+			sortKey.Value() == model.StaffSortKeyCreatedAtDesc,
+			createdAt,
+			cursor.ID,
+		), nil
+	case model.StaffSortKeyDisplayNameAsc, model.StaffSortKeyDisplayNameDesc:
+		return newKeysetQueryMod(
+			"\""+dbmodel.StaffColumns.DisplayName+"\"",
+			"\""+dbmodel.StaffColumns.ID+"\"",
+			sortKey.Value() == model.StaffSortKeyDisplayNameDesc,
+			cursor.Value,
+			cursor.ID,
+		), nil
+	case model.StaffSortKeyUnknown:
+		fallthrough
+	default:
+		return nil, errors.InternalErr.Errorf("invalid sort key: %s", sortKey.Value())
+	}
+}
+
 func (r *staff) Count(
 	ctx context.Context,
 	query repository.ListStaffQuery,
@@ -110,6 +153,27 @@ func (r *staff) buildListQuery(query repository.ListStaffQuery) []qm.QueryMod {
 	if query.TenantID.Valid {
 		mods = append(mods, dbmodel.StaffWhere.TenantID.EQ(query.TenantID.String))
 	}
+	if !query.IncludeDeleted {
+		mods = append(mods, dbmodel.StaffWhere.DeletedAt.IsNull())
+	}
+	if query.DeletedBefore.Valid {
+		mods = append(mods, dbmodel.StaffWhere.DeletedAt.LT(query.DeletedBefore))
+	}
+	if query.DisplayName.Valid {
+		mods = append(mods, newContainsQueryMod("\""+dbmodel.StaffColumns.DisplayName+"\"", query.DisplayName.String))
+	}
+	if query.Email.Valid {
+		mods = append(mods, dbmodel.StaffWhere.Email.EQ(query.Email.String))
+	}
+	if query.Role.Valid {
+		mods = append(mods, dbmodel.StaffWhere.Role.EQ(query.Role.Value().String()))
+	}
+	if query.CreatedAtFrom.Valid {
+		mods = append(mods, dbmodel.StaffWhere.CreatedAt.GTE(query.CreatedAtFrom.Time))
+	}
+	if query.CreatedAtTo.Valid {
+		mods = append(mods, dbmodel.StaffWhere.CreatedAt.LT(query.CreatedAtTo.Time))
+	}
 	return mods
 }
 
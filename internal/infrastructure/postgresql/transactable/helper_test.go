@@ -0,0 +1,135 @@
+package transactable
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/backoff"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactable(t *testing.T) {
+	// the database of sqlboiler is global, so the cases do not run in parallel
+
+	tx := NewTransactable(backoff.NewRetryPolicy(2, time.Millisecond, time.Millisecond))
+	exec := func(ctx context.Context, query string) error {
+		_, err := GetContextExecutor(ctx).ExecContext(ctx, query)
+		return err
+	}
+	expectExec := func(mock sqlmock.Sqlmock, query string) {
+		mock.ExpectExec(regexp.QuoteMeta(query)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	failedErr := errors.InternalErr.New()
+
+	tests := map[string]func(t *testing.T, mock sqlmock.Sqlmock){
+		"read-write transaction is committed": func(t *testing.T, mock sqlmock.Sqlmock) {
+			mock.ExpectBegin()
+			expectExec(mock, "UPDATE staffs")
+			mock.ExpectCommit()
+
+			require.NoError(t, tx.RWTx(context.Background(), func(ctx context.Context) error {
+				return exec(ctx, "UPDATE staffs")
+			}))
+		},
+		"failed read-write transaction is rolled back": func(t *testing.T, mock sqlmock.Sqlmock) {
+			mock.ExpectBegin()
+			mock.ExpectRollback()
+
+			err := tx.RWTx(context.Background(), func(ctx context.Context) error {
+				return failedErr
+			})
+			require.ErrorIs(t, err, errors.InternalErr)
+		},
+		"transaction failing to serialize is retried": func(t *testing.T, mock sqlmock.Sqlmock) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE staffs")).
+				WillReturnError(&pq.Error{Code: "40001"}) //nolint:exhaustruct
+			mock.ExpectRollback()
+			mock.ExpectBegin()
+			expectExec(mock, "UPDATE staffs")
+			mock.ExpectCommit()
+
+			require.NoError(t, tx.RWTx(context.Background(), func(ctx context.Context) error {
+				return exec(ctx, "UPDATE staffs")
+			}))
+		},
+		"nested read-write transactions run in savepoints": func(t *testing.T, mock sqlmock.Sqlmock) {
+			mock.ExpectBegin()
+			expectExec(mock, "SAVEPOINT sp_1")
+			expectExec(mock, "SAVEPOINT sp_2")
+			expectExec(mock, "UPDATE staffs")
+			expectExec(mock, "RELEASE SAVEPOINT sp_2")
+			expectExec(mock, "RELEASE SAVEPOINT sp_1")
+			mock.ExpectCommit()
+
+			require.NoError(t, tx.RWTx(context.Background(), func(ctx context.Context) error {
+				return tx.RWTx(ctx, func(ctx context.Context) error {
+					return tx.RWTx(ctx, func(ctx context.Context) error {
+						return exec(ctx, "UPDATE staffs")
+					})
+				})
+			}))
+		},
+		"failed nested read-write transaction is rolled back to its savepoint": func(t *testing.T, mock sqlmock.Sqlmock) {
+			mock.ExpectBegin()
+			expectExec(mock, "SAVEPOINT sp_1")
+			expectExec(mock, "UPDATE staffs")
+			expectExec(mock, "ROLLBACK TO SAVEPOINT sp_1")
+			expectExec(mock, "UPDATE tenants")
+			mock.ExpectCommit()
+
+			require.NoError(t, tx.RWTx(context.Background(), func(ctx context.Context) error {
+				err := tx.RWTx(ctx, func(ctx context.Context) error {
+					if err := exec(ctx, "UPDATE staffs"); err != nil {
+						return err
+					}
+					return failedErr
+				})
+				require.ErrorIs(t, err, errors.InternalErr)
+				// the outer transaction goes on without the changes of the savepoint
+				return exec(ctx, "UPDATE tenants")
+			}))
+		},
+		"read-only transaction nested in a read-write transaction joins it": func(t *testing.T, mock sqlmock.Sqlmock) {
+			mock.ExpectBegin()
+			expectExec(mock, "SELECT 1")
+			mock.ExpectCommit()
+
+			require.NoError(t, tx.RWTx(context.Background(), func(ctx context.Context) error {
+				return tx.ROTx(ctx, func(ctx context.Context) error {
+					return exec(ctx, "SELECT 1")
+				})
+			}))
+		},
+		"read-write transaction nested in a read-only transaction is rejected": func(t *testing.T, mock sqlmock.Sqlmock) {
+			mock.ExpectBegin()
+			mock.ExpectRollback()
+
+			err := tx.ROTx(context.Background(), func(ctx context.Context) error {
+				return tx.RWTx(ctx, func(ctx context.Context) error {
+					return exec(ctx, "UPDATE staffs")
+				})
+			})
+			require.ErrorIs(t, err, errors.InternalErr)
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func() { _ = db.Close() }()
+			boil.SetDB(db)
+
+			tc(t, mock)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
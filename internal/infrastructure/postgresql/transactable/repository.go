@@ -4,18 +4,25 @@ import (
 	"context"
 
 	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/backoff"
 )
 
-type transactable struct{}
+type transactable struct {
+	retryPolicy backoff.RetryPolicy
+}
 
-func NewTransactable() repository.Transactable {
-	return &transactable{}
+func NewTransactable(
+	retryPolicy backoff.RetryPolicy,
+) repository.Transactable {
+	return &transactable{
+		retryPolicy: retryPolicy,
+	}
 }
 
 func (r *transactable) ROTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	panic("not implemented for postgresql")
+	return RunROTx(ctx, r.retryPolicy, fn)
 }
 
 func (r *transactable) RWTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	return RunTx(ctx, fn)
+	return RunTx(ctx, r.retryPolicy, fn)
 }
@@ -7,9 +7,21 @@ import (
 	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
 	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	redis_helper "github.com/abyssparanoia/rapid-go/internal/infrastructure/redis/helper"
 	"github.com/redis/go-redis/v9"
 )
 
+// setAssetPathScript stores the path and records its key in the per-asset key set,
+// extending the key set expiration so that it outlives every key it lists.
+var setAssetPathScript = redis.NewScript(`
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+redis.call('SADD', KEYS[2], KEYS[1])
+if redis.call('PTTL', KEYS[2]) < tonumber(ARGV[2]) then
+  redis.call('PEXPIRE', KEYS[2], ARGV[2])
+end
+return 1
+`)
+
 type assetPath struct {
 	cli *redis.Client
 }
@@ -22,11 +34,18 @@ func NewAssetPath(
 	}
 }
 
+// The asset id is wrapped in a hash tag so that the keys of one asset share a cluster slot.
 func (c *assetPath) buildCacheKey(
 	assetKey string,
 	authContext model.AssetAuthContext,
 ) string {
-	return fmt.Sprintf("asset_path:%s:%s", assetKey, authContext.String())
+	return fmt.Sprintf("asset_path:{%s}:%s", assetKey, authContext.String())
+}
+
+func (c *assetPath) buildKeySetKey(
+	assetKey string,
+) string {
+	return fmt.Sprintf("asset_path_keys:{%s}", assetKey)
 }
 
 func (c *assetPath) Get(
@@ -49,8 +68,13 @@ func (c *assetPath) Set(
 	ctx context.Context,
 	asset *model.Asset,
 ) error {
-	cacheKey := c.buildCacheKey(asset.ID, asset.AuthContext)
-	if err := c.cli.Set(ctx, cacheKey, asset.Path, asset.Expiration()).Err(); err != nil {
+	if err := setAssetPathScript.Run(
+		ctx,
+		c.cli,
+		[]string{c.buildCacheKey(asset.ID, asset.AuthContext), c.buildKeySetKey(asset.ID)},
+		asset.Path,
+		asset.Expiration().Milliseconds(),
+	).Err(); err != nil {
 		return errors.InternalErr.Wrap(err)
 	}
 	return nil
@@ -60,16 +84,19 @@ func (c *assetPath) Clear(
 	ctx context.Context,
 	id string,
 ) error {
-	// Delete all cache entries for this asset ID regardless of auth context
-	pattern := fmt.Sprintf("asset_path:%s:*", id)
-	iter := c.cli.Scan(ctx, 0, pattern, 0).Iterator()
-	for iter.Next(ctx) {
-		if err := c.cli.Del(ctx, iter.Val()).Err(); err != nil {
+	// Delete all cache entries for this asset ID regardless of auth context.
+	// The keys are read from the key set and deleted in a transaction watching it, rather than in a script,
+	// since a script may only touch the keys it declares on Redis Cluster.
+	keySetKey := c.buildKeySetKey(id)
+	return redis_helper.RunTransaction(ctx, c.cli, func(ctx context.Context, tx *redis.Tx) error {
+		keys, err := tx.SMembers(ctx, keySetKey).Result()
+		if err != nil {
 			return errors.InternalErr.Wrap(err)
 		}
-	}
-	if err := iter.Err(); err != nil {
-		return errors.InternalErr.Wrap(err)
-	}
-	return nil
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(ctx, append(keys, keySetKey)...)
+			return nil
+		})
+		return err
+	}, keySetKey)
 }
@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T) (*redis.Client, *miniredis.Miniredis) {
+	t.Helper()
+	m := miniredis.RunT(t)
+	cli := redis.NewClient(&redis.Options{Addr: m.Addr()}) //nolint:exhaustruct
+	t.Cleanup(func() { _ = cli.Close() })
+	return cli, m
+}
+
+func TestAssetPath(t *testing.T) {
+	t.Parallel()
+
+	staffContext := model.NewAssetAuthContext("staff:staff-id")
+	adminContext := model.NewAssetAuthContext("admin:admin-id")
+	newAsset := func(authContext model.AssetAuthContext) *model.Asset {
+		return model.NewAsset(
+			model.AssetTypeUserImage,
+			model.ContentTypeImagePNG,
+			authContext,
+			now.Now(),
+		)
+	}
+
+	tests := map[string]func(t *testing.T){
+		"get returns the path set for the auth context": func(t *testing.T) {
+			ctx := context.Background()
+			cli, _ := newTestClient(t)
+			c := NewAssetPath(cli)
+			asset := newAsset(staffContext)
+			require.NoError(t, c.Set(ctx, asset))
+
+			got, err := c.Get(ctx, asset.ID, staffContext)
+			require.NoError(t, err)
+			require.Equal(t, asset.Path, got)
+
+			_, err = c.Get(ctx, asset.ID, adminContext)
+			require.ErrorIs(t, err, errors.AssetNotFoundErr)
+		},
+		"expired entries are not returned": func(t *testing.T) {
+			ctx := context.Background()
+			cli, m := newTestClient(t)
+			c := NewAssetPath(cli)
+			asset := newAsset(staffContext)
+			require.NoError(t, c.Set(ctx, asset))
+
+			m.FastForward(asset.Expiration())
+
+			_, err := c.Get(ctx, asset.ID, staffContext)
+			require.ErrorIs(t, err, errors.AssetNotFoundErr)
+			require.False(t, m.Exists(c.(*assetPath).buildKeySetKey(asset.ID)))
+		},
+		"clear removes the entries of every auth context": func(t *testing.T) {
+			ctx := context.Background()
+			cli, m := newTestClient(t)
+			c := NewAssetPath(cli)
+			asset := newAsset(staffContext)
+			require.NoError(t, c.Set(ctx, asset))
+			adminAsset := *asset
+			adminAsset.AuthContext = adminContext
+			require.NoError(t, c.Set(ctx, &adminAsset))
+			other := newAsset(staffContext)
+			require.NoError(t, c.Set(ctx, other))
+
+			require.NoError(t, c.Clear(ctx, asset.ID))
+
+			_, err := c.Get(ctx, asset.ID, staffContext)
+			require.ErrorIs(t, err, errors.AssetNotFoundErr)
+			_, err = c.Get(ctx, asset.ID, adminContext)
+			require.ErrorIs(t, err, errors.AssetNotFoundErr)
+			require.False(t, m.Exists(c.(*assetPath).buildKeySetKey(asset.ID)))
+			got, err := c.Get(ctx, other.ID, staffContext)
+			require.NoError(t, err)
+			require.Equal(t, other.Path, got)
+		},
+		"clear of an asset not cached succeeds": func(t *testing.T) {
+			ctx := context.Background()
+			cli, _ := newTestClient(t)
+			c := NewAssetPath(cli)
+
+			require.NoError(t, c.Clear(ctx, "asset-id"))
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			tc(t)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/redis/go-redis/v9"
+)
+
+// claimIdempotencyKeyScript stores the key unless it is stored, and returns the stored one otherwise.
+// It returns nil when the key is claimed.
+var claimIdempotencyKeyScript = redis.NewScript(`
+if redis.call('SET', KEYS[1], ARGV[1], 'NX', 'PX', ARGV[2]) then
+  return false
+end
+return redis.call('GET', KEYS[1])
+`)
+
+type idempotencyKey struct {
+	cli *redis.Client
+}
+
+// NewIdempotencyKey returns an IdempotencyKey storing the keys in redis, which expires them by itself.
+func NewIdempotencyKey(
+	cli *redis.Client,
+) cache.IdempotencyKey {
+	return &idempotencyKey{
+		cli: cli,
+	}
+}
+
+type idempotencyKeyDocument struct {
+	ID          string    `json:"id"`
+	Fingerprint string    `json:"fingerprint"`
+	Response    []byte    `json:"response"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func (c *idempotencyKey) buildCacheKey(id string) string {
+	return fmt.Sprintf("idempotency_key:%s", id)
+}
+
+func (c *idempotencyKey) Claim(
+	ctx context.Context,
+	key *model.IdempotencyKey,
+	t time.Time,
+) (*model.IdempotencyKey, bool, error) {
+	value, err := c.marshal(key)
+	if err != nil {
+		return nil, false, err
+	}
+	got, err := claimIdempotencyKeyScript.Run(
+		ctx,
+		c.cli,
+		[]string{c.buildCacheKey(key.ID)},
+		value,
+		key.ExpiresAt.Sub(t).Milliseconds(),
+	).Text()
+	if err != nil {
+		if err == redis.Nil {
+			return key, true, nil
+		}
+		return nil, false, errors.InternalErr.Wrap(err)
+	}
+	stored, err := c.unmarshal(got)
+	if err != nil {
+		return nil, false, err
+	}
+	return stored, false, nil
+}
+
+func (c *idempotencyKey) Complete(
+	ctx context.Context,
+	key *model.IdempotencyKey,
+) error {
+	value, err := c.marshal(key)
+	if err != nil {
+		return err
+	}
+	if err := c.cli.Set(
+		ctx,
+		c.buildCacheKey(key.ID),
+		value,
+		key.ExpiresAt.Sub(key.UpdatedAt),
+	).Err(); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (c *idempotencyKey) Release(
+	ctx context.Context,
+	id string,
+) error {
+	if err := c.cli.Del(ctx, c.buildCacheKey(id)).Err(); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+// DeleteExpired deletes nothing, since the keys are stored with an expiration.
+func (c *idempotencyKey) DeleteExpired(
+	ctx context.Context,
+	t time.Time,
+	limit uint64,
+) (int, error) {
+	return 0, nil
+}
+
+func (c *idempotencyKey) marshal(key *model.IdempotencyKey) (string, error) {
+	b, err := json.Marshal(idempotencyKeyDocument{
+		ID:          key.ID,
+		Fingerprint: key.Fingerprint,
+		Response:    key.Response,
+		ExpiresAt:   key.ExpiresAt,
+		CreatedAt:   key.CreatedAt,
+		UpdatedAt:   key.UpdatedAt,
+	})
+	if err != nil {
+		return "", errors.InternalErr.Wrap(err)
+	}
+	return string(b), nil
+}
+
+func (c *idempotencyKey) unmarshal(value string) (*model.IdempotencyKey, error) {
+	var doc idempotencyKeyDocument
+	if err := json.Unmarshal([]byte(value), &doc); err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return &model.IdempotencyKey{
+		ID:          doc.ID,
+		Fingerprint: doc.Fingerprint,
+		Response:    doc.Response,
+		ExpiresAt:   doc.ExpiresAt,
+		CreatedAt:   doc.CreatedAt,
+		UpdatedAt:   doc.UpdatedAt,
+	}, nil
+}
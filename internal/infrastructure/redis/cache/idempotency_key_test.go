@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	requestTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]func(t *testing.T){
+		"the stored key is returned until it expires": func(t *testing.T) {
+			ctx := context.Background()
+			cli, m := newTestClient(t)
+			c := NewIdempotencyKey(cli)
+
+			key := model.NewIdempotencyKey("id", "fingerprint", requestTime)
+			got, claimed, err := c.Claim(ctx, key, requestTime)
+			require.NoError(t, err)
+			require.True(t, claimed)
+			require.Equal(t, key, got)
+
+			key.Complete([]byte("response"), time.Hour, requestTime.Add(time.Second))
+			require.NoError(t, c.Complete(ctx, key))
+
+			retried := model.NewIdempotencyKey("id", "fingerprint", requestTime.Add(time.Minute))
+			got, claimed, err = c.Claim(ctx, retried, requestTime.Add(time.Minute))
+			require.NoError(t, err)
+			require.False(t, claimed)
+			require.Equal(t, key, got)
+
+			m.FastForward(time.Hour)
+
+			expired := requestTime.Add(time.Hour + time.Second)
+			retried = model.NewIdempotencyKey("id", "fingerprint", expired)
+			got, claimed, err = c.Claim(ctx, retried, expired)
+			require.NoError(t, err)
+			require.True(t, claimed)
+			require.Equal(t, retried, got)
+		},
+		"the released key is claimed again": func(t *testing.T) {
+			ctx := context.Background()
+			cli, _ := newTestClient(t)
+			c := NewIdempotencyKey(cli)
+
+			key := model.NewIdempotencyKey("id", "fingerprint", requestTime)
+			_, claimed, err := c.Claim(ctx, key, requestTime)
+			require.NoError(t, err)
+			require.True(t, claimed)
+			require.NoError(t, c.Release(ctx, key.ID))
+
+			_, claimed, err = c.Claim(ctx, key, requestTime)
+			require.NoError(t, err)
+			require.True(t, claimed)
+		},
+		"the keys expire by themselves": func(t *testing.T) {
+			ctx := context.Background()
+			cli, m := newTestClient(t)
+			c := NewIdempotencyKey(cli)
+
+			key := model.NewIdempotencyKey("id", "fingerprint", requestTime)
+			_, _, err := c.Claim(ctx, key, requestTime)
+			require.NoError(t, err)
+			require.Equal(t, key.ExpiresAt.Sub(requestTime), m.TTL(c.(*idempotencyKey).buildCacheKey(key.ID)))
+
+			deleted, err := c.DeleteExpired(ctx, key.ExpiresAt, 10)
+			require.NoError(t, err)
+			require.Zero(t, deleted)
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			tc(t)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// allowScript counts a request against the rate limit by GCRA, keeping the theoretical arrival time
+// of the next request in microseconds of the clock of redis, shared by every process.
+// It returns whether the request is allowed, the remaining requests, and the waits in microseconds
+// before the next request is allowed and before the allowance is replenished.
+var allowScript = redis.NewScript(`
+local period = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+local tat = tonumber(redis.call('GET', KEYS[1]) or now)
+if tat < now then
+  tat = now
+end
+local new_tat = tat + interval
+local allow_at = new_tat - period
+if allow_at > now then
+  return {0, 0, allow_at - now, tat - now}
+end
+redis.call('SET', KEYS[1], new_tat, 'PX', math.ceil((new_tat - now) / 1000))
+return {1, math.floor((now - allow_at) / interval), 0, new_tat - now}
+`)
+
+type rateLimiter struct {
+	cli *redis.Client
+}
+
+// NewRateLimiter returns a RateLimiter counting in redis, so that the limits apply across the processes.
+func NewRateLimiter(
+	cli *redis.Client,
+) cache.RateLimiter {
+	return &rateLimiter{
+		cli: cli,
+	}
+}
+
+func (l *rateLimiter) buildCacheKey(key string) string {
+	return fmt.Sprintf("rate_limit:%s", key)
+}
+
+func (l *rateLimiter) Allow(
+	ctx context.Context,
+	key string,
+	limit cache.RateLimit,
+) (*cache.RateLimitResult, error) {
+	got, err := allowScript.Run(
+		ctx,
+		l.cli,
+		[]string{l.buildCacheKey(key)},
+		limit.Period.Microseconds(),
+		limit.Interval().Microseconds(),
+	).Int64Slice()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	if len(got) != 4 {
+		return nil, errors.InternalErr.Errorf("unexpected result of the rate limit script: %v", got)
+	}
+	return &cache.RateLimitResult{
+		Allowed:    got[0] == 1,
+		Remaining:  int(got[1]),
+		RetryAfter: time.Duration(got[2]) * time.Microsecond,
+		ResetAfter: time.Duration(got[3]) * time.Microsecond,
+	}, nil
+}
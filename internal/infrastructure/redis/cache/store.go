@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+type store struct {
+	cli *redis.Client
+}
+
+func NewStore(
+	cli *redis.Client,
+) cache.Store {
+	return &store{
+		cli: cli,
+	}
+}
+
+func (s *store) Get(
+	ctx context.Context,
+	key string,
+) ([]byte, bool, error) {
+	got, err := s.cli.Get(ctx, key).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, false, nil
+		}
+		return nil, false, errors.InternalErr.Wrap(err)
+	}
+	return got, true, nil
+}
+
+func (s *store) Set(
+	ctx context.Context,
+	key string,
+	value []byte,
+	ttl time.Duration,
+) error {
+	if err := s.cli.Set(ctx, key, value, ttl).Err(); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (s *store) Delete(
+	ctx context.Context,
+	keys ...string,
+) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if err := s.cli.Del(ctx, keys...).Err(); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"fmt"
 
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -20,7 +21,7 @@ func NewClient(
 			MinVersion: tls.VersionTLS12,
 		}
 	}
-	return redis.NewClient(&redis.Options{
+	cli := redis.NewClient(&redis.Options{
 		Addr:       fmt.Sprintf("%s:%s", host, port),
 		Username:   username,
 		Password:   password,
@@ -28,4 +29,9 @@ func NewClient(
 		MaxRetries: 5,
 		TLSConfig:  tlsConfig,
 	})
+	// the commands are traced with the global tracer provider
+	if err := redisotel.InstrumentTracing(cli); err != nil {
+		panic(err)
+	}
+	return cli
 }
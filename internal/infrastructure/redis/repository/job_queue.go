@@ -0,0 +1,259 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	jobQueueReadyKey   = "job_queue:ready"   // pending job ids scored by run_at
+	jobQueueRunningKey = "job_queue:running" // running job ids scored by locked_until
+	jobQueueDeadKey    = "job_queue:dead"    // dead job ids scored by finished_at
+
+	// finishedJobRetention is how long a succeeded or dead job is kept for inspection.
+	finishedJobRetention = 7 * 24 * time.Hour
+)
+
+// dequeueJobsScript moves due and lease expired job ids into the running set atomically,
+// so that concurrent workers never claim the same job.
+var dequeueJobsScript = redis.NewScript(`
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+local rest = tonumber(ARGV[2]) - #ids
+if rest > 0 then
+  local expired = redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', ARGV[1], 'LIMIT', 0, rest)
+  for _, id in ipairs(expired) do
+    table.insert(ids, id)
+  end
+end
+for _, id in ipairs(ids) do
+  redis.call('ZREM', KEYS[1], id)
+  redis.call('ZADD', KEYS[2], ARGV[3], id)
+end
+return ids
+`)
+
+type jobQueue struct {
+	cli *redis.Client
+}
+
+// NewJobQueue returns a job queue backed by Redis.
+// Unlike the database backed queues, Enqueue does not join a database transaction.
+func NewJobQueue(
+	cli *redis.Client,
+) repository.JobQueue {
+	return &jobQueue{
+		cli: cli,
+	}
+}
+
+type jobDocument struct {
+	ID          string      `json:"id"`
+	Type        string      `json:"type"`
+	Payload     string      `json:"payload"`
+	Status      string      `json:"status"`
+	Attempts    uint64      `json:"attempts"`
+	MaxAttempts uint64      `json:"max_attempts"`
+	RunAt       time.Time   `json:"run_at"`
+	LockedUntil null.Time   `json:"locked_until"`
+	LastError   null.String `json:"last_error"`
+	FinishedAt  null.Time   `json:"finished_at"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+func (r *jobQueue) buildJobKey(id string) string {
+	return fmt.Sprintf("job:%s", id)
+}
+
+func (r *jobQueue) Enqueue(
+	ctx context.Context,
+	job *model.Job,
+) error {
+	return r.save(ctx, r.cli, job)
+}
+
+func (r *jobQueue) Dequeue(
+	ctx context.Context,
+	query repository.DequeueJobsQuery,
+) (model.Jobs, error) {
+	lockedUntil := query.RequestTime.Add(query.LeaseDuration)
+	ids, err := dequeueJobsScript.Run(
+		ctx,
+		r.cli,
+		[]string{jobQueueReadyKey, jobQueueRunningKey},
+		query.RequestTime.UnixMilli(),
+		query.Limit,
+		lockedUntil.UnixMilli(),
+	).StringSlice()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+
+	jobs := make(model.Jobs, 0, len(ids))
+	for _, id := range ids {
+		got, err := r.cli.Get(ctx, r.buildJobKey(id)).Result()
+		if err != nil {
+			if err == redis.Nil {
+				// the job expired after it finished, drop the stale id
+				if err := r.cli.ZRem(ctx, jobQueueRunningKey, id).Err(); err != nil {
+					return nil, errors.InternalErr.Wrap(err)
+				}
+				continue
+			}
+			return nil, errors.InternalErr.Wrap(err)
+		}
+		job, err := r.unmarshal(got)
+		if err != nil {
+			return nil, err
+		}
+		job.Start(lockedUntil, query.RequestTime)
+		if err := r.save(ctx, r.cli, job); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// Update records the result of the job only while the job is still running the attempt it was claimed for,
+// and returns JobLeaseExpiredErr once its lease has expired and another worker has claimed it again.
+// The job is watched, so that a claim between the check and the write aborts the write.
+func (r *jobQueue) Update(
+	ctx context.Context,
+	job *model.Job,
+) error {
+	key := r.buildJobKey(job.ID)
+	leaseExpiredErr := errors.JobLeaseExpiredErr.New().
+		WithDetail("job has been claimed again").
+		WithValue("job_id", job.ID)
+	err := r.cli.Watch(ctx, func(tx *redis.Tx) error {
+		got, err := tx.Get(ctx, key).Result()
+		if err != nil {
+			if err == redis.Nil {
+				return leaseExpiredErr
+			}
+			return errors.InternalErr.Wrap(err)
+		}
+		current, err := r.unmarshal(got)
+		if err != nil {
+			return err
+		}
+		if current.Status != model.JobStatusRunning || current.Attempts != job.Attempts {
+			return leaseExpiredErr
+		}
+		return r.save(ctx, tx, job)
+	}, key)
+	if err == redis.TxFailedErr {
+		return leaseExpiredErr
+	}
+	return err
+}
+
+// save writes the job and moves its id to the set of its status in a transaction.
+func (r *jobQueue) save(
+	ctx context.Context,
+	cli redis.Cmdable,
+	job *model.Job,
+) error {
+	data, err := r.marshal(job)
+	if err != nil {
+		return err
+	}
+	key := r.buildJobKey(job.ID)
+	if _, err := cli.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, jobQueueReadyKey, job.ID)
+		pipe.ZRem(ctx, jobQueueRunningKey, job.ID)
+		switch job.Status { //nolint:exhaustive // unknown status is never stored
+		case model.JobStatusPending:
+			pipe.Set(ctx, key, data, 0)
+			pipe.ZAdd(ctx, jobQueueReadyKey, redis.Z{Score: float64(job.RunAt.UnixMilli()), Member: job.ID})
+			r.trimDeadJobs(ctx, pipe, job.UpdatedAt)
+		case model.JobStatusRunning:
+			pipe.Set(ctx, key, data, 0)
+			pipe.ZAdd(ctx, jobQueueRunningKey, redis.Z{Score: float64(job.LockedUntil.Time.UnixMilli()), Member: job.ID})
+		case model.JobStatusSucceeded:
+			pipe.Set(ctx, key, data, finishedJobRetention)
+			r.trimDeadJobs(ctx, pipe, job.UpdatedAt)
+		case model.JobStatusDead:
+			pipe.Set(ctx, key, data, finishedJobRetention)
+			pipe.ZAdd(ctx, jobQueueDeadKey, redis.Z{Score: float64(job.FinishedAt.Time.UnixMilli()), Member: job.ID})
+			r.trimDeadJobs(ctx, pipe, job.UpdatedAt)
+		}
+		return nil
+	}); err != nil {
+		if err == redis.TxFailedErr {
+			return err
+		}
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+// trimDeadJobs drops the ids of the dead jobs finished before the retention,
+// whose keys have expired, so that the dead set does not grow forever.
+func (r *jobQueue) trimDeadJobs(
+	ctx context.Context,
+	pipe redis.Pipeliner,
+	t time.Time,
+) {
+	pipe.ZRemRangeByScore(ctx, jobQueueDeadKey, "-inf", strconv.FormatInt(t.Add(-finishedJobRetention).UnixMilli(), 10))
+}
+
+func (r *jobQueue) marshal(job *model.Job) (string, error) {
+	payload, err := json.Marshal(job.Payload)
+	if err != nil {
+		return "", errors.InternalErr.Wrap(err)
+	}
+	data, err := json.Marshal(&jobDocument{
+		ID:          job.ID,
+		Type:        job.Type.String(),
+		Payload:     string(payload),
+		Status:      job.Status.String(),
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.RunAt,
+		LockedUntil: job.LockedUntil,
+		LastError:   job.LastError,
+		FinishedAt:  job.FinishedAt,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	})
+	if err != nil {
+		return "", errors.InternalErr.Wrap(err)
+	}
+	return string(data), nil
+}
+
+func (r *jobQueue) unmarshal(data string) (*model.Job, error) {
+	doc := &jobDocument{}
+	if err := json.Unmarshal([]byte(data), doc); err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	payload := model.JobPayload{}
+	if err := json.Unmarshal([]byte(doc.Payload), &payload); err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return &model.Job{
+		ID:          doc.ID,
+		Type:        model.NewJobType(doc.Type),
+		Payload:     payload,
+		Status:      model.NewJobStatus(doc.Status),
+		Attempts:    doc.Attempts,
+		MaxAttempts: doc.MaxAttempts,
+		RunAt:       doc.RunAt,
+		LockedUntil: doc.LockedUntil,
+		LastError:   doc.LastError,
+		FinishedAt:  doc.FinishedAt,
+		CreatedAt:   doc.CreatedAt,
+		UpdatedAt:   doc.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domainerrors "github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestJobQueue(t *testing.T) (*jobQueue, *miniredis.Miniredis) {
+	t.Helper()
+	m := miniredis.RunT(t)
+	cli := redis.NewClient(&redis.Options{Addr: m.Addr()}) //nolint:exhaustruct
+	t.Cleanup(func() { _ = cli.Close() })
+	return &jobQueue{cli: cli}, m
+}
+
+func TestJobQueue(t *testing.T) {
+	t.Parallel()
+
+	requestTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	leaseDuration := 5 * time.Minute
+	dequeueQuery := func(t time.Time) repository.DequeueJobsQuery {
+		return repository.DequeueJobsQuery{
+			Limit:         10,
+			LeaseDuration: leaseDuration,
+			RequestTime:   t,
+		}
+	}
+
+	tests := map[string]func(t *testing.T){
+		"due jobs are claimed once": func(t *testing.T) {
+			ctx := context.Background()
+			q, _ := newTestJobQueue(t)
+
+			due := model.NewJob(model.JobTypePurgeDeletedTenants, model.JobPayload{"retention": "720h"}, 3, requestTime, requestTime)
+			later := model.NewJob(model.JobTypePurgeDeletedTenants, nil, 3, requestTime.Add(time.Hour), requestTime)
+			require.NoError(t, q.Enqueue(ctx, due))
+			require.NoError(t, q.Enqueue(ctx, later))
+
+			got, err := q.Dequeue(ctx, dequeueQuery(requestTime))
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			require.Equal(t, due.ID, got[0].ID)
+			require.Equal(t, model.JobStatusRunning, got[0].Status)
+			require.Equal(t, uint64(1), got[0].Attempts)
+			require.Equal(t, model.JobPayload{"retention": "720h"}, got[0].Payload)
+
+			got, err = q.Dequeue(ctx, dequeueQuery(requestTime))
+			require.NoError(t, err)
+			require.Empty(t, got)
+		},
+		"job whose lease expired is claimed again": func(t *testing.T) {
+			ctx := context.Background()
+			q, _ := newTestJobQueue(t)
+
+			job := model.NewJob(model.JobTypePurgeDeletedTenants, nil, 3, requestTime, requestTime)
+			require.NoError(t, q.Enqueue(ctx, job))
+			_, err := q.Dequeue(ctx, dequeueQuery(requestTime))
+			require.NoError(t, err)
+
+			got, err := q.Dequeue(ctx, dequeueQuery(requestTime.Add(leaseDuration+time.Second)))
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			require.Equal(t, job.ID, got[0].ID)
+			require.Equal(t, uint64(2), got[0].Attempts)
+		},
+		"succeeded job is acked": func(t *testing.T) {
+			ctx := context.Background()
+			q, m := newTestJobQueue(t)
+
+			job := model.NewJob(model.JobTypePurgeDeletedTenants, nil, 3, requestTime, requestTime)
+			require.NoError(t, q.Enqueue(ctx, job))
+			got, err := q.Dequeue(ctx, dequeueQuery(requestTime))
+			require.NoError(t, err)
+			require.NoError(t, q.Update(ctx, got[0].Succeed(requestTime.Add(time.Second))))
+
+			got, err = q.Dequeue(ctx, dequeueQuery(requestTime.Add(leaseDuration+time.Second)))
+			require.NoError(t, err)
+			require.Empty(t, got)
+			require.Equal(t, finishedJobRetention, m.TTL(q.buildJobKey(job.ID)))
+		},
+		"failed job is retried after the backoff": func(t *testing.T) {
+			ctx := context.Background()
+			q, _ := newTestJobQueue(t)
+
+			job := model.NewJob(model.JobTypePurgeDeletedTenants, nil, 3, requestTime, requestTime)
+			require.NoError(t, q.Enqueue(ctx, job))
+			got, err := q.Dequeue(ctx, dequeueQuery(requestTime))
+			require.NoError(t, err)
+			failed := got[0].Fail(errors.New("failed"), requestTime)
+			require.Equal(t, model.JobStatusPending, failed.Status)
+			require.NoError(t, q.Update(ctx, failed))
+
+			got, err = q.Dequeue(ctx, dequeueQuery(requestTime))
+			require.NoError(t, err)
+			require.Empty(t, got)
+
+			got, err = q.Dequeue(ctx, dequeueQuery(failed.RunAt))
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			require.Equal(t, job.ID, got[0].ID)
+			require.Equal(t, "failed", got[0].LastError.String)
+		},
+		"result of a job claimed again is not recorded": func(t *testing.T) {
+			ctx := context.Background()
+			q, _ := newTestJobQueue(t)
+
+			job := model.NewJob(model.JobTypePurgeDeletedTenants, nil, 3, requestTime, requestTime)
+			require.NoError(t, q.Enqueue(ctx, job))
+			stale, err := q.Dequeue(ctx, dequeueQuery(requestTime))
+			require.NoError(t, err)
+			claimed, err := q.Dequeue(ctx, dequeueQuery(requestTime.Add(leaseDuration+time.Second)))
+			require.NoError(t, err)
+			require.Len(t, claimed, 1)
+
+			err = q.Update(ctx, stale[0].Succeed(requestTime.Add(leaseDuration+2*time.Second)))
+			require.ErrorIs(t, err, domainerrors.JobLeaseExpiredErr)
+
+			require.NoError(t, q.Update(ctx, claimed[0].Succeed(requestTime.Add(leaseDuration+3*time.Second))))
+		},
+		"job with a broken payload is not claimed silently": func(t *testing.T) {
+			ctx := context.Background()
+			q, m := newTestJobQueue(t)
+
+			job := model.NewJob(model.JobTypePurgeDeletedTenants, nil, 3, requestTime, requestTime)
+			require.NoError(t, q.Enqueue(ctx, job))
+			data, err := m.Get(q.buildJobKey(job.ID))
+			require.NoError(t, err)
+			require.NoError(t, m.Set(q.buildJobKey(job.ID), strings.Replace(data, `"payload":"{}"`, `"payload":"{"`, 1)))
+
+			_, err = q.Dequeue(ctx, dequeueQuery(requestTime))
+			require.ErrorIs(t, err, domainerrors.InternalErr)
+		},
+		"job failed on the last attempt is dead": func(t *testing.T) {
+			ctx := context.Background()
+			q, m := newTestJobQueue(t)
+
+			job := model.NewJob(model.JobTypePurgeDeletedTenants, nil, 1, requestTime, requestTime)
+			require.NoError(t, q.Enqueue(ctx, job))
+			got, err := q.Dequeue(ctx, dequeueQuery(requestTime))
+			require.NoError(t, err)
+			dead := got[0].Fail(errors.New("failed"), requestTime)
+			require.Equal(t, model.JobStatusDead, dead.Status)
+			require.NoError(t, q.Update(ctx, dead))
+
+			got, err = q.Dequeue(ctx, dequeueQuery(requestTime.Add(24*time.Hour)))
+			require.NoError(t, err)
+			require.Empty(t, got)
+			members, err := m.ZMembers(jobQueueDeadKey)
+			require.NoError(t, err)
+			require.Equal(t, []string{job.ID}, members)
+		},
+		"dead jobs past the retention are trimmed": func(t *testing.T) {
+			ctx := context.Background()
+			q, m := newTestJobQueue(t)
+
+			job := model.NewJob(model.JobTypePurgeDeletedTenants, nil, 1, requestTime, requestTime)
+			require.NoError(t, q.Enqueue(ctx, job))
+			got, err := q.Dequeue(ctx, dequeueQuery(requestTime))
+			require.NoError(t, err)
+			require.NoError(t, q.Update(ctx, got[0].Fail(errors.New("failed"), requestTime)))
+
+			enqueuedAt := requestTime.Add(finishedJobRetention + time.Second)
+			require.NoError(t, q.Enqueue(ctx, model.NewJob(model.JobTypePurgeDeletedTenants, nil, 1, enqueuedAt, enqueuedAt)))
+
+			require.False(t, m.Exists(jobQueueDeadKey))
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			tc(t)
+		})
+	}
+}
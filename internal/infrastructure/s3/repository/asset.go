@@ -2,7 +2,11 @@ package repository
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
+	"io"
+	"maps"
+	"net/http"
 	"strings"
 	"time"
 
@@ -11,6 +15,7 @@ import (
 	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 )
 
 const (
@@ -42,29 +47,40 @@ func NewAsset(
 	}
 }
 
-func (r *asset) GenerateWritePresignedURL(
-	ctx context.Context,
-	contentType model.ContentType,
-	path string,
-	expires time.Duration,
-) (string, error) {
-	// Select bucket based on path prefix
-	bucketName := r.privateBucketName
+func (r *asset) bucketName(path string) string {
 	if strings.HasPrefix(path, "public/") {
-		bucketName = r.publicBucketName
+		return r.publicBucketName
 	}
+	return r.privateBucketName
+}
 
-	req, err := r.presignCli.PresignPutObject(ctx, &s3.PutObjectInput{
-		Bucket:      aws.String(bucketName),
-		Key:         aws.String(path),
-		ContentType: aws.String(contentType.String()),
-	}, func(opts *s3.PresignOptions) {
-		opts.Expires = expires
+func (r *asset) GenerateWritePresignedURL(
+	ctx context.Context,
+	asset *model.Asset,
+) (*model.AssetPresignedUpload, error) {
+	// A presigned POST is used over PUT, since only its policy can limit the content length
+	contentType := asset.ContentType.String()
+	req, err := r.presignCli.PresignPostObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(r.bucketName(asset.Path)),
+		Key:    aws.String(asset.Path),
+	}, func(opts *s3.PresignPostOptions) {
+		opts.Expires = asset.Expiration()
+		opts.Conditions = []any{
+			[]any{"content-length-range", 0, asset.Type.Policy().MaxSize},
+			map[string]string{"Content-Type": contentType},
+		}
 	})
 	if err != nil {
-		return "", errors.InternalErr.Wrap(err)
+		return nil, errors.InternalErr.Wrap(err)
 	}
-	return req.URL, nil
+	fields := maps.Clone(req.Values)
+	fields["Content-Type"] = contentType
+	return &model.AssetPresignedUpload{
+		Method:  http.MethodPost,
+		URL:     req.URL,
+		Fields:  fields,
+		Headers: map[string]string{},
+	}, nil
 }
 
 func (r *asset) GenerateReadURL(
@@ -94,3 +110,71 @@ func (r *asset) GenerateReadURL(
 	}
 	return req.URL, nil
 }
+
+func (r *asset) Head(
+	ctx context.Context,
+	path string,
+) (*model.AssetObject, error) {
+	out, err := r.cli.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(r.bucketName(path)),
+		Key:    aws.String(path),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if goerrors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return &model.AssetObject{
+		Path: path,
+		Size: aws.ToInt64(out.ContentLength),
+	}, nil
+}
+
+func (r *asset) NewReader(
+	ctx context.Context,
+	path string,
+) (io.ReadCloser, error) {
+	out, err := r.cli.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucketName(path)),
+		Key:    aws.String(path),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if goerrors.As(err, &noSuchKey) {
+			return nil, errors.AssetNotFoundErr.New().
+				WithValue("path", path)
+		}
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	return out.Body, nil
+}
+
+func (r *asset) NewWriter(
+	ctx context.Context,
+	path string,
+	contentType model.ContentType,
+) (io.WriteCloser, error) {
+	return newObjectWriter(
+		ctx,
+		r.cli,
+		r.bucketName(path),
+		path,
+		contentType.String(),
+	), nil
+}
+
+func (r *asset) Delete(
+	ctx context.Context,
+	path string,
+) error {
+	// DeleteObject succeeds for a missing key
+	if _, err := r.cli.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(r.bucketName(path)),
+		Key:    aws.String(path),
+	}); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// partSize is the size of the parts of a multipart upload, which is the minimum S3 accepts.
+const partSize = 5 << 20
+
+// objectWriter streams an object to S3 without knowing its size up front.
+// An object smaller than a part is put at once on close, and a larger one is sent as a multipart upload,
+// which is only completed on close and aborted when ctx has been cancelled.
+type objectWriter struct {
+	ctx         context.Context
+	cli         *s3.Client
+	bucket      string
+	key         string
+	contentType string
+	buf         bytes.Buffer
+	uploadID    *string
+	parts       []types.CompletedPart
+	err         error
+}
+
+func newObjectWriter(
+	ctx context.Context,
+	cli *s3.Client,
+	bucket string,
+	key string,
+	contentType string,
+) *objectWriter {
+	return &objectWriter{
+		ctx:         ctx,
+		cli:         cli,
+		bucket:      bucket,
+		key:         key,
+		contentType: contentType,
+		buf:         bytes.Buffer{},
+		uploadID:    nil,
+		parts:       nil,
+		err:         nil,
+	}
+}
+
+func (w *objectWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	w.buf.Write(p)
+	for w.buf.Len() >= partSize {
+		if err := w.uploadPart(w.buf.Next(partSize)); err != nil {
+			w.abort(err)
+			return 0, w.err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *objectWriter) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if err := w.ctx.Err(); err != nil {
+		w.abort(err)
+		return w.err
+	}
+	if w.uploadID == nil {
+		if _, err := w.cli.PutObject(w.ctx, &s3.PutObjectInput{
+			Bucket:      aws.String(w.bucket),
+			Key:         aws.String(w.key),
+			ContentType: aws.String(w.contentType),
+			Body:        bytes.NewReader(w.buf.Bytes()),
+		}); err != nil {
+			w.err = errors.InternalErr.Wrap(err)
+			return w.err
+		}
+		return nil
+	}
+	if w.buf.Len() > 0 {
+		if err := w.uploadPart(w.buf.Bytes()); err != nil {
+			w.abort(err)
+			return w.err
+		}
+	}
+	if _, err := w.cli.CompleteMultipartUpload(w.ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   aws.String(w.bucket),
+		Key:      aws.String(w.key),
+		UploadId: w.uploadID,
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: w.parts,
+		},
+	}); err != nil {
+		w.abort(err)
+		return w.err
+	}
+	return nil
+}
+
+func (w *objectWriter) uploadPart(part []byte) error {
+	if w.uploadID == nil {
+		out, err := w.cli.CreateMultipartUpload(w.ctx, &s3.CreateMultipartUploadInput{
+			Bucket:      aws.String(w.bucket),
+			Key:         aws.String(w.key),
+			ContentType: aws.String(w.contentType),
+		})
+		if err != nil {
+			return err
+		}
+		w.uploadID = out.UploadId
+	}
+	partNumber := aws.Int32(int32(len(w.parts) + 1)) //nolint:gosec // an object has at most 10,000 parts
+	out, err := w.cli.UploadPart(w.ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(w.bucket),
+		Key:        aws.String(w.key),
+		UploadId:   w.uploadID,
+		PartNumber: partNumber,
+		Body:       bytes.NewReader(part),
+	})
+	if err != nil {
+		return err
+	}
+	w.parts = append(w.parts, types.CompletedPart{
+		ETag:       out.ETag,
+		PartNumber: partNumber,
+	})
+	return nil
+}
+
+// abort records err and aborts the multipart upload, so that its parts are not billed.
+func (w *objectWriter) abort(err error) {
+	w.err = errors.InternalErr.Wrap(err)
+	if w.uploadID == nil {
+		return
+	}
+	// The upload is aborted even when ctx has been cancelled
+	_, _ = w.cli.AbortMultipartUpload(context.WithoutCancel(w.ctx), &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(w.bucket),
+		Key:      aws.String(w.key),
+		UploadId: w.uploadID,
+	})
+}
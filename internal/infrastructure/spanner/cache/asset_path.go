@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/abyssparanoia/memeduck"
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+	"google.golang.org/grpc/codes"
+)
+
+type assetPath struct{}
+
+// NewAssetPath returns an AssetPath reading the Assets table, which already holds the status of the assets,
+// so there is nothing to set or clear.
+func NewAssetPath() cache.AssetPath {
+	return &assetPath{}
+}
+
+func (c *assetPath) Get(
+	ctx context.Context,
+	id string,
+	authContext model.AssetAuthContext,
+) (string, error) {
+	sql, err := memeduck.Select(
+		dbmodel.AssetTableName(),
+		dbmodel.AssetColumns(),
+	).
+		Where(
+			memeduck.Eq(memeduck.Ident("AssetID"), memeduck.Param("AssetID")),
+			memeduck.Eq(memeduck.Ident("AuthContext"), memeduck.Param("AuthContext")),
+			memeduck.Eq(memeduck.Ident("Status"), memeduck.Param("Status")),
+		).
+		Limit(1).
+		SQL()
+	if err != nil {
+		return "", errors.InternalErr.Wrap(err)
+	}
+	params := map[string]interface{}{
+		"AssetID":     id,
+		"AuthContext": authContext.String(),
+		"Status":      model.AssetStatusUploaded.String(),
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return "", errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+		return "", errors.InternalErr.Wrap(err)
+	} else if !ok {
+		return "", errors.AssetNotFoundErr.New().
+			WithDetail("asset path is not found").
+			WithValue("asset_id", id)
+	}
+
+	var dst dbmodel.Asset
+	if err := rows.ToStruct(&dst); err != nil {
+		return "", errors.InternalErr.Wrap(err)
+	}
+	return dst.Path, nil
+}
+
+func (c *assetPath) Set(
+	ctx context.Context,
+	asset *model.Asset,
+) error {
+	return nil
+}
+
+func (c *assetPath) Clear(
+	ctx context.Context,
+	id string,
+) error {
+	return nil
+}
@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/abyssparanoia/memeduck"
+	"github.com/abyssparanoia/rapid-go/internal/domain/cache"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+	"google.golang.org/grpc/codes"
+)
+
+type idempotencyKey struct{}
+
+// NewIdempotencyKey returns an IdempotencyKey storing the keys in the IdempotencyKeys table,
+// whose row deletion policy removes the expired keys.
+func NewIdempotencyKey() cache.IdempotencyKey {
+	return &idempotencyKey{}
+}
+
+func (c *idempotencyKey) Claim(
+	ctx context.Context,
+	key *model.IdempotencyKey,
+	t time.Time,
+) (*model.IdempotencyKey, bool, error) {
+	// The row deletion policy runs in the background, so the expired key may still be there
+	sql, err := memeduck.Delete(dbmodel.IdempotencyKeyTableName()).
+		Where(
+			memeduck.Eq(memeduck.Ident("IdempotencyKeyID"), memeduck.Param("IdempotencyKeyID")),
+			memeduck.Le(memeduck.Ident("ExpiresAt"), memeduck.Param("RequestTime")),
+		).
+		SQL()
+	if err != nil {
+		return nil, false, errors.InternalErr.Wrap(err)
+	}
+	params := map[string]interface{}{
+		"IdempotencyKeyID": key.ID,
+		"RequestTime":      t,
+	}
+	if err := dbmodel.GetSpannerTransaction(ctx).ExecContext(ctx, sql, params); err != nil {
+		return nil, false, errors.InternalErr.Wrap(err)
+	}
+
+	if err := idempotencyKeyToDBModel(key).Insert(ctx); err != nil {
+		if spanner.ErrCode(err) != codes.AlreadyExists {
+			return nil, false, errors.InternalErr.Wrap(err)
+		}
+		stored, found, err := c.get(ctx, key.ID)
+		if err != nil {
+			return nil, false, err
+		}
+		if !found {
+			// released in the meantime
+			return c.Claim(ctx, key, t)
+		}
+		return stored, false, nil
+	}
+	return key, true, nil
+}
+
+func (c *idempotencyKey) Complete(
+	ctx context.Context,
+	key *model.IdempotencyKey,
+) error {
+	if err := idempotencyKeyToDBModel(key).Update(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (c *idempotencyKey) Release(
+	ctx context.Context,
+	id string,
+) error {
+	if err := (&dbmodel.IdempotencyKey{IdempotencyKeyID: id}).Delete(ctx); err != nil { //nolint:exhaustruct
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+// DeleteExpired deletes nothing, since the row deletion policy removes the expired keys.
+func (c *idempotencyKey) DeleteExpired(
+	ctx context.Context,
+	t time.Time,
+	limit uint64,
+) (int, error) {
+	return 0, nil
+}
+
+func (c *idempotencyKey) get(
+	ctx context.Context,
+	id string,
+) (*model.IdempotencyKey, bool, error) {
+	sql, err := memeduck.Select(
+		dbmodel.IdempotencyKeyTableName(),
+		dbmodel.IdempotencyKeyColumns(),
+	).
+		Where(
+			memeduck.Eq(memeduck.Ident("IdempotencyKeyID"), memeduck.Param("IdempotencyKeyID")),
+		).
+		Limit(1).
+		SQL()
+	if err != nil {
+		return nil, false, errors.InternalErr.Wrap(err)
+	}
+	params := map[string]interface{}{
+		"IdempotencyKeyID": id,
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, false, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+		return nil, false, errors.InternalErr.Wrap(err)
+	} else if !ok {
+		return nil, false, nil
+	}
+
+	var dst dbmodel.IdempotencyKey
+	if err := rows.ToStruct(&dst); err != nil {
+		return nil, false, errors.InternalErr.Wrap(err)
+	}
+	return &model.IdempotencyKey{
+		ID:          dst.IdempotencyKeyID,
+		Fingerprint: dst.Fingerprint,
+		Response:    dst.Response,
+		ExpiresAt:   dst.ExpiresAt,
+		CreatedAt:   dst.CreatedAt,
+		UpdatedAt:   dst.UpdatedAt,
+	}, true, nil
+}
+
+func idempotencyKeyToDBModel(m *model.IdempotencyKey) *dbmodel.IdempotencyKey {
+	return &dbmodel.IdempotencyKey{
+		IdempotencyKeyID: m.ID,
+		Fingerprint:      m.Fingerprint,
+		Response:         m.Response,
+		ExpiresAt:        m.ExpiresAt,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+	}
+}
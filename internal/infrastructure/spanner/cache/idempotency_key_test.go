@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"cloud.google.com/go/spanner/spannertest"
+	"cloud.google.com/go/spanner/spansql"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// the in-memory server does not support the row deletion policy, so the table is created without it
+const idempotencyKeysDDL = `CREATE TABLE IdempotencyKeys (
+  IdempotencyKeyID STRING(64) NOT NULL,
+  Fingerprint STRING(64) NOT NULL,
+  Response BYTES(MAX),
+  ExpiresAt TIMESTAMP NOT NULL,
+  CreatedAt TIMESTAMP NOT NULL,
+  UpdatedAt TIMESTAMP NOT NULL,
+) PRIMARY KEY(IdempotencyKeyID)`
+
+func newTestDB(t *testing.T) {
+	t.Helper()
+	srv, err := spannertest.NewServer("localhost:0")
+	require.NoError(t, err)
+	t.Cleanup(srv.Close)
+
+	ddl, err := spansql.ParseDDL("ddl", idempotencyKeysDDL)
+	require.NoError(t, err)
+	require.NoError(t, srv.UpdateDDL(ddl))
+
+	cli, err := spanner.NewClient(
+		t.Context(),
+		"projects/test/instances/test/databases/test",
+		option.WithEndpoint(srv.Addr),
+		option.WithoutAuthentication(),
+		option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	)
+	require.NoError(t, err)
+	t.Cleanup(cli.Close)
+	dbmodel.NewTransactable(cli)
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	// the default client of dbmodel is global, so the cases do not run in parallel
+
+	requestTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]func(t *testing.T){
+		"the stored key is returned until it expires": func(t *testing.T) {
+			ctx := context.Background()
+			c := NewIdempotencyKey()
+
+			key := model.NewIdempotencyKey("id", "fingerprint", requestTime)
+			got, claimed, err := c.Claim(ctx, key, requestTime)
+			require.NoError(t, err)
+			require.True(t, claimed)
+			require.Equal(t, key, got)
+
+			key.Complete([]byte("response"), time.Hour, requestTime.Add(time.Second))
+			require.NoError(t, c.Complete(ctx, key))
+
+			retried := model.NewIdempotencyKey("id", "fingerprint", requestTime.Add(time.Minute))
+			got, claimed, err = c.Claim(ctx, retried, requestTime.Add(time.Minute))
+			require.NoError(t, err)
+			require.False(t, claimed)
+			require.Equal(t, key.Response, got.Response)
+			require.True(t, key.ExpiresAt.Equal(got.ExpiresAt))
+
+			expired := key.ExpiresAt
+			retried = model.NewIdempotencyKey("id", "fingerprint", expired)
+			got, claimed, err = c.Claim(ctx, retried, expired)
+			require.NoError(t, err)
+			require.True(t, claimed)
+			require.Equal(t, retried, got)
+		},
+		"the released key is claimed again": func(t *testing.T) {
+			ctx := context.Background()
+			c := NewIdempotencyKey()
+
+			key := model.NewIdempotencyKey("id", "fingerprint", requestTime)
+			_, claimed, err := c.Claim(ctx, key, requestTime)
+			require.NoError(t, err)
+			require.True(t, claimed)
+			require.NoError(t, c.Release(ctx, key.ID))
+
+			_, claimed, err = c.Claim(ctx, key, requestTime)
+			require.NoError(t, err)
+			require.True(t, claimed)
+		},
+		"the keys are left to the row deletion policy": func(t *testing.T) {
+			ctx := context.Background()
+			c := NewIdempotencyKey()
+
+			key := model.NewIdempotencyKey("id", "fingerprint", requestTime)
+			_, _, err := c.Claim(ctx, key, requestTime)
+			require.NoError(t, err)
+
+			deleted, err := c.DeleteExpired(ctx, key.ExpiresAt, 10)
+			require.NoError(t, err)
+			require.Zero(t, deleted)
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			newTestDB(t)
+			tc(t)
+		})
+	}
+}
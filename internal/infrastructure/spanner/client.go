@@ -7,16 +7,33 @@ import (
 	"cloud.google.com/go/spanner"
 )
 
+// Client ... client.
+type Client struct {
+	*spanner.Client
+}
+
 func NewClient(
 	projectID string,
 	instanceID string,
 	databaseID string,
-) *spanner.Client {
+) *Client {
 	ctx := context.Background()
 	dsn := fmt.Sprintf("projects/%s/instances/%s/databases/%s", projectID, instanceID, databaseID)
 	c, err := spanner.NewClient(ctx, dsn)
 	if err != nil {
 		panic(err)
 	}
-	return c
+	return &Client{
+		Client: c,
+	}
+}
+
+// Ping ... check the connection to the database.
+func (c *Client) Ping(ctx context.Context) error {
+	iter := c.Single().Query(ctx, spanner.NewStatement("SELECT 1"))
+	defer iter.Stop()
+	if _, err := iter.Next(); err != nil {
+		return err
+	}
+	return nil
 }
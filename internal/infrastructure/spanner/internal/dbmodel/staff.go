@@ -13,15 +13,17 @@ import (
 
 // Staff represents a row from 'Staffs'.
 type Staff struct {
-	StaffID     string    `spanner:"StaffID" json:"StaffID"`         // StaffID
-	TenantID    string    `spanner:"TenantID" json:"TenantID"`       // TenantID
-	Role        string    `spanner:"Role" json:"Role"`               // Role
-	AuthUID     string    `spanner:"AuthUID" json:"AuthUID"`         // AuthUID
-	DisplayName string    `spanner:"DisplayName" json:"DisplayName"` // DisplayName
-	ImagePath   string    `spanner:"ImagePath" json:"ImagePath"`     // ImagePath
-	Email       string    `spanner:"Email" json:"Email"`             // Email
-	CreatedAt   time.Time `spanner:"CreatedAt" json:"CreatedAt"`     // CreatedAt
-	UpdatedAt   time.Time `spanner:"UpdatedAt" json:"UpdatedAt"`     // UpdatedAt
+	StaffID     string           `spanner:"StaffID" json:"StaffID"`         // StaffID
+	TenantID    string           `spanner:"TenantID" json:"TenantID"`       // TenantID
+	Role        string           `spanner:"Role" json:"Role"`               // Role
+	AuthUID     string           `spanner:"AuthUID" json:"AuthUID"`         // AuthUID
+	DisplayName string           `spanner:"DisplayName" json:"DisplayName"` // DisplayName
+	ImagePath   string           `spanner:"ImagePath" json:"ImagePath"`     // ImagePath
+	Email       string           `spanner:"Email" json:"Email"`             // Email
+	CreatedAt   time.Time        `spanner:"CreatedAt" json:"CreatedAt"`     // CreatedAt
+	UpdatedAt   time.Time        `spanner:"UpdatedAt" json:"UpdatedAt"`     // UpdatedAt
+	DeletedAt   spanner.NullTime `spanner:"DeletedAt" json:"DeletedAt"`     // DeletedAt
+	Version     int64            `spanner:"Version" json:"Version"`         // Version
 }
 
 type StaffSlice []*Staff
@@ -47,6 +49,8 @@ func StaffColumns() []string {
 		"Email",
 		"CreatedAt",
 		"UpdatedAt",
+		"DeletedAt",
+		"Version",
 	}
 }
 
@@ -61,6 +65,8 @@ func StaffWritableColumns() []string {
 		"Email",
 		"CreatedAt",
 		"UpdatedAt",
+		"DeletedAt",
+		"Version",
 	}
 }
 
@@ -91,6 +97,10 @@ func (s *Staff) columnsToPtrs(cols []string, customPtrs map[string]interface{})
 			ret = append(ret, &s.CreatedAt)
 		case "UpdatedAt":
 			ret = append(ret, &s.UpdatedAt)
+		case "DeletedAt":
+			ret = append(ret, &s.DeletedAt)
+		case "Version":
+			ret = append(ret, &s.Version)
 		default:
 			return nil, fmt.Errorf("unknown column: %s", col)
 		}
@@ -120,6 +130,10 @@ func (s *Staff) columnsToValues(cols []string) ([]interface{}, error) {
 			ret = append(ret, s.CreatedAt)
 		case "UpdatedAt":
 			ret = append(ret, s.UpdatedAt)
+		case "DeletedAt":
+			ret = append(ret, s.DeletedAt)
+		case "Version":
+			ret = append(ret, s.Version)
 		default:
 			return nil, fmt.Errorf("unknown column: %s", col)
 		}
@@ -159,6 +173,8 @@ func (s *Staff) Insert(ctx context.Context) error {
 	params[fmt.Sprintf("Email")] = s.Email
 	params[fmt.Sprintf("CreatedAt")] = s.CreatedAt
 	params[fmt.Sprintf("UpdatedAt")] = s.UpdatedAt
+	params[fmt.Sprintf("DeletedAt")] = s.DeletedAt
+	params[fmt.Sprintf("Version")] = s.Version
 
 	values := []string{
 		fmt.Sprintf("@StaffID"),
@@ -170,12 +186,14 @@ func (s *Staff) Insert(ctx context.Context) error {
 		fmt.Sprintf("@Email"),
 		fmt.Sprintf("@CreatedAt"),
 		fmt.Sprintf("@UpdatedAt"),
+		fmt.Sprintf("@DeletedAt"),
+		fmt.Sprintf("@Version"),
 	}
 	rowValue := fmt.Sprintf("(%s)", strings.Join(values, ","))
 
 	sql := fmt.Sprintf(`
     INSERT INTO Staffs
-        (StaffID, TenantID, Role, AuthUID, DisplayName, ImagePath, Email, CreatedAt, UpdatedAt)
+        (StaffID, TenantID, Role, AuthUID, DisplayName, ImagePath, Email, CreatedAt, UpdatedAt, DeletedAt, Version)
     VALUES
         %s
     `, rowValue)
@@ -205,6 +223,8 @@ func (sSlice StaffSlice) InsertAll(ctx context.Context) error {
 		params[fmt.Sprintf("Email%d", i)] = m.Email
 		params[fmt.Sprintf("CreatedAt%d", i)] = m.CreatedAt
 		params[fmt.Sprintf("UpdatedAt%d", i)] = m.UpdatedAt
+		params[fmt.Sprintf("DeletedAt%d", i)] = m.DeletedAt
+		params[fmt.Sprintf("Version%d", i)] = m.Version
 
 		values := []string{
 			fmt.Sprintf("@StaffID%d", i),
@@ -216,6 +236,8 @@ func (sSlice StaffSlice) InsertAll(ctx context.Context) error {
 			fmt.Sprintf("@Email%d", i),
 			fmt.Sprintf("@CreatedAt%d", i),
 			fmt.Sprintf("@UpdatedAt%d", i),
+			fmt.Sprintf("@DeletedAt%d", i),
+			fmt.Sprintf("@Version%d", i),
 		}
 		rowValue := fmt.Sprintf("(%s)", strings.Join(values, ","))
 		valueStmts = append(valueStmts, rowValue)
@@ -223,7 +245,7 @@ func (sSlice StaffSlice) InsertAll(ctx context.Context) error {
 
 	sql := fmt.Sprintf(`
     INSERT INTO Staffs
-        (StaffID, TenantID, Role, AuthUID, DisplayName, ImagePath, Email, CreatedAt, UpdatedAt)
+        (StaffID, TenantID, Role, AuthUID, DisplayName, ImagePath, Email, CreatedAt, UpdatedAt, DeletedAt, Version)
     VALUES
         %s
     `, strings.Join(valueStmts, ","))
@@ -248,6 +270,8 @@ func (s *Staff) Update(ctx context.Context) error {
 	updateColumns = append(updateColumns, "Email = @param_Email")
 	updateColumns = append(updateColumns, "CreatedAt = @param_CreatedAt")
 	updateColumns = append(updateColumns, "UpdatedAt = @param_UpdatedAt")
+	updateColumns = append(updateColumns, "DeletedAt = @param_DeletedAt")
+	updateColumns = append(updateColumns, "Version = @param_Version")
 
 	sql := fmt.Sprintf(`
 	UPDATE Staffs
@@ -267,6 +291,8 @@ func (s *Staff) Update(ctx context.Context) error {
 		"param_Email":       s.Email,
 		"param_CreatedAt":   s.CreatedAt,
 		"param_UpdatedAt":   s.UpdatedAt,
+		"param_DeletedAt":   s.DeletedAt,
+		"param_Version":     s.Version,
 	}
 
 	whereParams := map[string]interface{}{
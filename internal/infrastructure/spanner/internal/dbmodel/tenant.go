@@ -13,10 +13,12 @@ import (
 
 // Tenant represents a row from 'Tenants'.
 type Tenant struct {
-	TenantID  string    `spanner:"TenantID" json:"TenantID"`   // TenantID
-	Name      string    `spanner:"Name" json:"Name"`           // Name
-	CreatedAt time.Time `spanner:"CreatedAt" json:"CreatedAt"` // CreatedAt
-	UpdatedAt time.Time `spanner:"UpdatedAt" json:"UpdatedAt"` // UpdatedAt
+	TenantID  string           `spanner:"TenantID" json:"TenantID"`   // TenantID
+	Name      string           `spanner:"Name" json:"Name"`           // Name
+	CreatedAt time.Time        `spanner:"CreatedAt" json:"CreatedAt"` // CreatedAt
+	UpdatedAt time.Time        `spanner:"UpdatedAt" json:"UpdatedAt"` // UpdatedAt
+	DeletedAt spanner.NullTime `spanner:"DeletedAt" json:"DeletedAt"` // DeletedAt
+	Version   int64            `spanner:"Version" json:"Version"`     // Version
 }
 
 type TenantSlice []*Tenant
@@ -37,6 +39,8 @@ func TenantColumns() []string {
 		"Name",
 		"CreatedAt",
 		"UpdatedAt",
+		"DeletedAt",
+		"Version",
 	}
 }
 
@@ -46,6 +50,8 @@ func TenantWritableColumns() []string {
 		"Name",
 		"CreatedAt",
 		"UpdatedAt",
+		"DeletedAt",
+		"Version",
 	}
 }
 
@@ -66,6 +72,10 @@ func (t *Tenant) columnsToPtrs(cols []string, customPtrs map[string]interface{})
 			ret = append(ret, &t.CreatedAt)
 		case "UpdatedAt":
 			ret = append(ret, &t.UpdatedAt)
+		case "DeletedAt":
+			ret = append(ret, &t.DeletedAt)
+		case "Version":
+			ret = append(ret, &t.Version)
 		default:
 			return nil, fmt.Errorf("unknown column: %s", col)
 		}
@@ -85,6 +95,10 @@ func (t *Tenant) columnsToValues(cols []string) ([]interface{}, error) {
 			ret = append(ret, t.CreatedAt)
 		case "UpdatedAt":
 			ret = append(ret, t.UpdatedAt)
+		case "DeletedAt":
+			ret = append(ret, t.DeletedAt)
+		case "Version":
+			ret = append(ret, t.Version)
 		default:
 			return nil, fmt.Errorf("unknown column: %s", col)
 		}
@@ -119,18 +133,22 @@ func (t *Tenant) Insert(ctx context.Context) error {
 	params[fmt.Sprintf("Name")] = t.Name
 	params[fmt.Sprintf("CreatedAt")] = t.CreatedAt
 	params[fmt.Sprintf("UpdatedAt")] = t.UpdatedAt
+	params[fmt.Sprintf("DeletedAt")] = t.DeletedAt
+	params[fmt.Sprintf("Version")] = t.Version
 
 	values := []string{
 		fmt.Sprintf("@TenantID"),
 		fmt.Sprintf("@Name"),
 		fmt.Sprintf("@CreatedAt"),
 		fmt.Sprintf("@UpdatedAt"),
+		fmt.Sprintf("@DeletedAt"),
+		fmt.Sprintf("@Version"),
 	}
 	rowValue := fmt.Sprintf("(%s)", strings.Join(values, ","))
 
 	sql := fmt.Sprintf(`
     INSERT INTO Tenants
-        (TenantID, Name, CreatedAt, UpdatedAt)
+        (TenantID, Name, CreatedAt, UpdatedAt, DeletedAt, Version)
     VALUES
         %s
     `, rowValue)
@@ -155,12 +173,16 @@ func (tSlice TenantSlice) InsertAll(ctx context.Context) error {
 		params[fmt.Sprintf("Name%d", i)] = m.Name
 		params[fmt.Sprintf("CreatedAt%d", i)] = m.CreatedAt
 		params[fmt.Sprintf("UpdatedAt%d", i)] = m.UpdatedAt
+		params[fmt.Sprintf("DeletedAt%d", i)] = m.DeletedAt
+		params[fmt.Sprintf("Version%d", i)] = m.Version
 
 		values := []string{
 			fmt.Sprintf("@TenantID%d", i),
 			fmt.Sprintf("@Name%d", i),
 			fmt.Sprintf("@CreatedAt%d", i),
 			fmt.Sprintf("@UpdatedAt%d", i),
+			fmt.Sprintf("@DeletedAt%d", i),
+			fmt.Sprintf("@Version%d", i),
 		}
 		rowValue := fmt.Sprintf("(%s)", strings.Join(values, ","))
 		valueStmts = append(valueStmts, rowValue)
@@ -168,7 +190,7 @@ func (tSlice TenantSlice) InsertAll(ctx context.Context) error {
 
 	sql := fmt.Sprintf(`
     INSERT INTO Tenants
-        (TenantID, Name, CreatedAt, UpdatedAt)
+        (TenantID, Name, CreatedAt, UpdatedAt, DeletedAt, Version)
     VALUES
         %s
     `, strings.Join(valueStmts, ","))
@@ -188,6 +210,8 @@ func (t *Tenant) Update(ctx context.Context) error {
 	updateColumns = append(updateColumns, "Name = @param_Name")
 	updateColumns = append(updateColumns, "CreatedAt = @param_CreatedAt")
 	updateColumns = append(updateColumns, "UpdatedAt = @param_UpdatedAt")
+	updateColumns = append(updateColumns, "DeletedAt = @param_DeletedAt")
+	updateColumns = append(updateColumns, "Version = @param_Version")
 
 	sql := fmt.Sprintf(`
 	UPDATE Tenants
@@ -202,6 +226,8 @@ func (t *Tenant) Update(ctx context.Context) error {
 		"param_Name":      t.Name,
 		"param_CreatedAt": t.CreatedAt,
 		"param_UpdatedAt": t.UpdatedAt,
+		"param_DeletedAt": t.DeletedAt,
+		"param_Version":   t.Version,
 	}
 
 	whereParams := map[string]interface{}{
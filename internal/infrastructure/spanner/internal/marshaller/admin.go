@@ -0,0 +1,38 @@
+package marshaller
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+)
+
+func AdminToModel(e *dbmodel.Admin) *model.Admin {
+	return &model.Admin{
+		ID:          e.AdminID,
+		Role:        model.NewAdminRole(e.Role),
+		AuthUID:     e.AuthUID,
+		Email:       e.Email,
+		DisplayName: e.DisplayName,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+func AdminsToModel(slice dbmodel.AdminSlice) model.Admins {
+	dsts := make(model.Admins, len(slice))
+	for idx, e := range slice {
+		dsts[idx] = AdminToModel(e)
+	}
+	return dsts
+}
+
+func AdminToDBModel(m *model.Admin) *dbmodel.Admin {
+	return &dbmodel.Admin{
+		AdminID:     m.ID,
+		Role:        m.Role.String(),
+		AuthUID:     m.AuthUID,
+		Email:       m.Email,
+		DisplayName: m.DisplayName,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
@@ -0,0 +1,48 @@
+package marshaller
+
+import (
+	"cloud.google.com/go/spanner"
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+)
+
+func AssetToModel(e *dbmodel.Asset) *model.Asset {
+	return &model.Asset{
+		ID:          e.AssetID,
+		ContentType: model.NewContentType(e.ContentType),
+		Type:        model.NewAssetType(e.Type),
+		Path:        e.Path,
+		AuthContext: model.NewAssetAuthContext(e.AuthContext),
+		Status:      model.NewAssetStatus(e.Status),
+		Size:        null.NewInt64(e.Size.Int64, e.Size.Valid),
+		ExpiresAt:   e.ExpiresAt,
+		UploadedAt:  null.NewTime(e.UploadedAt.Time, e.UploadedAt.Valid),
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+func AssetsToModel(slice dbmodel.AssetSlice) model.Assets {
+	dsts := make(model.Assets, len(slice))
+	for idx, e := range slice {
+		dsts[idx] = AssetToModel(e)
+	}
+	return dsts
+}
+
+func AssetToDBModel(m *model.Asset) *dbmodel.Asset {
+	return &dbmodel.Asset{
+		AssetID:     m.ID,
+		AuthContext: m.AuthContext.String(),
+		ContentType: m.ContentType.String(),
+		Type:        m.Type.String(),
+		Path:        m.Path,
+		ExpiresAt:   m.ExpiresAt,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+		Status:      m.Status.String(),
+		Size:        spanner.NullInt64{Int64: m.Size.Int64, Valid: m.Size.Valid},
+		UploadedAt:  spanner.NullTime{Time: m.UploadedAt.Time, Valid: m.UploadedAt.Valid},
+	}
+}
@@ -0,0 +1,50 @@
+package marshaller
+
+import (
+	"cloud.google.com/go/spanner"
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+)
+
+func InvitationToModel(e *dbmodel.Invitation) *model.Invitation {
+	return &model.Invitation{
+		ID:              e.InvitationID,
+		TenantID:        e.TenantID,
+		Email:           e.Email,
+		Role:            model.NewStaffRole(e.Role),
+		TokenHash:       e.TokenHash,
+		InvitedBy:       e.InvitedBy,
+		ExpiresAt:       e.ExpiresAt,
+		AcceptedAt:      null.NewTime(e.AcceptedAt.Time, e.AcceptedAt.Valid),
+		AcceptedStaffID: null.NewString(e.AcceptedStaffID.StringVal, e.AcceptedStaffID.Valid),
+		RevokedAt:       null.NewTime(e.RevokedAt.Time, e.RevokedAt.Valid),
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+}
+
+func InvitationsToModel(slice dbmodel.InvitationSlice) model.Invitations {
+	dsts := make(model.Invitations, len(slice))
+	for idx, e := range slice {
+		dsts[idx] = InvitationToModel(e)
+	}
+	return dsts
+}
+
+func InvitationToDBModel(m *model.Invitation) *dbmodel.Invitation {
+	return &dbmodel.Invitation{
+		InvitationID:    m.ID,
+		TenantID:        m.TenantID,
+		Email:           m.Email,
+		Role:            m.Role.String(),
+		TokenHash:       m.TokenHash,
+		InvitedBy:       m.InvitedBy,
+		ExpiresAt:       m.ExpiresAt,
+		AcceptedAt:      spanner.NullTime{Time: m.AcceptedAt.Time, Valid: m.AcceptedAt.Valid},
+		AcceptedStaffID: spanner.NullString{StringVal: m.AcceptedStaffID.String, Valid: m.AcceptedStaffID.Valid},
+		RevokedAt:       spanner.NullTime{Time: m.RevokedAt.Time, Valid: m.RevokedAt.Valid},
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
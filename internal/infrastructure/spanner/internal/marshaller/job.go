@@ -0,0 +1,55 @@
+package marshaller
+
+import (
+	"encoding/json"
+
+	"cloud.google.com/go/spanner"
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+)
+
+func JobToModel(e *dbmodel.Job) *model.Job {
+	payload := model.JobPayload{}
+	_ = json.Unmarshal([]byte(e.Payload), &payload)
+	return &model.Job{
+		ID:          e.JobID,
+		Type:        model.NewJobType(e.Type),
+		Payload:     payload,
+		Status:      model.NewJobStatus(e.Status),
+		Attempts:    uint64(e.Attempts),    //nolint:gosec
+		MaxAttempts: uint64(e.MaxAttempts), //nolint:gosec
+		RunAt:       e.RunAt,
+		LockedUntil: null.NewTime(e.LockedUntil.Time, e.LockedUntil.Valid),
+		LastError:   null.NewString(e.LastError.StringVal, e.LastError.Valid),
+		FinishedAt:  null.NewTime(e.FinishedAt.Time, e.FinishedAt.Valid),
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+func JobsToModel(slice dbmodel.JobSlice) model.Jobs {
+	dsts := make(model.Jobs, len(slice))
+	for idx, e := range slice {
+		dsts[idx] = JobToModel(e)
+	}
+	return dsts
+}
+
+func JobToDBModel(m *model.Job) *dbmodel.Job {
+	payload, _ := json.Marshal(m.Payload) //nolint:errchkjson
+	return &dbmodel.Job{
+		JobID:       m.ID,
+		Type:        m.Type.String(),
+		Payload:     string(payload),
+		Status:      m.Status.String(),
+		Attempts:    int64(m.Attempts),    //nolint:gosec
+		MaxAttempts: int64(m.MaxAttempts), //nolint:gosec
+		RunAt:       m.RunAt,
+		LockedUntil: spanner.NullTime{Time: m.LockedUntil.Time, Valid: m.LockedUntil.Valid},
+		LastError:   spanner.NullString{StringVal: m.LastError.String, Valid: m.LastError.Valid},
+		FinishedAt:  spanner.NullTime{Time: m.FinishedAt.Time, Valid: m.FinishedAt.Valid},
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
@@ -0,0 +1,68 @@
+package marshaller
+
+import (
+	"encoding/json"
+
+	"cloud.google.com/go/spanner"
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+)
+
+func OutboxEventToModel(e *dbmodel.OutboxEvent) *model.OutboxEvent {
+	payload := model.DomainEventPayload{}
+	_ = json.Unmarshal([]byte(e.Payload), &payload)
+	return &model.OutboxEvent{
+		ID: e.OutboxEventID,
+		Event: &model.DomainEvent{
+			ID:          e.OutboxEventID,
+			Type:        model.NewDomainEventType(e.EventType),
+			TenantID:    null.NewString(e.TenantID.StringVal, e.TenantID.Valid),
+			AggregateID: e.AggregateID,
+			Payload:     payload,
+			OccurredAt:  e.OccurredAt,
+		},
+		Status:        model.NewOutboxEventStatus(e.Status),
+		Attempts:      uint64(e.Attempts), //nolint:gosec
+		NextAttemptAt: e.NextAttemptAt,
+		LastError:     null.NewString(e.LastError.StringVal, e.LastError.Valid),
+		DeliveredAt:   null.NewTime(e.DeliveredAt.Time, e.DeliveredAt.Valid),
+		CreatedAt:     e.CreatedAt,
+		UpdatedAt:     e.UpdatedAt,
+	}
+}
+
+func OutboxEventsToModel(slice dbmodel.OutboxEventSlice) model.OutboxEvents {
+	dsts := make(model.OutboxEvents, len(slice))
+	for idx, e := range slice {
+		dsts[idx] = OutboxEventToModel(e)
+	}
+	return dsts
+}
+
+func OutboxEventToDBModel(m *model.OutboxEvent) *dbmodel.OutboxEvent {
+	payload, _ := json.Marshal(m.Event.Payload) //nolint:errchkjson
+	return &dbmodel.OutboxEvent{
+		OutboxEventID: m.ID,
+		EventType:     m.Event.Type.String(),
+		TenantID:      spanner.NullString{StringVal: m.Event.TenantID.String, Valid: m.Event.TenantID.Valid},
+		AggregateID:   m.Event.AggregateID,
+		Payload:       string(payload),
+		OccurredAt:    m.Event.OccurredAt,
+		Status:        m.Status.String(),
+		Attempts:      int64(m.Attempts), //nolint:gosec
+		NextAttemptAt: m.NextAttemptAt,
+		LastError:     spanner.NullString{StringVal: m.LastError.String, Valid: m.LastError.Valid},
+		DeliveredAt:   spanner.NullTime{Time: m.DeliveredAt.Time, Valid: m.DeliveredAt.Valid},
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+func OutboxEventsToDBModel(slice model.OutboxEvents) dbmodel.OutboxEventSlice {
+	dsts := make(dbmodel.OutboxEventSlice, len(slice))
+	for idx, m := range slice {
+		dsts[idx] = OutboxEventToDBModel(m)
+	}
+	return dsts
+}
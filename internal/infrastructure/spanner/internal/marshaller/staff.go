@@ -1,6 +1,7 @@
 package marshaller
 
 import (
+	"cloud.google.com/go/spanner"
 	"github.com/aarondl/null/v8"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
@@ -17,8 +18,11 @@ func StaffToModel(e *dbmodel.Staff) *model.Staff {
 		Email:       e.Email,
 		CreatedAt:   e.CreatedAt,
 		UpdatedAt:   e.UpdatedAt,
+		DeletedAt:   null.NewTime(e.DeletedAt.Time, e.DeletedAt.Valid),
+		Version:     e.Version,
 
 		ImageURL:          null.String{},
+		ImageVariantURLs:  nil,
 		ReadonlyReference: nil,
 	}
 
@@ -44,5 +48,15 @@ func StaffToDBModel(m *model.Staff) *dbmodel.Staff {
 		Email:       m.Email,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
+		DeletedAt:   spanner.NullTime{Time: m.DeletedAt.Time, Valid: m.DeletedAt.Valid},
+		Version:     m.Version,
 	}
 }
+
+func StaffsToDBModel(m model.Staffs) dbmodel.StaffSlice {
+	dsts := make(dbmodel.StaffSlice, len(m))
+	for idx, e := range m {
+		dsts[idx] = StaffToDBModel(e)
+	}
+	return dsts
+}
@@ -1,25 +1,29 @@
 package marshaller
 
 import (
+	"cloud.google.com/go/spanner"
+	"github.com/aarondl/null/v8"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
 )
 
-func TenantToModel(e *dbmodel.Tenant) *model.Tenant {
+func TenantToModel(e *dbmodel.Tenant, tags dbmodel.TenantTagSlice) *model.Tenant {
 	m := &model.Tenant{
 		ID:        e.TenantID,
 		Name:      e.Name,
-		Tags:      make(model.TenantTags, 0),
+		Tags:      TenantTagsToModel(tags),
 		CreatedAt: e.CreatedAt,
 		UpdatedAt: e.UpdatedAt,
+		DeletedAt: null.NewTime(e.DeletedAt.Time, e.DeletedAt.Valid),
+		Version:   e.Version,
 	}
 	return m
 }
 
-func TenantsToModel(slice dbmodel.TenantSlice) model.Tenants {
+func TenantsToModel(slice dbmodel.TenantSlice, tagsByTenantID map[string]dbmodel.TenantTagSlice) model.Tenants {
 	dsts := make(model.Tenants, len(slice))
 	for idx, e := range slice {
-		dsts[idx] = TenantToModel(e)
+		dsts[idx] = TenantToModel(e, tagsByTenantID[e.TenantID])
 	}
 	return dsts
 }
@@ -30,5 +34,42 @@ func TenantToDBModel(m *model.Tenant) *dbmodel.Tenant {
 		Name:      m.Name,
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
+		DeletedAt: spanner.NullTime{Time: m.DeletedAt.Time, Valid: m.DeletedAt.Valid},
+		Version:   m.Version,
 	}
 }
+
+func TenantTagToModel(e *dbmodel.TenantTag) *model.TenantTag {
+	return &model.TenantTag{
+		ID:        e.TenantTagID,
+		Type:      model.NewTenantTagType(e.Type),
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+}
+
+func TenantTagsToModel(slice dbmodel.TenantTagSlice) model.TenantTags {
+	dsts := make(model.TenantTags, len(slice))
+	for idx, e := range slice {
+		dsts[idx] = TenantTagToModel(e)
+	}
+	return dsts
+}
+
+func TenantTagToDBModel(m *model.TenantTag, tenantID string) *dbmodel.TenantTag {
+	return &dbmodel.TenantTag{
+		TenantTagID: m.ID,
+		TenantID:    tenantID,
+		Type:        m.Type.String(),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func TenantTagsToDBModel(m model.TenantTags, tenantID string) dbmodel.TenantTagSlice {
+	dsts := make(dbmodel.TenantTagSlice, len(m))
+	for idx, e := range m {
+		dsts[idx] = TenantTagToDBModel(e, tenantID)
+	}
+	return dsts
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/abyssparanoia/memeduck"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/marshaller"
+	"google.golang.org/grpc/codes"
+)
+
+type admin struct{}
+
+func NewAdmin() repository.Admin {
+	return &admin{}
+}
+
+func (r *admin) Get(
+	ctx context.Context,
+	query repository.GetAdminQuery,
+) (*model.Admin, error) {
+	conds := []memeduck.WhereCond{}
+	params := map[string]interface{}{}
+	if query.ID.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("AdminID"), memeduck.Param("AdminID")))
+		params["AdminID"] = query.ID.String
+	}
+	if query.AuthUID.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("AuthUID"), memeduck.Param("AuthUID")))
+		params["AuthUID"] = query.AuthUID.String
+	}
+	if query.Email.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("Email"), memeduck.Param("Email")))
+		params["Email"] = query.Email.String
+	}
+	sql, err := memeduck.Select(
+		dbmodel.AdminTableName(),
+		dbmodel.AdminColumns(),
+	).
+		Where(conds...).
+		Limit(1).
+		SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+		return nil, errors.InternalErr.Wrap(err)
+	} else if !ok {
+		if !query.OrFail {
+			return nil, nil
+		} else {
+			return nil, errors.AdminNotFoundErr.New().
+				WithDetail("admin is not found").
+				WithValue("query", query)
+		}
+	}
+
+	var dst dbmodel.Admin
+	if err := rows.ToStruct(&dst); err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+
+	return marshaller.AdminToModel(&dst), nil
+}
+
+func (r *admin) buildListQuery(query repository.ListAdminsQuery) ([]memeduck.WhereCond, map[string]interface{}) {
+	conds := []memeduck.WhereCond{}
+	params := map[string]interface{}{}
+	if query.Role.Valid && query.Role.Value().Valid() {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("Role"), memeduck.Param("Role")))
+		params["Role"] = query.Role.Value().String()
+	}
+	return conds, params
+}
+
+func (r *admin) List(
+	ctx context.Context,
+	query repository.ListAdminsQuery,
+) (model.Admins, error) {
+	conds, params := r.buildListQuery(query)
+	stmt := memeduck.Select(
+		dbmodel.AdminTableName(),
+		dbmodel.AdminColumns(),
+	).
+		Where(conds...)
+
+	// Sorting (BEFORE pagination)
+	if query.SortKey.Valid && query.SortKey.Value().Valid() {
+		switch query.SortKey.Value() {
+		case model.AdminSortKeyCreatedAtDesc:
+			stmt = stmt.OrderBy("CreatedAt", memeduck.DESC)
+		case model.AdminSortKeyCreatedAtAsc:
+			stmt = stmt.OrderBy("CreatedAt", memeduck.ASC)
+		case model.AdminSortKeyDisplayNameAsc:
+			stmt = stmt.OrderBy("DisplayName", memeduck.ASC)
+		case model.AdminSortKeyDisplayNameDesc:
+			stmt = stmt.OrderBy("DisplayName", memeduck.DESC)
+		case model.AdminSortKeyUnknown:
+			return nil, errors.InternalErr.Errorf("invalid sort key: %s", query.SortKey.Value())
+		}
+	}
+
+	// Pagination (AFTER sorting)
+	if query.Page.Valid && query.Limit.Valid {
+		stmt = stmt.LimitOffset(int(query.Limit.Uint64), int(query.Limit.Uint64*(query.Page.Uint64-1)))
+	}
+	sql, err := stmt.SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	dsts := dbmodel.AdminSlice{}
+	for {
+		if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+			return nil, errors.InternalErr.Wrap(err)
+		} else if !ok {
+			break
+		}
+
+		var dst dbmodel.Admin
+		if err := rows.ToStruct(&dst); err != nil {
+			return nil, errors.InternalErr.Wrap(err)
+		}
+		dsts = append(dsts, &dst)
+	}
+
+	return marshaller.AdminsToModel(dsts), nil
+}
+
+func (r *admin) Count(
+	ctx context.Context,
+	query repository.ListAdminsQuery,
+) (uint64, error) {
+	conds, params := r.buildListQuery(query)
+	sql, err := memeduck.Select(
+		dbmodel.AdminTableName(),
+		[]string{"COUNT(*)"},
+	).
+		Where(conds...).
+		SQL()
+	if err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if ok, err := rows.Next(); err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	} else if !ok {
+		return 0, nil
+	}
+
+	var count uint64
+	if err := rows.Scan(&count); err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	return count, nil
+}
+
+func (r *admin) Create(
+	ctx context.Context,
+	admin *model.Admin,
+) error {
+	if err := marshaller.AdminToDBModel(admin).Insert(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *admin) Update(
+	ctx context.Context,
+	admin *model.Admin,
+) error {
+	if err := marshaller.AdminToDBModel(admin).Update(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *admin) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	dst := dbmodel.Admin{AdminID: id} //nolint:exhaustruct
+	if err := dst.Delete(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/abyssparanoia/memeduck"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/marshaller"
+	"google.golang.org/grpc/codes"
+)
+
+type assetMetadata struct{}
+
+func NewAssetMetadata() repository.AssetMetadata {
+	return &assetMetadata{}
+}
+
+func (r *assetMetadata) Get(
+	ctx context.Context,
+	query repository.GetAssetMetadataQuery,
+) (*model.Asset, error) {
+	conds := []memeduck.WhereCond{}
+	params := map[string]interface{}{}
+	if query.ID.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("AssetID"), memeduck.Param("AssetID")))
+		params["AssetID"] = query.ID.String
+	}
+	if query.AuthContext.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("AuthContext"), memeduck.Param("AuthContext")))
+		params["AuthContext"] = query.AuthContext.Value().String()
+	}
+	sql, err := memeduck.Select(
+		dbmodel.AssetTableName(),
+		dbmodel.AssetColumns(),
+	).
+		Where(conds...).
+		Limit(1).
+		SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+		return nil, errors.InternalErr.Wrap(err)
+	} else if !ok {
+		if !query.OrFail {
+			return nil, nil
+		} else {
+			return nil, errors.AssetNotFoundErr.New().
+				WithDetail("asset is not found").
+				WithValue("query", query)
+		}
+	}
+
+	var dst dbmodel.Asset
+	if err := rows.ToStruct(&dst); err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+
+	return marshaller.AssetToModel(&dst), nil
+}
+
+func (r *assetMetadata) List(
+	ctx context.Context,
+	query repository.ListAssetMetadataQuery,
+) (model.Assets, error) {
+	conds := []memeduck.WhereCond{}
+	params := map[string]interface{}{}
+	if query.Status.Valid && query.Status.Value().Valid() {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("Status"), memeduck.Param("Status")))
+		params["Status"] = query.Status.Value().String()
+	}
+	if query.ExpiresBefore.Valid {
+		conds = append(conds, memeduck.Le(memeduck.Ident("ExpiresAt"), memeduck.Param("ExpiresBefore")))
+		params["ExpiresBefore"] = query.ExpiresBefore.Time
+	}
+	if query.UploadedBefore.Valid {
+		conds = append(conds, memeduck.Le(memeduck.Ident("UploadedAt"), memeduck.Param("UploadedBefore")))
+		params["UploadedBefore"] = query.UploadedBefore.Time
+	}
+	stmt := memeduck.Select(
+		dbmodel.AssetTableName(),
+		dbmodel.AssetColumns(),
+	).
+		Where(conds...).
+		OrderBy("CreatedAt", memeduck.ASC).
+		OrderBy("AssetID", memeduck.ASC)
+
+	// Pagination
+	if query.Page.Valid && query.Limit.Valid {
+		stmt = stmt.LimitOffset(int(query.Limit.Uint64), int(query.Limit.Uint64*(query.Page.Uint64-1)))
+	} else if query.Keyset && query.Limit.Valid {
+		stmt = stmt.Limit(int(query.Limit.Uint64))
+	}
+	sql, err := stmt.SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	dsts := dbmodel.AssetSlice{}
+	for {
+		if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+			return nil, errors.InternalErr.Wrap(err)
+		} else if !ok {
+			break
+		}
+
+		var dst dbmodel.Asset
+		if err := rows.ToStruct(&dst); err != nil {
+			return nil, errors.InternalErr.Wrap(err)
+		}
+		dsts = append(dsts, &dst)
+	}
+
+	return marshaller.AssetsToModel(dsts), nil
+}
+
+func (r *assetMetadata) Create(
+	ctx context.Context,
+	asset *model.Asset,
+) error {
+	if err := marshaller.AssetToDBModel(asset).Insert(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *assetMetadata) Update(
+	ctx context.Context,
+	asset *model.Asset,
+) error {
+	if err := marshaller.AssetToDBModel(asset).Update(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *assetMetadata) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	dst := dbmodel.Asset{AssetID: id} //nolint:exhaustruct
+	if err := dst.Delete(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
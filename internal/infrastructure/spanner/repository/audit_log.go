@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/abyssparanoia/memeduck"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/marshaller"
+	"google.golang.org/grpc/codes"
+)
+
+type auditLog struct{}
+
+func NewAuditLog() repository.AuditLog {
+	return &auditLog{}
+}
+
+func (r *auditLog) buildListQuery(query repository.ListAuditLogsQuery) ([]memeduck.WhereCond, map[string]interface{}) {
+	conds := []memeduck.WhereCond{}
+	params := map[string]interface{}{}
+	if query.TenantID.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("TenantID"), memeduck.Param("TenantID")))
+		params["TenantID"] = query.TenantID.String
+	}
+	if query.ActorType.Valid && query.ActorType.Value().Valid() {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("ActorType"), memeduck.Param("ActorType")))
+		params["ActorType"] = query.ActorType.Value().String()
+	}
+	if query.ActorID.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("ActorID"), memeduck.Param("ActorID")))
+		params["ActorID"] = query.ActorID.String
+	}
+	if query.TargetType.Valid && query.TargetType.Value().Valid() {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("TargetType"), memeduck.Param("TargetType")))
+		params["TargetType"] = query.TargetType.Value().String()
+	}
+	if query.TargetID.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("TargetID"), memeduck.Param("TargetID")))
+		params["TargetID"] = query.TargetID.String
+	}
+	return conds, params
+}
+
+func (r *auditLog) List(
+	ctx context.Context,
+	query repository.ListAuditLogsQuery,
+) (model.AuditLogs, error) {
+	conds, params := r.buildListQuery(query)
+	stmt := memeduck.Select(
+		dbmodel.AuditLogTableName(),
+		dbmodel.AuditLogColumns(),
+	).
+		Where(conds...).
+		OrderBy("CreatedAt", memeduck.DESC).
+		OrderBy("AuditLogID", memeduck.DESC)
+
+	// Pagination
+	if query.Page.Valid && query.Limit.Valid {
+		stmt = stmt.LimitOffset(int(query.Limit.Uint64), int(query.Limit.Uint64*(query.Page.Uint64-1)))
+	} else if query.Keyset && query.Limit.Valid {
+		stmt = stmt.Limit(int(query.Limit.Uint64))
+	}
+	sql, err := stmt.SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	dsts := dbmodel.AuditLogSlice{}
+	for {
+		if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+			return nil, errors.InternalErr.Wrap(err)
+		} else if !ok {
+			break
+		}
+
+		var dst dbmodel.AuditLog
+		if err := rows.ToStruct(&dst); err != nil {
+			return nil, errors.InternalErr.Wrap(err)
+		}
+		dsts = append(dsts, &dst)
+	}
+
+	return marshaller.AuditLogsToModel(dsts), nil
+}
+
+func (r *auditLog) Count(
+	ctx context.Context,
+	query repository.ListAuditLogsQuery,
+) (uint64, error) {
+	conds, params := r.buildListQuery(query)
+	sql, err := memeduck.Select(
+		dbmodel.AuditLogTableName(),
+		[]string{"COUNT(*)"},
+	).
+		Where(conds...).
+		SQL()
+	if err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if ok, err := rows.Next(); err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	} else if !ok {
+		return 0, nil
+	}
+
+	var count uint64
+	if err := rows.Scan(&count); err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	return count, nil
+}
+
+func (r *auditLog) Create(
+	ctx context.Context,
+	auditLog *model.AuditLog,
+) error {
+	if err := marshaller.AuditLogToDBModel(auditLog).Insert(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/abyssparanoia/memeduck"
+	"github.com/cloudspannerecosystem/memefish/ast"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// lowerExpr is LOWER(column), which memeduck has no builder for.
+type lowerExpr struct {
+	column string
+}
+
+func (e *lowerExpr) ToASTExpr() (ast.Expr, error) {
+	column, err := memeduck.Ident(e.column).ToASTExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &ast.CallExpr{
+		Func: &ast.Ident{Name: "LOWER"},
+		Args: []ast.Arg{&ast.ExprArg{Expr: column}},
+	}, nil
+}
+
+// newContainsCond restricts the list to the rows whose column contains text, ignoring case.
+func newContainsCond(
+	column string,
+	text string,
+	params map[string]interface{},
+) memeduck.WhereCond {
+	param := column + "Contains"
+	params[param] = "%" + likeEscaper.Replace(strings.ToLower(text)) + "%"
+	return memeduck.Like(&lowerExpr{column: column}, memeduck.Param(param))
+}
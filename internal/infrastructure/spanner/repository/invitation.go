@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/abyssparanoia/memeduck"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/marshaller"
+	"google.golang.org/grpc/codes"
+)
+
+type invitation struct{}
+
+func NewInvitation() repository.Invitation {
+	return &invitation{}
+}
+
+func (r *invitation) Get(
+	ctx context.Context,
+	query repository.GetInvitationQuery,
+) (*model.Invitation, error) {
+	conds := []memeduck.WhereCond{}
+	params := map[string]interface{}{}
+	if query.ID.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("InvitationID"), memeduck.Param("InvitationID")))
+		params["InvitationID"] = query.ID.String
+	}
+	if query.TenantID.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("TenantID"), memeduck.Param("TenantID")))
+		params["TenantID"] = query.TenantID.String
+	}
+	if query.TokenHash.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("TokenHash"), memeduck.Param("TokenHash")))
+		params["TokenHash"] = query.TokenHash.String
+	}
+	sql, err := memeduck.Select(
+		dbmodel.InvitationTableName(),
+		dbmodel.InvitationColumns(),
+	).
+		Where(conds...).
+		Limit(1).
+		SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+		return nil, errors.InternalErr.Wrap(err)
+	} else if !ok {
+		if !query.OrFail {
+			return nil, nil
+		} else {
+			return nil, errors.InvitationNotFoundErr.New().
+				WithDetail("invitation is not found").
+				WithValue("query", query)
+		}
+	}
+
+	var dst dbmodel.Invitation
+	if err := rows.ToStruct(&dst); err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+
+	return marshaller.InvitationToModel(&dst), nil
+}
+
+func (r *invitation) buildListQuery(query repository.ListInvitationsQuery) ([]memeduck.WhereCond, map[string]interface{}) {
+	conds := []memeduck.WhereCond{}
+	params := map[string]interface{}{}
+	if query.TenantID.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("TenantID"), memeduck.Param("TenantID")))
+		params["TenantID"] = query.TenantID.String
+	}
+	return conds, params
+}
+
+func (r *invitation) List(
+	ctx context.Context,
+	query repository.ListInvitationsQuery,
+) (model.Invitations, error) {
+	conds, params := r.buildListQuery(query)
+	stmt := memeduck.Select(
+		dbmodel.InvitationTableName(),
+		dbmodel.InvitationColumns(),
+	).
+		Where(conds...).
+		OrderBy("CreatedAt", memeduck.DESC).
+		OrderBy("InvitationID", memeduck.DESC)
+
+	// Pagination
+	if query.Page.Valid && query.Limit.Valid {
+		stmt = stmt.LimitOffset(int(query.Limit.Uint64), int(query.Limit.Uint64*(query.Page.Uint64-1)))
+	} else if query.Keyset && query.Limit.Valid {
+		stmt = stmt.Limit(int(query.Limit.Uint64))
+	}
+	sql, err := stmt.SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	dsts := dbmodel.InvitationSlice{}
+	for {
+		if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+			return nil, errors.InternalErr.Wrap(err)
+		} else if !ok {
+			break
+		}
+
+		var dst dbmodel.Invitation
+		if err := rows.ToStruct(&dst); err != nil {
+			return nil, errors.InternalErr.Wrap(err)
+		}
+		dsts = append(dsts, &dst)
+	}
+
+	return marshaller.InvitationsToModel(dsts), nil
+}
+
+func (r *invitation) Count(
+	ctx context.Context,
+	query repository.ListInvitationsQuery,
+) (uint64, error) {
+	conds, params := r.buildListQuery(query)
+	sql, err := memeduck.Select(
+		dbmodel.InvitationTableName(),
+		[]string{"COUNT(*)"},
+	).
+		Where(conds...).
+		SQL()
+	if err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if ok, err := rows.Next(); err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	} else if !ok {
+		return 0, nil
+	}
+
+	var count uint64
+	if err := rows.Scan(&count); err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	return count, nil
+}
+
+func (r *invitation) Create(
+	ctx context.Context,
+	invitation *model.Invitation,
+) error {
+	if err := marshaller.InvitationToDBModel(invitation).Insert(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *invitation) Update(
+	ctx context.Context,
+	invitation *model.Invitation,
+) error {
+	if err := marshaller.InvitationToDBModel(invitation).Update(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/abyssparanoia/memeduck"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/marshaller"
+	"google.golang.org/grpc/codes"
+)
+
+type jobQueue struct{}
+
+func NewJobQueue() repository.JobQueue {
+	return &jobQueue{}
+}
+
+func (r *jobQueue) Enqueue(
+	ctx context.Context,
+	job *model.Job,
+) error {
+	if err := marshaller.JobToDBModel(job).Insert(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+// Dequeue has no SKIP LOCKED on Spanner; concurrent workers claiming the same jobs
+// conflict on their locks and the losing transaction is aborted and retried.
+func (r *jobQueue) Dequeue(
+	ctx context.Context,
+	query repository.DequeueJobsQuery,
+) (model.Jobs, error) {
+	sql, err := memeduck.Select(
+		dbmodel.JobTableName(),
+		dbmodel.JobColumns(),
+	).
+		Where(memeduck.Or(
+			memeduck.And(
+				memeduck.Eq(memeduck.Ident("Status"), memeduck.Param("PendingStatus")),
+				memeduck.Le(memeduck.Ident("RunAt"), memeduck.Param("RequestTime")),
+			),
+			// take over jobs whose worker died before finishing them
+			memeduck.And(
+				memeduck.Eq(memeduck.Ident("Status"), memeduck.Param("RunningStatus")),
+				memeduck.Le(memeduck.Ident("LockedUntil"), memeduck.Param("RequestTime")),
+			),
+		)).
+		OrderBy("RunAt", memeduck.ASC).
+		OrderBy("JobID", memeduck.ASC).
+		Limit(int(query.Limit)). //nolint:gosec
+		SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	params := map[string]interface{}{
+		"PendingStatus": model.JobStatusPending.String(),
+		"RunningStatus": model.JobStatusRunning.String(),
+		"RequestTime":   query.RequestTime,
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	dsts := dbmodel.JobSlice{}
+	for {
+		if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+			return nil, errors.InternalErr.Wrap(err)
+		} else if !ok {
+			break
+		}
+
+		var dst dbmodel.Job
+		if err := rows.ToStruct(&dst); err != nil {
+			return nil, errors.InternalErr.Wrap(err)
+		}
+		dsts = append(dsts, &dst)
+	}
+
+	jobs := marshaller.JobsToModel(dsts)
+	lockedUntil := query.RequestTime.Add(query.LeaseDuration)
+	for _, job := range jobs {
+		job.Start(lockedUntil, query.RequestTime)
+		if err := r.update(ctx, job); err != nil {
+			return nil, err
+		}
+	}
+	return jobs, nil
+}
+
+// Update records the result of the job only while the job is still running the attempt it was claimed for,
+// and returns JobLeaseExpiredErr once its lease has expired and another worker has claimed it again.
+// The row is read in the read-write transaction, which locks it until the result is recorded.
+func (r *jobQueue) Update(
+	ctx context.Context,
+	job *model.Job,
+) error {
+	sql, err := memeduck.Select(
+		dbmodel.JobTableName(),
+		[]string{"JobID"},
+	).
+		Where(memeduck.And(
+			memeduck.Eq(memeduck.Ident("JobID"), memeduck.Param("JobID")),
+			memeduck.Eq(memeduck.Ident("Status"), memeduck.Param("RunningStatus")),
+			memeduck.Eq(memeduck.Ident("Attempts"), memeduck.Param("Attempts")),
+		)).
+		SQL()
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	params := map[string]interface{}{
+		"JobID":         job.ID,
+		"RunningStatus": model.JobStatusRunning.String(),
+		"Attempts":      int64(job.Attempts), //nolint:gosec
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if ok, err := rows.Next(); err != nil {
+		return errors.InternalErr.Wrap(err)
+	} else if !ok {
+		return errors.JobLeaseExpiredErr.New().
+			WithDetail("job has been claimed again").
+			WithValue("job_id", job.ID)
+	}
+	return r.update(ctx, job)
+}
+
+func (r *jobQueue) update(
+	ctx context.Context,
+	job *model.Job,
+) error {
+	if err := marshaller.JobToDBModel(job).Update(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/abyssparanoia/memeduck"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/marshaller"
+	"google.golang.org/grpc/codes"
+)
+
+type outboxEvent struct{}
+
+func NewOutboxEvent() repository.OutboxEvent {
+	return &outboxEvent{}
+}
+
+func (r *outboxEvent) List(
+	ctx context.Context,
+	query repository.ListOutboxEventsQuery,
+) (model.OutboxEvents, error) {
+	conds := []memeduck.WhereCond{}
+	params := map[string]interface{}{}
+	if query.Status.Valid && query.Status.Value().Valid() {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("Status"), memeduck.Param("Status")))
+		params["Status"] = query.Status.Value().String()
+	}
+	if query.NextAttemptBefore.Valid {
+		conds = append(conds, memeduck.Le(memeduck.Ident("NextAttemptAt"), memeduck.Param("NextAttemptBefore")))
+		params["NextAttemptBefore"] = query.NextAttemptBefore.Time
+	}
+	stmt := memeduck.Select(
+		dbmodel.OutboxEventTableName(),
+		dbmodel.OutboxEventColumns(),
+	).
+		Where(conds...).
+		OrderBy("CreatedAt", memeduck.ASC).
+		OrderBy("OutboxEventID", memeduck.ASC)
+
+	// Pagination
+	if query.Page.Valid && query.Limit.Valid {
+		stmt = stmt.LimitOffset(int(query.Limit.Uint64), int(query.Limit.Uint64*(query.Page.Uint64-1)))
+	} else if query.Keyset && query.Limit.Valid {
+		stmt = stmt.Limit(int(query.Limit.Uint64))
+	}
+	sql, err := stmt.SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	dsts := dbmodel.OutboxEventSlice{}
+	for {
+		if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+			return nil, errors.InternalErr.Wrap(err)
+		} else if !ok {
+			break
+		}
+
+		var dst dbmodel.OutboxEvent
+		if err := rows.ToStruct(&dst); err != nil {
+			return nil, errors.InternalErr.Wrap(err)
+		}
+		dsts = append(dsts, &dst)
+	}
+
+	return marshaller.OutboxEventsToModel(dsts), nil
+}
+
+func (r *outboxEvent) BatchCreate(
+	ctx context.Context,
+	outboxEvents model.OutboxEvents,
+) error {
+	if err := marshaller.OutboxEventsToDBModel(outboxEvents).InsertAll(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
+
+func (r *outboxEvent) Update(
+	ctx context.Context,
+	outboxEvent *model.OutboxEvent,
+) error {
+	if err := marshaller.OutboxEventToDBModel(outboxEvent).Update(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
@@ -33,6 +33,9 @@ func (r *staff) Get(
 		conds = append(conds, memeduck.Eq(memeduck.Ident("AuthUID"), memeduck.Param("AuthUID")))
 		params["AuthUID"] = query.AuthUID.String
 	}
+	if !query.IncludeDeleted {
+		conds = append(conds, memeduck.IsNull(memeduck.Ident("DeletedAt")))
+	}
 	sql, err := memeduck.Select(
 		dbmodel.StaffTableName(),
 		dbmodel.StaffColumns(),
@@ -66,21 +69,227 @@ func (r *staff) Get(
 		return nil, errors.InternalErr.Wrap(err)
 	}
 
-	return marshaller.StaffToModel(&dst), nil
+	staff := marshaller.StaffToModel(&dst)
+	if query.Preload {
+		if err := r.preload(ctx, model.Staffs{staff}); err != nil {
+			return nil, err
+		}
+	}
+	return staff, nil
+}
+
+func (r *staff) buildListQuery(query repository.ListStaffQuery) ([]memeduck.WhereCond, map[string]interface{}) {
+	conds := []memeduck.WhereCond{}
+	params := map[string]interface{}{}
+	if query.TenantID.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("TenantID"), memeduck.Param("TenantID")))
+		params["TenantID"] = query.TenantID.String
+	}
+	if !query.IncludeDeleted {
+		conds = append(conds, memeduck.IsNull(memeduck.Ident("DeletedAt")))
+	}
+	if query.DeletedBefore.Valid {
+		conds = append(conds, memeduck.Lt(memeduck.Ident("DeletedAt"), memeduck.Param("DeletedBefore")))
+		params["DeletedBefore"] = query.DeletedBefore.Time
+	}
+	if query.DisplayName.Valid {
+		conds = append(conds, newContainsCond("DisplayName", query.DisplayName.String, params))
+	}
+	if query.Email.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("Email"), memeduck.Param("Email")))
+		params["Email"] = query.Email.String
+	}
+	if query.Role.Valid {
+		conds = append(conds, memeduck.Eq(memeduck.Ident("Role"), memeduck.Param("Role")))
+		params["Role"] = query.Role.Value().String()
+	}
+	if query.CreatedAtFrom.Valid {
+		conds = append(conds, memeduck.Ge(memeduck.Ident("CreatedAt"), memeduck.Param("CreatedAtFrom")))
+		params["CreatedAtFrom"] = query.CreatedAtFrom.Time
+	}
+	if query.CreatedAtTo.Valid {
+		conds = append(conds, memeduck.Lt(memeduck.Ident("CreatedAt"), memeduck.Param("CreatedAtTo")))
+		params["CreatedAtTo"] = query.CreatedAtTo.Time
+	}
+	return conds, params
 }
 
 func (r *staff) List(
 	ctx context.Context,
 	query repository.ListStaffQuery,
 ) (model.Staffs, error) {
-	return nil, errors.InternalErr.Errorf("not implemented")
+	conds, params := r.buildListQuery(query)
+	stmt := memeduck.Select(
+		dbmodel.StaffTableName(),
+		dbmodel.StaffColumns(),
+	).
+		Where(conds...)
+
+	// Sorting (BEFORE pagination)
+	if query.SortKey.Valid && query.SortKey.Value().Valid() {
+		column, dir, err := r.sortColumn(query.SortKey.Value())
+		if err != nil {
+			return nil, err
+		}
+		stmt = stmt.OrderBy(column, dir).OrderBy("StaffID", dir)
+
+		// Keyset pagination
+		if query.Cursor.Valid {
+			cond, err := r.buildCursorCond(query.SortKey.Value(), query.Cursor.Value(), params)
+			if err != nil {
+				return nil, err
+			}
+			stmt = stmt.Where(cond)
+		}
+	} else if query.Cursor.Valid {
+		return nil, errors.InternalErr.Errorf("cursor requires sort key")
+	}
+
+	// Pagination
+	if query.Page.Valid && query.Limit.Valid {
+		stmt = stmt.LimitOffset(int(query.Limit.Uint64), int(query.Limit.Uint64*(query.Page.Uint64-1)))
+	} else if query.Keyset && query.Limit.Valid {
+		stmt = stmt.Limit(int(query.Limit.Uint64))
+	}
+	sql, err := stmt.SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	dsts := dbmodel.StaffSlice{}
+	for {
+		if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+			return nil, errors.InternalErr.Wrap(err)
+		} else if !ok {
+			break
+		}
+
+		var dst dbmodel.Staff
+		if err := rows.ToStruct(&dst); err != nil {
+			return nil, errors.InternalErr.Wrap(err)
+		}
+		dsts = append(dsts, &dst)
+	}
+
+	staffs := marshaller.StaffsToModel(dsts)
+	if query.Preload {
+		if err := r.preload(ctx, staffs); err != nil {
+			return nil, err
+		}
+	}
+	return staffs, nil
+}
+
+func (r *staff) sortColumn(sortKey model.StaffSortKey) (string, memeduck.Direction, error) {
+	switch sortKey {
+	case model.StaffSortKeyCreatedAtDesc:
+		return "CreatedAt", memeduck.DESC, nil
+	case model.StaffSortKeyCreatedAtAsc:
+		return "CreatedAt", memeduck.ASC, nil
+	case model.StaffSortKeyDisplayNameAsc:
+		return "DisplayName", memeduck.ASC, nil
+	case model.StaffSortKeyDisplayNameDesc:
+		return "DisplayName", memeduck.DESC, nil
+	case model.StaffSortKeyUnknown:
+		fallthrough
+	default:
+		return "", memeduck.ASC, errors.InternalErr.Errorf("invalid sort key: %s", sortKey)
+	}
+}
+
+// buildCursorCond restricts the list to the rows after the cursor position.
+func (r *staff) buildCursorCond(
+	sortKey model.StaffSortKey,
+	cursor model.Cursor,
+	params map[string]interface{},
+) (memeduck.WhereCond, error) {
+	column, dir, err := r.sortColumn(sortKey)
+	if err != nil {
+		return nil, err
+	}
+	if column == "CreatedAt" {
+		createdAt, err := cursor.TimeValue()
+		if err != nil {
+			return nil, err
+		}
+		params["CursorValue"] = createdAt
+	} else {
+		params["CursorValue"] = cursor.Value
+	}
+	params["CursorID"] = cursor.ID
+
+	after := memeduck.Gt
+	if dir == memeduck.DESC {
+		after = memeduck.Lt
+	}
+	return memeduck.Or(
+		after(memeduck.Ident(column), memeduck.Param("CursorValue")),
+		memeduck.And(
+			memeduck.Eq(memeduck.Ident(column), memeduck.Param("CursorValue")),
+			after(memeduck.Ident("StaffID"), memeduck.Param("CursorID")),
+		),
+	), nil
 }
 
 func (r *staff) Count(
 	ctx context.Context,
 	query repository.ListStaffQuery,
 ) (uint64, error) {
-	return 0, errors.InternalErr.Errorf("not implemented")
+	conds, params := r.buildListQuery(query)
+	sql, err := memeduck.Select(
+		dbmodel.StaffTableName(),
+		[]string{"COUNT(*)"},
+	).
+		Where(conds...).
+		SQL()
+	if err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	if ok, err := rows.Next(); err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	} else if !ok {
+		return 0, nil
+	}
+
+	var count uint64
+	if err := rows.Scan(&count); err != nil {
+		return 0, errors.InternalErr.Wrap(err)
+	}
+	return count, nil
+}
+
+// preload sets the tenant of each staff as the MySQL repository does with eager loading.
+func (r *staff) preload(
+	ctx context.Context,
+	staffs model.Staffs,
+) error {
+	tenantIDs := make([]string, len(staffs))
+	for idx, staff := range staffs {
+		tenantIDs[idx] = staff.TenantID
+	}
+	tenantsByID, err := listTenantsByID(ctx, tenantIDs)
+	if err != nil {
+		return err
+	}
+	for _, staff := range staffs {
+		staff.ReadonlyReference = &struct {
+			Tenant *model.Tenant
+		}{
+			Tenant: tenantsByID[staff.TenantID],
+		}
+	}
+	return nil
 }
 
 func (r *staff) Create(
@@ -97,19 +306,29 @@ func (r *staff) BatchCreate(
 	ctx context.Context,
 	staffs model.Staffs,
 ) error {
-	return errors.InternalErr.Errorf("not implemented")
+	if err := marshaller.StaffsToDBModel(staffs).InsertAll(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
 }
 
 func (r *staff) Update(
 	ctx context.Context,
 	staff *model.Staff,
 ) error {
-	return errors.InternalErr.Errorf("not implemented")
+	if err := marshaller.StaffToDBModel(staff).Update(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
 }
 
 func (r *staff) Delete(
 	ctx context.Context,
 	id string,
 ) error {
-	return errors.InternalErr.Errorf("not implemented")
+	dst := dbmodel.Staff{StaffID: id} //nolint:exhaustruct
+	if err := dst.Delete(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
 }
@@ -29,6 +29,9 @@ func (r *tenant) Get(
 		conds = append(conds, memeduck.Eq(memeduck.Ident("TenantID"), memeduck.Param("TenantID")))
 		params["TenantID"] = query.ID.String
 	}
+	if !query.IncludeDeleted {
+		conds = append(conds, memeduck.IsNull(memeduck.Ident("DeletedAt")))
+	}
 	sql, err := memeduck.Select(
 		dbmodel.TenantTableName(),
 		dbmodel.TenantColumns(),
@@ -62,25 +65,88 @@ func (r *tenant) Get(
 		return nil, errors.InternalErr.Wrap(err)
 	}
 
-	return marshaller.TenantToModel(&dst), nil
+	tagsByTenantID, err := listTenantTags(ctx, []string{dst.TenantID})
+	if err != nil {
+		return nil, err
+	}
+
+	return marshaller.TenantToModel(&dst, tagsByTenantID[dst.TenantID]), nil
 }
 
-func (r *tenant) buildListQuery(_ repository.ListTenantsQuery) ([]memeduck.WhereCond, map[string]interface{}) {
-	return []memeduck.WhereCond{}, map[string]interface{}{}
+func (r *tenant) buildListQuery(
+	ctx context.Context,
+	query repository.ListTenantsQuery,
+) ([]memeduck.WhereCond, map[string]interface{}, error) {
+	conds := []memeduck.WhereCond{}
+	params := map[string]interface{}{}
+	if !query.IncludeDeleted {
+		conds = append(conds, memeduck.IsNull(memeduck.Ident("DeletedAt")))
+	}
+	if query.DeletedBefore.Valid {
+		conds = append(conds, memeduck.Lt(memeduck.Ident("DeletedAt"), memeduck.Param("DeletedBefore")))
+		params["DeletedBefore"] = query.DeletedBefore.Time
+	}
+	if query.Name.Valid {
+		conds = append(conds, newContainsCond("Name", query.Name.String, params))
+	}
+	if query.CreatedAtFrom.Valid {
+		conds = append(conds, memeduck.Ge(memeduck.Ident("CreatedAt"), memeduck.Param("CreatedAtFrom")))
+		params["CreatedAtFrom"] = query.CreatedAtFrom.Time
+	}
+	if query.CreatedAtTo.Valid {
+		conds = append(conds, memeduck.Lt(memeduck.Ident("CreatedAt"), memeduck.Param("CreatedAtTo")))
+		params["CreatedAtTo"] = query.CreatedAtTo.Time
+	}
+	if len(query.TagTypes) > 0 {
+		tenantIDs, err := listTenantIDsByTagTypes(ctx, query.TagTypes)
+		if err != nil {
+			return nil, nil, err
+		}
+		conds = append(conds, memeduck.In(memeduck.Ident("TenantID"), memeduck.Unnest(memeduck.Param("TagTenantIDs"))))
+		params["TagTenantIDs"] = tenantIDs
+	}
+	return conds, params, nil
 }
 
 func (r *tenant) List(
 	ctx context.Context,
 	query repository.ListTenantsQuery,
 ) (model.Tenants, error) {
-	conds, params := r.buildListQuery(query)
+	conds, params, err := r.buildListQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
 	stmt := memeduck.Select(
 		dbmodel.TenantTableName(),
 		dbmodel.TenantColumns(),
 	).
 		Where(conds...)
+
+	// Sorting (BEFORE pagination)
+	if query.SortKey.Valid && query.SortKey.Value().Valid() {
+		column, dir, err := r.sortColumn(query.SortKey.Value())
+		if err != nil {
+			return nil, err
+		}
+		stmt = stmt.OrderBy(column, dir).OrderBy("TenantID", dir)
+
+		// Keyset pagination
+		if query.Cursor.Valid {
+			cond, err := r.buildCursorCond(query.SortKey.Value(), query.Cursor.Value(), params)
+			if err != nil {
+				return nil, err
+			}
+			stmt = stmt.Where(cond)
+		}
+	} else if query.Cursor.Valid {
+		return nil, errors.InternalErr.Errorf("cursor requires sort key")
+	}
+
+	// Pagination
 	if query.Page.Valid && query.Limit.Valid {
 		stmt = stmt.LimitOffset(int(query.Limit.Uint64), int(query.Limit.Uint64*(query.Page.Uint64-1)))
+	} else if query.Keyset && query.Limit.Valid {
+		stmt = stmt.Limit(int(query.Limit.Uint64))
 	}
 	sql, err := stmt.SQL()
 	if err != nil {
@@ -107,14 +173,77 @@ func (r *tenant) List(
 		dsts = append(dsts, &dst)
 	}
 
-	return marshaller.TenantsToModel(dsts), nil
+	tenantIDs := make([]string, len(dsts))
+	for idx, dst := range dsts {
+		tenantIDs[idx] = dst.TenantID
+	}
+	tagsByTenantID, err := listTenantTags(ctx, tenantIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshaller.TenantsToModel(dsts, tagsByTenantID), nil
+}
+
+func (r *tenant) sortColumn(sortKey model.TenantSortKey) (string, memeduck.Direction, error) {
+	switch sortKey {
+	case model.TenantSortKeyCreatedAtDesc:
+		return "CreatedAt", memeduck.DESC, nil
+	case model.TenantSortKeyCreatedAtAsc:
+		return "CreatedAt", memeduck.ASC, nil
+	case model.TenantSortKeyNameAsc:
+		return "Name", memeduck.ASC, nil
+	case model.TenantSortKeyNameDesc:
+		return "Name", memeduck.DESC, nil
+	case model.TenantSortKeyUnknown:
+		fallthrough
+	default:
+		return "", memeduck.ASC, errors.InternalErr.Errorf("invalid sort key: %s", sortKey)
+	}
+}
+
+// buildCursorCond restricts the list to the rows after the cursor position.
+func (r *tenant) buildCursorCond(
+	sortKey model.TenantSortKey,
+	cursor model.Cursor,
+	params map[string]interface{},
+) (memeduck.WhereCond, error) {
+	column, dir, err := r.sortColumn(sortKey)
+	if err != nil {
+		return nil, err
+	}
+	if column == "CreatedAt" {
+		createdAt, err := cursor.TimeValue()
+		if err != nil {
+			return nil, err
+		}
+		params["CursorValue"] = createdAt
+	} else {
+		params["CursorValue"] = cursor.Value
+	}
+	params["CursorID"] = cursor.ID
+
+	after := memeduck.Gt
+	if dir == memeduck.DESC {
+		after = memeduck.Lt
+	}
+	return memeduck.Or(
+		after(memeduck.Ident(column), memeduck.Param("CursorValue")),
+		memeduck.And(
+			memeduck.Eq(memeduck.Ident(column), memeduck.Param("CursorValue")),
+			after(memeduck.Ident("TenantID"), memeduck.Param("CursorID")),
+		),
+	), nil
 }
 
 func (r *tenant) Count(
 	ctx context.Context,
 	query repository.ListTenantsQuery,
 ) (uint64, error) {
-	conds, params := r.buildListQuery(query)
+	conds, params, err := r.buildListQuery(ctx, query)
+	if err != nil {
+		return 0, err
+	}
 	sql, err := memeduck.Select(
 		dbmodel.TenantTableName(),
 		[]string{"COUNT(*)"},
@@ -150,6 +279,9 @@ func (r *tenant) Create(
 	if err := marshaller.TenantToDBModel(tenant).Insert(ctx); err != nil {
 		return errors.InternalErr.Wrap(err)
 	}
+	if err := marshaller.TenantTagsToDBModel(tenant.Tags, tenant.ID).InsertAll(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
 	return nil
 }
 
@@ -157,10 +289,17 @@ func (r *tenant) BatchCreate(
 	ctx context.Context,
 	tenants model.Tenants,
 ) error {
-	for _, tenant := range tenants {
-		if err := marshaller.TenantToDBModel(tenant).Insert(ctx); err != nil {
-			return errors.InternalErr.Wrap(err)
-		}
+	dstTenants := make(dbmodel.TenantSlice, len(tenants))
+	dstTags := dbmodel.TenantTagSlice{}
+	for idx, tenant := range tenants {
+		dstTenants[idx] = marshaller.TenantToDBModel(tenant)
+		dstTags = append(dstTags, marshaller.TenantTagsToDBModel(tenant.Tags, tenant.ID)...)
+	}
+	if err := dstTenants.InsertAll(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	if err := dstTags.InsertAll(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
 	}
 	return nil
 }
@@ -172,6 +311,12 @@ func (r *tenant) Update(
 	if err := marshaller.TenantToDBModel(tenant).Update(ctx); err != nil {
 		return errors.InternalErr.Wrap(err)
 	}
+	if err := deleteByTenantID(ctx, dbmodel.TenantTagTableName(), tenant.ID); err != nil {
+		return err
+	}
+	if err := marshaller.TenantTagsToDBModel(tenant.Tags, tenant.ID).InsertAll(ctx); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
 	return nil
 }
 
@@ -180,8 +325,8 @@ func (r *tenant) BatchUpdate(
 	tenants model.Tenants,
 ) error {
 	for _, tenant := range tenants {
-		if err := marshaller.TenantToDBModel(tenant).Update(ctx); err != nil {
-			return errors.InternalErr.Wrap(err)
+		if err := r.Update(ctx, tenant); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -191,9 +336,183 @@ func (r *tenant) Delete(
 	ctx context.Context,
 	id string,
 ) error {
+	if err := deleteByTenantID(ctx, dbmodel.TenantTagTableName(), id); err != nil {
+		return err
+	}
+	if err := deleteByTenantID(ctx, dbmodel.InvitationTableName(), id); err != nil {
+		return err
+	}
 	dst := dbmodel.Tenant{TenantID: id} //nolint:exhaustruct
 	if err := dst.Delete(ctx); err != nil {
 		return errors.InternalErr.Wrap(err)
 	}
 	return nil
 }
+
+// listTenantsByID loads the tenants with their tags, keyed by tenant id.
+func listTenantsByID(
+	ctx context.Context,
+	tenantIDs []string,
+) (map[string]*model.Tenant, error) {
+	tenantsByID := map[string]*model.Tenant{}
+	if len(tenantIDs) == 0 {
+		return tenantsByID, nil
+	}
+	sql, err := memeduck.Select(
+		dbmodel.TenantTableName(),
+		dbmodel.TenantColumns(),
+	).
+		Where(memeduck.In(memeduck.Ident("TenantID"), memeduck.Unnest(memeduck.Param("TenantIDs")))).
+		SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	params := map[string]interface{}{
+		"TenantIDs": tenantIDs,
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	dsts := dbmodel.TenantSlice{}
+	for {
+		if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+			return nil, errors.InternalErr.Wrap(err)
+		} else if !ok {
+			break
+		}
+
+		var dst dbmodel.Tenant
+		if err := rows.ToStruct(&dst); err != nil {
+			return nil, errors.InternalErr.Wrap(err)
+		}
+		dsts = append(dsts, &dst)
+	}
+
+	tagsByTenantID, err := listTenantTags(ctx, tenantIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, dst := range dsts {
+		tenantsByID[dst.TenantID] = marshaller.TenantToModel(dst, tagsByTenantID[dst.TenantID])
+	}
+	return tenantsByID, nil
+}
+
+// listTenantTags loads the tags of the tenants, keyed by tenant id, since Spanner has no eager loading.
+func listTenantTags(
+	ctx context.Context,
+	tenantIDs []string,
+) (map[string]dbmodel.TenantTagSlice, error) {
+	tagsByTenantID := map[string]dbmodel.TenantTagSlice{}
+	if len(tenantIDs) == 0 {
+		return tagsByTenantID, nil
+	}
+	sql, err := memeduck.Select(
+		dbmodel.TenantTagTableName(),
+		dbmodel.TenantTagColumns(),
+	).
+		Where(memeduck.In(memeduck.Ident("TenantID"), memeduck.Unnest(memeduck.Param("TenantIDs")))).
+		OrderBy("CreatedAt", memeduck.ASC).
+		OrderBy("TenantTagID", memeduck.ASC).
+		SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	params := map[string]interface{}{
+		"TenantIDs": tenantIDs,
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for {
+		if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+			return nil, errors.InternalErr.Wrap(err)
+		} else if !ok {
+			break
+		}
+
+		var dst dbmodel.TenantTag
+		if err := rows.ToStruct(&dst); err != nil {
+			return nil, errors.InternalErr.Wrap(err)
+		}
+		tagsByTenantID[dst.TenantID] = append(tagsByTenantID[dst.TenantID], &dst)
+	}
+	return tagsByTenantID, nil
+}
+
+// listTenantIDsByTagTypes returns the ids of the tenants tagged with any of the types,
+// since memeduck cannot express an IN subquery.
+func listTenantIDsByTagTypes(
+	ctx context.Context,
+	tagTypes []model.TenantTagType,
+) ([]string, error) {
+	types := make([]string, 0, len(tagTypes))
+	for _, tagType := range tagTypes {
+		types = append(types, tagType.String())
+	}
+	sql, err := memeduck.Select(
+		dbmodel.TenantTagTableName(),
+		[]string{"TenantID"},
+	).
+		Where(memeduck.In(memeduck.Ident("Type"), memeduck.Unnest(memeduck.Param("Types")))).
+		SQL()
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	params := map[string]interface{}{
+		"Types": types,
+	}
+	rows, err := dbmodel.GetSpannerTransaction(ctx).QueryContext(ctx, sql, params)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	tenantIDs := []string{}
+	seen := map[string]struct{}{}
+	for {
+		if ok, err := rows.Next(); err != nil && spanner.ErrCode(err) != codes.NotFound {
+			return nil, errors.InternalErr.Wrap(err)
+		} else if !ok {
+			break
+		}
+
+		var tenantID string
+		if err := rows.Scan(&tenantID); err != nil {
+			return nil, errors.InternalErr.Wrap(err)
+		}
+		if _, ok := seen[tenantID]; ok {
+			continue
+		}
+		seen[tenantID] = struct{}{}
+		tenantIDs = append(tenantIDs, tenantID)
+	}
+	return tenantIDs, nil
+}
+
+// deleteByTenantID deletes the rows of the table belonging to the tenant.
+func deleteByTenantID(
+	ctx context.Context,
+	table string,
+	tenantID string,
+) error {
+	sql, err := memeduck.Delete(table).
+		Where(memeduck.Eq(memeduck.Ident("TenantID"), memeduck.Param("TenantID"))).
+		SQL()
+	if err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	params := map[string]interface{}{
+		"TenantID": tenantID,
+	}
+	if err := dbmodel.GetSpannerTransaction(ctx).ExecContext(ctx, sql, params); err != nil {
+		return errors.InternalErr.Wrap(err)
+	}
+	return nil
+}
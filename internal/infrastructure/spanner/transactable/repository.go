@@ -6,18 +6,23 @@ import (
 	"cloud.google.com/go/spanner"
 	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
 	"github.com/abyssparanoia/rapid-go/internal/infrastructure/spanner/internal/dbmodel"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/backoff"
+	"google.golang.org/grpc/codes"
 )
 
 type transactable struct {
 	*dbmodel.SpannerTransactable
+	retryPolicy backoff.RetryPolicy
 }
 
 func NewTransactable(
 	spannerCli *spanner.Client,
+	retryPolicy backoff.RetryPolicy,
 ) repository.Transactable {
 	t := dbmodel.NewTransactable(spannerCli)
 	return &transactable{
 		t,
+		retryPolicy,
 	}
 }
 
@@ -28,9 +33,17 @@ func (r *transactable) ROTx(ctx context.Context, fn func(ctx context.Context) er
 	return nil
 }
 
+// RWTx retries the whole transaction when it is still aborted after the retries of the client library,
+// which happens when it keeps losing lock conflicts.
 func (r *transactable) RWTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	if err := r.SpannerTransactable.RWTx(ctx, fn); err != nil {
+	if err := r.retryPolicy.Do(ctx, "spanner transaction", isRetryable, func() error {
+		return r.SpannerTransactable.RWTx(ctx, fn)
+	}); err != nil {
 		return err
 	}
 	return nil
 }
+
+func isRetryable(err error) bool {
+	return spanner.ErrCode(err) == codes.Aborted
+}
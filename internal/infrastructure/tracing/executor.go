@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	goerrors "errors"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type contextExecutor struct {
+	boil.ContextExecutor
+	dbSystem attribute.KeyValue
+}
+
+// NewContextExecutor returns exec recording a span for each query run with a context.
+// dbSystem is the db.system.name attribute of the spans, e.g. semconv.DBSystemNameMySQL.
+func NewContextExecutor(
+	exec boil.ContextExecutor,
+	dbSystem attribute.KeyValue,
+) boil.ContextExecutor {
+	return &contextExecutor{
+		ContextExecutor: exec,
+		dbSystem:        dbSystem,
+	}
+}
+
+func (e *contextExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := e.start(ctx, query)
+	result, err := e.ContextExecutor.ExecContext(ctx, query, args...)
+	end(span, err)
+	return result, err
+}
+
+func (e *contextExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := e.start(ctx, query)
+	rows, err := e.ContextExecutor.QueryContext(ctx, query, args...)
+	end(span, err)
+	return rows, err
+}
+
+// QueryRowContext ends the span before the row is scanned, so an error of the query is not recorded.
+func (e *contextExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx, span := e.start(ctx, query)
+	row := e.ContextExecutor.QueryRowContext(ctx, query, args...)
+	end(span, nil)
+	return row
+}
+
+// start starts the span of query, named by its operation such as SELECT.
+// The arguments are left out of the span, since they may hold personal data.
+func (e *contextExecutor) start(ctx context.Context, query string) (context.Context, trace.Span) {
+	operation, _, _ := strings.Cut(strings.TrimSpace(query), " ")
+	operation = strings.ToUpper(operation)
+	return Tracer().Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			e.dbSystem,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(query),
+		),
+	)
+}
+
+func end(span trace.Span, err error) {
+	if err != nil && !goerrors.Is(err, sql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
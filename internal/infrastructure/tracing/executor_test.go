@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+)
+
+type stubExecutor struct {
+	boil.ContextExecutor
+	err error
+}
+
+func (e *stubExecutor) ExecContext(context.Context, string, ...interface{}) (sql.Result, error) {
+	return nil, e.err
+}
+
+//nolint:paralleltest // the tracer provider is global
+func TestContextExecutor_ExecContext(t *testing.T) {
+	type want struct {
+		name   string
+		status codes.Code
+	}
+
+	testcases := map[string]struct {
+		query string
+		err   error
+		want  want
+	}{
+		"success": {
+			query: "  insert INTO `users` (`id`) VALUES (?)",
+			err:   nil,
+			want: want{
+				name:   "INSERT",
+				status: codes.Unset,
+			},
+		},
+		"no rows is not an error": {
+			query: "DELETE FROM `users`",
+			err:   sql.ErrNoRows,
+			want: want{
+				name:   "DELETE",
+				status: codes.Unset,
+			},
+		},
+		"error": {
+			query: "UPDATE `users` SET `id` = ?",
+			err:   fmt.Errorf("connection refused"),
+			want: want{
+				name:   "UPDATE",
+				status: codes.Error,
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+			exec := NewContextExecutor(&stubExecutor{err: tc.err}, semconv.DBSystemNameMySQL)
+			_, err := exec.ExecContext(t.Context(), tc.query, "id")
+			require.ErrorIs(t, err, tc.err)
+
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			require.Equal(t, tc.want.name, spans[0].Name())
+			require.Equal(t, tc.want.status, spans[0].Status().Code)
+			require.Contains(t, spans[0].Attributes(), semconv.DBQueryText(tc.query))
+		})
+	}
+}
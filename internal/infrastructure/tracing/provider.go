@@ -0,0 +1,61 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/environment"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the spans started by this application.
+const tracerName = "github.com/abyssparanoia/rapid-go"
+
+// Tracer returns the tracer of the spans started by this application.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// NewTracerProvider installs the global tracer provider exporting the spans over OTLP,
+// and the propagator of the W3C trace context and baggage.
+// Without an OTLP endpoint the spans are not recorded, while the trace context is still propagated.
+// The returned function flushes the spans left and must be called before exiting.
+func NewTracerProvider(
+	ctx context.Context,
+	e *environment.Environment,
+) func(context.Context) error {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	if e.OTELExporterOTLPEndpoint == "" {
+		return func(context.Context) error { return nil }
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpointURL(e.OTELExporterOTLPEndpoint))
+	if err != nil {
+		panic(err)
+	}
+	res, err := resource.New(ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			semconv.ServiceName(e.OTELServiceName),
+			semconv.DeploymentEnvironmentName(e.Environment.String()),
+		),
+	)
+	if err != nil {
+		panic(err)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(e.OTELTracesSampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown
+}
@@ -0,0 +1,141 @@
+package worker
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/dependency"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/environment"
+	"github.com/abyssparanoia/rapid-go/internal/infrastructure/tracing"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/logger"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/caarlos0/env/v11"
+	"go.uber.org/zap"
+)
+
+func Run() {
+	e := &environment.Environment{}
+	if err := env.Parse(e); err != nil {
+		panic(err)
+	}
+
+	l := logger.New(e.MinLogLevel.ZapLogLevel())
+	ctx := logger.ToContext(context.Background(), l)
+
+	shutdownTracerProvider := tracing.NewTracerProvider(ctx, e)
+
+	d := &dependency.Dependency{}
+	d.Inject(ctx, e)
+
+	// graceful shutdown: the batch in progress is finished before exiting
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
+	l.Info("[START] worker", zap.Duration("poll_interval", e.WorkerPollInterval))
+
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		poll(ctx, e.WorkerPollInterval, func(ctx context.Context) bool {
+			ctx, span := tracing.Tracer().Start(ctx, "worker.RelayOutbox")
+			defer span.End()
+			result, err := d.WorkerOutboxInteractor.Relay(
+				ctx,
+				input.NewWorkerRelayOutbox(
+					e.WorkerOutboxBatchSize,
+					e.WorkerOutboxMaxAttempts,
+					e.WorkerOutboxLeaseDuration,
+					now.Now(),
+				),
+			)
+			if err != nil {
+				l.Error("failed to relay outbox events", zap.Error(err)) //nolint:forbidigo
+				return false
+			}
+			if result.DeliveredCount > 0 || result.FailedCount > 0 {
+				l.Info("relayed outbox events",
+					zap.Int("delivered", result.DeliveredCount),
+					zap.Int("failed", result.FailedCount),
+				)
+			}
+			return uint64(result.DeliveredCount+result.FailedCount) >= e.WorkerOutboxBatchSize //nolint:gosec
+		})
+	})
+	wg.Go(func() {
+		poll(ctx, e.WorkerPollInterval, func(ctx context.Context) bool {
+			ctx, span := tracing.Tracer().Start(ctx, "worker.ProcessJobs")
+			defer span.End()
+			result, err := d.WorkerJobInteractor.Process(
+				ctx,
+				input.NewWorkerProcessJobs(
+					e.WorkerJobBatchSize,
+					e.WorkerJobLeaseDuration,
+				),
+			)
+			if err != nil {
+				l.Error("failed to process jobs", zap.Error(err)) //nolint:forbidigo
+				return false
+			}
+			if result.SucceededCount > 0 || result.RetriedCount > 0 || result.DeadCount > 0 {
+				l.Info("processed jobs",
+					zap.Int("succeeded", result.SucceededCount),
+					zap.Int("retried", result.RetriedCount),
+					zap.Int("dead", result.DeadCount),
+				)
+			}
+			return uint64(result.SucceededCount+result.RetriedCount+result.DeadCount) >= e.WorkerJobBatchSize //nolint:gosec
+		})
+	})
+	// the idempotency keys stored in the database are only deleted on a retry otherwise, which rarely comes
+	wg.Go(func() {
+		poll(ctx, e.WorkerPollInterval, func(ctx context.Context) bool {
+			ctx, span := tracing.Tracer().Start(ctx, "worker.DeleteExpiredIdempotencyKeys")
+			defer span.End()
+			deleted, err := d.IdempotencyKeyCache.DeleteExpired(ctx, now.Now(), e.WorkerIdempotencyKeyBatchSize)
+			if err != nil {
+				l.Error("failed to delete expired idempotency keys", zap.Error(err)) //nolint:forbidigo
+				return false
+			}
+			if deleted > 0 {
+				l.Info("deleted expired idempotency keys", zap.Int("deleted", deleted))
+			}
+			return uint64(deleted) >= e.WorkerIdempotencyKeyBatchSize //nolint:gosec
+		})
+	})
+	wg.Wait()
+
+	// the context is canceled by the signal, while the spans left are still flushed
+	if err := shutdownTracerProvider(context.WithoutCancel(ctx)); err != nil {
+		l.Error("failed to flush the spans", zap.Error(err)) //nolint:forbidigo
+	}
+
+	l.Info("worker shutdown completed")
+}
+
+// poll calls fn on every tick, and again without waiting while fn reports a full batch.
+// fn runs with a context that is not canceled on shutdown, so the batch in progress is never cut off.
+func poll(
+	ctx context.Context,
+	interval time.Duration,
+	fn func(ctx context.Context) bool,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for ctx.Err() == nil {
+			if !fn(context.WithoutCancel(ctx)) {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package backoff
+
+import (
+	"time"
+)
+
+// Exponential returns the delay before the given retry attempt, starting at 1.
+// The delay doubles from base on every attempt and is capped at maxDelay.
+func Exponential(
+	attempt uint64,
+	base time.Duration,
+	maxDelay time.Duration,
+) time.Duration {
+	if attempt == 0 {
+		return 0
+	}
+	delay := base
+	for i := uint64(1); i < attempt; i++ {
+		delay *= 2
+		if delay >= maxDelay || delay <= 0 {
+			return maxDelay
+		}
+	}
+	return min(delay, maxDelay)
+}
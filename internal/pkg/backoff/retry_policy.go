@@ -0,0 +1,78 @@
+package backoff
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/pkg/logger"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/logger/logger_field"
+	"go.uber.org/zap"
+)
+
+// RetryPolicy retries an operation failing with a transient error,
+// waiting a jittered exponential backoff between attempts.
+type RetryPolicy struct {
+	MaxAttempts uint64
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+func NewRetryPolicy(
+	maxAttempts uint64,
+	baseDelay time.Duration,
+	maxDelay time.Duration,
+) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: maxAttempts,
+		BaseDelay:   baseDelay,
+		MaxDelay:    maxDelay,
+	}
+}
+
+// Do calls fn until it succeeds, fails with an error retryable rejects, or MaxAttempts is reached.
+// It gives up early when ctx is done or the next delay would pass the ctx deadline,
+// and always returns the last error of fn.
+func (p RetryPolicy) Do(
+	ctx context.Context,
+	operation string,
+	retryable func(err error) bool,
+	fn func() error,
+) error {
+	for attempt := uint64(1); ; attempt++ {
+		err := fn()
+		if err == nil || !retryable(err) || attempt >= p.MaxAttempts {
+			return err
+		}
+
+		delay := p.Delay(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return err
+		}
+		logger.L(ctx).Warn("retrying after a transient error",
+			zap.String("operation", operation),
+			zap.Uint64("attempt", attempt),
+			zap.Duration("delay", delay),
+			logger_field.Error(err),
+		)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// Delay returns the wait after the given failed attempt, starting at 1.
+// It is drawn uniformly up to the exponential backoff ("full jitter"),
+// so that transactions that conflicted once do not collide again on retry.
+func (p RetryPolicy) Delay(attempt uint64) time.Duration {
+	upper := Exponential(attempt, p.BaseDelay, p.MaxDelay)
+	if upper <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(upper) + 1)) //nolint:gosec
+}
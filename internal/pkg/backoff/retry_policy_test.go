@@ -0,0 +1,132 @@
+package backoff
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	t.Parallel()
+
+	policy := NewRetryPolicy(5, 10*time.Millisecond, 50*time.Millisecond)
+
+	tests := map[string]struct {
+		attempt uint64
+		upper   time.Duration
+	}{
+		"first attempt waits up to the base delay": {
+			attempt: 1,
+			upper:   10 * time.Millisecond,
+		},
+		"delay doubles on every attempt": {
+			attempt: 3,
+			upper:   40 * time.Millisecond,
+		},
+		"delay is capped at the max delay": {
+			attempt: 10,
+			upper:   50 * time.Millisecond,
+		},
+		"no attempt waits nothing": {
+			attempt: 0,
+			upper:   0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			for range 100 {
+				got := policy.Delay(tc.attempt)
+				require.GreaterOrEqual(t, got, time.Duration(0))
+				require.LessOrEqual(t, got, tc.upper)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Do(t *testing.T) {
+	t.Parallel()
+
+	errTransient := errors.New("transient")
+	errPermanent := errors.New("permanent")
+	retryable := func(err error) bool {
+		return errors.Is(err, errTransient)
+	}
+
+	tests := map[string]struct {
+		policy       RetryPolicy
+		errs         []error
+		timeout      time.Duration
+		deadline     time.Duration
+		wantAttempts int
+		wantErr      error
+	}{
+		"success is not retried": {
+			policy:       NewRetryPolicy(3, time.Millisecond, time.Millisecond),
+			errs:         []error{nil},
+			wantAttempts: 1,
+			wantErr:      nil,
+		},
+		"transient error is retried until it succeeds": {
+			policy:       NewRetryPolicy(3, time.Millisecond, time.Millisecond),
+			errs:         []error{errTransient, errTransient, nil},
+			wantAttempts: 3,
+			wantErr:      nil,
+		},
+		"transient error is retried up to the max attempts": {
+			policy:       NewRetryPolicy(3, time.Millisecond, time.Millisecond),
+			errs:         []error{errTransient, errTransient, errTransient, errTransient},
+			wantAttempts: 3,
+			wantErr:      errTransient,
+		},
+		"non retryable error stops early": {
+			policy:       NewRetryPolicy(3, time.Millisecond, time.Millisecond),
+			errs:         []error{errTransient, errPermanent, nil},
+			wantAttempts: 2,
+			wantErr:      errPermanent,
+		},
+		"context cancelled during the wait stops": {
+			policy:       NewRetryPolicy(3, time.Hour, time.Hour),
+			errs:         []error{errTransient, nil},
+			timeout:      10 * time.Millisecond,
+			wantAttempts: 1,
+			wantErr:      errTransient,
+		},
+		"delay past the deadline is not waited": {
+			policy:       NewRetryPolicy(3, time.Hour, time.Hour),
+			errs:         []error{errTransient, nil},
+			deadline:     time.Minute,
+			wantAttempts: 1,
+			wantErr:      errTransient,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+			if tc.timeout > 0 {
+				// cancelled rather than given a deadline, so that the wait is started and interrupted
+				time.AfterFunc(tc.timeout, cancel)
+			}
+			if tc.deadline > 0 {
+				ctx, cancel = context.WithTimeout(ctx, tc.deadline)
+				defer cancel()
+			}
+
+			attempts := 0
+			err := tc.policy.Do(ctx, "test", retryable, func() error {
+				err := tc.errs[attempts]
+				attempts++
+				return err
+			})
+			require.ErrorIs(t, err, tc.wantErr)
+			require.Equal(t, tc.wantAttempts, attempts)
+		})
+	}
+}
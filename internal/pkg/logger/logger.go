@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/blendle/zapdriver"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -65,8 +66,15 @@ func Extract(ctx context.Context) *zap.Logger {
 	return l.logger.With(fields...)
 }
 
+// TagsToFields returns the fields of the values in ctx, which are the IDs of the span the log belongs to.
 func TagsToFields(ctx context.Context) []zapcore.Field {
 	fields := []zapcore.Field{}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		fields = append(fields,
+			zap.String("trace_id", spanContext.TraceID().String()),
+			zap.String("span_id", spanContext.SpanID().String()),
+		)
+	}
 	return fields
 }
 
@@ -0,0 +1,35 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+const DefaultByteLength = 32
+
+// Generate is a variable function for token generation (mockable)
+var Generate = func(byteLength int) (string, error) {
+	bytes := make([]byte, byteLength)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// Hash returns the hex encoded SHA-256 digest of the token.
+// Only the hash is stored, so that a leaked row cannot be used as a token.
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Mock replaces Generate with a function that returns a fixed value
+func Mock(mockToken string) string {
+	Generate = func(_ int) (string, error) {
+		return mockToken, nil
+	}
+	return mockToken
+}
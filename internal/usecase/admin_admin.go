@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+type AdminAdminInteractor interface {
+	Get(
+		ctx context.Context,
+		param *input.AdminGetAdmin,
+	) (*model.Admin, error)
+	List(
+		ctx context.Context,
+		param *input.AdminListAdmins,
+	) (*output.ListAdmins, error)
+	Create(
+		ctx context.Context,
+		param *input.AdminCreateAdmin,
+	) (*output.AdminCreateAdmin, error)
+	Update(
+		ctx context.Context,
+		param *input.AdminUpdateAdmin,
+	) (*model.Admin, error)
+	UpdateRole(
+		ctx context.Context,
+		param *input.AdminUpdateAdminRole,
+	) (*model.Admin, error)
+	Delete(
+		ctx context.Context,
+		param *input.AdminDeleteAdmin,
+	) error
+}
@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/password"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+)
+
+type adminAdminInteractor struct {
+	transactable                  repository.Transactable
+	adminRepository               repository.Admin
+	auditLogRepository            repository.AuditLog
+	adminAuthenticationRepository repository.AdminAuthentication
+}
+
+func NewAdminAdminInteractor(
+	transactable repository.Transactable,
+	adminRepository repository.Admin,
+	auditLogRepository repository.AuditLog,
+	adminAuthenticationRepository repository.AdminAuthentication,
+) AdminAdminInteractor {
+	return &adminAdminInteractor{
+		transactable:                  transactable,
+		adminRepository:               adminRepository,
+		auditLogRepository:            auditLogRepository,
+		adminAuthenticationRepository: adminAuthenticationRepository,
+	}
+}
+
+func (i *adminAdminInteractor) Get(
+	ctx context.Context,
+	param *input.AdminGetAdmin,
+) (*model.Admin, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	return i.adminRepository.Get(ctx, repository.GetAdminQuery{
+		ID: null.StringFrom(param.TargetAdminID),
+		BaseGetOptions: repository.BaseGetOptions{
+			OrFail: true,
+		},
+	})
+}
+
+func (i *adminAdminInteractor) List(
+	ctx context.Context,
+	param *input.AdminListAdmins,
+) (*output.ListAdmins, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := repository.ListAdminsQuery{
+		BaseListOptions: repository.BaseListOptions{
+			Page:  null.Uint64From(param.Page),
+			Limit: null.Uint64From(param.Limit),
+		},
+		Role:    param.Role,
+		SortKey: nullable.TypeFrom(param.SortKey),
+	}
+
+	admins, err := i.adminRepository.List(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := i.adminRepository.Count(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewAdminListAdmins(
+		admins,
+		model.NewPagination(
+			param.Page,
+			param.Limit,
+			totalCount,
+		),
+	), nil
+}
+
+func (i *adminAdminInteractor) Create(
+	ctx context.Context,
+	param *input.AdminCreateAdmin,
+) (*output.AdminCreateAdmin, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	generatedPassword, err := password.Generate(password.DefaultLength)
+	if err != nil {
+		return nil, errors.InternalErr.Wrap(err)
+	}
+
+	var admin *model.Admin
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		existing, err := i.adminRepository.Get(ctx, repository.GetAdminQuery{
+			Email: null.StringFrom(param.Email),
+		})
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return errors.AdminAlreadyExistsErr.New().
+				WithValue("email", param.Email)
+		}
+
+		res, err := i.adminAuthenticationRepository.GetUserByEmail(ctx, param.Email)
+		if err != nil {
+			return err
+		}
+		// Reuse the auth user when it already exists (e.g. a previously deleted admin),
+		// resetting its password so that the generated password returned works.
+		authUID := res.AuthUID
+		if res.Exist {
+			if err := i.adminAuthenticationRepository.UpdatePassword(ctx, authUID, param.Email, generatedPassword); err != nil {
+				return err
+			}
+		} else {
+			authUID, err = i.adminAuthenticationRepository.CreateUser(
+				ctx,
+				repository.AdminAuthenticationCreateUserParam{
+					Email:    param.Email,
+					Password: null.StringFrom(generatedPassword),
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		admin = model.NewAdmin(
+			param.Role,
+			authUID,
+			param.Email,
+			param.DisplayName,
+			param.RequestTime,
+		)
+		if err := i.adminRepository.Create(ctx, admin); err != nil {
+			return err
+		}
+		if err := i.createAuditLog(ctx, param.Actor, admin, nil, admin.AuditLogSnapshot(), param.RequestTime); err != nil {
+			return err
+		}
+
+		return i.storeClaims(ctx, admin)
+	}); err != nil {
+		return nil, err
+	}
+
+	return output.NewAdminCreateAdmin(admin, generatedPassword), nil
+}
+
+func (i *adminAdminInteractor) Update(
+	ctx context.Context,
+	param *input.AdminUpdateAdmin,
+) (*model.Admin, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	var admin *model.Admin
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		admin, err = i.adminRepository.Get(ctx, repository.GetAdminQuery{
+			ID: null.StringFrom(param.TargetAdminID),
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail:    true,
+				ForUpdate: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		before := admin.AuditLogSnapshot()
+		admin.Update(param.DisplayName, param.RequestTime)
+
+		if err := i.adminRepository.Update(ctx, admin); err != nil {
+			return err
+		}
+
+		return i.createAuditLog(ctx, param.Actor, admin, before, admin.AuditLogSnapshot(), param.RequestTime)
+	}); err != nil {
+		return nil, err
+	}
+
+	return admin, nil
+}
+
+func (i *adminAdminInteractor) UpdateRole(
+	ctx context.Context,
+	param *input.AdminUpdateAdminRole,
+) (*model.Admin, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	var admin *model.Admin
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		admin, err = i.adminRepository.Get(ctx, repository.GetAdminQuery{
+			ID: null.StringFrom(param.TargetAdminID),
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail:    true,
+				ForUpdate: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		before := admin.AuditLogSnapshot()
+		admin.UpdateRole(param.Role, param.RequestTime)
+
+		if err := i.adminRepository.Update(ctx, admin); err != nil {
+			return err
+		}
+		if err := i.createAuditLog(ctx, param.Actor, admin, before, admin.AuditLogSnapshot(), param.RequestTime); err != nil {
+			return err
+		}
+
+		// Keep the role in the ID token claims in sync with the database.
+		return i.storeClaims(ctx, admin)
+	}); err != nil {
+		return nil, err
+	}
+
+	return admin, nil
+}
+
+func (i *adminAdminInteractor) Delete(
+	ctx context.Context,
+	param *input.AdminDeleteAdmin,
+) error {
+	if err := param.Validate(); err != nil {
+		return err
+	}
+
+	return i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		admin, err := i.adminRepository.Get(ctx, repository.GetAdminQuery{
+			ID: null.StringFrom(param.TargetAdminID),
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail:    true,
+				ForUpdate: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := i.adminRepository.Delete(ctx, admin.ID); err != nil {
+			return err
+		}
+		if err := i.createAuditLog(ctx, param.Actor, admin, admin.AuditLogSnapshot(), nil, param.RequestTime); err != nil {
+			return err
+		}
+
+		return i.adminAuthenticationRepository.DeleteUser(ctx, admin.AuthUID, admin.Email)
+	})
+}
+
+func (i *adminAdminInteractor) createAuditLog(
+	ctx context.Context,
+	actor model.AuditLogActor,
+	admin *model.Admin,
+	before model.AuditLogSnapshot,
+	after model.AuditLogSnapshot,
+	t time.Time,
+) error {
+	return i.auditLogRepository.Create(ctx, model.NewAuditLog(
+		actor,
+		null.String{},
+		model.AuditLogTargetTypeAdmin,
+		admin.ID,
+		before,
+		after,
+		t,
+	))
+}
+
+func (i *adminAdminInteractor) storeClaims(
+	ctx context.Context,
+	admin *model.Admin,
+) error {
+	claims := model.NewAdminClaims(
+		admin.AuthUID,
+		admin.Email,
+		null.StringFrom(admin.ID),
+		nullable.TypeFrom(admin.Role),
+	)
+	return i.adminAuthenticationRepository.StoreClaims(ctx, admin.AuthUID, claims)
+}
@@ -0,0 +1,719 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminAdminInteractor_Get(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		adminID string
+	}
+
+	type want struct {
+		admin          *model.Admin
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase AdminAdminInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"invalid argument": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return testcase{
+				args: args{},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mock_repository.NewMockAdmin(ctrl),
+					adminAuthenticationRepository: mock_repository.NewMockAdminAuthentication(ctrl),
+				},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"not found": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			admin := testdata.Admin
+
+			mockAdminRepo := mock_repository.NewMockAdmin(ctrl)
+			mockAdminRepo.EXPECT().
+				Get(gomock.Any(), repository.GetAdminQuery{
+					BaseGetOptions: repository.BaseGetOptions{
+						OrFail: true,
+					},
+					ID: null.StringFrom(admin.ID),
+				}).
+				Return(nil, errors.AdminNotFoundErr)
+
+			return testcase{
+				args: args{
+					adminID: admin.ID,
+				},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mockAdminRepo,
+					adminAuthenticationRepository: mock_repository.NewMockAdminAuthentication(ctrl),
+				},
+				want: want{
+					expectedResult: errors.AdminNotFoundErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			admin := testdata.Admin
+
+			mockAdminRepo := mock_repository.NewMockAdmin(ctrl)
+			mockAdminRepo.EXPECT().
+				Get(gomock.Any(), repository.GetAdminQuery{
+					BaseGetOptions: repository.BaseGetOptions{
+						OrFail: true,
+					},
+					ID: null.StringFrom(admin.ID),
+				}).
+				Return(admin, nil)
+
+			return testcase{
+				args: args{
+					adminID: admin.ID,
+				},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mockAdminRepo,
+					adminAuthenticationRepository: mock_repository.NewMockAdminAuthentication(ctrl),
+				},
+				want: want{
+					admin: admin,
+				},
+			}
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.Get(ctx, input.NewAdminGetAdmin(
+				tc.args.adminID,
+				time.Now(),
+			))
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.admin, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
+
+func TestAdminAdminInteractor_List(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		role nullable.Type[model.AdminRole]
+	}
+
+	type want struct {
+		output         *output.ListAdmins
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase AdminAdminInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"invalid role": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return testcase{
+				args: args{
+					role: nullable.TypeFrom(model.AdminRoleUnknown),
+				},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mock_repository.NewMockAdmin(ctrl),
+					adminAuthenticationRepository: mock_repository.NewMockAdminAuthentication(ctrl),
+				},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			admin := testdata.Admin
+
+			query := repository.ListAdminsQuery{
+				BaseListOptions: repository.BaseListOptions{
+					Page:  null.Uint64From(1),
+					Limit: null.Uint64From(30),
+				},
+				Role:    nullable.TypeFrom(model.AdminRoleRoot),
+				SortKey: nullable.TypeFrom(model.AdminSortKeyCreatedAtDesc),
+			}
+
+			mockAdminRepo := mock_repository.NewMockAdmin(ctrl)
+			mockAdminRepo.EXPECT().
+				List(gomock.Any(), query).
+				Return(model.Admins{admin}, nil)
+			mockAdminRepo.EXPECT().
+				Count(gomock.Any(), query).
+				Return(uint64(1), nil)
+
+			return testcase{
+				args: args{
+					role: nullable.TypeFrom(model.AdminRoleRoot),
+				},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mockAdminRepo,
+					adminAuthenticationRepository: mock_repository.NewMockAdminAuthentication(ctrl),
+				},
+				want: want{
+					output: output.NewAdminListAdmins(
+						model.Admins{admin},
+						model.NewPagination(1, 30, 1),
+					),
+				},
+			}
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.List(ctx, input.NewAdminListAdmins(
+				0,
+				0,
+				tc.args.role,
+				nullable.Type[model.AdminSortKey]{},
+				time.Now(),
+			))
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.output, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
+
+func TestAdminAdminInteractor_Create(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		email       string
+		displayName string
+		role        model.AdminRole
+		actor       model.AuditLogActor
+		requestTime time.Time
+	}
+
+	type want struct {
+		admin          *model.Admin
+		password       *string
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase AdminAdminInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"invalid role": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return testcase{
+				args: args{
+					email:       "admin@example.com",
+					displayName: "Admin",
+					role:        model.AdminRoleUnknown,
+					requestTime: time.Now(),
+				},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mock_repository.NewMockAdmin(ctrl),
+					adminAuthenticationRepository: mock_repository.NewMockAdminAuthentication(ctrl),
+				},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"already exists": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			existing := testdata.Admin
+
+			mockAdminRepo := mock_repository.NewMockAdmin(ctrl)
+			mockAdminRepo.EXPECT().
+				Get(gomock.Any(), repository.GetAdminQuery{
+					Email: null.StringFrom("admin@example.com"),
+				}).
+				Return(existing, nil)
+
+			return testcase{
+				args: args{
+					email:       "admin@example.com",
+					displayName: "Admin",
+					role:        model.AdminRoleNormal,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mockAdminRepo,
+					adminAuthenticationRepository: mock_repository.NewMockAdminAuthentication(ctrl),
+				},
+				want: want{
+					expectedResult: errors.AdminAlreadyExistsErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			requestTime := testdata.RequestTime
+			authUID := "test-auth-uid"
+			email := "admin@example.com"
+			displayName := "Admin"
+			mockID := id.Mock()
+
+			admin := model.NewAdmin(
+				model.AdminRoleNormal,
+				authUID,
+				email,
+				displayName,
+				requestTime,
+			)
+			admin.ID = mockID
+
+			mockAdminRepo := mock_repository.NewMockAdmin(ctrl)
+			mockAdminRepo.EXPECT().
+				Get(gomock.Any(), repository.GetAdminQuery{
+					Email: null.StringFrom(email),
+				}).
+				Return(nil, nil)
+			mockAdminRepo.EXPECT().
+				Create(gomock.Any(), admin).
+				Return(nil)
+
+			mockAdminAuthRepo := mock_repository.NewMockAdminAuthentication(ctrl)
+			mockAdminAuthRepo.EXPECT().
+				GetUserByEmail(gomock.Any(), email).
+				Return(&repository.AdminAuthenticationGetUserByEmailResult{
+					Exist: false,
+				}, nil)
+			mockAdminAuthRepo.EXPECT().
+				CreateUser(gomock.Any(), gomock.Any()).
+				Return(authUID, nil)
+			mockAdminAuthRepo.EXPECT().
+				StoreClaims(gomock.Any(), authUID, model.NewAdminClaims(
+					authUID,
+					email,
+					null.StringFrom(mockID),
+					nullable.TypeFrom(model.AdminRoleNormal),
+				)).
+				Return(nil)
+
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					email:       email,
+					displayName: displayName,
+					role:        model.AdminRoleNormal,
+					actor:       model.NewAdminAuditLogActor(testdata.Admin.ID, "/rapid.admin_api.v1.AdminV1Service/CreateAdmin"),
+					requestTime: requestTime,
+				},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mockAdminRepo,
+					auditLogRepository:            mockAuditLogRepo,
+					adminAuthenticationRepository: mockAdminAuthRepo,
+				},
+				want: want{
+					admin: admin,
+				},
+			}
+		},
+		"success with reused auth user": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			requestTime := testdata.RequestTime
+			authUID := "deleted-admin-auth-uid"
+			email := "admin@example.com"
+			displayName := "Admin"
+			mockID := id.Mock()
+
+			admin := model.NewAdmin(
+				model.AdminRoleNormal,
+				authUID,
+				email,
+				displayName,
+				requestTime,
+			)
+			admin.ID = mockID
+
+			mockAdminRepo := mock_repository.NewMockAdmin(ctrl)
+			mockAdminRepo.EXPECT().
+				Get(gomock.Any(), repository.GetAdminQuery{
+					Email: null.StringFrom(email),
+				}).
+				Return(nil, nil)
+			mockAdminRepo.EXPECT().
+				Create(gomock.Any(), admin).
+				Return(nil)
+
+			var password string
+			mockAdminAuthRepo := mock_repository.NewMockAdminAuthentication(ctrl)
+			mockAdminAuthRepo.EXPECT().
+				GetUserByEmail(gomock.Any(), email).
+				Return(&repository.AdminAuthenticationGetUserByEmailResult{
+					AuthUID: authUID,
+					Exist:   true,
+				}, nil)
+			mockAdminAuthRepo.EXPECT().
+				UpdatePassword(gomock.Any(), authUID, email, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, _ string, p string) error {
+					password = p
+					return nil
+				})
+			mockAdminAuthRepo.EXPECT().
+				StoreClaims(gomock.Any(), authUID, model.NewAdminClaims(
+					authUID,
+					email,
+					null.StringFrom(mockID),
+					nullable.TypeFrom(model.AdminRoleNormal),
+				)).
+				Return(nil)
+
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					email:       email,
+					displayName: displayName,
+					role:        model.AdminRoleNormal,
+					actor:       model.NewAdminAuditLogActor(testdata.Admin.ID, "/rapid.admin_api.v1.AdminV1Service/CreateAdmin"),
+					requestTime: requestTime,
+				},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mockAdminRepo,
+					auditLogRepository:            mockAuditLogRepo,
+					adminAuthenticationRepository: mockAdminAuthRepo,
+				},
+				want: want{
+					admin:    admin,
+					password: &password,
+				},
+			}
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.Create(ctx, input.NewAdminCreateAdmin(
+				tc.args.email,
+				tc.args.displayName,
+				tc.args.role,
+				tc.args.actor,
+				tc.args.requestTime,
+			))
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.admin, got.Admin)
+				require.NotEmpty(t, got.Password)
+				if tc.want.password != nil {
+					require.Equal(t, *tc.want.password, got.Password)
+				}
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
+
+func TestAdminAdminInteractor_UpdateRole(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		adminID       string
+		targetAdminID string
+		role          model.AdminRole
+		actor         model.AuditLogActor
+		requestTime   time.Time
+	}
+
+	type want struct {
+		admin          *model.Admin
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase AdminAdminInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"cannot operate self": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			admin := testdata.Admin
+
+			return testcase{
+				args: args{
+					adminID:       admin.ID,
+					targetAdminID: admin.ID,
+					role:          model.AdminRoleNormal,
+					requestTime:   testdata.RequestTime,
+				},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mock_repository.NewMockAdmin(ctrl),
+					adminAuthenticationRepository: mock_repository.NewMockAdminAuthentication(ctrl),
+				},
+				want: want{
+					expectedResult: errors.AdminCannotOperateSelfErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			admin := testdata.Admin
+			admin.Role = model.AdminRoleNormal
+			requestTime := testdata.RequestTime.Add(time.Minute)
+
+			updated := &model.Admin{}
+			factory.CloneValue(admin, updated)
+			updated.UpdateRole(model.AdminRoleRoot, requestTime)
+
+			mockAdminRepo := mock_repository.NewMockAdmin(ctrl)
+			mockAdminRepo.EXPECT().
+				Get(gomock.Any(), repository.GetAdminQuery{
+					BaseGetOptions: repository.BaseGetOptions{
+						OrFail:    true,
+						ForUpdate: true,
+					},
+					ID: null.StringFrom(admin.ID),
+				}).
+				Return(admin, nil)
+			mockAdminRepo.EXPECT().
+				Update(gomock.Any(), updated).
+				Return(nil)
+
+			mockAdminAuthRepo := mock_repository.NewMockAdminAuthentication(ctrl)
+			mockAdminAuthRepo.EXPECT().
+				StoreClaims(gomock.Any(), admin.AuthUID, model.NewAdminClaims(
+					admin.AuthUID,
+					admin.Email,
+					null.StringFrom(admin.ID),
+					nullable.TypeFrom(model.AdminRoleRoot),
+				)).
+				Return(nil)
+
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					adminID:       "requester",
+					targetAdminID: admin.ID,
+					role:          model.AdminRoleRoot,
+					actor:         model.NewAdminAuditLogActor("requester", "/rapid.admin_api.v1.AdminV1Service/UpdateAdminRole"),
+					requestTime:   requestTime,
+				},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mockAdminRepo,
+					auditLogRepository:            mockAuditLogRepo,
+					adminAuthenticationRepository: mockAdminAuthRepo,
+				},
+				want: want{
+					admin: updated,
+				},
+			}
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.UpdateRole(ctx, input.NewAdminUpdateAdminRole(
+				tc.args.adminID,
+				tc.args.targetAdminID,
+				tc.args.role,
+				tc.args.actor,
+				tc.args.requestTime,
+			))
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.admin, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
+
+func TestAdminAdminInteractor_Delete(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		adminID       string
+		targetAdminID string
+		actor         model.AuditLogActor
+	}
+
+	type want struct {
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase AdminAdminInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"cannot operate self": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return testcase{
+				args: args{
+					adminID:       "self",
+					targetAdminID: "self",
+				},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mock_repository.NewMockAdmin(ctrl),
+					adminAuthenticationRepository: mock_repository.NewMockAdminAuthentication(ctrl),
+				},
+				want: want{
+					expectedResult: errors.AdminCannotOperateSelfErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			admin := testdata.Admin
+
+			mockAdminRepo := mock_repository.NewMockAdmin(ctrl)
+			mockAdminRepo.EXPECT().
+				Get(gomock.Any(), repository.GetAdminQuery{
+					BaseGetOptions: repository.BaseGetOptions{
+						OrFail:    true,
+						ForUpdate: true,
+					},
+					ID: null.StringFrom(admin.ID),
+				}).
+				Return(admin, nil)
+			mockAdminRepo.EXPECT().
+				Delete(gomock.Any(), admin.ID).
+				Return(nil)
+
+			mockAdminAuthRepo := mock_repository.NewMockAdminAuthentication(ctrl)
+			mockAdminAuthRepo.EXPECT().
+				DeleteUser(gomock.Any(), admin.AuthUID, admin.Email).
+				Return(nil)
+
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					adminID:       "requester",
+					targetAdminID: admin.ID,
+					actor:         model.NewAdminAuditLogActor("requester", "/rapid.admin_api.v1.AdminV1Service/DeleteAdmin"),
+				},
+				usecase: &adminAdminInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					adminRepository:               mockAdminRepo,
+					auditLogRepository:            mockAuditLogRepo,
+					adminAuthenticationRepository: mockAdminAuthRepo,
+				},
+				want: want{},
+			}
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			err := tc.usecase.Delete(ctx, input.NewAdminDeleteAdmin(
+				tc.args.adminID,
+				tc.args.targetAdminID,
+				tc.args.actor,
+				time.Now(),
+			))
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
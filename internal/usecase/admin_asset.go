@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
 	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
 )
@@ -13,4 +14,17 @@ type AdminAssetInteractor interface {
 		ctx context.Context,
 		param *input.AdminCreateAssetPresignedURL,
 	) (*output.AdminCreateAssetPresignedURL, error)
+	ConfirmUpload(
+		ctx context.Context,
+		param *input.AdminConfirmAssetUpload,
+	) (*model.Asset, error)
+	// Upload stores the streamed content as a new asset and confirms it.
+	Upload(
+		ctx context.Context,
+		param *input.AdminUploadAsset,
+	) (*model.Asset, error)
+	Download(
+		ctx context.Context,
+		param *input.AdminDownloadAsset,
+	) (*output.AdminDownloadAsset, error)
 }
@@ -4,19 +4,23 @@ import (
 	"context"
 
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
 	"github.com/abyssparanoia/rapid-go/internal/domain/service"
 	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
 	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
 )
 
 type adminAssetInteractor struct {
+	transactable repository.Transactable
 	assetService service.Asset
 }
 
 func NewAdminAssetInteractor(
+	transactable repository.Transactable,
 	assetService service.Asset,
 ) AdminAssetInteractor {
 	return &adminAssetInteractor{
+		transactable: transactable,
 		assetService: assetService,
 	}
 }
@@ -41,6 +45,86 @@ func (i *adminAssetInteractor) CreatePresignedURL(
 	}
 	return output.NewAdminCreateAssetPresignedURL(
 		got.AssetID,
-		got.PresignedURL,
+		got.PresignedUpload,
+	), nil
+}
+
+func (i *adminAssetInteractor) ConfirmUpload(
+	ctx context.Context,
+	param *input.AdminConfirmAssetUpload,
+) (*model.Asset, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+	var asset *model.Asset
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		asset, err = i.assetService.ConfirmUpload(
+			ctx,
+			param.AssetID,
+			model.NewAdminAssetAuthContext(param.AdminID),
+			param.RequestTime,
+		)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return asset, nil
+}
+
+func (i *adminAssetInteractor) Upload(
+	ctx context.Context,
+	param *input.AdminUploadAsset,
+) (*model.Asset, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+	authContext := model.NewAdminAssetAuthContext(param.AdminID)
+	// The content is streamed outside the transaction, which is only held to confirm the upload
+	asset, err := i.assetService.Upload(
+		ctx,
+		param.AssetType,
+		param.ContentType,
+		param.Checksum,
+		param.Content,
+		authContext,
+		param.RequestTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		asset, err = i.assetService.ConfirmUpload(
+			ctx,
+			asset.ID,
+			authContext,
+			param.RequestTime,
+		)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return asset, nil
+}
+
+func (i *adminAssetInteractor) Download(
+	ctx context.Context,
+	param *input.AdminDownloadAsset,
+) (*output.AdminDownloadAsset, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+	got, err := i.assetService.Download(
+		ctx,
+		param.AssetID,
+		model.NewAdminAssetAuthContext(param.AdminID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return output.NewAdminDownloadAsset(
+		got.Asset,
+		got.Content,
 	), nil
 }
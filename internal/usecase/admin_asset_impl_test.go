@@ -1,13 +1,18 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
 	"github.com/abyssparanoia/rapid-go/internal/domain/service"
 	mock_service "github.com/abyssparanoia/rapid-go/internal/domain/service/mock"
 	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
@@ -89,11 +94,16 @@ func TestAdminAssetInteractor_CreatePresignedURL(t *testing.T) {
 			contentType := model.ContentTypeImagePNG
 			assetType := model.AssetTypeUserImage
 			assetID := "test-asset-id"
-			presignedURL := "https://example.com/presigned-url"
+			presignedUpload := &model.AssetPresignedUpload{
+				Method:  http.MethodPut,
+				URL:     "https://example.com/presigned-url",
+				Fields:  map[string]string{},
+				Headers: map[string]string{},
+			}
 
 			serviceResult := &service.AssetCreatePresignedURLResult{
-				AssetID:      assetID,
-				PresignedURL: presignedURL,
+				AssetID:         assetID,
+				PresignedUpload: presignedUpload,
 			}
 
 			mockAssetService := mock_service.NewMockAsset(ctrl)
@@ -112,7 +122,7 @@ func TestAdminAssetInteractor_CreatePresignedURL(t *testing.T) {
 					assetService: mockAssetService,
 				},
 				want: want{
-					result: output.NewAdminCreateAssetPresignedURL(assetID, presignedURL),
+					result: output.NewAdminCreateAssetPresignedURL(assetID, presignedUpload),
 				},
 			}
 		},
@@ -143,3 +153,345 @@ func TestAdminAssetInteractor_CreatePresignedURL(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminAssetInteractor_ConfirmUpload(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		adminID     string
+		assetID     string
+		requestTime time.Time
+	}
+
+	type want struct {
+		result         *model.Asset
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase AdminAssetInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"invalid argument": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+
+			return testcase{
+				args: args{
+					adminID:     testdata.Admin.ID,
+					assetID:     "",
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminAssetInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"service error": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				ConfirmUpload(gomock.Any(), asset.ID, model.NewAdminAssetAuthContext(testdata.Admin.ID), testdata.RequestTime).
+				Return(nil, errors.AssetNotUploadedErr.New())
+
+			return testcase{
+				args: args{
+					adminID:     testdata.Admin.ID,
+					assetID:     asset.ID,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminAssetInteractor{
+					transactable: mock_repository.TestMockTransactable(),
+					assetService: mockAssetService,
+				},
+				want: want{
+					expectedResult: errors.AssetNotUploadedErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			asset.Status = model.AssetStatusUploaded
+
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				ConfirmUpload(gomock.Any(), asset.ID, model.NewAdminAssetAuthContext(testdata.Admin.ID), testdata.RequestTime).
+				Return(asset, nil)
+
+			return testcase{
+				args: args{
+					adminID:     testdata.Admin.ID,
+					assetID:     asset.ID,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminAssetInteractor{
+					transactable: mock_repository.TestMockTransactable(),
+					assetService: mockAssetService,
+				},
+				want: want{
+					result: asset,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.ConfirmUpload(ctx, input.NewAdminConfirmAssetUpload(
+				tc.args.adminID,
+				tc.args.assetID,
+				tc.args.requestTime,
+			))
+
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.result, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
+
+func TestAdminAssetInteractor_Upload(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		adminID     string
+		contentType model.ContentType
+		assetType   model.AssetType
+		checksum    string
+		content     io.Reader
+		requestTime time.Time
+	}
+
+	type want struct {
+		result         *model.Asset
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase AdminAssetInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	checksum := strings.Repeat("0", 64)
+
+	tests := map[string]testcaseFunc{
+		"invalid argument": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+
+			return testcase{
+				args: args{
+					adminID:     testdata.Admin.ID,
+					contentType: asset.ContentType,
+					assetType:   asset.Type,
+					checksum:    "not a checksum",
+					content:     bytes.NewReader(nil),
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminAssetInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"upload error": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			content := bytes.NewReader([]byte("content"))
+
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				Upload(gomock.Any(), asset.Type, asset.ContentType, checksum, content, model.NewAdminAssetAuthContext(testdata.Admin.ID), testdata.RequestTime).
+				Return(nil, errors.AssetInvalidErr.New())
+
+			return testcase{
+				args: args{
+					adminID:     testdata.Admin.ID,
+					contentType: asset.ContentType,
+					assetType:   asset.Type,
+					checksum:    checksum,
+					content:     content,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminAssetInteractor{
+					transactable: mock_repository.TestMockTransactable(),
+					assetService: mockAssetService,
+				},
+				want: want{
+					expectedResult: errors.AssetInvalidErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			content := bytes.NewReader([]byte("content"))
+			authContext := model.NewAdminAssetAuthContext(testdata.Admin.ID)
+			confirmed := *asset
+			confirmed.Status = model.AssetStatusUploaded
+
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				Upload(gomock.Any(), asset.Type, asset.ContentType, checksum, content, authContext, testdata.RequestTime).
+				Return(asset, nil)
+			mockAssetService.EXPECT().
+				ConfirmUpload(gomock.Any(), asset.ID, authContext, testdata.RequestTime).
+				Return(&confirmed, nil)
+
+			return testcase{
+				args: args{
+					adminID:     testdata.Admin.ID,
+					contentType: asset.ContentType,
+					assetType:   asset.Type,
+					checksum:    checksum,
+					content:     content,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminAssetInteractor{
+					transactable: mock_repository.TestMockTransactable(),
+					assetService: mockAssetService,
+				},
+				want: want{
+					result: &confirmed,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.Upload(ctx, input.NewAdminUploadAsset(
+				tc.args.adminID,
+				tc.args.contentType,
+				tc.args.assetType,
+				tc.args.checksum,
+				tc.args.content,
+				tc.args.requestTime,
+			))
+
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.result, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
+
+func TestAdminAssetInteractor_Download(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		adminID string
+		assetID string
+	}
+
+	type want struct {
+		result         *output.AdminDownloadAsset
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase AdminAssetInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"invalid argument": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+
+			return testcase{
+				args: args{
+					adminID: testdata.Admin.ID,
+					assetID: "",
+				},
+				usecase: &adminAssetInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			asset := testdata.Asset
+			asset.Status = model.AssetStatusUploaded
+			content := io.NopCloser(bytes.NewReader([]byte("content")))
+
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				Download(gomock.Any(), asset.ID, model.NewAdminAssetAuthContext(testdata.Admin.ID)).
+				Return(&service.AssetDownloadResult{
+					Asset:   asset,
+					Content: content,
+				}, nil)
+
+			return testcase{
+				args: args{
+					adminID: testdata.Admin.ID,
+					assetID: asset.ID,
+				},
+				usecase: &adminAssetInteractor{
+					assetService: mockAssetService,
+				},
+				want: want{
+					result: output.NewAdminDownloadAsset(asset, content),
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.Download(ctx, input.NewAdminDownloadAsset(
+				tc.args.adminID,
+				tc.args.assetID,
+			))
+
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.result, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+)
+
+type adminAuditLogInteractor struct {
+	auditLogRepository repository.AuditLog
+}
+
+func NewAdminAuditLogInteractor(
+	auditLogRepository repository.AuditLog,
+) AdminAuditLogInteractor {
+	return &adminAuditLogInteractor{
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+func (i *adminAuditLogInteractor) List(
+	ctx context.Context,
+	param *input.AdminListAuditLogs,
+) (*output.ListAuditLogs, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := repository.ListAuditLogsQuery{
+		BaseListOptions: repository.BaseListOptions{
+			Page:  null.Uint64From(param.Page),
+			Limit: null.Uint64From(param.Limit),
+		},
+		TenantID:   param.TenantID,
+		ActorType:  param.ActorType,
+		ActorID:    param.ActorID,
+		TargetType: param.TargetType,
+		TargetID:   param.TargetID,
+	}
+
+	auditLogs, err := i.auditLogRepository.List(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := i.auditLogRepository.Count(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewAdminListAuditLogs(
+		auditLogs,
+		model.NewPagination(
+			param.Page,
+			param.Limit,
+			totalCount,
+		),
+	), nil
+}
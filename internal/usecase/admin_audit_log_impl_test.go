@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminAuditLogInteractor_List(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		tenantID   null.String
+		actorType  nullable.Type[model.AuditLogActorType]
+		targetType nullable.Type[model.AuditLogTargetType]
+	}
+
+	type want struct {
+		output         *output.ListAuditLogs
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase AdminAuditLogInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"invalid actor type": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return testcase{
+				args: args{
+					actorType: nullable.TypeFrom(model.AuditLogActorTypeUnknown),
+				},
+				usecase: &adminAuditLogInteractor{
+					auditLogRepository: mock_repository.NewMockAuditLog(ctrl),
+				},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"invalid target type": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return testcase{
+				args: args{
+					targetType: nullable.TypeFrom(model.AuditLogTargetTypeUnknown),
+				},
+				usecase: &adminAuditLogInteractor{
+					auditLogRepository: mock_repository.NewMockAuditLog(ctrl),
+				},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+			auditLog := model.NewAuditLog(
+				model.NewAdminAuditLogActor(testdata.Admin.ID, "/rapid.admin_api.v1.AdminV1Service/UpdateTenant"),
+				null.StringFrom(tenant.ID),
+				model.AuditLogTargetTypeTenant,
+				tenant.ID,
+				model.AuditLogSnapshot{"name": "before"},
+				model.AuditLogSnapshot{"name": "after"},
+				testdata.RequestTime,
+			)
+
+			query := repository.ListAuditLogsQuery{
+				BaseListOptions: repository.BaseListOptions{
+					Page:  null.Uint64From(1),
+					Limit: null.Uint64From(30),
+				},
+				TenantID:   null.StringFrom(tenant.ID),
+				TargetType: nullable.TypeFrom(model.AuditLogTargetTypeTenant),
+			}
+
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				List(gomock.Any(), query).
+				Return(model.AuditLogs{auditLog}, nil)
+			mockAuditLogRepo.EXPECT().
+				Count(gomock.Any(), query).
+				Return(uint64(1), nil)
+
+			return testcase{
+				args: args{
+					tenantID:   null.StringFrom(tenant.ID),
+					targetType: nullable.TypeFrom(model.AuditLogTargetTypeTenant),
+				},
+				usecase: &adminAuditLogInteractor{
+					auditLogRepository: mockAuditLogRepo,
+				},
+				want: want{
+					output: output.NewAdminListAuditLogs(
+						model.AuditLogs{auditLog},
+						model.NewPagination(1, 30, 1),
+					),
+				},
+			}
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.List(ctx, input.NewAdminListAuditLogs(
+				0,
+				0,
+				tc.args.tenantID,
+				tc.args.actorType,
+				null.String{},
+				tc.args.targetType,
+				null.String{},
+				time.Now(),
+			))
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.output, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
@@ -15,17 +15,21 @@ import (
 )
 
 type adminStaffInteractor struct {
-	transactable     repository.Transactable
-	tenantRepository repository.Tenant
-	staffRepository  repository.Staff
-	staffService     service.Staff
-	assetService     service.Asset
+	transactable          repository.Transactable
+	tenantRepository      repository.Tenant
+	staffRepository       repository.Staff
+	auditLogRepository    repository.AuditLog
+	outboxEventRepository repository.OutboxEvent
+	staffService          service.Staff
+	assetService          service.Asset
 }
 
 func NewAdminStaffInteractor(
 	transactable repository.Transactable,
 	tenantRepository repository.Tenant,
 	staffRepository repository.Staff,
+	auditLogRepository repository.AuditLog,
+	outboxEventRepository repository.OutboxEvent,
 	staffService service.Staff,
 	assetService service.Asset,
 ) AdminStaffInteractor {
@@ -33,6 +37,8 @@ func NewAdminStaffInteractor(
 		transactable,
 		tenantRepository,
 		staffRepository,
+		auditLogRepository,
+		outboxEventRepository,
 		staffService,
 		assetService,
 	}
@@ -79,7 +85,27 @@ func (i *adminStaffInteractor) List(
 			Limit:   null.Uint64From(param.Limit),
 			Preload: true,
 		},
-		SortKey: nullable.TypeFrom(param.SortKey),
+		SortKey:        nullable.TypeFrom(param.SortKey),
+		IncludeDeleted: param.IncludeDeleted,
+		DisplayName:    param.DisplayName,
+		Email:          param.Email,
+		Role:           param.Role,
+		CreatedAtFrom:  param.CreatedAtFrom,
+		CreatedAtTo:    param.CreatedAtTo,
+	}
+
+	if param.Cursor.Valid {
+		// Fetch one extra row to know whether a next page exists.
+		query.Page = null.Uint64{}
+		query.Limit = null.Uint64From(param.Limit + 1)
+		query.Keyset = true
+		if param.Cursor.String != "" {
+			cursor, err := model.ParseCursor(param.Cursor.String, param.SortKey.String())
+			if err != nil {
+				return nil, err
+			}
+			query.Cursor = nullable.TypeFrom(*cursor)
+		}
 	}
 
 	staffs, err := i.staffRepository.List(ctx, query)
@@ -87,22 +113,35 @@ func (i *adminStaffInteractor) List(
 		return nil, err
 	}
 
+	var nextCursor null.String
+	if param.Cursor.Valid {
+		staffs, nextCursor = model.NextCursor(staffs, param.Limit, func(m *model.Staff) *model.Cursor {
+			return m.Cursor(param.SortKey)
+		})
+	}
+
 	if err = i.assetService.BatchSetStaffURLs(ctx, staffs, param.RequestTime); err != nil {
 		return nil, err
 	}
 
-	totalCount, err := i.staffRepository.Count(ctx, query)
-	if err != nil {
-		return nil, err
+	// Cursor pagination skips the count query unless it is requested.
+	var pagination *model.Pagination
+	if !param.Cursor.Valid || param.IncludeTotalCount {
+		totalCount, err := i.staffRepository.Count(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		if param.Cursor.Valid {
+			pagination = model.NewCursorPagination(param.Limit, totalCount, nextCursor.Valid)
+		} else {
+			pagination = model.NewPagination(param.Page, param.Limit, totalCount)
+		}
 	}
 
 	return output.NewAdminListStaffs(
 		staffs,
-		model.NewPagination(
-			param.Page,
-			param.Limit,
-			totalCount,
-		),
+		pagination,
+		nextCursor,
 	), nil
 }
 
@@ -138,12 +177,10 @@ func (i *adminStaffInteractor) Create(
 		}
 
 		authContext := model.NewAdminAssetAuthContext(param.AdminID)
-		var clearAssetPath func()
-		imagePath, clearAssetPath, err = i.assetService.GetWithValidate(ctx, model.AssetTypeUserImage, param.ImageAssetID, authContext)
+		imagePath, err = i.assetService.GetWithValidate(ctx, model.AssetTypeUserImage, param.ImageAssetID, authContext, param.RequestTime)
 		if err != nil {
 			return err
 		}
-		defer clearAssetPath()
 
 		staff, err = i.staffService.Create(
 			ctx,
@@ -161,7 +198,21 @@ func (i *adminStaffInteractor) Create(
 			return err
 		}
 
-		return nil
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			param.Actor,
+			null.StringFrom(staff.TenantID),
+			model.AuditLogTargetTypeStaff,
+			staff.ID,
+			nil,
+			staff.AuditLogSnapshot(),
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+
+		return i.outboxEventRepository.BatchCreate(ctx, model.NewOutboxEvents(
+			model.NewStaffCreatedEvent(staff, param.RequestTime),
+		))
 	})
 	if err != nil {
 		return nil, err
@@ -207,20 +258,24 @@ func (i *adminStaffInteractor) Update(
 		if err != nil {
 			return err
 		}
+		if err := staff.ValidateETag(param.ETag); err != nil {
+			return err
+		}
 
 		// Get image path if image_asset_id is provided
 		var imagePath null.String
 		if param.ImageAssetID.Valid {
 			authContext := model.NewAdminAssetAuthContext(param.AdminID)
-			path, clearAssetPath, err := i.assetService.GetWithValidate(ctx, model.AssetTypeUserImage, param.ImageAssetID.String, authContext)
+			path, err := i.assetService.GetWithValidate(ctx, model.AssetTypeUserImage, param.ImageAssetID.String, authContext, param.RequestTime)
 			if err != nil {
 				return err
 			}
-			defer clearAssetPath()
 			imagePath = null.StringFrom(path)
 		}
 
 		// Update via domain method
+		before := staff.AuditLogSnapshot()
+		beforeRole := staff.Role
 		staff.Update(
 			param.DisplayName,
 			param.Role,
@@ -232,7 +287,23 @@ func (i *adminStaffInteractor) Update(
 			return err
 		}
 
-		return nil
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			param.Actor,
+			null.StringFrom(staff.TenantID),
+			model.AuditLogTargetTypeStaff,
+			staff.ID,
+			before,
+			staff.AuditLogSnapshot(),
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+
+		events := model.DomainEvents{model.NewStaffUpdatedEvent(staff, param.RequestTime)}
+		if staff.Role != beforeRole {
+			events = append(events, model.NewStaffRoleChangedEvent(staff, beforeRole, param.RequestTime))
+		}
+		return i.outboxEventRepository.BatchCreate(ctx, model.NewOutboxEvents(events...))
 	}); err != nil {
 		return nil, err
 	}
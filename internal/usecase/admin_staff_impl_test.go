@@ -167,10 +167,17 @@ func TestAdminStaffInteractor_List(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		tenantID    string
-		page        uint64
-		limit       uint64
-		requestTime time.Time
+		tenantID          string
+		page              uint64
+		limit             uint64
+		cursor            null.String
+		includeTotalCount bool
+		displayName       null.String
+		email             null.String
+		role              nullable.Type[model.StaffRole]
+		createdAtFrom     null.Time
+		createdAtTo       null.Time
+		requestTime       time.Time
 	}
 
 	type want struct {
@@ -245,10 +252,228 @@ func TestAdminStaffInteractor_List(t *testing.T) {
 					output: output.NewAdminListStaffs(
 						model.Staffs{staff},
 						model.NewPagination(2, 30, 60),
+						null.String{},
+					),
+				},
+			}
+		},
+		"success with first cursor page": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			tenant := testdata.Tenant
+			nextStaff := &model.Staff{}
+			factory.CloneValue(staff, nextStaff)
+			nextStaff.ID = "next"
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				List(
+					gomock.Any(),
+					repository.ListStaffQuery{
+						TenantID: null.StringFrom(tenant.ID),
+						BaseListOptions: repository.BaseListOptions{
+							Limit:   null.Uint64From(2),
+							Keyset:  true,
+							Preload: true,
+						},
+						SortKey: nullable.TypeFrom(model.StaffSortKeyCreatedAtDesc),
+					}).
+				Return(model.Staffs{staff, nextStaff}, nil)
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockStaffService := mock_service.NewMockStaff(ctrl)
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				BatchSetStaffURLs(gomock.Any(), model.Staffs{staff}, gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					tenantID:    tenant.ID,
+					limit:       1,
+					cursor:      null.StringFrom(""),
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminStaffInteractor{
+					staffRepository:  mockStaffRepo,
+					tenantRepository: mockTenantRepo,
+					staffService:     mockStaffService,
+					assetService:     mockAssetService,
+				},
+				want: want{
+					output: output.NewAdminListStaffs(
+						model.Staffs{staff},
+						nil,
+						null.StringFrom(staff.Cursor(model.StaffSortKeyCreatedAtDesc).Encode()),
 					),
 				},
 			}
 		},
+		"success with cursor and total count": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			tenant := testdata.Tenant
+			cursor := staff.Cursor(model.StaffSortKeyCreatedAtDesc)
+
+			query := repository.ListStaffQuery{
+				TenantID: null.StringFrom(tenant.ID),
+				BaseListOptions: repository.BaseListOptions{
+					Limit:   null.Uint64From(2),
+					Keyset:  true,
+					Cursor:  nullable.TypeFrom(*cursor),
+					Preload: true,
+				},
+				SortKey: nullable.TypeFrom(model.StaffSortKeyCreatedAtDesc),
+			}
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				List(gomock.Any(), query).
+				Return(model.Staffs{staff}, nil)
+			mockStaffRepo.EXPECT().
+				Count(gomock.Any(), query).
+				Return(uint64(2), nil)
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockStaffService := mock_service.NewMockStaff(ctrl)
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				BatchSetStaffURLs(gomock.Any(), model.Staffs{staff}, gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					tenantID:          tenant.ID,
+					limit:             1,
+					cursor:            null.StringFrom(cursor.Encode()),
+					includeTotalCount: true,
+					requestTime:       testdata.RequestTime,
+				},
+				usecase: &adminStaffInteractor{
+					staffRepository:  mockStaffRepo,
+					tenantRepository: mockTenantRepo,
+					staffService:     mockStaffService,
+					assetService:     mockAssetService,
+				},
+				want: want{
+					output: output.NewAdminListStaffs(
+						model.Staffs{staff},
+						model.NewCursorPagination(1, 2, false),
+						null.String{},
+					),
+				},
+			}
+		},
+		"success with filters": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			tenant := testdata.Tenant
+			createdAtFrom := testdata.RequestTime.Add(-24 * time.Hour)
+			createdAtTo := testdata.RequestTime
+
+			query := repository.ListStaffQuery{
+				TenantID: null.StringFrom(tenant.ID),
+				BaseListOptions: repository.BaseListOptions{
+					Page:    null.Uint64From(1),
+					Limit:   null.Uint64From(30),
+					Preload: true,
+				},
+				SortKey:       nullable.TypeFrom(model.StaffSortKeyCreatedAtDesc),
+				DisplayName:   null.StringFrom("taro"),
+				Email:         null.StringFrom(staff.Email),
+				Role:          nullable.TypeFrom(model.StaffRoleAdmin),
+				CreatedAtFrom: null.TimeFrom(createdAtFrom),
+				CreatedAtTo:   null.TimeFrom(createdAtTo),
+			}
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				List(gomock.Any(), query).
+				Return(model.Staffs{staff}, nil)
+			mockStaffRepo.EXPECT().
+				Count(gomock.Any(), query).
+				Return(uint64(1), nil)
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				BatchSetStaffURLs(gomock.Any(), model.Staffs{staff}, gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					tenantID:      tenant.ID,
+					page:          1,
+					limit:         30,
+					displayName:   null.StringFrom("taro"),
+					email:         null.StringFrom(staff.Email),
+					role:          nullable.TypeFrom(model.StaffRoleAdmin),
+					createdAtFrom: null.TimeFrom(createdAtFrom),
+					createdAtTo:   null.TimeFrom(createdAtTo),
+					requestTime:   testdata.RequestTime,
+				},
+				usecase: &adminStaffInteractor{
+					staffRepository: mockStaffRepo,
+					assetService:    mockAssetService,
+				},
+				want: want{
+					output: output.NewAdminListStaffs(
+						model.Staffs{staff},
+						model.NewPagination(1, 30, 1),
+						null.String{},
+					),
+				},
+			}
+		},
+		"invalid role": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+
+			return testcase{
+				args: args{
+					tenantID:    testdata.Tenant.ID,
+					limit:       30,
+					role:        nullable.TypeFrom(model.StaffRoleUnknown),
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminStaffInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"invalid created at range": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+
+			return testcase{
+				args: args{
+					tenantID:      testdata.Tenant.ID,
+					limit:         30,
+					createdAtFrom: null.TimeFrom(testdata.RequestTime),
+					createdAtTo:   null.TimeFrom(testdata.RequestTime),
+					requestTime:   testdata.RequestTime,
+				},
+				usecase: &adminStaffInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"invalid cursor": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+
+			return testcase{
+				args: args{
+					tenantID:    tenant.ID,
+					limit:       30,
+					cursor:      null.StringFrom("invalid"),
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminStaffInteractor{
+					staffRepository:  mock_repository.NewMockStaff(ctrl),
+					tenantRepository: mock_repository.NewMockTenant(ctrl),
+					staffService:     mock_service.NewMockStaff(ctrl),
+					assetService:     mock_service.NewMockAsset(ctrl),
+				},
+				want: want{
+					expectedResult: errors.InvalidCursorErr,
+				},
+			}
+		},
 	}
 
 	for name, tc := range tests {
@@ -265,6 +490,14 @@ func TestAdminStaffInteractor_List(t *testing.T) {
 				tc.args.page,
 				tc.args.limit,
 				nullable.Type[model.StaffSortKey]{}, // Use empty nullable for default
+				tc.args.cursor,
+				tc.args.includeTotalCount,
+				false,
+				tc.args.displayName,
+				tc.args.email,
+				tc.args.role,
+				tc.args.createdAtFrom,
+				tc.args.createdAtTo,
 				tc.args.requestTime,
 			))
 			if tc.want.expectedResult == nil {
@@ -287,6 +520,7 @@ func TestAdminStaffInteractor_Create(t *testing.T) {
 		displayName  string
 		role         model.StaffRole
 		imageAssetID string
+		actor        model.AuditLogActor
 		requestTime  time.Time
 	}
 
@@ -345,8 +579,8 @@ func TestAdminStaffInteractor_Create(t *testing.T) {
 
 			mockAssetService := mock_service.NewMockAsset(ctrl)
 			mockAssetService.EXPECT().
-				GetWithValidate(gomock.Any(), model.AssetTypeUserImage, staff.ImagePath, model.NewAdminAssetAuthContext(admin.ID)).
-				Return(staff.ImagePath, func() {}, nil)
+				GetWithValidate(gomock.Any(), model.AssetTypeUserImage, staff.ImagePath, model.NewAdminAssetAuthContext(admin.ID), requestTime).
+				Return(staff.ImagePath, nil)
 
 			mockStaffService := mock_service.NewMockStaff(ctrl)
 			mockStaffService.EXPECT().
@@ -378,6 +612,18 @@ func TestAdminStaffInteractor_Create(t *testing.T) {
 				BatchSetStaffURLs(gomock.Any(), model.Staffs{staff}, gomock.Any()).
 				Return(nil)
 
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewStaffCreatedEvent(staff, requestTime),
+				)).
+				Return(nil)
+
 			return testcase{
 				args: args{
 					adminID:      admin.ID,
@@ -386,14 +632,17 @@ func TestAdminStaffInteractor_Create(t *testing.T) {
 					displayName:  staff.DisplayName,
 					role:         staff.Role,
 					imageAssetID: staff.ImagePath,
+					actor:        model.NewAdminAuditLogActor(testdata.Admin.ID, "/rapid.admin_api.v1.AdminV1Service/CreateStaff"),
 					requestTime:  requestTime,
 				},
 				usecase: &adminStaffInteractor{
-					transactable:     mock_repository.TestMockTransactable(),
-					staffRepository:  mockStaffRepo,
-					tenantRepository: mockTenantRepo,
-					staffService:     mockStaffService,
-					assetService:     mockAssetService,
+					transactable:          mock_repository.TestMockTransactable(),
+					staffRepository:       mockStaffRepo,
+					tenantRepository:      mockTenantRepo,
+					auditLogRepository:    mockAuditLogRepo,
+					outboxEventRepository: mockOutboxEventRepo,
+					staffService:          mockStaffService,
+					assetService:          mockAssetService,
 				},
 				want: want{
 					output: output.NewAdminCreateStaff(staff, mockPassword),
@@ -418,6 +667,7 @@ func TestAdminStaffInteractor_Create(t *testing.T) {
 				tc.args.displayName,
 				tc.args.role,
 				tc.args.imageAssetID,
+				tc.args.actor,
 				tc.args.requestTime,
 			))
 			if tc.want.expectedResult == nil {
@@ -439,6 +689,8 @@ func TestAdminStaffInteractor_Update(t *testing.T) {
 		displayName  null.String
 		role         nullable.Type[model.StaffRole]
 		imageAssetID null.String
+		etag         null.String
+		actor        model.AuditLogActor
 		requestTime  time.Time
 	}
 
@@ -500,12 +752,25 @@ func TestAdminStaffInteractor_Update(t *testing.T) {
 			mockStaffService := mock_service.NewMockStaff(ctrl)
 			mockAssetService := mock_service.NewMockAsset(ctrl)
 			mockAssetService.EXPECT().
-				GetWithValidate(gomock.Any(), model.AssetTypeUserImage, staff.ImagePath, model.NewAdminAssetAuthContext(admin.ID)).
-				Return(staff.ImagePath, func() {}, nil)
+				GetWithValidate(gomock.Any(), model.AssetTypeUserImage, staff.ImagePath, model.NewAdminAssetAuthContext(admin.ID), requestTime).
+				Return(staff.ImagePath, nil)
 			mockAssetService.EXPECT().
 				BatchSetStaffURLs(gomock.Any(), model.Staffs{staff}, gomock.Any()).
 				Return(nil)
 
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			id.Mock()
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewStaffUpdatedEvent(staff, requestTime),
+				)).
+				Return(nil)
+
 			return testcase{
 				args: args{
 					adminID:      admin.ID,
@@ -513,20 +778,133 @@ func TestAdminStaffInteractor_Update(t *testing.T) {
 					displayName:  null.StringFrom(staff.DisplayName),
 					role:         nullable.TypeFrom(staff.Role),
 					imageAssetID: null.StringFrom(staff.ImagePath),
+					actor:        model.NewAdminAuditLogActor(testdata.Admin.ID, "/rapid.admin_api.v1.AdminV1Service/UpdateStaff"),
 					requestTime:  requestTime,
 				},
 				usecase: &adminStaffInteractor{
-					transactable:     mock_repository.TestMockTransactable(),
-					staffRepository:  mockStaffRepo,
-					tenantRepository: mockTenantRepo,
-					staffService:     mockStaffService,
-					assetService:     mockAssetService,
+					transactable:          mock_repository.TestMockTransactable(),
+					staffRepository:       mockStaffRepo,
+					tenantRepository:      mockTenantRepo,
+					auditLogRepository:    mockAuditLogRepo,
+					outboxEventRepository: mockOutboxEventRepo,
+					staffService:          mockStaffService,
+					assetService:          mockAssetService,
 				},
 				want: want{
 					staff: staff,
 				},
 			}
 		},
+		"success with role change": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			requestTime := testdata.RequestTime
+			staff := testdata.Staff
+			staff.Role = model.StaffRoleNormal
+			admin := testdata.Admin
+			updatedStaff := &model.Staff{}
+			factory.CloneValue(staff, updatedStaff)
+			updatedStaff.Role = model.StaffRoleAdmin
+			updatedStaff.Version++
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(),
+					repository.GetStaffQuery{
+						ID: null.StringFrom(staff.ID),
+						BaseGetOptions: repository.BaseGetOptions{
+							OrFail:    true,
+							ForUpdate: true,
+						},
+					}).
+				Return(staff, nil)
+			mockStaffRepo.EXPECT().
+				Update(gomock.Any(), updatedStaff).
+				Return(nil)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(),
+					repository.GetStaffQuery{
+						ID: null.StringFrom(staff.ID),
+						BaseGetOptions: repository.BaseGetOptions{
+							OrFail:  true,
+							Preload: true,
+						},
+					}).
+				Return(updatedStaff, nil)
+
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				BatchSetStaffURLs(gomock.Any(), model.Staffs{updatedStaff}, gomock.Any()).
+				Return(nil)
+
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			id.Mock()
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewStaffUpdatedEvent(updatedStaff, requestTime),
+					model.NewStaffRoleChangedEvent(updatedStaff, model.StaffRoleNormal, requestTime),
+				)).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					adminID:     admin.ID,
+					staffID:     staff.ID,
+					role:        nullable.TypeFrom(model.StaffRoleAdmin),
+					etag:        null.StringFrom(staff.ETag()),
+					actor:       model.NewAdminAuditLogActor(testdata.Admin.ID, "/rapid.admin_api.v1.AdminV1Service/UpdateStaff"),
+					requestTime: requestTime,
+				},
+				usecase: &adminStaffInteractor{
+					transactable:          mock_repository.TestMockTransactable(),
+					staffRepository:       mockStaffRepo,
+					auditLogRepository:    mockAuditLogRepo,
+					outboxEventRepository: mockOutboxEventRepo,
+					assetService:          mockAssetService,
+				},
+				want: want{
+					staff: updatedStaff,
+				},
+			}
+		},
+		"modified since the etag was read": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(),
+					repository.GetStaffQuery{
+						ID: null.StringFrom(staff.ID),
+						BaseGetOptions: repository.BaseGetOptions{
+							OrFail:    true,
+							ForUpdate: true,
+						},
+					}).
+				Return(staff, nil)
+
+			return testcase{
+				args: args{
+					adminID:     testdata.Admin.ID,
+					staffID:     staff.ID,
+					role:        nullable.TypeFrom(model.StaffRoleAdmin),
+					etag:        null.StringFrom(model.NewETag(staff.Version - 1)),
+					actor:       model.NewAdminAuditLogActor(testdata.Admin.ID, "/rapid.admin_api.v1.AdminV1Service/UpdateStaff"),
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminStaffInteractor{
+					transactable:    mock_repository.TestMockTransactable(),
+					staffRepository: mockStaffRepo,
+				},
+				want: want{
+					expectedResult: errors.StaffModifiedErr,
+				},
+			}
+		},
 	}
 
 	for name, tc := range tests {
@@ -544,6 +922,8 @@ func TestAdminStaffInteractor_Update(t *testing.T) {
 				tc.args.displayName,
 				tc.args.role,
 				tc.args.imageAssetID,
+				tc.args.etag,
+				tc.args.actor,
 				tc.args.requestTime,
 			))
 			if tc.want.expectedResult == nil {
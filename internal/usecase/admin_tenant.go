@@ -30,4 +30,8 @@ type AdminTenantInteractor interface {
 		ctx context.Context,
 		param *input.AdminDeleteTenant,
 	) error
+	Restore(
+		ctx context.Context,
+		param *input.AdminRestoreTenant,
+	) (*model.Tenant, error)
 }
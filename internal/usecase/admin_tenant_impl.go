@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
 	"github.com/abyssparanoia/rapid-go/internal/domain/service"
@@ -13,19 +14,28 @@ import (
 )
 
 type adminTenantInteractor struct {
-	transactable     repository.Transactable
-	tenantRepository repository.Tenant
-	assetService     service.Asset
+	transactable          repository.Transactable
+	tenantRepository      repository.Tenant
+	staffRepository       repository.Staff
+	auditLogRepository    repository.AuditLog
+	outboxEventRepository repository.OutboxEvent
+	assetService          service.Asset
 }
 
 func NewAdminTenantInteractor(
 	transactable repository.Transactable,
 	tenantRepository repository.Tenant,
+	staffRepository repository.Staff,
+	auditLogRepository repository.AuditLog,
+	outboxEventRepository repository.OutboxEvent,
 	assetService service.Asset,
 ) AdminTenantInteractor {
 	return &adminTenantInteractor{
 		transactable,
 		tenantRepository,
+		staffRepository,
+		auditLogRepository,
+		outboxEventRepository,
 		assetService,
 	}
 }
@@ -44,7 +54,8 @@ func (i *adminTenantInteractor) Get(
 				OrFail:  true,
 				Preload: true,
 			},
-			ID: null.StringFrom(param.TenantID),
+			ID:             null.StringFrom(param.TenantID),
+			IncludeDeleted: param.IncludeDeleted,
 		},
 	)
 	if err != nil {
@@ -69,32 +80,62 @@ func (i *adminTenantInteractor) List(
 			Limit:   null.Uint64From(param.Limit),
 			Preload: true,
 		},
-		SortKey: nullable.TypeFrom(param.SortKey),
+		SortKey:        nullable.TypeFrom(param.SortKey),
+		IncludeDeleted: param.IncludeDeleted,
+		Name:           param.Name,
+		CreatedAtFrom:  param.CreatedAtFrom,
+		CreatedAtTo:    param.CreatedAtTo,
+		TagTypes:       param.TagTypes,
 	}
-	tenants, err := i.tenantRepository.List(
-		ctx,
-		query,
-	)
+
+	if param.Cursor.Valid {
+		// Fetch one extra row to know whether a next page exists.
+		query.Page = null.Uint64{}
+		query.Limit = null.Uint64From(param.Limit + 1)
+		query.Keyset = true
+		if param.Cursor.String != "" {
+			cursor, err := model.ParseCursor(param.Cursor.String, param.SortKey.String())
+			if err != nil {
+				return nil, err
+			}
+			query.Cursor = nullable.TypeFrom(*cursor)
+		}
+	}
+
+	tenants, err := i.tenantRepository.List(ctx, query)
 	if err != nil {
 		return nil, err
 	}
+
+	var nextCursor null.String
+	if param.Cursor.Valid {
+		tenants, nextCursor = model.NextCursor(tenants, param.Limit, func(m *model.Tenant) *model.Cursor {
+			return m.Cursor(param.SortKey)
+		})
+	}
+
 	if err = i.assetService.BatchSetTenantURLs(ctx, tenants, param.RequestTime); err != nil {
 		return nil, err
 	}
-	ttl, err := i.tenantRepository.Count(
-		ctx,
-		query,
-	)
-	if err != nil {
-		return nil, err
+
+	// Cursor pagination skips the count query unless it is requested.
+	var pagination *model.Pagination
+	if !param.Cursor.Valid || param.IncludeTotalCount {
+		ttl, err := i.tenantRepository.Count(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		if param.Cursor.Valid {
+			pagination = model.NewCursorPagination(param.Limit, ttl, nextCursor.Valid)
+		} else {
+			pagination = model.NewPagination(param.Page, param.Limit, ttl)
+		}
 	}
+
 	return output.NewAdminListTenants(
 		tenants,
-		model.NewPagination(
-			param.Page,
-			param.Limit,
-			ttl,
-		),
+		pagination,
+		nextCursor,
 	), nil
 }
 
@@ -105,9 +146,25 @@ func (i *adminTenantInteractor) Create(
 	if err := param.Validate(); err != nil {
 		return nil, err
 	}
-	tenant := model.NewTenant(param.Name, param.RequestTime)
+	tenant := model.NewTenant(param.Name, param.TagTypes, param.RequestTime)
 	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
-		return i.tenantRepository.Create(ctx, tenant)
+		if err := i.tenantRepository.Create(ctx, tenant); err != nil {
+			return err
+		}
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			param.Actor,
+			null.StringFrom(tenant.ID),
+			model.AuditLogTargetTypeTenant,
+			tenant.ID,
+			nil,
+			tenant.AuditLogSnapshot(),
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+		return i.outboxEventRepository.BatchCreate(ctx, model.NewOutboxEvents(
+			model.NewTenantCreatedEvent(tenant, param.RequestTime),
+		))
 	}); err != nil {
 		return nil, err
 	}
@@ -140,6 +197,7 @@ func (i *adminTenantInteractor) Update(
 			repository.GetTenantQuery{
 				BaseGetOptions: repository.BaseGetOptions{
 					OrFail:    true,
+					Preload:   true,
 					ForUpdate: true,
 				},
 				ID: null.StringFrom(param.TenantID),
@@ -148,11 +206,32 @@ func (i *adminTenantInteractor) Update(
 		if err != nil {
 			return err
 		}
+		if err := tenant.ValidateETag(param.ETag); err != nil {
+			return err
+		}
+		before := tenant.AuditLogSnapshot()
 		tenant.Update(
 			param.Name,
+			param.TagTypes,
 			param.RequestTime,
 		)
-		return i.tenantRepository.Update(ctx, tenant)
+		if err := i.tenantRepository.Update(ctx, tenant); err != nil {
+			return err
+		}
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			param.Actor,
+			null.StringFrom(tenant.ID),
+			model.AuditLogTargetTypeTenant,
+			tenant.ID,
+			before,
+			tenant.AuditLogSnapshot(),
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+		return i.outboxEventRepository.BatchCreate(ctx, model.NewOutboxEvents(
+			model.NewTenantUpdatedEvent(tenant, param.RequestTime),
+		))
 	}); err != nil {
 		return nil, err
 	}
@@ -180,7 +259,7 @@ func (i *adminTenantInteractor) Delete(
 		return err
 	}
 	return i.transactable.RWTx(ctx, func(ctx context.Context) error {
-		_, err := i.tenantRepository.Get(ctx, repository.GetTenantQuery{
+		tenant, err := i.tenantRepository.Get(ctx, repository.GetTenantQuery{
 			BaseGetOptions: repository.BaseGetOptions{
 				OrFail:    true,
 				ForUpdate: true,
@@ -190,6 +269,149 @@ func (i *adminTenantInteractor) Delete(
 		if err != nil {
 			return err
 		}
-		return i.tenantRepository.Delete(ctx, param.TenantID)
+		staffs, err := i.staffRepository.List(ctx, repository.ListStaffQuery{
+			BaseListOptions: repository.BaseListOptions{
+				ForUpdate: true,
+			},
+			TenantID: null.StringFrom(tenant.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		before := tenant.AuditLogSnapshot()
+		tenant.Delete(param.RequestTime)
+		if err := i.tenantRepository.Update(ctx, tenant); err != nil {
+			return err
+		}
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			param.Actor,
+			null.StringFrom(tenant.ID),
+			model.AuditLogTargetTypeTenant,
+			tenant.ID,
+			before,
+			nil,
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+
+		// Staffs are deleted at the same time as the tenant, so that restoring
+		// the tenant brings back exactly the staffs removed with it.
+		events := model.DomainEvents{model.NewTenantDeletedEvent(tenant, param.RequestTime)}
+		for _, staff := range staffs {
+			before := staff.AuditLogSnapshot()
+			staff.Delete(param.RequestTime)
+			if err := i.staffRepository.Update(ctx, staff); err != nil {
+				return err
+			}
+			if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+				param.Actor,
+				null.StringFrom(tenant.ID),
+				model.AuditLogTargetTypeStaff,
+				staff.ID,
+				before,
+				nil,
+				param.RequestTime,
+			)); err != nil {
+				return err
+			}
+			events = append(events, model.NewStaffDeletedEvent(staff, param.RequestTime))
+		}
+
+		return i.outboxEventRepository.BatchCreate(ctx, model.NewOutboxEvents(events...))
+	})
+}
+
+func (i *adminTenantInteractor) Restore(
+	ctx context.Context,
+	param *input.AdminRestoreTenant,
+) (*model.Tenant, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		tenant, err := i.tenantRepository.Get(ctx, repository.GetTenantQuery{
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail:    true,
+				ForUpdate: true,
+			},
+			ID:             null.StringFrom(param.TenantID),
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return err
+		}
+		if !tenant.IsDeleted() {
+			return errors.TenantNotDeletedErr.New().
+				WithValue("tenant_id", tenant.ID)
+		}
+		staffs, err := i.staffRepository.List(ctx, repository.ListStaffQuery{
+			BaseListOptions: repository.BaseListOptions{
+				ForUpdate: true,
+			},
+			TenantID:       null.StringFrom(tenant.ID),
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return err
+		}
+		staffs = staffs.FilterDeletedAt(tenant.DeletedAt.Time)
+
+		before := tenant.AuditLogSnapshot()
+		tenant.Restore(param.RequestTime)
+		if err := i.tenantRepository.Update(ctx, tenant); err != nil {
+			return err
+		}
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			param.Actor,
+			null.StringFrom(tenant.ID),
+			model.AuditLogTargetTypeTenant,
+			tenant.ID,
+			before,
+			tenant.AuditLogSnapshot(),
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+
+		events := model.DomainEvents{model.NewTenantRestoredEvent(tenant, param.RequestTime)}
+		for _, staff := range staffs {
+			before := staff.AuditLogSnapshot()
+			staff.Restore(param.RequestTime)
+			if err := i.staffRepository.Update(ctx, staff); err != nil {
+				return err
+			}
+			if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+				param.Actor,
+				null.StringFrom(tenant.ID),
+				model.AuditLogTargetTypeStaff,
+				staff.ID,
+				before,
+				staff.AuditLogSnapshot(),
+				param.RequestTime,
+			)); err != nil {
+				return err
+			}
+			events = append(events, model.NewStaffRestoredEvent(staff, param.RequestTime))
+		}
+
+		return i.outboxEventRepository.BatchCreate(ctx, model.NewOutboxEvents(events...))
+	}); err != nil {
+		return nil, err
+	}
+	tenant, err := i.tenantRepository.Get(ctx, repository.GetTenantQuery{
+		BaseGetOptions: repository.BaseGetOptions{
+			OrFail:  true,
+			Preload: true,
+		},
+		ID: null.StringFrom(param.TenantID),
 	})
+	if err != nil {
+		return nil, err
+	}
+	if err := i.assetService.BatchSetTenantURLs(ctx, model.Tenants{tenant}, param.RequestTime); err != nil {
+		return nil, err
+	}
+	return tenant, nil
 }
@@ -134,6 +134,7 @@ func TestAdminAdminTenantInteractor_Get(t *testing.T) {
 
 			got, err := tc.usecase.Get(ctx, input.NewAdminGetTenant(
 				tc.args.tenantID,
+				false,
 				time.Now(),
 			))
 			if tc.want.expectedResult == nil {
@@ -150,9 +151,15 @@ func TestAdminAdminTenantInteractor_List(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		page        uint64
-		limit       uint64
-		requestTime time.Time
+		page              uint64
+		limit             uint64
+		cursor            null.String
+		includeTotalCount bool
+		name              null.String
+		tagTypes          []model.TenantTagType
+		createdAtFrom     null.Time
+		createdAtTo       null.Time
+		requestTime       time.Time
 	}
 
 	type want struct {
@@ -219,10 +226,163 @@ func TestAdminAdminTenantInteractor_List(t *testing.T) {
 					output: output.NewAdminListTenants(
 						model.Tenants{tenant},
 						model.NewPagination(2, 30, 60),
+						null.String{},
+					),
+				},
+			}
+		},
+		"success with cursor": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+			nextTenant := &model.Tenant{}
+			factory.CloneValue(tenant, nextTenant)
+			nextTenant.ID = "next"
+			cursor := tenant.Cursor(model.TenantSortKeyCreatedAtDesc)
+
+			query := repository.ListTenantsQuery{
+				BaseListOptions: repository.BaseListOptions{
+					Limit:   null.Uint64From(2),
+					Keyset:  true,
+					Cursor:  nullable.TypeFrom(*cursor),
+					Preload: true,
+				},
+				SortKey: nullable.TypeFrom(model.TenantSortKeyCreatedAtDesc),
+			}
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				List(gomock.Any(), query).
+				Return(model.Tenants{tenant, nextTenant}, nil)
+			mockTenantRepo.EXPECT().
+				Count(gomock.Any(), query).
+				Return(uint64(3), nil)
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				BatchSetTenantURLs(gomock.Any(), model.Tenants{tenant}, gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					limit:             1,
+					cursor:            null.StringFrom(cursor.Encode()),
+					includeTotalCount: true,
+					requestTime:       testdata.RequestTime,
+				},
+				usecase: &adminTenantInteractor{
+					tenantRepository: mockTenantRepo,
+					assetService:     mockAssetService,
+				},
+				want: want{
+					output: output.NewAdminListTenants(
+						model.Tenants{tenant},
+						model.NewCursorPagination(1, 3, true),
+						null.StringFrom(cursor.Encode()),
+					),
+				},
+			}
+		},
+		"success with filters": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+			tagTypes := []model.TenantTagType{model.TenantTagTypeEducation, model.TenantTagTypeBusiness}
+			createdAtFrom := testdata.RequestTime.Add(-24 * time.Hour)
+			createdAtTo := testdata.RequestTime
+
+			query := repository.ListTenantsQuery{
+				BaseListOptions: repository.BaseListOptions{
+					Page:    null.Uint64From(1),
+					Limit:   null.Uint64From(30),
+					Preload: true,
+				},
+				SortKey:       nullable.TypeFrom(model.TenantSortKeyCreatedAtDesc),
+				Name:          null.StringFrom("acme"),
+				CreatedAtFrom: null.TimeFrom(createdAtFrom),
+				CreatedAtTo:   null.TimeFrom(createdAtTo),
+				TagTypes:      tagTypes,
+			}
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				List(gomock.Any(), query).
+				Return(model.Tenants{tenant}, nil)
+			mockTenantRepo.EXPECT().
+				Count(gomock.Any(), query).
+				Return(uint64(1), nil)
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				BatchSetTenantURLs(gomock.Any(), model.Tenants{tenant}, gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					page:          1,
+					limit:         30,
+					name:          null.StringFrom("acme"),
+					tagTypes:      tagTypes,
+					createdAtFrom: null.TimeFrom(createdAtFrom),
+					createdAtTo:   null.TimeFrom(createdAtTo),
+					requestTime:   testdata.RequestTime,
+				},
+				usecase: &adminTenantInteractor{
+					tenantRepository: mockTenantRepo,
+					assetService:     mockAssetService,
+				},
+				want: want{
+					output: output.NewAdminListTenants(
+						model.Tenants{tenant},
+						model.NewPagination(1, 30, 1),
+						null.String{},
 					),
 				},
 			}
 		},
+		"invalid tag type": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+
+			return testcase{
+				args: args{
+					limit:       30,
+					tagTypes:    []model.TenantTagType{model.TenantTagTypeUnknown},
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminTenantInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"invalid created at range": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+
+			return testcase{
+				args: args{
+					limit:         30,
+					createdAtFrom: null.TimeFrom(testdata.RequestTime),
+					createdAtTo:   null.TimeFrom(testdata.RequestTime.Add(-time.Hour)),
+					requestTime:   testdata.RequestTime,
+				},
+				usecase: &adminTenantInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"invalid cursor": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+
+			return testcase{
+				args: args{
+					limit:       30,
+					cursor:      null.StringFrom("invalid"),
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminTenantInteractor{
+					tenantRepository: mock_repository.NewMockTenant(ctrl),
+					assetService:     mock_service.NewMockAsset(ctrl),
+				},
+				want: want{
+					expectedResult: errors.InvalidCursorErr,
+				},
+			}
+		},
 	}
 
 	for name, tc := range tests {
@@ -238,6 +398,13 @@ func TestAdminAdminTenantInteractor_List(t *testing.T) {
 				tc.args.page,
 				tc.args.limit,
 				nullable.Type[model.TenantSortKey]{}, // Use empty nullable for default
+				tc.args.cursor,
+				tc.args.includeTotalCount,
+				false,
+				tc.args.name,
+				tc.args.tagTypes,
+				tc.args.createdAtFrom,
+				tc.args.createdAtTo,
 				tc.args.requestTime,
 			))
 			if tc.want.expectedResult == nil {
@@ -255,6 +422,8 @@ func TestAdminAdminTenantInteractor_Create(t *testing.T) {
 
 	type args struct {
 		name        string
+		tagTypes    []model.TenantTagType
+		actor       model.AuditLogActor
 		requestTime time.Time
 	}
 
@@ -284,13 +453,48 @@ func TestAdminAdminTenantInteractor_Create(t *testing.T) {
 				},
 			}
 		},
+		"invalid tag type": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			return testcase{
+				args: args{
+					name:        testdata.Tenant.Name,
+					tagTypes:    []model.TenantTagType{model.TenantTagTypeUnknown},
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminTenantInteractor{},
+				want: want{
+					expectedResult: errors.TenantTagInvalidErr,
+				},
+			}
+		},
+		"duplicated tag type": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			return testcase{
+				args: args{
+					name:        testdata.Tenant.Name,
+					tagTypes:    []model.TenantTagType{model.TenantTagTypeOther, model.TenantTagTypeOther},
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminTenantInteractor{},
+				want: want{
+					expectedResult: errors.TenantTagInvalidErr,
+				},
+			}
+		},
 		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
 			testdata := factory.NewFactory()
 			requestTime := testdata.RequestTime
 			tenant := testdata.Tenant
 			mockID := id.Mock()
 			tenant.ID = mockID
-			tenant.Tags = model.TenantTags{}
+			tenant.Tags = model.TenantTags{
+				{
+					ID:        mockID,
+					Type:      model.TenantTagTypeBusiness,
+					CreatedAt: requestTime,
+					UpdatedAt: requestTime,
+				},
+			}
 
 			mockTransactable := mock_repository.TestMockTransactable()
 			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
@@ -311,15 +515,40 @@ func TestAdminAdminTenantInteractor_Create(t *testing.T) {
 				BatchSetTenantURLs(gomock.Any(), model.Tenants{tenant}, gomock.Any()).
 				Return(nil)
 
+			id.Mock()
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewTenantCreatedEvent(tenant, testdata.RequestTime),
+				)).
+				Return(nil)
+			actor := model.NewAdminAuditLogActor(testdata.Admin.ID, "/rapid.admin_api.v1.AdminV1Service/CreateTenant")
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), model.NewAuditLog(
+					actor,
+					null.StringFrom(tenant.ID),
+					model.AuditLogTargetTypeTenant,
+					tenant.ID,
+					nil,
+					tenant.AuditLogSnapshot(),
+					testdata.RequestTime,
+				)).
+				Return(nil)
+
 			return testcase{
 				args: args{
 					name:        tenant.Name,
+					tagTypes:    []model.TenantTagType{model.TenantTagTypeBusiness},
+					actor:       actor,
 					requestTime: requestTime,
 				},
 				usecase: &adminTenantInteractor{
-					transactable:     mockTransactable,
-					tenantRepository: mockTenantRepo,
-					assetService:     mockAssetService,
+					transactable:          mockTransactable,
+					tenantRepository:      mockTenantRepo,
+					auditLogRepository:    mockAuditLogRepo,
+					outboxEventRepository: mockOutboxEventRepo,
+					assetService:          mockAssetService,
 				},
 				want: want{
 					tenant: tenant,
@@ -339,6 +568,8 @@ func TestAdminAdminTenantInteractor_Create(t *testing.T) {
 
 			got, err := tc.usecase.Create(ctx, input.NewAdminCreateTenant(
 				tc.args.name,
+				tc.args.tagTypes,
+				tc.args.actor,
 				tc.args.requestTime,
 			))
 			if tc.want.expectedResult == nil {
@@ -357,6 +588,9 @@ func TestAdminAdminTenantInteractor_Update(t *testing.T) {
 	type args struct {
 		tenantID    string
 		name        null.String
+		tagTypes    nullable.Type[[]model.TenantTagType]
+		etag        null.String
+		actor       model.AuditLogActor
 		requestTime time.Time
 	}
 
@@ -387,10 +621,26 @@ func TestAdminAdminTenantInteractor_Update(t *testing.T) {
 			testdata := factory.NewFactory()
 			requestTime := testdata.RequestTime
 			tenant := testdata.Tenant
+			tenant.Tags = model.TenantTags{
+				{
+					ID:        "education-tag-id",
+					Type:      model.TenantTagTypeEducation,
+					CreatedAt: tenant.CreatedAt,
+					UpdatedAt: tenant.UpdatedAt,
+				},
+			}
 			updatedName := "Updated Name"
 			updatedTenant := &model.Tenant{} //nolint:exhaustruct
 			factory.CloneValue(tenant, updatedTenant)
 			updatedTenant.Name = updatedName
+			updatedTenant.Version++
+			mockID := id.Mock()
+			updatedTenant.Tags = append(updatedTenant.Tags, &model.TenantTag{
+				ID:        mockID,
+				Type:      model.TenantTagTypeOther,
+				CreatedAt: requestTime,
+				UpdatedAt: requestTime,
+			})
 
 			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
 			mockTenantRepo.EXPECT().
@@ -398,6 +648,7 @@ func TestAdminAdminTenantInteractor_Update(t *testing.T) {
 					repository.GetTenantQuery{
 						BaseGetOptions: repository.BaseGetOptions{
 							OrFail:    true,
+							Preload:   true,
 							ForUpdate: true,
 						},
 						ID: null.StringFrom(tenant.ID),
@@ -421,19 +672,80 @@ func TestAdminAdminTenantInteractor_Update(t *testing.T) {
 				BatchSetTenantURLs(gomock.Any(), model.Tenants{updatedTenant}, gomock.Any()).
 				Return(nil)
 
+			id.Mock()
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewTenantUpdatedEvent(updatedTenant, testdata.RequestTime),
+				)).
+				Return(nil)
+			actor := model.NewAdminAuditLogActor(testdata.Admin.ID, "/rapid.admin_api.v1.AdminV1Service/UpdateTenant")
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), model.NewAuditLog(
+					actor,
+					null.StringFrom(tenant.ID),
+					model.AuditLogTargetTypeTenant,
+					tenant.ID,
+					tenant.AuditLogSnapshot(),
+					updatedTenant.AuditLogSnapshot(),
+					testdata.RequestTime,
+				)).
+				Return(nil)
+
 			return testcase{
 				args: args{
 					tenantID:    tenant.ID,
 					name:        null.StringFrom(updatedName),
+					tagTypes:    nullable.TypeFrom([]model.TenantTagType{model.TenantTagTypeEducation, model.TenantTagTypeOther}),
+					etag:        null.StringFrom(tenant.ETag()),
+					actor:       actor,
 					requestTime: requestTime,
 				},
+				usecase: &adminTenantInteractor{
+					transactable:          mock_repository.TestMockTransactable(),
+					tenantRepository:      mockTenantRepo,
+					auditLogRepository:    mockAuditLogRepo,
+					outboxEventRepository: mockOutboxEventRepo,
+					assetService:          mockAssetService,
+				},
+				want: want{
+					tenant: updatedTenant,
+				},
+			}
+		},
+		"modified since the etag was read": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(),
+					repository.GetTenantQuery{
+						BaseGetOptions: repository.BaseGetOptions{
+							OrFail:    true,
+							Preload:   true,
+							ForUpdate: true,
+						},
+						ID: null.StringFrom(tenant.ID),
+					}).
+				Return(tenant, nil)
+
+			return testcase{
+				args: args{
+					tenantID:    tenant.ID,
+					name:        null.StringFrom("Updated Name"),
+					tagTypes:    nullable.Type[[]model.TenantTagType]{},
+					etag:        null.StringFrom(model.NewETag(tenant.Version - 1)),
+					actor:       model.NewAdminAuditLogActor(testdata.Admin.ID, "/rapid.admin_api.v1.AdminV1Service/UpdateTenant"),
+					requestTime: testdata.RequestTime,
+				},
 				usecase: &adminTenantInteractor{
 					transactable:     mock_repository.TestMockTransactable(),
 					tenantRepository: mockTenantRepo,
-					assetService:     mockAssetService,
 				},
 				want: want{
-					tenant: updatedTenant,
+					expectedResult: errors.TenantModifiedErr,
 				},
 			}
 		},
@@ -451,6 +763,9 @@ func TestAdminAdminTenantInteractor_Update(t *testing.T) {
 			got, err := tc.usecase.Update(ctx, input.NewAdminUpdateTenant(
 				tc.args.tenantID,
 				tc.args.name,
+				tc.args.tagTypes,
+				tc.args.etag,
+				tc.args.actor,
 				tc.args.requestTime,
 			))
 			if tc.want.expectedResult == nil {
@@ -468,6 +783,7 @@ func TestAdminAdminTenantInteractor_Delete(t *testing.T) {
 
 	type args struct {
 		tenantID    string
+		actor       model.AuditLogActor
 		requestTime time.Time
 	}
 
@@ -496,6 +812,7 @@ func TestAdminAdminTenantInteractor_Delete(t *testing.T) {
 		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
 			testdata := factory.NewFactory()
 			tenant := testdata.Tenant
+			staff := testdata.Staff
 
 			mockTransactable := mock_repository.NewMockTransactable(ctrl)
 			mockTransactable.EXPECT().RWTx(gomock.Any(), gomock.Any()).DoAndReturn(
@@ -513,20 +830,76 @@ func TestAdminAdminTenantInteractor_Delete(t *testing.T) {
 					ID: null.StringFrom(tenant.ID),
 				}).
 				Return(tenant, nil)
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				List(gomock.Any(), repository.ListStaffQuery{
+					BaseListOptions: repository.BaseListOptions{
+						ForUpdate: true,
+					},
+					TenantID: null.StringFrom(tenant.ID),
+				}).
+				Return(model.Staffs{staff}, nil)
+
+			deletedTenant := &model.Tenant{}
+			factory.CloneValue(tenant, deletedTenant)
+			deletedTenant.Delete(testdata.RequestTime)
 			mockTenantRepo.EXPECT().
-				Delete(gomock.Any(), tenant.ID).
+				Update(gomock.Any(), deletedTenant).
+				Return(nil)
+			deletedStaff := &model.Staff{}
+			factory.CloneValue(staff, deletedStaff)
+			deletedStaff.Delete(testdata.RequestTime)
+			mockStaffRepo.EXPECT().
+				Update(gomock.Any(), deletedStaff).
 				Return(nil)
 			mockAssetService := mock_service.NewMockAsset(ctrl)
 
+			id.Mock()
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewTenantDeletedEvent(deletedTenant, testdata.RequestTime),
+					model.NewStaffDeletedEvent(deletedStaff, testdata.RequestTime),
+				)).
+				Return(nil)
+			actor := model.NewAdminAuditLogActor(testdata.Admin.ID, "/rapid.admin_api.v1.AdminV1Service/DeleteTenant")
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), model.NewAuditLog(
+					actor,
+					null.StringFrom(tenant.ID),
+					model.AuditLogTargetTypeTenant,
+					tenant.ID,
+					tenant.AuditLogSnapshot(),
+					nil,
+					testdata.RequestTime,
+				)).
+				Return(nil)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), model.NewAuditLog(
+					actor,
+					null.StringFrom(tenant.ID),
+					model.AuditLogTargetTypeStaff,
+					staff.ID,
+					staff.AuditLogSnapshot(),
+					nil,
+					testdata.RequestTime,
+				)).
+				Return(nil)
+
 			return testcase{
 				args: args{
 					tenantID:    tenant.ID,
+					actor:       actor,
 					requestTime: testdata.RequestTime,
 				},
 				usecase: &adminTenantInteractor{
-					transactable:     mockTransactable,
-					tenantRepository: mockTenantRepo,
-					assetService:     mockAssetService,
+					transactable:          mockTransactable,
+					tenantRepository:      mockTenantRepo,
+					staffRepository:       mockStaffRepo,
+					auditLogRepository:    mockAuditLogRepo,
+					outboxEventRepository: mockOutboxEventRepo,
+					assetService:          mockAssetService,
 				},
 				want: want{},
 			}
@@ -544,6 +917,7 @@ func TestAdminAdminTenantInteractor_Delete(t *testing.T) {
 
 			err := tc.usecase.Delete(ctx, input.NewAdminDeleteTenant(
 				tc.args.tenantID,
+				tc.args.actor,
 				tc.args.requestTime,
 			))
 			if tc.want.expectedResult == nil {
@@ -554,3 +928,204 @@ func TestAdminAdminTenantInteractor_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminAdminTenantInteractor_Restore(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		tenantID    string
+		actor       model.AuditLogActor
+		requestTime time.Time
+	}
+
+	type want struct {
+		tenant         *model.Tenant
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase AdminTenantInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"invalid argument": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return testcase{
+				args:    args{},
+				usecase: &adminTenantInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"not deleted": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(), repository.GetTenantQuery{
+					BaseGetOptions: repository.BaseGetOptions{
+						OrFail:    true,
+						ForUpdate: true,
+					},
+					ID:             null.StringFrom(tenant.ID),
+					IncludeDeleted: true,
+				}).
+				Return(tenant, nil)
+
+			return testcase{
+				args: args{
+					tenantID:    tenant.ID,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminTenantInteractor{
+					transactable:     mock_repository.TestMockTransactable(),
+					tenantRepository: mockTenantRepo,
+				},
+				want: want{
+					expectedResult: errors.TenantNotDeletedErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			deletedAt := testdata.RequestTime.Add(-time.Hour)
+			tenant := testdata.Tenant
+			tenant.Delete(deletedAt)
+			staff := testdata.Staff
+			staff.Delete(deletedAt)
+			// A staff deleted apart from the tenant stays deleted.
+			otherStaff := &model.Staff{}
+			factory.CloneValue(staff, otherStaff)
+			otherStaff.ID = "other"
+			otherStaff.Delete(deletedAt.Add(-time.Hour))
+
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(), repository.GetTenantQuery{
+					BaseGetOptions: repository.BaseGetOptions{
+						OrFail:    true,
+						ForUpdate: true,
+					},
+					ID:             null.StringFrom(tenant.ID),
+					IncludeDeleted: true,
+				}).
+				Return(tenant, nil)
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				List(gomock.Any(), repository.ListStaffQuery{
+					BaseListOptions: repository.BaseListOptions{
+						ForUpdate: true,
+					},
+					TenantID:       null.StringFrom(tenant.ID),
+					IncludeDeleted: true,
+				}).
+				Return(model.Staffs{staff, otherStaff}, nil)
+
+			restoredTenant := &model.Tenant{}
+			factory.CloneValue(tenant, restoredTenant)
+			restoredTenant.Restore(testdata.RequestTime)
+			mockTenantRepo.EXPECT().
+				Update(gomock.Any(), restoredTenant).
+				Return(nil)
+			restoredStaff := &model.Staff{}
+			factory.CloneValue(staff, restoredStaff)
+			restoredStaff.Restore(testdata.RequestTime)
+			mockStaffRepo.EXPECT().
+				Update(gomock.Any(), restoredStaff).
+				Return(nil)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(), repository.GetTenantQuery{
+					BaseGetOptions: repository.BaseGetOptions{
+						OrFail:  true,
+						Preload: true,
+					},
+					ID: null.StringFrom(tenant.ID),
+				}).
+				Return(restoredTenant, nil)
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				BatchSetTenantURLs(gomock.Any(), model.Tenants{restoredTenant}, testdata.RequestTime).
+				Return(nil)
+
+			id.Mock()
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewTenantRestoredEvent(restoredTenant, testdata.RequestTime),
+					model.NewStaffRestoredEvent(restoredStaff, testdata.RequestTime),
+				)).
+				Return(nil)
+			actor := model.NewAdminAuditLogActor(testdata.Admin.ID, "/rapid.admin_api.v1.AdminV1Service/RestoreTenant")
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), model.NewAuditLog(
+					actor,
+					null.StringFrom(tenant.ID),
+					model.AuditLogTargetTypeTenant,
+					tenant.ID,
+					tenant.AuditLogSnapshot(),
+					restoredTenant.AuditLogSnapshot(),
+					testdata.RequestTime,
+				)).
+				Return(nil)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), model.NewAuditLog(
+					actor,
+					null.StringFrom(tenant.ID),
+					model.AuditLogTargetTypeStaff,
+					staff.ID,
+					staff.AuditLogSnapshot(),
+					restoredStaff.AuditLogSnapshot(),
+					testdata.RequestTime,
+				)).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					tenantID:    tenant.ID,
+					actor:       actor,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &adminTenantInteractor{
+					transactable:          mock_repository.TestMockTransactable(),
+					tenantRepository:      mockTenantRepo,
+					staffRepository:       mockStaffRepo,
+					auditLogRepository:    mockAuditLogRepo,
+					outboxEventRepository: mockOutboxEventRepo,
+					assetService:          mockAssetService,
+				},
+				want: want{
+					tenant: restoredTenant,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.Restore(ctx, input.NewAdminRestoreTenant(
+				tc.args.tenantID,
+				tc.args.actor,
+				tc.args.requestTime,
+			))
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.tenant, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
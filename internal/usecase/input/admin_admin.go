@@ -0,0 +1,211 @@
+package input
+
+import (
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/validation"
+)
+
+type AdminGetAdmin struct {
+	TargetAdminID string    `validate:"required"`
+	RequestTime   time.Time `validate:"required"`
+}
+
+func NewAdminGetAdmin(
+	targetAdminID string,
+	requestTime time.Time,
+) *AdminGetAdmin {
+	return &AdminGetAdmin{
+		TargetAdminID: targetAdminID,
+		RequestTime:   requestTime,
+	}
+}
+
+func (p *AdminGetAdmin) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
+
+type AdminListAdmins struct {
+	Page        uint64
+	Limit       uint64 `validate:"gte=1,lte=100"`
+	Role        nullable.Type[model.AdminRole]
+	SortKey     model.AdminSortKey // NON-nullable field
+	RequestTime time.Time          `validate:"required"`
+}
+
+func NewAdminListAdmins(
+	page uint64,
+	limit uint64,
+	role nullable.Type[model.AdminRole],
+	sortKey nullable.Type[model.AdminSortKey], // nullable param
+	requestTime time.Time,
+) *AdminListAdmins {
+	// Pagination defaults
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = 30
+	}
+
+	// SortKey default: CreatedAtDesc
+	resolvedSortKey := model.AdminSortKeyCreatedAtDesc
+	if sortKey.Valid && sortKey.Value().Valid() {
+		resolvedSortKey = sortKey.Value()
+	}
+
+	return &AdminListAdmins{
+		Page:        page,
+		Limit:       limit,
+		Role:        role,
+		SortKey:     resolvedSortKey,
+		RequestTime: requestTime,
+	}
+}
+
+func (p *AdminListAdmins) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	if p.Role.Valid && !p.Role.Value().Valid() {
+		return errors.RequestInvalidArgumentErr.Errorf("invalid role: %s", p.Role.Value())
+	}
+	return nil
+}
+
+type AdminCreateAdmin struct {
+	Email       string          `validate:"required,email"`
+	DisplayName string          `validate:"required"`
+	Role        model.AdminRole `validate:"required"`
+	Actor       model.AuditLogActor
+	RequestTime time.Time `validate:"required"`
+}
+
+func NewAdminCreateAdmin(
+	email string,
+	displayName string,
+	role model.AdminRole,
+	actor model.AuditLogActor,
+	requestTime time.Time,
+) *AdminCreateAdmin {
+	return &AdminCreateAdmin{
+		Email:       email,
+		DisplayName: displayName,
+		Role:        role,
+		Actor:       actor,
+		RequestTime: requestTime,
+	}
+}
+
+func (p *AdminCreateAdmin) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	if !p.Role.Valid() {
+		return errors.RequestInvalidArgumentErr.Errorf("invalid role: %s", p.Role)
+	}
+	return nil
+}
+
+type AdminUpdateAdmin struct {
+	TargetAdminID string `validate:"required"`
+	DisplayName   null.String
+	Actor         model.AuditLogActor
+	RequestTime   time.Time `validate:"required"`
+}
+
+func NewAdminUpdateAdmin(
+	targetAdminID string,
+	displayName null.String,
+	actor model.AuditLogActor,
+	requestTime time.Time,
+) *AdminUpdateAdmin {
+	return &AdminUpdateAdmin{
+		TargetAdminID: targetAdminID,
+		DisplayName:   displayName,
+		Actor:         actor,
+		RequestTime:   requestTime,
+	}
+}
+
+func (p *AdminUpdateAdmin) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
+
+type AdminUpdateAdminRole struct {
+	AdminID       string          `validate:"required"`
+	TargetAdminID string          `validate:"required"`
+	Role          model.AdminRole `validate:"required"`
+	Actor         model.AuditLogActor
+	RequestTime   time.Time `validate:"required"`
+}
+
+func NewAdminUpdateAdminRole(
+	adminID string,
+	targetAdminID string,
+	role model.AdminRole,
+	actor model.AuditLogActor,
+	requestTime time.Time,
+) *AdminUpdateAdminRole {
+	return &AdminUpdateAdminRole{
+		AdminID:       adminID,
+		TargetAdminID: targetAdminID,
+		Role:          role,
+		Actor:         actor,
+		RequestTime:   requestTime,
+	}
+}
+
+func (p *AdminUpdateAdminRole) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	if !p.Role.Valid() {
+		return errors.RequestInvalidArgumentErr.Errorf("invalid role: %s", p.Role)
+	}
+	if p.AdminID == p.TargetAdminID {
+		return errors.AdminCannotOperateSelfErr.New()
+	}
+	return nil
+}
+
+type AdminDeleteAdmin struct {
+	AdminID       string `validate:"required"`
+	TargetAdminID string `validate:"required"`
+	Actor         model.AuditLogActor
+	RequestTime   time.Time `validate:"required"`
+}
+
+func NewAdminDeleteAdmin(
+	adminID string,
+	targetAdminID string,
+	actor model.AuditLogActor,
+	requestTime time.Time,
+) *AdminDeleteAdmin {
+	return &AdminDeleteAdmin{
+		AdminID:       adminID,
+		TargetAdminID: targetAdminID,
+		Actor:         actor,
+		RequestTime:   requestTime,
+	}
+}
+
+func (p *AdminDeleteAdmin) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	if p.AdminID == p.TargetAdminID {
+		return errors.AdminCannotOperateSelfErr.New()
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package input
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
@@ -44,3 +45,93 @@ func (p *AdminCreateAssetPresignedURL) Validate() error {
 	}
 	return nil
 }
+
+type AdminConfirmAssetUpload struct {
+	AdminID     string    `validate:"required"`
+	AssetID     string    `validate:"required"`
+	RequestTime time.Time `validate:"required"`
+}
+
+func NewAdminConfirmAssetUpload(
+	adminID string,
+	assetID string,
+	requestTime time.Time,
+) *AdminConfirmAssetUpload {
+	return &AdminConfirmAssetUpload{
+		AdminID:     adminID,
+		AssetID:     assetID,
+		RequestTime: requestTime,
+	}
+}
+
+func (p *AdminConfirmAssetUpload) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
+
+type AdminUploadAsset struct {
+	AdminID     string            `validate:"required"`
+	ContentType model.ContentType `validate:"required"`
+	AssetType   model.AssetType   `validate:"required"`
+	// Checksum is the hex-encoded SHA-256 of Content.
+	Checksum    string    `validate:"required,len=64,hexadecimal"`
+	Content     io.Reader `validate:"required"`
+	RequestTime time.Time `validate:"required"`
+}
+
+func NewAdminUploadAsset(
+	adminID string,
+	contentType model.ContentType,
+	assetType model.AssetType,
+	checksum string,
+	content io.Reader,
+	requestTime time.Time,
+) *AdminUploadAsset {
+	return &AdminUploadAsset{
+		AdminID:     adminID,
+		ContentType: contentType,
+		AssetType:   assetType,
+		Checksum:    checksum,
+		Content:     content,
+		RequestTime: requestTime,
+	}
+}
+
+func (p *AdminUploadAsset) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	if !p.AssetType.Valid() {
+		return errors.RequestInvalidArgumentErr.New().
+			WithDetail(fmt.Sprintf("invalid asset type %s", p.AssetType))
+	}
+	if !p.ContentType.Valid() {
+		return errors.RequestInvalidArgumentErr.New().
+			WithDetail(fmt.Sprintf("invalid content type %s", p.ContentType))
+	}
+	return nil
+}
+
+type AdminDownloadAsset struct {
+	AdminID string `validate:"required"`
+	AssetID string `validate:"required"`
+}
+
+func NewAdminDownloadAsset(
+	adminID string,
+	assetID string,
+) *AdminDownloadAsset {
+	return &AdminDownloadAsset{
+		AdminID: adminID,
+		AssetID: assetID,
+	}
+}
+
+func (p *AdminDownloadAsset) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package input
+
+import (
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/validation"
+)
+
+type AdminListAuditLogs struct {
+	Page        uint64
+	Limit       uint64 `validate:"gte=1,lte=100"`
+	TenantID    null.String
+	ActorType   nullable.Type[model.AuditLogActorType]
+	ActorID     null.String
+	TargetType  nullable.Type[model.AuditLogTargetType]
+	TargetID    null.String
+	RequestTime time.Time `validate:"required"`
+}
+
+func NewAdminListAuditLogs(
+	page uint64,
+	limit uint64,
+	tenantID null.String,
+	actorType nullable.Type[model.AuditLogActorType],
+	actorID null.String,
+	targetType nullable.Type[model.AuditLogTargetType],
+	targetID null.String,
+	requestTime time.Time,
+) *AdminListAuditLogs {
+	// Pagination defaults
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = 30
+	}
+
+	return &AdminListAuditLogs{
+		Page:        page,
+		Limit:       limit,
+		TenantID:    tenantID,
+		ActorType:   actorType,
+		ActorID:     actorID,
+		TargetType:  targetType,
+		TargetID:    targetID,
+		RequestTime: requestTime,
+	}
+}
+
+func (p *AdminListAuditLogs) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	if p.ActorType.Valid && !p.ActorType.Value().Valid() {
+		return errors.RequestInvalidArgumentErr.Errorf("invalid actor type: %s", p.ActorType.Value())
+	}
+	if p.TargetType.Valid && !p.TargetType.Value().Valid() {
+		return errors.RequestInvalidArgumentErr.Errorf("invalid target type: %s", p.TargetType.Value())
+	}
+	return nil
+}
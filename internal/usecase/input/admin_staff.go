@@ -35,11 +35,19 @@ func (p *AdminGetStaff) Validate() error {
 
 // AdminListStaffs represents input for listing staff members
 type AdminListStaffs struct {
-	TenantID    string `validate:"required"`
-	Page        uint64
-	Limit       uint64             `validate:"gte=1,lte=100"`
-	SortKey     model.StaffSortKey // NON-nullable field
-	RequestTime time.Time          `validate:"required"`
+	TenantID          string `validate:"required"`
+	Page              uint64
+	Limit             uint64             `validate:"gte=1,lte=100"`
+	SortKey           model.StaffSortKey // NON-nullable field
+	Cursor            null.String
+	IncludeTotalCount bool
+	IncludeDeleted    bool
+	DisplayName       null.String
+	Email             null.String
+	Role              nullable.Type[model.StaffRole]
+	CreatedAtFrom     null.Time
+	CreatedAtTo       null.Time
+	RequestTime       time.Time `validate:"required"`
 }
 
 func NewAdminListStaffs(
@@ -47,6 +55,14 @@ func NewAdminListStaffs(
 	page uint64,
 	limit uint64,
 	sortKey nullable.Type[model.StaffSortKey], // nullable param
+	cursor null.String,
+	includeTotalCount bool,
+	includeDeleted bool,
+	displayName null.String,
+	email null.String,
+	role nullable.Type[model.StaffRole],
+	createdAtFrom null.Time,
+	createdAtTo null.Time,
 	requestTime time.Time,
 ) *AdminListStaffs {
 	// Pagination defaults
@@ -64,11 +80,19 @@ func NewAdminListStaffs(
 	}
 
 	return &AdminListStaffs{
-		TenantID:    tenantID,
-		Page:        page,
-		Limit:       limit,
-		SortKey:     resolvedSortKey,
-		RequestTime: requestTime,
+		TenantID:          tenantID,
+		Page:              page,
+		Limit:             limit,
+		SortKey:           resolvedSortKey,
+		Cursor:            cursor,
+		IncludeTotalCount: includeTotalCount,
+		IncludeDeleted:    includeDeleted,
+		DisplayName:       displayName,
+		Email:             email,
+		Role:              role,
+		CreatedAtFrom:     createdAtFrom,
+		CreatedAtTo:       createdAtTo,
+		RequestTime:       requestTime,
 	}
 }
 
@@ -76,6 +100,12 @@ func (p *AdminListStaffs) Validate() error {
 	if err := validation.Validate(p); err != nil {
 		return errors.RequestInvalidArgumentErr.Wrap(err)
 	}
+	if p.Role.Valid && !p.Role.Value().Valid() {
+		return errors.RequestInvalidArgumentErr.Errorf("invalid role: %s", p.Role.Value())
+	}
+	if p.CreatedAtFrom.Valid && p.CreatedAtTo.Valid && !p.CreatedAtFrom.Time.Before(p.CreatedAtTo.Time) {
+		return errors.RequestInvalidArgumentErr.Errorf("created_at_from must be before created_at_to")
+	}
 	return nil
 }
 
@@ -86,7 +116,8 @@ type AdminCreateStaff struct {
 	DisplayName  string          `validate:"required"`
 	Role         model.StaffRole `validate:"required"`
 	ImageAssetID string          `validate:"required"`
-	RequestTime  time.Time       `validate:"required"`
+	Actor        model.AuditLogActor
+	RequestTime  time.Time `validate:"required"`
 }
 
 func NewAdminCreateStaff(
@@ -96,6 +127,7 @@ func NewAdminCreateStaff(
 	displayName string,
 	role model.StaffRole,
 	imageAssetID string,
+	actor model.AuditLogActor,
 	requestTime time.Time,
 ) *AdminCreateStaff {
 	return &AdminCreateStaff{
@@ -105,6 +137,7 @@ func NewAdminCreateStaff(
 		DisplayName:  displayName,
 		Role:         role,
 		ImageAssetID: imageAssetID,
+		Actor:        actor,
 		RequestTime:  requestTime,
 	}
 }
@@ -123,6 +156,8 @@ type AdminUpdateStaff struct {
 	DisplayName  null.String
 	Role         nullable.Type[model.StaffRole]
 	ImageAssetID null.String
+	ETag         null.String
+	Actor        model.AuditLogActor
 	RequestTime  time.Time `validate:"required"`
 }
 
@@ -132,6 +167,8 @@ func NewAdminUpdateStaff(
 	displayName null.String,
 	role nullable.Type[model.StaffRole],
 	imageAssetID null.String,
+	etag null.String,
+	actor model.AuditLogActor,
 	requestTime time.Time,
 ) *AdminUpdateStaff {
 	return &AdminUpdateStaff{
@@ -140,6 +177,8 @@ func NewAdminUpdateStaff(
 		DisplayName:  displayName,
 		Role:         role,
 		ImageAssetID: imageAssetID,
+		ETag:         etag,
+		Actor:        actor,
 		RequestTime:  requestTime,
 	}
 }
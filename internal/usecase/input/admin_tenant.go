@@ -11,17 +11,20 @@ import (
 )
 
 type AdminGetTenant struct {
-	TenantID    string    `validate:"required"`
-	RequestTime time.Time `validate:"required"`
+	TenantID       string `validate:"required"`
+	IncludeDeleted bool
+	RequestTime    time.Time `validate:"required"`
 }
 
 func NewAdminGetTenant(
 	tenantID string,
+	includeDeleted bool,
 	requestTime time.Time,
 ) *AdminGetTenant {
 	return &AdminGetTenant{
-		TenantID:    tenantID,
-		RequestTime: requestTime,
+		TenantID:       tenantID,
+		IncludeDeleted: includeDeleted,
+		RequestTime:    requestTime,
 	}
 }
 
@@ -33,16 +36,30 @@ func (p *AdminGetTenant) Validate() error {
 }
 
 type AdminListTenants struct {
-	Page        uint64
-	Limit       uint64              `validate:"gte=1,lte=100"`
-	SortKey     model.TenantSortKey // NON-nullable field
-	RequestTime time.Time           `validate:"required"`
+	Page              uint64
+	Limit             uint64              `validate:"gte=1,lte=100"`
+	SortKey           model.TenantSortKey // NON-nullable field
+	Cursor            null.String
+	IncludeTotalCount bool
+	IncludeDeleted    bool
+	Name              null.String
+	TagTypes          []model.TenantTagType
+	CreatedAtFrom     null.Time
+	CreatedAtTo       null.Time
+	RequestTime       time.Time `validate:"required"`
 }
 
 func NewAdminListTenants(
 	page uint64,
 	limit uint64,
 	sortKey nullable.Type[model.TenantSortKey], // nullable param
+	cursor null.String,
+	includeTotalCount bool,
+	includeDeleted bool,
+	name null.String,
+	tagTypes []model.TenantTagType,
+	createdAtFrom null.Time,
+	createdAtTo null.Time,
 	requestTime time.Time,
 ) *AdminListTenants {
 	// Pagination defaults
@@ -60,10 +77,17 @@ func NewAdminListTenants(
 	}
 
 	return &AdminListTenants{
-		Page:        page,
-		Limit:       limit,
-		SortKey:     resolvedSortKey,
-		RequestTime: requestTime,
+		Page:              page,
+		Limit:             limit,
+		SortKey:           resolvedSortKey,
+		Cursor:            cursor,
+		IncludeTotalCount: includeTotalCount,
+		IncludeDeleted:    includeDeleted,
+		Name:              name,
+		TagTypes:          tagTypes,
+		CreatedAtFrom:     createdAtFrom,
+		CreatedAtTo:       createdAtTo,
+		RequestTime:       requestTime,
 	}
 }
 
@@ -71,20 +95,34 @@ func (p *AdminListTenants) Validate() error {
 	if err := validation.Validate(p); err != nil {
 		return errors.RequestInvalidArgumentErr.Wrap(err)
 	}
+	for _, tagType := range p.TagTypes {
+		if !tagType.Valid() {
+			return errors.RequestInvalidArgumentErr.Errorf("invalid tag type: %s", tagType)
+		}
+	}
+	if p.CreatedAtFrom.Valid && p.CreatedAtTo.Valid && !p.CreatedAtFrom.Time.Before(p.CreatedAtTo.Time) {
+		return errors.RequestInvalidArgumentErr.Errorf("created_at_from must be before created_at_to")
+	}
 	return nil
 }
 
 type AdminCreateTenant struct {
-	Name        string    `validate:"required"`
+	Name        string `validate:"required"`
+	TagTypes    []model.TenantTagType
+	Actor       model.AuditLogActor
 	RequestTime time.Time `validate:"required"`
 }
 
 func NewAdminCreateTenant(
 	name string,
+	tagTypes []model.TenantTagType,
+	actor model.AuditLogActor,
 	requestTime time.Time,
 ) *AdminCreateTenant {
 	return &AdminCreateTenant{
 		Name:        name,
+		TagTypes:    tagTypes,
+		Actor:       actor,
 		RequestTime: requestTime,
 	}
 }
@@ -93,23 +131,32 @@ func (p *AdminCreateTenant) Validate() error {
 	if err := validation.Validate(p); err != nil {
 		return errors.RequestInvalidArgumentErr.Wrap(err)
 	}
-	return nil
+	return model.ValidateTenantTagTypes(p.TagTypes)
 }
 
 type AdminUpdateTenant struct {
 	TenantID    string `validate:"required"`
 	Name        null.String
+	TagTypes    nullable.Type[[]model.TenantTagType]
+	ETag        null.String
+	Actor       model.AuditLogActor
 	RequestTime time.Time `validate:"required"`
 }
 
 func NewAdminUpdateTenant(
 	tenantID string,
 	name null.String,
+	tagTypes nullable.Type[[]model.TenantTagType],
+	etag null.String,
+	actor model.AuditLogActor,
 	requestTime time.Time,
 ) *AdminUpdateTenant {
 	return &AdminUpdateTenant{
 		TenantID:    tenantID,
 		Name:        name,
+		TagTypes:    tagTypes,
+		ETag:        etag,
+		Actor:       actor,
 		RequestTime: requestTime,
 	}
 }
@@ -118,20 +165,26 @@ func (p *AdminUpdateTenant) Validate() error {
 	if err := validation.Validate(p); err != nil {
 		return errors.RequestInvalidArgumentErr.Wrap(err)
 	}
+	if p.TagTypes.Valid {
+		return model.ValidateTenantTagTypes(p.TagTypes.Value())
+	}
 	return nil
 }
 
 type AdminDeleteTenant struct {
-	TenantID    string    `validate:"required"`
+	TenantID    string `validate:"required"`
+	Actor       model.AuditLogActor
 	RequestTime time.Time `validate:"required"`
 }
 
 func NewAdminDeleteTenant(
 	tenantID string,
+	actor model.AuditLogActor,
 	requestTime time.Time,
 ) *AdminDeleteTenant {
 	return &AdminDeleteTenant{
 		TenantID:    tenantID,
+		Actor:       actor,
 		RequestTime: requestTime,
 	}
 }
@@ -142,3 +195,28 @@ func (p *AdminDeleteTenant) Validate() error {
 	}
 	return nil
 }
+
+type AdminRestoreTenant struct {
+	TenantID    string `validate:"required"`
+	Actor       model.AuditLogActor
+	RequestTime time.Time `validate:"required"`
+}
+
+func NewAdminRestoreTenant(
+	tenantID string,
+	actor model.AuditLogActor,
+	requestTime time.Time,
+) *AdminRestoreTenant {
+	return &AdminRestoreTenant{
+		TenantID:    tenantID,
+		Actor:       actor,
+		RequestTime: requestTime,
+	}
+}
+
+func (p *AdminRestoreTenant) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
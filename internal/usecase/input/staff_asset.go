@@ -2,6 +2,7 @@ package input
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
@@ -44,3 +45,93 @@ func (p *StaffCreateAssetPresignedURL) Validate() error {
 	}
 	return nil
 }
+
+type StaffConfirmAssetUpload struct {
+	StaffID     string    `validate:"required"`
+	AssetID     string    `validate:"required"`
+	RequestTime time.Time `validate:"required"`
+}
+
+func NewStaffConfirmAssetUpload(
+	staffID string,
+	assetID string,
+	requestTime time.Time,
+) *StaffConfirmAssetUpload {
+	return &StaffConfirmAssetUpload{
+		StaffID:     staffID,
+		AssetID:     assetID,
+		RequestTime: requestTime,
+	}
+}
+
+func (p *StaffConfirmAssetUpload) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
+
+type StaffUploadAsset struct {
+	StaffID     string            `validate:"required"`
+	ContentType model.ContentType `validate:"required"`
+	AssetType   model.AssetType   `validate:"required"`
+	// Checksum is the hex-encoded SHA-256 of Content.
+	Checksum    string    `validate:"required,len=64,hexadecimal"`
+	Content     io.Reader `validate:"required"`
+	RequestTime time.Time `validate:"required"`
+}
+
+func NewStaffUploadAsset(
+	staffID string,
+	contentType model.ContentType,
+	assetType model.AssetType,
+	checksum string,
+	content io.Reader,
+	requestTime time.Time,
+) *StaffUploadAsset {
+	return &StaffUploadAsset{
+		StaffID:     staffID,
+		ContentType: contentType,
+		AssetType:   assetType,
+		Checksum:    checksum,
+		Content:     content,
+		RequestTime: requestTime,
+	}
+}
+
+func (p *StaffUploadAsset) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	if !p.AssetType.Valid() {
+		return errors.RequestInvalidArgumentErr.New().
+			WithDetail(fmt.Sprintf("invalid asset type %s", p.AssetType))
+	}
+	if !p.ContentType.Valid() {
+		return errors.RequestInvalidArgumentErr.New().
+			WithDetail(fmt.Sprintf("invalid content type %s", p.ContentType))
+	}
+	return nil
+}
+
+type StaffDownloadAsset struct {
+	StaffID string `validate:"required"`
+	AssetID string `validate:"required"`
+}
+
+func NewStaffDownloadAsset(
+	staffID string,
+	assetID string,
+) *StaffDownloadAsset {
+	return &StaffDownloadAsset{
+		StaffID: staffID,
+		AssetID: assetID,
+	}
+}
+
+func (p *StaffDownloadAsset) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package input
+
+import (
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/validation"
+)
+
+type StaffCreateInvitation struct {
+	TenantID    string          `validate:"required"`
+	StaffID     string          `validate:"required"`
+	Email       string          `validate:"required,email"`
+	Role        model.StaffRole `validate:"required"`
+	Actor       model.AuditLogActor
+	RequestTime time.Time `validate:"required"`
+}
+
+func NewStaffCreateInvitation(
+	tenantID string,
+	staffID string,
+	email string,
+	role model.StaffRole,
+	actor model.AuditLogActor,
+	requestTime time.Time,
+) *StaffCreateInvitation {
+	return &StaffCreateInvitation{
+		TenantID:    tenantID,
+		StaffID:     staffID,
+		Email:       email,
+		Role:        role,
+		Actor:       actor,
+		RequestTime: requestTime,
+	}
+}
+
+func (p *StaffCreateInvitation) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	if !p.Role.Valid() {
+		return errors.RequestInvalidArgumentErr.Errorf("invalid role: %s", p.Role)
+	}
+	return nil
+}
+
+type StaffListInvitations struct {
+	TenantID    string `validate:"required"`
+	StaffID     string `validate:"required"`
+	Page        uint64
+	Limit       uint64    `validate:"gte=1,lte=100"`
+	RequestTime time.Time `validate:"required"`
+}
+
+func NewStaffListInvitations(
+	tenantID string,
+	staffID string,
+	page uint64,
+	limit uint64,
+	requestTime time.Time,
+) *StaffListInvitations {
+	// Pagination defaults
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = 30
+	}
+
+	return &StaffListInvitations{
+		TenantID:    tenantID,
+		StaffID:     staffID,
+		Page:        page,
+		Limit:       limit,
+		RequestTime: requestTime,
+	}
+}
+
+func (p *StaffListInvitations) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
+
+type StaffRevokeInvitation struct {
+	TenantID     string `validate:"required"`
+	StaffID      string `validate:"required"`
+	InvitationID string `validate:"required"`
+	Actor        model.AuditLogActor
+	RequestTime  time.Time `validate:"required"`
+}
+
+func NewStaffRevokeInvitation(
+	tenantID string,
+	staffID string,
+	invitationID string,
+	actor model.AuditLogActor,
+	requestTime time.Time,
+) *StaffRevokeInvitation {
+	return &StaffRevokeInvitation{
+		TenantID:     tenantID,
+		StaffID:      staffID,
+		InvitationID: invitationID,
+		Actor:        actor,
+		RequestTime:  requestTime,
+	}
+}
+
+func (p *StaffRevokeInvitation) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
+
+// StaffAcceptInvitation is made by a signed in user who is not a staff yet,
+// so the caller is identified by the auth UID and email of the ID token.
+type StaffAcceptInvitation struct {
+	AuthUID     string    `validate:"required"`
+	Email       string    `validate:"required,email"`
+	Token       string    `validate:"required"`
+	DisplayName string    `validate:"required,max=256"`
+	Method      string    `validate:"required"`
+	RequestTime time.Time `validate:"required"`
+}
+
+func NewStaffAcceptInvitation(
+	authUID string,
+	email string,
+	token string,
+	displayName string,
+	method string,
+	requestTime time.Time,
+) *StaffAcceptInvitation {
+	return &StaffAcceptInvitation{
+		AuthUID:     authUID,
+		Email:       email,
+		Token:       token,
+		DisplayName: displayName,
+		Method:      method,
+		RequestTime: requestTime,
+	}
+}
+
+func (p *StaffAcceptInvitation) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aarondl/null/v8"
 	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/validation"
 )
 
@@ -14,6 +15,7 @@ type StaffSignUp struct {
 	TenantName   string    `validate:"required,max=256"`
 	DisplayName  string    `validate:"required,max=256"`
 	ImageAssetID string    `validate:"required"`
+	Method       string    `validate:"required"`
 	RequestTime  time.Time `validate:"required"`
 }
 
@@ -23,6 +25,7 @@ func NewStaffSignUp(
 	tenantName string,
 	displayName string,
 	imageAssetID string,
+	method string,
 	requestTime time.Time,
 ) *StaffSignUp {
 	return &StaffSignUp{
@@ -31,6 +34,7 @@ func NewStaffSignUp(
 		TenantName:   tenantName,
 		DisplayName:  displayName,
 		ImageAssetID: imageAssetID,
+		Method:       method,
 		RequestTime:  requestTime,
 	}
 }
@@ -72,6 +76,8 @@ type StaffUpdateMe struct {
 	StaffID      string `validate:"required"`
 	DisplayName  null.String
 	ImageAssetID null.String
+	ETag         null.String
+	Actor        model.AuditLogActor
 	RequestTime  time.Time `validate:"required"`
 }
 
@@ -80,6 +86,8 @@ func NewStaffUpdateMe(
 	staffID string,
 	displayName null.String,
 	imageAssetID null.String,
+	etag null.String,
+	actor model.AuditLogActor,
 	requestTime time.Time,
 ) *StaffUpdateMe {
 	return &StaffUpdateMe{
@@ -87,6 +95,8 @@ func NewStaffUpdateMe(
 		StaffID:      staffID,
 		DisplayName:  displayName,
 		ImageAssetID: imageAssetID,
+		ETag:         etag,
+		Actor:        actor,
 		RequestTime:  requestTime,
 	}
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/aarondl/null/v8"
 	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/validation"
 )
 
@@ -37,6 +39,9 @@ type StaffUpdateMeTenant struct {
 	TenantID    string `validate:"required"`
 	StaffID     string `validate:"required"`
 	Name        null.String
+	TagTypes    nullable.Type[[]model.TenantTagType]
+	ETag        null.String
+	Actor       model.AuditLogActor
 	RequestTime time.Time `validate:"required"`
 }
 
@@ -44,12 +49,18 @@ func NewStaffUpdateMeTenant(
 	tenantID string,
 	staffID string,
 	name null.String,
+	tagTypes nullable.Type[[]model.TenantTagType],
+	etag null.String,
+	actor model.AuditLogActor,
 	requestTime time.Time,
 ) *StaffUpdateMeTenant {
 	return &StaffUpdateMeTenant{
 		TenantID:    tenantID,
 		StaffID:     staffID,
 		Name:        name,
+		TagTypes:    tagTypes,
+		ETag:        etag,
+		Actor:       actor,
 		RequestTime: requestTime,
 	}
 }
@@ -58,5 +69,8 @@ func (p *StaffUpdateMeTenant) Validate() error {
 	if err := validation.Validate(p); err != nil {
 		return errors.RequestInvalidArgumentErr.Wrap(err)
 	}
+	if p.TagTypes.Valid {
+		return model.ValidateTenantTagTypes(p.TagTypes.Value())
+	}
 	return nil
 }
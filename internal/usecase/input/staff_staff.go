@@ -3,6 +3,7 @@ package input
 import (
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
 	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
@@ -40,12 +41,19 @@ func (p *StaffGetStaff) Validate() error {
 
 // StaffListStaffs represents input for listing staff members
 type StaffListStaffs struct {
-	StaffID     string `validate:"required"`
-	TenantID    string `validate:"required"`
-	Page        uint64
-	Limit       uint64             `validate:"gte=1,lte=100"`
-	SortKey     model.StaffSortKey // NON-nullable field
-	RequestTime time.Time          `validate:"required"`
+	StaffID           string `validate:"required"`
+	TenantID          string `validate:"required"`
+	Page              uint64
+	Limit             uint64             `validate:"gte=1,lte=100"`
+	SortKey           model.StaffSortKey // NON-nullable field
+	Cursor            null.String
+	IncludeTotalCount bool
+	DisplayName       null.String
+	Email             null.String
+	Role              nullable.Type[model.StaffRole]
+	CreatedAtFrom     null.Time
+	CreatedAtTo       null.Time
+	RequestTime       time.Time `validate:"required"`
 }
 
 func NewStaffListStaffs(
@@ -54,6 +62,13 @@ func NewStaffListStaffs(
 	page uint64,
 	limit uint64,
 	sortKey nullable.Type[model.StaffSortKey], // nullable param
+	cursor null.String,
+	includeTotalCount bool,
+	displayName null.String,
+	email null.String,
+	role nullable.Type[model.StaffRole],
+	createdAtFrom null.Time,
+	createdAtTo null.Time,
 	requestTime time.Time,
 ) *StaffListStaffs {
 	// Pagination defaults
@@ -71,12 +86,19 @@ func NewStaffListStaffs(
 	}
 
 	return &StaffListStaffs{
-		StaffID:     staffID,
-		TenantID:    tenantID,
-		Page:        page,
-		Limit:       limit,
-		SortKey:     resolvedSortKey,
-		RequestTime: requestTime,
+		StaffID:           staffID,
+		TenantID:          tenantID,
+		Page:              page,
+		Limit:             limit,
+		SortKey:           resolvedSortKey,
+		Cursor:            cursor,
+		IncludeTotalCount: includeTotalCount,
+		DisplayName:       displayName,
+		Email:             email,
+		Role:              role,
+		CreatedAtFrom:     createdAtFrom,
+		CreatedAtTo:       createdAtTo,
+		RequestTime:       requestTime,
 	}
 }
 
@@ -84,5 +106,11 @@ func (p *StaffListStaffs) Validate() error {
 	if err := validation.Validate(p); err != nil {
 		return errors.RequestInvalidArgumentErr.Wrap(err)
 	}
+	if p.Role.Valid && !p.Role.Value().Valid() {
+		return errors.RequestInvalidArgumentErr.Errorf("invalid role: %s", p.Role.Value())
+	}
+	if p.CreatedAtFrom.Valid && p.CreatedAtTo.Valid && !p.CreatedAtFrom.Time.Before(p.CreatedAtTo.Time) {
+		return errors.RequestInvalidArgumentErr.Errorf("created_at_from must be before created_at_to")
+	}
 	return nil
 }
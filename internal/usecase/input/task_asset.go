@@ -0,0 +1,35 @@
+package input
+
+import (
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/validation"
+)
+
+type TaskCleanupAssets struct {
+	Retention   time.Duration `validate:"gt=0"`
+	RequestTime time.Time     `validate:"required"`
+}
+
+func NewTaskCleanupAssets(
+	retention time.Duration,
+	t time.Time,
+) *TaskCleanupAssets {
+	return &TaskCleanupAssets{
+		Retention:   retention,
+		RequestTime: t,
+	}
+}
+
+func (p *TaskCleanupAssets) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
+
+// UploadedBefore returns the time before which uploaded assets never attached are orphaned.
+func (p *TaskCleanupAssets) UploadedBefore() time.Time {
+	return p.RequestTime.Add(-p.Retention)
+}
@@ -0,0 +1,54 @@
+package input
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/validation"
+)
+
+type TaskEnqueueJob struct {
+	Type        model.JobType
+	Payload     model.JobPayload
+	RunAt       null.Time // runs immediately when not set
+	MaxAttempts uint64    `validate:"gt=0"`
+	RequestTime time.Time `validate:"required"`
+}
+
+func NewTaskEnqueueJob(
+	jobType model.JobType,
+	payload model.JobPayload,
+	runAt null.Time,
+	maxAttempts uint64,
+	t time.Time,
+) *TaskEnqueueJob {
+	return &TaskEnqueueJob{
+		Type:        jobType,
+		Payload:     payload,
+		RunAt:       runAt,
+		MaxAttempts: maxAttempts,
+		RequestTime: t,
+	}
+}
+
+func (p *TaskEnqueueJob) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	if !p.Type.Valid() {
+		return errors.RequestInvalidArgumentErr.New().
+			WithDetail(fmt.Sprintf("invalid job type %s", p.Type))
+	}
+	return nil
+}
+
+// ScheduledAt returns the time the job becomes due.
+func (p *TaskEnqueueJob) ScheduledAt() time.Time {
+	if p.RunAt.Valid {
+		return p.RunAt.Time
+	}
+	return p.RequestTime
+}
@@ -0,0 +1,35 @@
+package input
+
+import (
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/validation"
+)
+
+type TaskPurgeDeletedTenants struct {
+	Retention   time.Duration `validate:"gt=0"`
+	RequestTime time.Time     `validate:"required"`
+}
+
+func NewTaskPurgeDeletedTenants(
+	retention time.Duration,
+	t time.Time,
+) *TaskPurgeDeletedTenants {
+	return &TaskPurgeDeletedTenants{
+		Retention:   retention,
+		RequestTime: t,
+	}
+}
+
+func (p *TaskPurgeDeletedTenants) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
+
+// DeletedBefore returns the time before which soft deleted rows are purged.
+func (p *TaskPurgeDeletedTenants) DeletedBefore() time.Time {
+	return p.RequestTime.Add(-p.Retention)
+}
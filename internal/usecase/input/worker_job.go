@@ -0,0 +1,32 @@
+package input
+
+import (
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/validation"
+)
+
+// WorkerProcessJobs has no request time, since the jobs of a batch are claimed and finished
+// at the times they are, long after the batch starts.
+type WorkerProcessJobs struct {
+	BatchSize     uint64        `validate:"gt=0"`
+	LeaseDuration time.Duration `validate:"gt=0"`
+}
+
+func NewWorkerProcessJobs(
+	batchSize uint64,
+	leaseDuration time.Duration,
+) *WorkerProcessJobs {
+	return &WorkerProcessJobs{
+		BatchSize:     batchSize,
+		LeaseDuration: leaseDuration,
+	}
+}
+
+func (p *WorkerProcessJobs) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package input
+
+import (
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/validation"
+)
+
+type WorkerRelayOutbox struct {
+	BatchSize     uint64        `validate:"gt=0"`
+	MaxAttempts   uint64        `validate:"gt=0"`
+	LeaseDuration time.Duration `validate:"gt=0"`
+	RequestTime   time.Time     `validate:"required"`
+}
+
+func NewWorkerRelayOutbox(
+	batchSize uint64,
+	maxAttempts uint64,
+	leaseDuration time.Duration,
+	t time.Time,
+) *WorkerRelayOutbox {
+	return &WorkerRelayOutbox{
+		BatchSize:     batchSize,
+		MaxAttempts:   maxAttempts,
+		LeaseDuration: leaseDuration,
+		RequestTime:   t,
+	}
+}
+
+func (p *WorkerRelayOutbox) Validate() error {
+	if err := validation.Validate(p); err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err)
+	}
+	return nil
+}
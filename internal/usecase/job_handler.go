@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
+// JobHandler runs the jobs of a single type for the worker.
+// A job is retried after a failure or an expired lease, so handlers must be idempotent.
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+type JobHandler interface {
+	Type() model.JobType
+	Handle(
+		ctx context.Context,
+		job *model.Job,
+		requestTime time.Time,
+	) error
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+)
+
+// PurgeDeletedTenantsJobRetentionKey is the payload key of the retention window, such as "720h".
+const PurgeDeletedTenantsJobRetentionKey = "retention"
+
+type purgeDeletedTenantsJobHandler struct {
+	taskTenantInteractor TaskTenantInteractor
+}
+
+func NewPurgeDeletedTenantsJobHandler(
+	taskTenantInteractor TaskTenantInteractor,
+) JobHandler {
+	return &purgeDeletedTenantsJobHandler{
+		taskTenantInteractor: taskTenantInteractor,
+	}
+}
+
+func (h *purgeDeletedTenantsJobHandler) Type() model.JobType {
+	return model.JobTypePurgeDeletedTenants
+}
+
+func (h *purgeDeletedTenantsJobHandler) Handle(
+	ctx context.Context,
+	job *model.Job,
+	requestTime time.Time,
+) error {
+	value, ok := job.Payload[PurgeDeletedTenantsJobRetentionKey].(string)
+	if !ok {
+		return errors.RequestInvalidArgumentErr.New().
+			WithDetail("retention is required").
+			WithValue("job_id", job.ID)
+	}
+	retention, err := time.ParseDuration(value)
+	if err != nil {
+		return errors.RequestInvalidArgumentErr.Wrap(err).
+			WithValue("job_id", job.ID)
+	}
+
+	if _, err := h.taskTenantInteractor.PurgeDeleted(
+		ctx,
+		input.NewTaskPurgeDeletedTenants(
+			retention,
+			requestTime,
+		),
+	); err != nil {
+		return err
+	}
+	return nil
+}
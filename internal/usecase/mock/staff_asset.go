@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	model "github.com/abyssparanoia/rapid-go/internal/domain/model"
 	input "github.com/abyssparanoia/rapid-go/internal/usecase/input"
 	output "github.com/abyssparanoia/rapid-go/internal/usecase/output"
 	gomock "go.uber.org/mock/gomock"
@@ -42,6 +43,21 @@ func (m *MockStaffAssetInteractor) EXPECT() *MockStaffAssetInteractorMockRecorde
 	return m.recorder
 }
 
+// ConfirmUpload mocks base method.
+func (m *MockStaffAssetInteractor) ConfirmUpload(ctx context.Context, param *input.StaffConfirmAssetUpload) (*model.Asset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmUpload", ctx, param)
+	ret0, _ := ret[0].(*model.Asset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmUpload indicates an expected call of ConfirmUpload.
+func (mr *MockStaffAssetInteractorMockRecorder) ConfirmUpload(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmUpload", reflect.TypeOf((*MockStaffAssetInteractor)(nil).ConfirmUpload), ctx, param)
+}
+
 // CreatePresignedURL mocks base method.
 func (m *MockStaffAssetInteractor) CreatePresignedURL(ctx context.Context, param *input.StaffCreateAssetPresignedURL) (*output.StaffCreateAssetPresignedURL, error) {
 	m.ctrl.T.Helper()
@@ -56,3 +72,33 @@ func (mr *MockStaffAssetInteractorMockRecorder) CreatePresignedURL(ctx, param an
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePresignedURL", reflect.TypeOf((*MockStaffAssetInteractor)(nil).CreatePresignedURL), ctx, param)
 }
+
+// Download mocks base method.
+func (m *MockStaffAssetInteractor) Download(ctx context.Context, param *input.StaffDownloadAsset) (*output.StaffDownloadAsset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, param)
+	ret0, _ := ret[0].(*output.StaffDownloadAsset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockStaffAssetInteractorMockRecorder) Download(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockStaffAssetInteractor)(nil).Download), ctx, param)
+}
+
+// Upload mocks base method.
+func (m *MockStaffAssetInteractor) Upload(ctx context.Context, param *input.StaffUploadAsset) (*model.Asset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, param)
+	ret0, _ := ret[0].(*model.Asset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockStaffAssetInteractorMockRecorder) Upload(ctx, param any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockStaffAssetInteractor)(nil).Upload), ctx, param)
+}
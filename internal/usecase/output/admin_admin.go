@@ -0,0 +1,30 @@
+package output
+
+import "github.com/abyssparanoia/rapid-go/internal/domain/model"
+
+type AdminCreateAdmin struct {
+	Admin    *model.Admin
+	Password string //nolint:gosec
+}
+
+func NewAdminCreateAdmin(admin *model.Admin, password string) *AdminCreateAdmin {
+	return &AdminCreateAdmin{
+		Admin:    admin,
+		Password: password,
+	}
+}
+
+type ListAdmins struct {
+	Admins     model.Admins
+	Pagination *model.Pagination
+}
+
+func NewAdminListAdmins(
+	admins model.Admins,
+	pagination *model.Pagination,
+) *ListAdmins {
+	return &ListAdmins{
+		Admins:     admins,
+		Pagination: pagination,
+	}
+}
@@ -1,16 +1,38 @@
 package output
 
+import (
+	"io"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
 type AdminCreateAssetPresignedURL struct {
-	AssetID      string
-	PresignedURL string
+	AssetID         string
+	PresignedUpload *model.AssetPresignedUpload
 }
 
 func NewAdminCreateAssetPresignedURL(
 	assetID string,
-	presignedURL string,
+	presignedUpload *model.AssetPresignedUpload,
 ) *AdminCreateAssetPresignedURL {
 	return &AdminCreateAssetPresignedURL{
-		AssetID:      assetID,
-		PresignedURL: presignedURL,
+		AssetID:         assetID,
+		PresignedUpload: presignedUpload,
+	}
+}
+
+// AdminDownloadAsset holds the content of the asset, which the caller must close.
+type AdminDownloadAsset struct {
+	Asset   *model.Asset
+	Content io.ReadCloser
+}
+
+func NewAdminDownloadAsset(
+	asset *model.Asset,
+	content io.ReadCloser,
+) *AdminDownloadAsset {
+	return &AdminDownloadAsset{
+		Asset:   asset,
+		Content: content,
 	}
 }
@@ -0,0 +1,18 @@
+package output
+
+import "github.com/abyssparanoia/rapid-go/internal/domain/model"
+
+type ListAuditLogs struct {
+	AuditLogs  model.AuditLogs
+	Pagination *model.Pagination
+}
+
+func NewAdminListAuditLogs(
+	auditLogs model.AuditLogs,
+	pagination *model.Pagination,
+) *ListAuditLogs {
+	return &ListAuditLogs{
+		AuditLogs:  auditLogs,
+		Pagination: pagination,
+	}
+}
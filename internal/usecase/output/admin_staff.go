@@ -1,6 +1,9 @@
 package output
 
-import "github.com/abyssparanoia/rapid-go/internal/domain/model"
+import (
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
 
 type AdminCreateStaff struct {
 	Staff    *model.Staff
@@ -17,24 +20,29 @@ func NewAdminCreateStaff(staff *model.Staff, password string) *AdminCreateStaff
 type ListStaffs struct {
 	Staffs     model.Staffs
 	Pagination *model.Pagination
+	NextCursor null.String
 }
 
 func NewAdminListStaffs(
 	staffs model.Staffs,
 	pagination *model.Pagination,
+	nextCursor null.String,
 ) *ListStaffs {
 	return &ListStaffs{
 		Staffs:     staffs,
 		Pagination: pagination,
+		NextCursor: nextCursor,
 	}
 }
 
 func NewStaffListStaffs(
 	staffs model.Staffs,
 	pagination *model.Pagination,
+	nextCursor null.String,
 ) *ListStaffs {
 	return &ListStaffs{
 		Staffs:     staffs,
 		Pagination: pagination,
+		NextCursor: nextCursor,
 	}
 }
@@ -1,28 +1,36 @@
 package output
 
-import "github.com/abyssparanoia/rapid-go/internal/domain/model"
+import (
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
 
 type ListTenants struct {
 	Tenants    model.Tenants
 	Pagination *model.Pagination
+	NextCursor null.String
 }
 
 func NewAdminListTenants(
 	tenants model.Tenants,
 	pagination *model.Pagination,
+	nextCursor null.String,
 ) *ListTenants {
 	return &ListTenants{
 		Tenants:    tenants,
 		Pagination: pagination,
+		NextCursor: nextCursor,
 	}
 }
 
 func NewStaffListTenants(
 	tenants model.Tenants,
 	pagination *model.Pagination,
+	nextCursor null.String,
 ) *ListTenants {
 	return &ListTenants{
 		Tenants:    tenants,
 		Pagination: pagination,
+		NextCursor: nextCursor,
 	}
 }
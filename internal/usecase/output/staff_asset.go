@@ -1,16 +1,38 @@
 package output
 
+import (
+	"io"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
 type StaffCreateAssetPresignedURL struct {
-	AssetID      string
-	PresignedURL string
+	AssetID         string
+	PresignedUpload *model.AssetPresignedUpload
 }
 
 func NewStaffCreateAssetPresignedURL(
 	assetID string,
-	presignedURL string,
+	presignedUpload *model.AssetPresignedUpload,
 ) *StaffCreateAssetPresignedURL {
 	return &StaffCreateAssetPresignedURL{
-		AssetID:      assetID,
-		PresignedURL: presignedURL,
+		AssetID:         assetID,
+		PresignedUpload: presignedUpload,
+	}
+}
+
+// StaffDownloadAsset holds the content of the asset, which the caller must close.
+type StaffDownloadAsset struct {
+	Asset   *model.Asset
+	Content io.ReadCloser
+}
+
+func NewStaffDownloadAsset(
+	asset *model.Asset,
+	content io.ReadCloser,
+) *StaffDownloadAsset {
+	return &StaffDownloadAsset{
+		Asset:   asset,
+		Content: content,
 	}
 }
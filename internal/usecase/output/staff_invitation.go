@@ -0,0 +1,20 @@
+package output
+
+import (
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+)
+
+type StaffListInvitations struct {
+	Invitations model.Invitations
+	Pagination  *model.Pagination
+}
+
+func NewStaffListInvitations(
+	invitations model.Invitations,
+	pagination *model.Pagination,
+) *StaffListInvitations {
+	return &StaffListInvitations{
+		Invitations: invitations,
+		Pagination:  pagination,
+	}
+}
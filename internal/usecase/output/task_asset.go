@@ -0,0 +1,16 @@
+package output
+
+type TaskCleanupAssets struct {
+	OrphanedAssetCount int
+	DeletedAssetCount  int
+}
+
+func NewTaskCleanupAssets(
+	orphanedAssetCount int,
+	deletedAssetCount int,
+) *TaskCleanupAssets {
+	return &TaskCleanupAssets{
+		OrphanedAssetCount: orphanedAssetCount,
+		DeletedAssetCount:  deletedAssetCount,
+	}
+}
@@ -0,0 +1,18 @@
+package output
+
+import "time"
+
+type TaskEnqueueJob struct {
+	JobID string
+	RunAt time.Time
+}
+
+func NewTaskEnqueueJob(
+	jobID string,
+	runAt time.Time,
+) *TaskEnqueueJob {
+	return &TaskEnqueueJob{
+		JobID: jobID,
+		RunAt: runAt,
+	}
+}
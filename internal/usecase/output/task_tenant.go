@@ -0,0 +1,16 @@
+package output
+
+type TaskPurgeDeletedTenants struct {
+	PurgedTenantCount int
+	PurgedStaffCount  int
+}
+
+func NewTaskPurgeDeletedTenants(
+	purgedTenantCount int,
+	purgedStaffCount int,
+) *TaskPurgeDeletedTenants {
+	return &TaskPurgeDeletedTenants{
+		PurgedTenantCount: purgedTenantCount,
+		PurgedStaffCount:  purgedStaffCount,
+	}
+}
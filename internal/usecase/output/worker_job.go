@@ -0,0 +1,19 @@
+package output
+
+type WorkerProcessJobs struct {
+	SucceededCount int
+	RetriedCount   int
+	DeadCount      int
+}
+
+func NewWorkerProcessJobs(
+	succeededCount int,
+	retriedCount int,
+	deadCount int,
+) *WorkerProcessJobs {
+	return &WorkerProcessJobs{
+		SucceededCount: succeededCount,
+		RetriedCount:   retriedCount,
+		DeadCount:      deadCount,
+	}
+}
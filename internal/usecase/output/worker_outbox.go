@@ -0,0 +1,16 @@
+package output
+
+type WorkerRelayOutbox struct {
+	DeliveredCount int
+	FailedCount    int
+}
+
+func NewWorkerRelayOutbox(
+	deliveredCount int,
+	failedCount int,
+) *WorkerRelayOutbox {
+	return &WorkerRelayOutbox{
+		DeliveredCount: deliveredCount,
+		FailedCount:    failedCount,
+	}
+}
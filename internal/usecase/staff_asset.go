@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
 	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
 	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
 )
@@ -13,4 +14,17 @@ type StaffAssetInteractor interface {
 		ctx context.Context,
 		param *input.StaffCreateAssetPresignedURL,
 	) (*output.StaffCreateAssetPresignedURL, error)
+	ConfirmUpload(
+		ctx context.Context,
+		param *input.StaffConfirmAssetUpload,
+	) (*model.Asset, error)
+	// Upload stores the streamed content as a new asset and confirms it.
+	Upload(
+		ctx context.Context,
+		param *input.StaffUploadAsset,
+	) (*model.Asset, error)
+	Download(
+		ctx context.Context,
+		param *input.StaffDownloadAsset,
+	) (*output.StaffDownloadAsset, error)
 }
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+type StaffInvitationInteractor interface {
+	Create(
+		ctx context.Context,
+		param *input.StaffCreateInvitation,
+	) (*model.Invitation, error)
+	List(
+		ctx context.Context,
+		param *input.StaffListInvitations,
+	) (*output.StaffListInvitations, error)
+	Revoke(
+		ctx context.Context,
+		param *input.StaffRevokeInvitation,
+	) (*model.Invitation, error)
+	Accept(
+		ctx context.Context,
+		param *input.StaffAcceptInvitation,
+	) (*model.Staff, error)
+}
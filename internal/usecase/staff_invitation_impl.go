@@ -0,0 +1,328 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/domain/service"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/token"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+)
+
+type staffInvitationInteractor struct {
+	transactable              repository.Transactable
+	tenantRepository          repository.Tenant
+	staffRepository           repository.Staff
+	invitationRepository      repository.Invitation
+	auditLogRepository        repository.AuditLog
+	outboxEventRepository     repository.OutboxEvent
+	invitationEmailRepository repository.InvitationEmail
+	staffService              service.Staff
+	assetService              service.Asset
+}
+
+func NewStaffInvitationInteractor(
+	transactable repository.Transactable,
+	tenantRepository repository.Tenant,
+	staffRepository repository.Staff,
+	invitationRepository repository.Invitation,
+	auditLogRepository repository.AuditLog,
+	outboxEventRepository repository.OutboxEvent,
+	invitationEmailRepository repository.InvitationEmail,
+	staffService service.Staff,
+	assetService service.Asset,
+) StaffInvitationInteractor {
+	return &staffInvitationInteractor{
+		transactable:              transactable,
+		tenantRepository:          tenantRepository,
+		staffRepository:           staffRepository,
+		invitationRepository:      invitationRepository,
+		auditLogRepository:        auditLogRepository,
+		outboxEventRepository:     outboxEventRepository,
+		invitationEmailRepository: invitationEmailRepository,
+		staffService:              staffService,
+		assetService:              assetService,
+	}
+}
+
+func (i *staffInvitationInteractor) Create(
+	ctx context.Context,
+	param *input.StaffCreateInvitation,
+) (*model.Invitation, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	var invitation *model.Invitation
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		tenant, err := i.tenantRepository.Get(ctx, repository.GetTenantQuery{
+			ID: null.StringFrom(param.TenantID),
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		rawToken, err := token.Generate(token.DefaultByteLength)
+		if err != nil {
+			return errors.InternalErr.Wrap(err)
+		}
+
+		invitation = model.NewInvitation(
+			tenant.ID,
+			param.Email,
+			param.Role,
+			token.Hash(rawToken),
+			param.StaffID,
+			param.RequestTime,
+		)
+		if err := i.invitationRepository.Create(ctx, invitation); err != nil {
+			return err
+		}
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			param.Actor,
+			null.StringFrom(tenant.ID),
+			model.AuditLogTargetTypeInvitation,
+			invitation.ID,
+			nil,
+			invitation.AuditLogSnapshot(),
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+
+		// Send last, so that a failed delivery rolls back the invitation.
+		return i.invitationEmailRepository.Send(ctx, repository.InvitationEmailSendParam{
+			Email:      invitation.Email,
+			TenantName: tenant.Name,
+			Role:       invitation.Role,
+			Token:      rawToken,
+			ExpiresAt:  invitation.ExpiresAt,
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func (i *staffInvitationInteractor) List(
+	ctx context.Context,
+	param *input.StaffListInvitations,
+) (*output.StaffListInvitations, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	query := repository.ListInvitationsQuery{
+		TenantID: null.StringFrom(param.TenantID),
+		BaseListOptions: repository.BaseListOptions{
+			Page:  null.Uint64From(param.Page),
+			Limit: null.Uint64From(param.Limit),
+		},
+	}
+
+	invitations, err := i.invitationRepository.List(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := i.invitationRepository.Count(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewStaffListInvitations(
+		invitations,
+		model.NewPagination(param.Page, param.Limit, totalCount),
+	), nil
+}
+
+func (i *staffInvitationInteractor) Revoke(
+	ctx context.Context,
+	param *input.StaffRevokeInvitation,
+) (*model.Invitation, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	var invitation *model.Invitation
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		invitation, err = i.invitationRepository.Get(ctx, repository.GetInvitationQuery{
+			ID:       null.StringFrom(param.InvitationID),
+			TenantID: null.StringFrom(param.TenantID),
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail:    true,
+				ForUpdate: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if status := invitation.Status(param.RequestTime); !status.IsPending() {
+			return errors.InvitationNotPendingErr.New().
+				WithDetail("only a pending invitation can be revoked").
+				WithValue("status", status.String())
+		}
+
+		before := invitation.AuditLogSnapshot()
+		invitation.Revoke(param.RequestTime)
+
+		if err := i.invitationRepository.Update(ctx, invitation); err != nil {
+			return err
+		}
+		return i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			param.Actor,
+			null.StringFrom(invitation.TenantID),
+			model.AuditLogTargetTypeInvitation,
+			invitation.ID,
+			before,
+			invitation.AuditLogSnapshot(),
+			param.RequestTime,
+		))
+	}); err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func (i *staffInvitationInteractor) Accept(
+	ctx context.Context,
+	param *input.StaffAcceptInvitation,
+) (*model.Staff, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	var staff *model.Staff
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		invitation, err := i.invitationRepository.Get(ctx, repository.GetInvitationQuery{
+			TokenHash: null.StringFrom(token.Hash(param.Token)),
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail:    true,
+				ForUpdate: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		switch status := invitation.Status(param.RequestTime); status {
+		case model.InvitationStatusPending:
+		case model.InvitationStatusExpired:
+			return errors.InvitationExpiredErr.New().
+				WithDetail("invitation has expired").
+				WithValue("expires_at", invitation.ExpiresAt)
+		case model.InvitationStatusAccepted, model.InvitationStatusRevoked, model.InvitationStatusUnknown:
+			fallthrough
+		default:
+			return errors.InvitationNotPendingErr.New().
+				WithDetail("only a pending invitation can be accepted").
+				WithValue("status", status.String())
+		}
+
+		if !strings.EqualFold(invitation.Email, param.Email) {
+			return errors.InvitationEmailMismatchErr.New().
+				WithDetail("invitation is not addressed to the caller")
+		}
+
+		// A user belongs to a single tenant, including a soft deleted one.
+		existingStaff, err := i.staffRepository.Get(ctx, repository.GetStaffQuery{
+			AuthUID:        null.StringFrom(param.AuthUID),
+			IncludeDeleted: true,
+		})
+		if err != nil {
+			return err
+		}
+		if existingStaff.Exist() {
+			return errors.StaffAlreadyExistsErr.New().
+				WithDetail("user is already a staff").
+				WithValue("staff_id", existingStaff.ID)
+		}
+
+		if _, err := i.tenantRepository.Get(ctx, repository.GetTenantQuery{
+			ID: null.StringFrom(invitation.TenantID),
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail: true,
+			},
+		}); err != nil {
+			return err
+		}
+
+		staff, err = i.staffService.Create(ctx, service.StaffCreateParam{
+			TenantID:    invitation.TenantID,
+			Email:       param.Email,
+			Password:    "", // User already has an auth account
+			StaffRole:   invitation.Role,
+			DisplayName: param.DisplayName,
+			ImagePath:   "",
+			RequestTime: param.RequestTime,
+		})
+		if err != nil {
+			return err
+		}
+
+		before := invitation.AuditLogSnapshot()
+		invitation.Accept(staff.ID, param.RequestTime)
+		if err := i.invitationRepository.Update(ctx, invitation); err != nil {
+			return err
+		}
+
+		// The new staff is the actor of its own acceptance.
+		actor := model.NewStaffAuditLogActor(staff.ID, param.Method)
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			actor,
+			null.StringFrom(invitation.TenantID),
+			model.AuditLogTargetTypeStaff,
+			staff.ID,
+			nil,
+			staff.AuditLogSnapshot(),
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			actor,
+			null.StringFrom(invitation.TenantID),
+			model.AuditLogTargetTypeInvitation,
+			invitation.ID,
+			before,
+			invitation.AuditLogSnapshot(),
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+		return i.outboxEventRepository.BatchCreate(ctx, model.NewOutboxEvents(
+			model.NewStaffCreatedEvent(staff, param.RequestTime),
+		))
+	}); err != nil {
+		return nil, err
+	}
+
+	staff, err := i.staffRepository.Get(ctx, repository.GetStaffQuery{
+		ID: null.StringFrom(staff.ID),
+		BaseGetOptions: repository.BaseGetOptions{
+			OrFail:  true,
+			Preload: true,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.assetService.BatchSetStaffURLs(ctx, model.Staffs{staff}, param.RequestTime); err != nil {
+		return nil, err
+	}
+
+	return staff, nil
+}
@@ -0,0 +1,690 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
+	"github.com/abyssparanoia/rapid-go/internal/domain/service"
+	mock_service "github.com/abyssparanoia/rapid-go/internal/domain/service/mock"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/token"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStaffInvitationInteractor_Create(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		tenantID    string
+		staffID     string
+		email       string
+		role        model.StaffRole
+		actor       model.AuditLogActor
+		requestTime time.Time
+	}
+
+	type want struct {
+		invitation     *model.Invitation
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase StaffInvitationInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"invalid argument": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return testcase{
+				args: args{
+					// Empty args to trigger validation error
+				},
+				usecase: &staffInvitationInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"invalid role": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+
+			return testcase{
+				args: args{
+					tenantID:    testdata.Tenant.ID,
+					staffID:     testdata.Staff.ID,
+					email:       "invitee@example.com",
+					role:        model.StaffRoleUnknown,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &staffInvitationInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+			staff := testdata.Staff
+			requestTime := testdata.RequestTime
+			mockID := id.Mock()
+			mockToken := token.Mock("mock-token")
+
+			invitation := &model.Invitation{
+				ID:              mockID,
+				TenantID:        tenant.ID,
+				Email:           "invitee@example.com",
+				Role:            model.StaffRoleNormal,
+				TokenHash:       token.Hash(mockToken),
+				InvitedBy:       staff.ID,
+				ExpiresAt:       requestTime.Add(model.InvitationExpiresIn),
+				AcceptedAt:      null.Time{},
+				AcceptedStaffID: null.String{},
+				RevokedAt:       null.Time{},
+				CreatedAt:       requestTime,
+				UpdatedAt:       requestTime,
+			}
+
+			mockTransactable := mock_repository.TestMockTransactable()
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(), repository.GetTenantQuery{
+					ID: null.StringFrom(tenant.ID),
+					BaseGetOptions: repository.BaseGetOptions{
+						OrFail: true,
+					},
+				}).
+				Return(tenant, nil)
+			mockInvitationRepo := mock_repository.NewMockInvitation(ctrl)
+			mockInvitationRepo.EXPECT().
+				Create(gomock.Any(), invitation).
+				Return(nil)
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(nil)
+			mockInvitationEmailRepo := mock_repository.NewMockInvitationEmail(ctrl)
+			mockInvitationEmailRepo.EXPECT().
+				Send(gomock.Any(), repository.InvitationEmailSendParam{
+					Email:      invitation.Email,
+					TenantName: tenant.Name,
+					Role:       model.StaffRoleNormal,
+					Token:      mockToken,
+					ExpiresAt:  invitation.ExpiresAt,
+				}).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					tenantID:    tenant.ID,
+					staffID:     staff.ID,
+					email:       invitation.Email,
+					role:        model.StaffRoleNormal,
+					actor:       model.NewStaffAuditLogActor(staff.ID, "/rapid.staff_api.v1.StaffV1Service/CreateInvitation"),
+					requestTime: requestTime,
+				},
+				usecase: &staffInvitationInteractor{
+					transactable:              mockTransactable,
+					tenantRepository:          mockTenantRepo,
+					invitationRepository:      mockInvitationRepo,
+					auditLogRepository:        mockAuditLogRepo,
+					invitationEmailRepository: mockInvitationEmailRepo,
+				},
+				want: want{
+					invitation: invitation,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.Create(ctx, input.NewStaffCreateInvitation(
+				tc.args.tenantID,
+				tc.args.staffID,
+				tc.args.email,
+				tc.args.role,
+				tc.args.actor,
+				tc.args.requestTime,
+			))
+
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.invitation, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
+
+func TestStaffInvitationInteractor_List(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		tenantID    string
+		staffID     string
+		page        uint64
+		limit       uint64
+		requestTime time.Time
+	}
+
+	type want struct {
+		output         *output.StaffListInvitations
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase StaffInvitationInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			invitation := testdata.Invitation
+
+			query := repository.ListInvitationsQuery{
+				TenantID: null.StringFrom(invitation.TenantID),
+				BaseListOptions: repository.BaseListOptions{
+					Page:  null.Uint64From(1),
+					Limit: null.Uint64From(30),
+				},
+			}
+			mockInvitationRepo := mock_repository.NewMockInvitation(ctrl)
+			mockInvitationRepo.EXPECT().
+				List(gomock.Any(), query).
+				Return(model.Invitations{invitation}, nil)
+			mockInvitationRepo.EXPECT().
+				Count(gomock.Any(), query).
+				Return(uint64(1), nil)
+
+			return testcase{
+				args: args{
+					tenantID:    invitation.TenantID,
+					staffID:     invitation.InvitedBy,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &staffInvitationInteractor{
+					invitationRepository: mockInvitationRepo,
+				},
+				want: want{
+					output: output.NewStaffListInvitations(
+						model.Invitations{invitation},
+						model.NewPagination(1, 30, 1),
+					),
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.List(ctx, input.NewStaffListInvitations(
+				tc.args.tenantID,
+				tc.args.staffID,
+				tc.args.page,
+				tc.args.limit,
+				tc.args.requestTime,
+			))
+
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.output, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
+
+func TestStaffInvitationInteractor_Revoke(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		tenantID     string
+		staffID      string
+		invitationID string
+		actor        model.AuditLogActor
+		requestTime  time.Time
+	}
+
+	type want struct {
+		invitation     *model.Invitation
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase StaffInvitationInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	getQuery := func(invitation *model.Invitation) repository.GetInvitationQuery {
+		return repository.GetInvitationQuery{
+			ID:       null.StringFrom(invitation.ID),
+			TenantID: null.StringFrom(invitation.TenantID),
+			BaseGetOptions: repository.BaseGetOptions{
+				OrFail:    true,
+				ForUpdate: true,
+			},
+		}
+	}
+
+	tests := map[string]testcaseFunc{
+		"not pending": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			invitation := testdata.Invitation
+			invitation.AcceptedAt = null.TimeFrom(testdata.RequestTime)
+
+			mockTransactable := mock_repository.TestMockTransactable()
+			mockInvitationRepo := mock_repository.NewMockInvitation(ctrl)
+			mockInvitationRepo.EXPECT().
+				Get(gomock.Any(), getQuery(invitation)).
+				Return(invitation, nil)
+
+			return testcase{
+				args: args{
+					tenantID:     invitation.TenantID,
+					staffID:      invitation.InvitedBy,
+					invitationID: invitation.ID,
+					requestTime:  testdata.RequestTime,
+				},
+				usecase: &staffInvitationInteractor{
+					transactable:         mockTransactable,
+					invitationRepository: mockInvitationRepo,
+				},
+				want: want{
+					expectedResult: errors.InvitationNotPendingErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			invitation := testdata.Invitation
+			requestTime := testdata.RequestTime.Add(time.Hour)
+
+			revokedInvitation := &model.Invitation{} //nolint:exhaustruct
+			factory.CloneValue(invitation, revokedInvitation)
+			revokedInvitation.RevokedAt = null.TimeFrom(requestTime)
+			revokedInvitation.UpdatedAt = requestTime
+
+			mockTransactable := mock_repository.TestMockTransactable()
+			mockInvitationRepo := mock_repository.NewMockInvitation(ctrl)
+			mockInvitationRepo.EXPECT().
+				Get(gomock.Any(), getQuery(invitation)).
+				Return(invitation, nil)
+			mockInvitationRepo.EXPECT().
+				Update(gomock.Any(), revokedInvitation).
+				Return(nil)
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					tenantID:     invitation.TenantID,
+					staffID:      invitation.InvitedBy,
+					invitationID: invitation.ID,
+					actor:        model.NewStaffAuditLogActor(invitation.InvitedBy, "/rapid.staff_api.v1.StaffV1Service/RevokeInvitation"),
+					requestTime:  requestTime,
+				},
+				usecase: &staffInvitationInteractor{
+					transactable:         mockTransactable,
+					invitationRepository: mockInvitationRepo,
+					auditLogRepository:   mockAuditLogRepo,
+				},
+				want: want{
+					invitation: revokedInvitation,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.Revoke(ctx, input.NewStaffRevokeInvitation(
+				tc.args.tenantID,
+				tc.args.staffID,
+				tc.args.invitationID,
+				tc.args.actor,
+				tc.args.requestTime,
+			))
+
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.invitation, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
+
+func TestStaffInvitationInteractor_Accept(t *testing.T) {
+	t.Parallel()
+
+	const (
+		rawToken = "invitation-token"
+		method   = "/rapid.staff_api.v1.StaffV1Service/AcceptInvitation"
+	)
+
+	type args struct {
+		authUID     string
+		email       string
+		token       string
+		displayName string
+		requestTime time.Time
+	}
+
+	type want struct {
+		staff          *model.Staff
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase StaffInvitationInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	getQuery := repository.GetInvitationQuery{
+		TokenHash: null.StringFrom(token.Hash(rawToken)),
+		BaseGetOptions: repository.BaseGetOptions{
+			OrFail:    true,
+			ForUpdate: true,
+		},
+	}
+
+	tests := map[string]testcaseFunc{
+		"not found": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+
+			mockTransactable := mock_repository.TestMockTransactable()
+			mockInvitationRepo := mock_repository.NewMockInvitation(ctrl)
+			mockInvitationRepo.EXPECT().
+				Get(gomock.Any(), getQuery).
+				Return(nil, errors.InvitationNotFoundErr)
+
+			return testcase{
+				args: args{
+					authUID:     "auth-uid",
+					email:       "invitee@example.com",
+					token:       rawToken,
+					displayName: "Invitee",
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &staffInvitationInteractor{
+					transactable:         mockTransactable,
+					invitationRepository: mockInvitationRepo,
+				},
+				want: want{
+					expectedResult: errors.InvitationNotFoundErr,
+				},
+			}
+		},
+		"expired": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			invitation := testdata.Invitation
+
+			mockTransactable := mock_repository.TestMockTransactable()
+			mockInvitationRepo := mock_repository.NewMockInvitation(ctrl)
+			mockInvitationRepo.EXPECT().
+				Get(gomock.Any(), getQuery).
+				Return(invitation, nil)
+
+			return testcase{
+				args: args{
+					authUID:     "auth-uid",
+					email:       invitation.Email,
+					token:       rawToken,
+					displayName: "Invitee",
+					requestTime: invitation.ExpiresAt,
+				},
+				usecase: &staffInvitationInteractor{
+					transactable:         mockTransactable,
+					invitationRepository: mockInvitationRepo,
+				},
+				want: want{
+					expectedResult: errors.InvitationExpiredErr,
+				},
+			}
+		},
+		"email mismatch": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			invitation := testdata.Invitation
+			invitation.Email = "invitee@example.com"
+
+			mockTransactable := mock_repository.TestMockTransactable()
+			mockInvitationRepo := mock_repository.NewMockInvitation(ctrl)
+			mockInvitationRepo.EXPECT().
+				Get(gomock.Any(), getQuery).
+				Return(invitation, nil)
+
+			return testcase{
+				args: args{
+					authUID:     "auth-uid",
+					email:       "someone-else@example.com",
+					token:       rawToken,
+					displayName: "Invitee",
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &staffInvitationInteractor{
+					transactable:         mockTransactable,
+					invitationRepository: mockInvitationRepo,
+				},
+				want: want{
+					expectedResult: errors.InvitationEmailMismatchErr,
+				},
+			}
+		},
+		"already staff": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			invitation := testdata.Invitation
+			invitation.Email = "invitee@example.com"
+			staff := testdata.Staff
+
+			mockTransactable := mock_repository.TestMockTransactable()
+			mockInvitationRepo := mock_repository.NewMockInvitation(ctrl)
+			mockInvitationRepo.EXPECT().
+				Get(gomock.Any(), getQuery).
+				Return(invitation, nil)
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(), repository.GetStaffQuery{
+					AuthUID:        null.StringFrom(staff.AuthUID),
+					IncludeDeleted: true,
+				}).
+				Return(staff, nil)
+
+			return testcase{
+				args: args{
+					authUID:     staff.AuthUID,
+					email:       "Invitee@example.com",
+					token:       rawToken,
+					displayName: "Invitee",
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &staffInvitationInteractor{
+					transactable:         mockTransactable,
+					staffRepository:      mockStaffRepo,
+					invitationRepository: mockInvitationRepo,
+				},
+				want: want{
+					expectedResult: errors.StaffAlreadyExistsErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+			invitation := testdata.Invitation
+			invitation.Email = "invitee@example.com"
+			staff := testdata.Staff
+			staff.Email = invitation.Email
+			staff.Role = invitation.Role
+			staff.ImagePath = ""
+			requestTime := testdata.RequestTime.Add(time.Hour)
+
+			acceptedInvitation := &model.Invitation{} //nolint:exhaustruct
+			factory.CloneValue(invitation, acceptedInvitation)
+			acceptedInvitation.AcceptedAt = null.TimeFrom(requestTime)
+			acceptedInvitation.AcceptedStaffID = null.StringFrom(staff.ID)
+			acceptedInvitation.UpdatedAt = requestTime
+
+			mockTransactable := mock_repository.TestMockTransactable()
+			mockInvitationRepo := mock_repository.NewMockInvitation(ctrl)
+			mockInvitationRepo.EXPECT().
+				Get(gomock.Any(), getQuery).
+				Return(invitation, nil)
+			mockInvitationRepo.EXPECT().
+				Update(gomock.Any(), acceptedInvitation).
+				Return(nil)
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(), repository.GetStaffQuery{
+					AuthUID:        null.StringFrom(staff.AuthUID),
+					IncludeDeleted: true,
+				}).
+				Return(nil, nil)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(), repository.GetStaffQuery{
+					ID: null.StringFrom(staff.ID),
+					BaseGetOptions: repository.BaseGetOptions{
+						OrFail:  true,
+						Preload: true,
+					},
+				}).
+				Return(staff, nil)
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(), repository.GetTenantQuery{
+					ID: null.StringFrom(invitation.TenantID),
+					BaseGetOptions: repository.BaseGetOptions{
+						OrFail: true,
+					},
+				}).
+				Return(tenant, nil)
+			mockStaffService := mock_service.NewMockStaff(ctrl)
+			mockStaffService.EXPECT().
+				Create(gomock.Any(), service.StaffCreateParam{
+					TenantID:    invitation.TenantID,
+					Email:       invitation.Email,
+					Password:    "",
+					StaffRole:   invitation.Role,
+					DisplayName: staff.DisplayName,
+					ImagePath:   "",
+					RequestTime: requestTime,
+				}).
+				Return(staff, nil)
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(nil).
+				Times(2)
+			id.Mock()
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewStaffCreatedEvent(staff, requestTime),
+				)).
+				Return(nil)
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				BatchSetStaffURLs(gomock.Any(), model.Staffs{staff}, requestTime).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					authUID:     staff.AuthUID,
+					email:       invitation.Email,
+					token:       rawToken,
+					displayName: staff.DisplayName,
+					requestTime: requestTime,
+				},
+				usecase: &staffInvitationInteractor{
+					transactable:          mockTransactable,
+					tenantRepository:      mockTenantRepo,
+					staffRepository:       mockStaffRepo,
+					invitationRepository:  mockInvitationRepo,
+					auditLogRepository:    mockAuditLogRepo,
+					outboxEventRepository: mockOutboxEventRepo,
+					staffService:          mockStaffService,
+					assetService:          mockAssetService,
+				},
+				want: want{
+					staff: staff,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.Accept(ctx, input.NewStaffAcceptInvitation(
+				tc.args.authUID,
+				tc.args.email,
+				tc.args.token,
+				tc.args.displayName,
+				method,
+				tc.args.requestTime,
+			))
+
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.staff, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
@@ -12,26 +12,32 @@ import (
 )
 
 type staffMeInteractor struct {
-	transactable     repository.Transactable
-	tenantRepository repository.Tenant
-	staffRepository  repository.Staff
-	staffService     service.Staff
-	assetService     service.Asset
+	transactable          repository.Transactable
+	tenantRepository      repository.Tenant
+	staffRepository       repository.Staff
+	auditLogRepository    repository.AuditLog
+	outboxEventRepository repository.OutboxEvent
+	staffService          service.Staff
+	assetService          service.Asset
 }
 
 func NewStaffMeInteractor(
 	transactable repository.Transactable,
 	tenantRepository repository.Tenant,
 	staffRepository repository.Staff,
+	auditLogRepository repository.AuditLog,
+	outboxEventRepository repository.OutboxEvent,
 	staffService service.Staff,
 	assetService service.Asset,
 ) StaffMeInteractor {
 	return &staffMeInteractor{
-		transactable:     transactable,
-		tenantRepository: tenantRepository,
-		staffRepository:  staffRepository,
-		staffService:     staffService,
-		assetService:     assetService,
+		transactable:          transactable,
+		tenantRepository:      tenantRepository,
+		staffRepository:       staffRepository,
+		auditLogRepository:    auditLogRepository,
+		outboxEventRepository: outboxEventRepository,
+		staffService:          staffService,
+		assetService:          assetService,
 	}
 }
 
@@ -77,15 +83,15 @@ func (i *staffMeInteractor) SignUp(
 	txErr := i.transactable.RWTx(ctx, func(ctx context.Context) error {
 		// Validate asset inside transaction
 		authContext := model.NewStaffAssetAuthContext(param.AuthUID)
-		imagePath, clearAssetPath, err := i.assetService.GetWithValidate(ctx, model.AssetTypeUserImage, param.ImageAssetID, authContext)
+		imagePath, err := i.assetService.GetWithValidate(ctx, model.AssetTypeUserImage, param.ImageAssetID, authContext, param.RequestTime)
 		if err != nil {
 			return err
 		}
-		defer clearAssetPath()
 
 		// Create new tenant
 		tenant := model.NewTenant(
 			param.TenantName,
+			nil,
 			param.RequestTime,
 		)
 		if createErr := i.tenantRepository.Create(ctx, tenant); createErr != nil {
@@ -107,7 +113,34 @@ func (i *staffMeInteractor) SignUp(
 			return createStaffErr
 		}
 
-		return nil
+		// The new staff is the actor of its own sign up.
+		actor := model.NewStaffAuditLogActor(staff.ID, param.Method)
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			actor,
+			null.StringFrom(tenant.ID),
+			model.AuditLogTargetTypeTenant,
+			tenant.ID,
+			nil,
+			tenant.AuditLogSnapshot(),
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			actor,
+			null.StringFrom(tenant.ID),
+			model.AuditLogTargetTypeStaff,
+			staff.ID,
+			nil,
+			staff.AuditLogSnapshot(),
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+		return i.outboxEventRepository.BatchCreate(ctx, model.NewOutboxEvents(
+			model.NewTenantCreatedEvent(tenant, param.RequestTime),
+			model.NewStaffCreatedEvent(staff, param.RequestTime),
+		))
 	})
 	if txErr != nil {
 		return nil, txErr
@@ -153,24 +186,44 @@ func (i *staffMeInteractor) Update(
 		if err != nil {
 			return err
 		}
+		if err := staff.ValidateETag(param.ETag); err != nil {
+			return err
+		}
 
 		// Validate asset if provided (inside transaction)
 		var imagePath null.String
 		if param.ImageAssetID.Valid {
 			authContext := model.NewStaffAssetAuthContext(param.StaffID)
-			path, clearAssetPath, err := i.assetService.GetWithValidate(ctx, model.AssetTypeUserImage, param.ImageAssetID.String, authContext)
+			path, err := i.assetService.GetWithValidate(ctx, model.AssetTypeUserImage, param.ImageAssetID.String, authContext, param.RequestTime)
 			if err != nil {
 				return err
 			}
-			defer clearAssetPath()
 			imagePath = null.StringFrom(path)
 		}
 
 		// Apply updates via domain method (role is not updated in UpdateMe)
+		before := staff.AuditLogSnapshot()
 		staff.Update(param.DisplayName, nullable.Type[model.StaffRole]{}, imagePath, param.RequestTime)
 
 		// Persist
-		return i.staffRepository.Update(ctx, staff)
+		if err := i.staffRepository.Update(ctx, staff); err != nil {
+			return err
+		}
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			param.Actor,
+			null.StringFrom(staff.TenantID),
+			model.AuditLogTargetTypeStaff,
+			staff.ID,
+			before,
+			staff.AuditLogSnapshot(),
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+
+		return i.outboxEventRepository.BatchCreate(ctx, model.NewOutboxEvents(
+			model.NewStaffUpdatedEvent(staff, param.RequestTime),
+		))
 	}); err != nil {
 		return nil, err
 	}
@@ -28,6 +28,7 @@ func TestStaffMeInteractor_SignUp(t *testing.T) {
 		tenantName   string
 		displayName  string
 		imageAssetID string
+		method       string
 		requestTime  time.Time
 	}
 
@@ -75,7 +76,7 @@ func TestStaffMeInteractor_SignUp(t *testing.T) {
 			requestTime := testdata.RequestTime
 
 			// Create tenant with mock ID (matches what implementation will create)
-			tenant := model.NewTenant("Test Tenant", requestTime)
+			tenant := model.NewTenant("Test Tenant", nil, requestTime)
 			tenant.ID = mockID
 
 			staff.ID = mockID
@@ -113,12 +114,26 @@ func TestStaffMeInteractor_SignUp(t *testing.T) {
 				Return(staff, nil)
 			mockAssetService := mock_service.NewMockAsset(ctrl)
 			mockAssetService.EXPECT().
-				GetWithValidate(gomock.Any(), model.AssetTypeUserImage, "image-asset-id", model.NewStaffAssetAuthContext("auth-uid")).
-				Return("/path/to/image", func() {}, nil)
+				GetWithValidate(gomock.Any(), model.AssetTypeUserImage, "image-asset-id", model.NewStaffAssetAuthContext("auth-uid"), requestTime).
+				Return("/path/to/image", nil)
 			mockAssetService.EXPECT().
 				BatchSetStaffURLs(gomock.Any(), model.Staffs{staff}, gomock.Any()).
 				Return(nil)
 
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Times(2).
+				Return(nil)
+
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewTenantCreatedEvent(tenant, requestTime),
+					model.NewStaffCreatedEvent(staff, requestTime),
+				)).
+				Return(nil)
+
 			return testcase{
 				args: args{
 					authUID:      "auth-uid",
@@ -126,14 +141,17 @@ func TestStaffMeInteractor_SignUp(t *testing.T) {
 					tenantName:   "Test Tenant",
 					displayName:  "Test User",
 					imageAssetID: "image-asset-id",
+					method:       "/rapid.staff_api.v1.StaffV1Service/SignUp",
 					requestTime:  requestTime,
 				},
 				usecase: &staffMeInteractor{
-					transactable:     mockTransactable,
-					tenantRepository: mockTenantRepo,
-					staffRepository:  mockStaffRepo,
-					staffService:     mockStaffService,
-					assetService:     mockAssetService,
+					transactable:          mockTransactable,
+					tenantRepository:      mockTenantRepo,
+					staffRepository:       mockStaffRepo,
+					auditLogRepository:    mockAuditLogRepo,
+					outboxEventRepository: mockOutboxEventRepo,
+					staffService:          mockStaffService,
+					assetService:          mockAssetService,
 				},
 				want: want{
 					staff: staff,
@@ -157,6 +175,7 @@ func TestStaffMeInteractor_SignUp(t *testing.T) {
 				tc.args.tenantName,
 				tc.args.displayName,
 				tc.args.imageAssetID,
+				tc.args.method,
 				tc.args.requestTime,
 			))
 
@@ -336,6 +355,8 @@ func TestStaffMeInteractor_Update(t *testing.T) {
 		staffID      string
 		displayName  null.String
 		imageAssetID null.String
+		etag         null.String
+		actor        model.AuditLogActor
 		requestTime  time.Time
 	}
 
@@ -431,6 +452,7 @@ func TestStaffMeInteractor_Update(t *testing.T) {
 			updatedStaff.ImagePath = "/path/to/image"
 			requestTime := testdata.RequestTime
 			updatedStaff.UpdatedAt = requestTime
+			updatedStaff.Version++
 
 			mockTransactable := mock_repository.TestMockTransactable()
 			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
@@ -461,32 +483,82 @@ func TestStaffMeInteractor_Update(t *testing.T) {
 			mockStaffService := mock_service.NewMockStaff(ctrl)
 			mockAssetService := mock_service.NewMockAsset(ctrl)
 			mockAssetService.EXPECT().
-				GetWithValidate(gomock.Any(), model.AssetTypeUserImage, "image-asset-id", model.NewStaffAssetAuthContext(staff.ID)).
-				Return("/path/to/image", func() {}, nil)
+				GetWithValidate(gomock.Any(), model.AssetTypeUserImage, "image-asset-id", model.NewStaffAssetAuthContext(staff.ID), requestTime).
+				Return("/path/to/image", nil)
 			mockAssetService.EXPECT().
 				BatchSetStaffURLs(gomock.Any(), model.Staffs{updatedStaff}, gomock.Any()).
 				Return(nil)
 
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewStaffUpdatedEvent(updatedStaff, requestTime),
+				)).
+				Return(nil)
+
 			return testcase{
 				args: args{
 					tenantID:     staff.TenantID,
 					staffID:      staff.ID,
 					displayName:  null.StringFrom("Updated Name"),
 					imageAssetID: null.StringFrom("image-asset-id"),
+					etag:         null.StringFrom(staff.ETag()),
+					actor:        model.NewStaffAuditLogActor(staff.ID, "/rapid.staff_api.v1.StaffV1Service/UpdateMe"),
 					requestTime:  requestTime,
 				},
 				usecase: &staffMeInteractor{
-					transactable:     mockTransactable,
-					tenantRepository: mockTenantRepo,
-					staffRepository:  mockStaffRepo,
-					staffService:     mockStaffService,
-					assetService:     mockAssetService,
+					transactable:          mockTransactable,
+					tenantRepository:      mockTenantRepo,
+					staffRepository:       mockStaffRepo,
+					auditLogRepository:    mockAuditLogRepo,
+					outboxEventRepository: mockOutboxEventRepo,
+					staffService:          mockStaffService,
+					assetService:          mockAssetService,
 				},
 				want: want{
 					staff: updatedStaff,
 				},
 			}
 		},
+		"modified since the etag was read": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				Get(gomock.Any(),
+					repository.GetStaffQuery{
+						ID: null.StringFrom(staff.ID),
+						BaseGetOptions: repository.BaseGetOptions{
+							OrFail:    true,
+							ForUpdate: true,
+						},
+					}).
+				Return(staff, nil)
+
+			return testcase{
+				args: args{
+					tenantID:    staff.TenantID,
+					staffID:     staff.ID,
+					displayName: null.StringFrom("Updated Name"),
+					etag:        null.StringFrom(model.NewETag(staff.Version - 1)),
+					actor:       model.NewStaffAuditLogActor(staff.ID, "/rapid.staff_api.v1.StaffV1Service/UpdateMe"),
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &staffMeInteractor{
+					transactable:    mock_repository.TestMockTransactable(),
+					staffRepository: mockStaffRepo,
+				},
+				want: want{
+					expectedResult: errors.StaffModifiedErr,
+				},
+			}
+		},
 	}
 
 	for name, tc := range tests {
@@ -503,6 +575,8 @@ func TestStaffMeInteractor_Update(t *testing.T) {
 				tc.args.staffID,
 				tc.args.displayName,
 				tc.args.imageAssetID,
+				tc.args.etag,
+				tc.args.actor,
 				tc.args.requestTime,
 			))
 
@@ -11,20 +11,26 @@ import (
 )
 
 type staffMeTenantInteractor struct {
-	transactable     repository.Transactable
-	tenantRepository repository.Tenant
-	assetService     service.Asset
+	transactable          repository.Transactable
+	tenantRepository      repository.Tenant
+	auditLogRepository    repository.AuditLog
+	outboxEventRepository repository.OutboxEvent
+	assetService          service.Asset
 }
 
 func NewStaffMeTenantInteractor(
 	transactable repository.Transactable,
 	tenantRepository repository.Tenant,
+	auditLogRepository repository.AuditLog,
+	outboxEventRepository repository.OutboxEvent,
 	assetService service.Asset,
 ) StaffMeTenantInteractor {
 	return &staffMeTenantInteractor{
-		transactable:     transactable,
-		tenantRepository: tenantRepository,
-		assetService:     assetService,
+		transactable:          transactable,
+		tenantRepository:      tenantRepository,
+		auditLogRepository:    auditLogRepository,
+		outboxEventRepository: outboxEventRepository,
+		assetService:          assetService,
 	}
 }
 
@@ -69,18 +75,39 @@ func (i *staffMeTenantInteractor) Update(
 			ID: null.StringFrom(param.TenantID),
 			BaseGetOptions: repository.BaseGetOptions{
 				OrFail:    true,
+				Preload:   true,
 				ForUpdate: true,
 			},
 		})
 		if err != nil {
 			return err
 		}
+		if err := tenant.ValidateETag(param.ETag); err != nil {
+			return err
+		}
 
 		// Apply updates via domain method
-		tenant.Update(param.Name, param.RequestTime)
+		before := tenant.AuditLogSnapshot()
+		tenant.Update(param.Name, param.TagTypes, param.RequestTime)
 
 		// Persist
-		return i.tenantRepository.Update(ctx, tenant)
+		if err := i.tenantRepository.Update(ctx, tenant); err != nil {
+			return err
+		}
+		if err := i.auditLogRepository.Create(ctx, model.NewAuditLog(
+			param.Actor,
+			null.StringFrom(tenant.ID),
+			model.AuditLogTargetTypeTenant,
+			tenant.ID,
+			before,
+			tenant.AuditLogSnapshot(),
+			param.RequestTime,
+		)); err != nil {
+			return err
+		}
+		return i.outboxEventRepository.BatchCreate(ctx, model.NewOutboxEvents(
+			model.NewTenantUpdatedEvent(tenant, param.RequestTime),
+		))
 	}); err != nil {
 		return nil, err
 	}
@@ -13,6 +13,7 @@ import (
 	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
 	mock_service "github.com/abyssparanoia/rapid-go/internal/domain/service/mock"
 	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
 	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -171,6 +172,9 @@ func TestStaffMeTenantInteractor_Update(t *testing.T) {
 		tenantID    string
 		staffID     string
 		name        null.String
+		tagTypes    nullable.Type[[]model.TenantTagType]
+		etag        null.String
+		actor       model.AuditLogActor
 		requestTime time.Time
 	}
 
@@ -207,6 +211,22 @@ func TestStaffMeTenantInteractor_Update(t *testing.T) {
 				},
 			}
 		},
+		"invalid tag type": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+
+			return testcase{
+				args: args{
+					tenantID:    testdata.Tenant.ID,
+					staffID:     "staff-id",
+					tagTypes:    nullable.TypeFrom([]model.TenantTagType{model.TenantTagTypeUnknown}),
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &staffMeTenantInteractor{},
+				want: want{
+					expectedResult: errors.TenantTagInvalidErr,
+				},
+			}
+		},
 		"not found": func(ctx context.Context, ctrl *gomock.Controller) testcase {
 			testdata := factory.NewFactory()
 			tenant := testdata.Tenant
@@ -222,6 +242,7 @@ func TestStaffMeTenantInteractor_Update(t *testing.T) {
 						ID: null.StringFrom(tenant.ID),
 						BaseGetOptions: repository.BaseGetOptions{
 							OrFail:    true,
+							Preload:   true,
 							ForUpdate: true,
 						},
 					}).
@@ -254,8 +275,10 @@ func TestStaffMeTenantInteractor_Update(t *testing.T) {
 			tenant.ID = mockID
 			updatedTenant.ID = mockID
 			updatedTenant.Name = "Updated Name"
+			updatedTenant.Tags = model.TenantTags{}
 			requestTime := testdata.RequestTime
 			updatedTenant.UpdatedAt = requestTime
+			updatedTenant.Version++
 
 			mockTransactable := mock_repository.TestMockTransactable()
 			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
@@ -265,6 +288,7 @@ func TestStaffMeTenantInteractor_Update(t *testing.T) {
 						ID: null.StringFrom(tenant.ID),
 						BaseGetOptions: repository.BaseGetOptions{
 							OrFail:    true,
+							Preload:   true,
 							ForUpdate: true,
 						},
 					}).
@@ -287,20 +311,73 @@ func TestStaffMeTenantInteractor_Update(t *testing.T) {
 				BatchSetTenantURLs(gomock.Any(), model.Tenants{updatedTenant}, gomock.Any()).
 				Return(nil)
 
+			mockAuditLogRepo := mock_repository.NewMockAuditLog(ctrl)
+			mockAuditLogRepo.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			mockOutboxEventRepo.EXPECT().
+				BatchCreate(gomock.Any(), model.NewOutboxEvents(
+					model.NewTenantUpdatedEvent(updatedTenant, requestTime),
+				)).
+				Return(nil)
+
 			return testcase{
 				args: args{
 					tenantID:    tenant.ID,
 					staffID:     "staff-id",
 					name:        null.StringFrom("Updated Name"),
+					tagTypes:    nullable.TypeFrom([]model.TenantTagType{}),
+					etag:        null.StringFrom(tenant.ETag()),
+					actor:       model.NewStaffAuditLogActor("staff-id", "/rapid.staff_api.v1.StaffV1Service/UpdateMeTenant"),
 					requestTime: requestTime,
 				},
+				usecase: &staffMeTenantInteractor{
+					transactable:          mockTransactable,
+					tenantRepository:      mockTenantRepo,
+					auditLogRepository:    mockAuditLogRepo,
+					outboxEventRepository: mockOutboxEventRepo,
+					assetService:          mockAssetService,
+				},
+				want: want{
+					tenant: updatedTenant,
+				},
+			}
+		},
+		"modified since the etag was read": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			tenant := testdata.Tenant
+
+			mockTransactable := mock_repository.TestMockTransactable()
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				Get(gomock.Any(),
+					repository.GetTenantQuery{
+						ID: null.StringFrom(tenant.ID),
+						BaseGetOptions: repository.BaseGetOptions{
+							OrFail:    true,
+							Preload:   true,
+							ForUpdate: true,
+						},
+					}).
+				Return(tenant, nil)
+
+			return testcase{
+				args: args{
+					tenantID:    tenant.ID,
+					staffID:     "staff-id",
+					name:        null.StringFrom("Updated Name"),
+					etag:        null.StringFrom(model.NewETag(tenant.Version - 1)),
+					actor:       model.NewStaffAuditLogActor("staff-id", "/rapid.staff_api.v1.StaffV1Service/UpdateMeTenant"),
+					requestTime: testdata.RequestTime,
+				},
 				usecase: &staffMeTenantInteractor{
 					transactable:     mockTransactable,
 					tenantRepository: mockTenantRepo,
-					assetService:     mockAssetService,
 				},
 				want: want{
-					tenant: updatedTenant,
+					expectedResult: errors.TenantModifiedErr,
 				},
 			}
 		},
@@ -319,6 +396,9 @@ func TestStaffMeTenantInteractor_Update(t *testing.T) {
 				tc.args.tenantID,
 				tc.args.staffID,
 				tc.args.name,
+				tc.args.tagTypes,
+				tc.args.etag,
+				tc.args.actor,
 				tc.args.requestTime,
 			))
 
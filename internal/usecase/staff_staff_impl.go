@@ -68,7 +68,26 @@ func (i *staffStaffInteractor) List(
 			Limit:   null.Uint64From(param.Limit),
 			Preload: true,
 		},
-		SortKey: nullable.TypeFrom(param.SortKey),
+		SortKey:       nullable.TypeFrom(param.SortKey),
+		DisplayName:   param.DisplayName,
+		Email:         param.Email,
+		Role:          param.Role,
+		CreatedAtFrom: param.CreatedAtFrom,
+		CreatedAtTo:   param.CreatedAtTo,
+	}
+
+	if param.Cursor.Valid {
+		// Fetch one extra row to know whether a next page exists.
+		query.Page = null.Uint64{}
+		query.Limit = null.Uint64From(param.Limit + 1)
+		query.Keyset = true
+		if param.Cursor.String != "" {
+			cursor, err := model.ParseCursor(param.Cursor.String, param.SortKey.String())
+			if err != nil {
+				return nil, err
+			}
+			query.Cursor = nullable.TypeFrom(*cursor)
+		}
 	}
 
 	staffs, err := i.staffRepository.List(ctx, query)
@@ -76,21 +95,34 @@ func (i *staffStaffInteractor) List(
 		return nil, err
 	}
 
+	var nextCursor null.String
+	if param.Cursor.Valid {
+		staffs, nextCursor = model.NextCursor(staffs, param.Limit, func(m *model.Staff) *model.Cursor {
+			return m.Cursor(param.SortKey)
+		})
+	}
+
 	if err = i.assetService.BatchSetStaffURLs(ctx, staffs, param.RequestTime); err != nil {
 		return nil, err
 	}
 
-	totalCount, err := i.staffRepository.Count(ctx, query)
-	if err != nil {
-		return nil, err
+	// Cursor pagination skips the count query unless it is requested.
+	var pagination *model.Pagination
+	if !param.Cursor.Valid || param.IncludeTotalCount {
+		totalCount, err := i.staffRepository.Count(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		if param.Cursor.Valid {
+			pagination = model.NewCursorPagination(param.Limit, totalCount, nextCursor.Valid)
+		} else {
+			pagination = model.NewPagination(param.Page, param.Limit, totalCount)
+		}
 	}
 
 	return output.NewStaffListStaffs(
 		staffs,
-		model.NewPagination(
-			param.Page,
-			param.Limit,
-			totalCount,
-		),
+		pagination,
+		nextCursor,
 	), nil
 }
@@ -166,12 +166,16 @@ func TestStaffStaffInteractor_List(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		tenantID    string
-		staffID     string
-		page        uint64
-		limit       uint64
-		sortKey     model.StaffSortKey
-		requestTime time.Time
+		tenantID      string
+		staffID       string
+		page          uint64
+		limit         uint64
+		sortKey       model.StaffSortKey
+		cursor        null.String
+		displayName   null.String
+		role          nullable.Type[model.StaffRole]
+		createdAtFrom null.Time
+		requestTime   time.Time
 	}
 
 	type want struct {
@@ -248,10 +252,143 @@ func TestStaffStaffInteractor_List(t *testing.T) {
 					output: output.NewStaffListStaffs(
 						model.Staffs{staff},
 						model.NewPagination(2, 30, 60),
+						null.String{},
+					),
+				},
+			}
+		},
+		"success with cursor": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			tenant := testdata.Tenant
+			mockID := id.Mock()
+			staff.ID = mockID
+			tenant.ID = mockID
+			staff.TenantID = mockID
+			cursor := staff.Cursor(model.StaffSortKeyDisplayNameAsc)
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				List(
+					gomock.Any(),
+					repository.ListStaffQuery{
+						TenantID: null.StringFrom(tenant.ID),
+						BaseListOptions: repository.BaseListOptions{
+							Limit:   null.Uint64From(31),
+							Keyset:  true,
+							Cursor:  nullable.TypeFrom(*cursor),
+							Preload: true,
+						},
+						SortKey: nullable.TypeFrom(model.StaffSortKeyDisplayNameAsc),
+					}).
+				Return(model.Staffs{staff}, nil)
+
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				BatchSetStaffURLs(gomock.Any(), model.Staffs{staff}, gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					tenantID:    tenant.ID,
+					staffID:     staff.ID,
+					limit:       30,
+					sortKey:     model.StaffSortKeyDisplayNameAsc,
+					cursor:      null.StringFrom(cursor.Encode()),
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &staffStaffInteractor{
+					staffRepository: mockStaffRepo,
+					assetService:    mockAssetService,
+				},
+				want: want{
+					output: output.NewStaffListStaffs(
+						model.Staffs{staff},
+						nil,
+						null.String{},
+					),
+				},
+			}
+		},
+		"success with filters": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+			mockID := id.Mock()
+			staff.ID = mockID
+			staff.TenantID = mockID
+			createdAtFrom := testdata.RequestTime.Add(-time.Hour)
+
+			query := repository.ListStaffQuery{
+				TenantID: null.StringFrom(staff.TenantID),
+				BaseListOptions: repository.BaseListOptions{
+					Page:    null.Uint64From(1),
+					Limit:   null.Uint64From(30),
+					Preload: true,
+				},
+				SortKey:       nullable.TypeFrom(model.StaffSortKeyDisplayNameAsc),
+				DisplayName:   null.StringFrom("hana"),
+				Role:          nullable.TypeFrom(model.StaffRoleNormal),
+				CreatedAtFrom: null.TimeFrom(createdAtFrom),
+			}
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				List(gomock.Any(), query).
+				Return(model.Staffs{staff}, nil)
+			mockStaffRepo.EXPECT().
+				Count(gomock.Any(), query).
+				Return(uint64(1), nil)
+			mockAssetService := mock_service.NewMockAsset(ctrl)
+			mockAssetService.EXPECT().
+				BatchSetStaffURLs(gomock.Any(), model.Staffs{staff}, gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					tenantID:      staff.TenantID,
+					staffID:       staff.ID,
+					page:          1,
+					limit:         30,
+					sortKey:       model.StaffSortKeyDisplayNameAsc,
+					displayName:   null.StringFrom("hana"),
+					role:          nullable.TypeFrom(model.StaffRoleNormal),
+					createdAtFrom: null.TimeFrom(createdAtFrom),
+					requestTime:   testdata.RequestTime,
+				},
+				usecase: &staffStaffInteractor{
+					staffRepository: mockStaffRepo,
+					assetService:    mockAssetService,
+				},
+				want: want{
+					output: output.NewStaffListStaffs(
+						model.Staffs{staff},
+						model.NewPagination(1, 30, 1),
+						null.String{},
 					),
 				},
 			}
 		},
+		"cursor issued for another sort key": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staff := testdata.Staff
+
+			return testcase{
+				args: args{
+					tenantID:    staff.TenantID,
+					staffID:     staff.ID,
+					limit:       30,
+					sortKey:     model.StaffSortKeyDisplayNameAsc,
+					cursor:      null.StringFrom(staff.Cursor(model.StaffSortKeyCreatedAtDesc).Encode()),
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &staffStaffInteractor{
+					staffRepository: mock_repository.NewMockStaff(ctrl),
+					assetService:    mock_service.NewMockAsset(ctrl),
+				},
+				want: want{
+					expectedResult: errors.InvalidCursorErr,
+				},
+			}
+		},
 	}
 
 	for name, tc := range tests {
@@ -269,6 +406,13 @@ func TestStaffStaffInteractor_List(t *testing.T) {
 				tc.args.page,
 				tc.args.limit,
 				nullable.TypeFrom(tc.args.sortKey),
+				tc.args.cursor,
+				false,
+				tc.args.displayName,
+				null.String{},
+				tc.args.role,
+				tc.args.createdAtFrom,
+				null.Time{},
 				tc.args.requestTime,
 			))
 
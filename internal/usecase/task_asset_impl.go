@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+)
+
+// cleanupAssetsBatchSize is the number of assets processed per query.
+const cleanupAssetsBatchSize uint64 = 100
+
+type taskAssetInteractor struct {
+	transactable            repository.Transactable
+	assetRepository         repository.Asset
+	assetMetadataRepository repository.AssetMetadata
+}
+
+func NewTaskAssetInteractor(
+	transactable repository.Transactable,
+	assetRepository repository.Asset,
+	assetMetadataRepository repository.AssetMetadata,
+) TaskAssetInteractor {
+	return &taskAssetInteractor{
+		transactable:            transactable,
+		assetRepository:         assetRepository,
+		assetMetadataRepository: assetMetadataRepository,
+	}
+}
+
+// CleanupAssets orphans the uploaded assets never attached within the retention window,
+// then deletes the objects, including the image variants, and the rows of the expired pending assets and the orphaned ones.
+// The object is deleted before the row, so an interrupted run is picked up by the next one.
+func (i *taskAssetInteractor) CleanupAssets(
+	ctx context.Context,
+	param *input.TaskCleanupAssets,
+) (*output.TaskCleanupAssets, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	orphanedCount, err := i.orphanUnattached(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedCount := 0
+	for _, query := range []repository.ListAssetMetadataQuery{
+		{
+			Status:        nullable.TypeFrom(model.AssetStatusPending),
+			ExpiresBefore: null.TimeFrom(param.RequestTime),
+		},
+		{
+			Status: nullable.TypeFrom(model.AssetStatusOrphaned),
+		},
+	} {
+		query.Limit = null.Uint64From(cleanupAssetsBatchSize)
+		query.Keyset = true
+		for {
+			assets, err := i.assetMetadataRepository.List(ctx, query)
+			if err != nil {
+				return nil, err
+			}
+			for _, asset := range assets {
+				for _, path := range asset.ObjectPaths() {
+					if err := i.assetRepository.Delete(ctx, path); err != nil {
+						return nil, err
+					}
+				}
+				if err := i.assetMetadataRepository.Delete(ctx, asset.ID); err != nil {
+					return nil, err
+				}
+			}
+			deletedCount += len(assets)
+			if uint64(len(assets)) < cleanupAssetsBatchSize {
+				break
+			}
+		}
+	}
+
+	return output.NewTaskCleanupAssets(orphanedCount, deletedCount), nil
+}
+
+func (i *taskAssetInteractor) orphanUnattached(
+	ctx context.Context,
+	param *input.TaskCleanupAssets,
+) (int, error) {
+	orphanedCount := 0
+	for {
+		var assets model.Assets
+		if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+			var err error
+			assets, err = i.assetMetadataRepository.List(ctx, repository.ListAssetMetadataQuery{
+				BaseListOptions: repository.BaseListOptions{
+					Limit:     null.Uint64From(cleanupAssetsBatchSize),
+					Keyset:    true,
+					ForUpdate: true,
+				},
+				Status:         nullable.TypeFrom(model.AssetStatusUploaded),
+				UploadedBefore: null.TimeFrom(param.UploadedBefore()),
+			})
+			if err != nil {
+				return err
+			}
+			for _, asset := range assets {
+				if err := i.assetMetadataRepository.Update(ctx, asset.Orphan(param.RequestTime)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return 0, err
+		}
+		orphanedCount += len(assets)
+		if uint64(len(assets)) < cleanupAssetsBatchSize {
+			return orphanedCount, nil
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskAssetInteractor_CleanupAssets(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		retention   time.Duration
+		requestTime time.Time
+	}
+
+	type want struct {
+		result         *output.TaskCleanupAssets
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase TaskAssetInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"invalid argument": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return testcase{
+				args: args{
+					retention:   0,
+					requestTime: time.Now(),
+				},
+				usecase: &taskAssetInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			retention := 24 * time.Hour
+			requestTime := testdata.RequestTime
+			uploadedBefore := requestTime.Add(-retention)
+
+			uploaded := *testdata.Asset
+			uploaded.ID = "uploaded-asset-id"
+			uploaded.Path = "private/user_images/uploaded.png"
+			uploaded.Status = model.AssetStatusUploaded
+			uploaded.UploadedAt = null.TimeFrom(uploadedBefore.Add(-time.Hour))
+			orphaned := uploaded
+			orphaned.Status = model.AssetStatusOrphaned
+			orphaned.UpdatedAt = requestTime
+			expired := *testdata.Asset
+			expired.ID = "expired-asset-id"
+			expired.Path = "private/user_images/expired.png"
+			expired.ExpiresAt = requestTime.Add(-time.Minute)
+
+			mockAssetRepo := mock_repository.NewMockAsset(ctrl)
+			mockAssetMetadataRepo := mock_repository.NewMockAssetMetadata(ctrl)
+			gomock.InOrder(
+				mockAssetMetadataRepo.EXPECT().
+					List(gomock.Any(), repository.ListAssetMetadataQuery{
+						BaseListOptions: repository.BaseListOptions{
+							Limit:     null.Uint64From(cleanupAssetsBatchSize),
+							Keyset:    true,
+							ForUpdate: true,
+						},
+						Status:         nullable.TypeFrom(model.AssetStatusUploaded),
+						UploadedBefore: null.TimeFrom(uploadedBefore),
+					}).
+					Return(model.Assets{&uploaded}, nil),
+				mockAssetMetadataRepo.EXPECT().
+					Update(gomock.Any(), &orphaned).
+					Return(nil),
+				mockAssetMetadataRepo.EXPECT().
+					List(gomock.Any(), repository.ListAssetMetadataQuery{
+						BaseListOptions: repository.BaseListOptions{
+							Limit:  null.Uint64From(cleanupAssetsBatchSize),
+							Keyset: true,
+						},
+						Status:        nullable.TypeFrom(model.AssetStatusPending),
+						ExpiresBefore: null.TimeFrom(requestTime),
+					}).
+					Return(model.Assets{&expired}, nil),
+				mockAssetRepo.EXPECT().
+					Delete(gomock.Any(), expired.Path).
+					Return(nil),
+				mockAssetRepo.EXPECT().
+					Delete(gomock.Any(), "private/user_images/expired_small.png").
+					Return(nil),
+				mockAssetRepo.EXPECT().
+					Delete(gomock.Any(), "private/user_images/expired_medium.png").
+					Return(nil),
+				mockAssetMetadataRepo.EXPECT().
+					Delete(gomock.Any(), expired.ID).
+					Return(nil),
+				mockAssetMetadataRepo.EXPECT().
+					List(gomock.Any(), repository.ListAssetMetadataQuery{
+						BaseListOptions: repository.BaseListOptions{
+							Limit:  null.Uint64From(cleanupAssetsBatchSize),
+							Keyset: true,
+						},
+						Status: nullable.TypeFrom(model.AssetStatusOrphaned),
+					}).
+					Return(model.Assets{&orphaned}, nil),
+				mockAssetRepo.EXPECT().
+					Delete(gomock.Any(), orphaned.Path).
+					Return(nil),
+				mockAssetRepo.EXPECT().
+					Delete(gomock.Any(), "private/user_images/uploaded_small.png").
+					Return(nil),
+				mockAssetRepo.EXPECT().
+					Delete(gomock.Any(), "private/user_images/uploaded_medium.png").
+					Return(nil),
+				mockAssetMetadataRepo.EXPECT().
+					Delete(gomock.Any(), orphaned.ID).
+					Return(nil),
+			)
+
+			return testcase{
+				args: args{
+					retention:   retention,
+					requestTime: requestTime,
+				},
+				usecase: &taskAssetInteractor{
+					transactable:            mock_repository.TestMockTransactable(),
+					assetRepository:         mockAssetRepo,
+					assetMetadataRepository: mockAssetMetadataRepo,
+				},
+				want: want{
+					result: output.NewTaskCleanupAssets(1, 2),
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.CleanupAssets(ctx, input.NewTaskCleanupAssets(
+				tc.args.retention,
+				tc.args.requestTime,
+			))
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.result, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
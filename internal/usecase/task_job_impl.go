@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+)
+
+type taskJobInteractor struct {
+	jobQueueRepository repository.JobQueue
+}
+
+func NewTaskJobInteractor(
+	jobQueueRepository repository.JobQueue,
+) TaskJobInteractor {
+	return &taskJobInteractor{
+		jobQueueRepository: jobQueueRepository,
+	}
+}
+
+func (i *taskJobInteractor) Enqueue(
+	ctx context.Context,
+	param *input.TaskEnqueueJob,
+) (*output.TaskEnqueueJob, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	job := model.NewJob(
+		param.Type,
+		param.Payload,
+		param.MaxAttempts,
+		param.ScheduledAt(),
+		param.RequestTime,
+	)
+	if err := i.jobQueueRepository.Enqueue(ctx, job); err != nil {
+		return nil, err
+	}
+
+	return output.NewTaskEnqueueJob(job.ID, job.RunAt), nil
+}
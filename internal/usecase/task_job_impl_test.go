@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/id"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskJobInteractor_Enqueue(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		jobType     model.JobType
+		payload     model.JobPayload
+		runAt       null.Time
+		maxAttempts uint64
+		requestTime time.Time
+	}
+
+	type want struct {
+		result         *output.TaskEnqueueJob
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase TaskJobInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	payload := model.JobPayload{PurgeDeletedTenantsJobRetentionKey: "720h"}
+
+	tests := map[string]testcaseFunc{
+		"invalid job type": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			return testcase{
+				args: args{
+					jobType:     model.JobTypeUnknown,
+					payload:     payload,
+					runAt:       null.Time{},
+					maxAttempts: model.JobDefaultMaxAttempts,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &taskJobInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			id.Mock()
+			job := model.NewJob(
+				model.JobTypePurgeDeletedTenants,
+				payload,
+				model.JobDefaultMaxAttempts,
+				testdata.RequestTime,
+				testdata.RequestTime,
+			)
+
+			mockJobQueueRepo := mock_repository.NewMockJobQueue(ctrl)
+			mockJobQueueRepo.EXPECT().
+				Enqueue(gomock.Any(), job).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					jobType:     model.JobTypePurgeDeletedTenants,
+					payload:     payload,
+					runAt:       null.Time{},
+					maxAttempts: model.JobDefaultMaxAttempts,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &taskJobInteractor{
+					jobQueueRepository: mockJobQueueRepo,
+				},
+				want: want{
+					result: output.NewTaskEnqueueJob(job.ID, testdata.RequestTime),
+				},
+			}
+		},
+		"success with schedule": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			runAt := testdata.RequestTime.Add(time.Hour)
+			id.Mock()
+			job := model.NewJob(
+				model.JobTypePurgeDeletedTenants,
+				payload,
+				1,
+				runAt,
+				testdata.RequestTime,
+			)
+
+			mockJobQueueRepo := mock_repository.NewMockJobQueue(ctrl)
+			mockJobQueueRepo.EXPECT().
+				Enqueue(gomock.Any(), job).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					jobType:     model.JobTypePurgeDeletedTenants,
+					payload:     payload,
+					runAt:       null.TimeFrom(runAt),
+					maxAttempts: 1,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &taskJobInteractor{
+					jobQueueRepository: mockJobQueueRepo,
+				},
+				want: want{
+					result: output.NewTaskEnqueueJob(job.ID, runAt),
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.Enqueue(ctx, input.NewTaskEnqueueJob(
+				tc.args.jobType,
+				tc.args.payload,
+				tc.args.runAt,
+				tc.args.maxAttempts,
+				tc.args.requestTime,
+			))
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.result, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+)
+
+type taskTenantInteractor struct {
+	transactable                  repository.Transactable
+	tenantRepository              repository.Tenant
+	staffRepository               repository.Staff
+	staffAuthenticationRepository repository.StaffAuthentication
+}
+
+func NewTaskTenantInteractor(
+	transactable repository.Transactable,
+	tenantRepository repository.Tenant,
+	staffRepository repository.Staff,
+	staffAuthenticationRepository repository.StaffAuthentication,
+) TaskTenantInteractor {
+	return &taskTenantInteractor{
+		transactable:                  transactable,
+		tenantRepository:              tenantRepository,
+		staffRepository:               staffRepository,
+		staffAuthenticationRepository: staffAuthenticationRepository,
+	}
+}
+
+func (i *taskTenantInteractor) PurgeDeleted(
+	ctx context.Context,
+	param *input.TaskPurgeDeletedTenants,
+) (*output.TaskPurgeDeletedTenants, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	var tenants model.Tenants
+	var staffs model.Staffs
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		staffs, err = i.staffRepository.List(ctx, repository.ListStaffQuery{
+			BaseListOptions: repository.BaseListOptions{
+				ForUpdate: true,
+			},
+			IncludeDeleted: true,
+			DeletedBefore:  null.TimeFrom(param.DeletedBefore()),
+		})
+		if err != nil {
+			return err
+		}
+		tenants, err = i.tenantRepository.List(ctx, repository.ListTenantsQuery{
+			BaseListOptions: repository.BaseListOptions{
+				ForUpdate: true,
+			},
+			IncludeDeleted: true,
+			DeletedBefore:  null.TimeFrom(param.DeletedBefore()),
+		})
+		if err != nil {
+			return err
+		}
+
+		// Staffs reference their tenant, so they are removed first.
+		for _, staff := range staffs {
+			if err := i.staffRepository.Delete(ctx, staff.ID); err != nil {
+				return err
+			}
+		}
+		for _, tenant := range tenants {
+			if err := i.tenantRepository.Delete(ctx, tenant.ID); err != nil {
+				return err
+			}
+		}
+
+		for _, staff := range staffs {
+			if err := i.staffAuthenticationRepository.DeleteUser(ctx, staff.AuthUID, staff.Email); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return output.NewTaskPurgeDeletedTenants(len(tenants), len(staffs)), nil
+}
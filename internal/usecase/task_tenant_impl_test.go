@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskTenantInteractor_PurgeDeleted(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		retention   time.Duration
+		requestTime time.Time
+	}
+
+	type want struct {
+		result         *output.TaskPurgeDeletedTenants
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase TaskTenantInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	tests := map[string]testcaseFunc{
+		"invalid argument": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return testcase{
+				args: args{
+					retention:   0,
+					requestTime: time.Now(),
+				},
+				usecase: &taskTenantInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			retention := 24 * time.Hour
+			deletedBefore := testdata.RequestTime.Add(-retention)
+			tenant := testdata.Tenant
+			tenant.Delete(deletedBefore.Add(-time.Hour))
+			staff := testdata.Staff
+			staff.Delete(deletedBefore.Add(-time.Hour))
+
+			mockStaffRepo := mock_repository.NewMockStaff(ctrl)
+			mockStaffRepo.EXPECT().
+				List(gomock.Any(), repository.ListStaffQuery{
+					BaseListOptions: repository.BaseListOptions{
+						ForUpdate: true,
+					},
+					IncludeDeleted: true,
+					DeletedBefore:  null.TimeFrom(deletedBefore),
+				}).
+				Return(model.Staffs{staff}, nil)
+			mockTenantRepo := mock_repository.NewMockTenant(ctrl)
+			mockTenantRepo.EXPECT().
+				List(gomock.Any(), repository.ListTenantsQuery{
+					BaseListOptions: repository.BaseListOptions{
+						ForUpdate: true,
+					},
+					IncludeDeleted: true,
+					DeletedBefore:  null.TimeFrom(deletedBefore),
+				}).
+				Return(model.Tenants{tenant}, nil)
+			gomock.InOrder(
+				mockStaffRepo.EXPECT().
+					Delete(gomock.Any(), staff.ID).
+					Return(nil),
+				mockTenantRepo.EXPECT().
+					Delete(gomock.Any(), tenant.ID).
+					Return(nil),
+			)
+			mockStaffAuthRepo := mock_repository.NewMockStaffAuthentication(ctrl)
+			mockStaffAuthRepo.EXPECT().
+				DeleteUser(gomock.Any(), staff.AuthUID, staff.Email).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					retention:   retention,
+					requestTime: testdata.RequestTime,
+				},
+				usecase: &taskTenantInteractor{
+					transactable:                  mock_repository.TestMockTransactable(),
+					tenantRepository:              mockTenantRepo,
+					staffRepository:               mockStaffRepo,
+					staffAuthenticationRepository: mockStaffAuthRepo,
+				},
+				want: want{
+					result: output.NewTaskPurgeDeletedTenants(1, 1),
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.PurgeDeleted(ctx, input.NewTaskPurgeDeletedTenants(
+				tc.args.retention,
+				tc.args.requestTime,
+			))
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.result, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/now"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+)
+
+type workerJobInteractor struct {
+	transactable       repository.Transactable
+	jobQueueRepository repository.JobQueue
+	handlers           map[model.JobType]JobHandler
+}
+
+func NewWorkerJobInteractor(
+	transactable repository.Transactable,
+	jobQueueRepository repository.JobQueue,
+	handlers []JobHandler,
+) WorkerJobInteractor {
+	handlerMap := make(map[model.JobType]JobHandler, len(handlers))
+	for _, handler := range handlers {
+		handlerMap[handler.Type()] = handler
+	}
+	return &workerJobInteractor{
+		transactable:       transactable,
+		jobQueueRepository: jobQueueRepository,
+		handlers:           handlerMap,
+	}
+}
+
+// Process claims the due jobs one at a time up to BatchSize and runs them with the registered handlers.
+// Each job is claimed in a short transaction and run outside of it, leased for LeaseDuration from its claim,
+// so a job whose worker dies is taken over once the lease expires without the jobs before it eating the lease.
+// The result is recorded only while the job is still leased, so a worker outliving its lease does not
+// overwrite the attempt of the worker that took the job over.
+// A failed job is retried with a backoff until it runs out of attempts and is dead lettered.
+func (i *workerJobInteractor) Process(
+	ctx context.Context,
+	param *input.WorkerProcessJobs,
+) (*output.WorkerProcessJobs, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	var succeededCount, retriedCount, deadCount int
+	var errs []error
+	for range param.BatchSize {
+		claimedAt := now.Now()
+		job, err := i.claim(ctx, param.LeaseDuration, claimedAt)
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if job == nil {
+			break
+		}
+		if err := i.process(ctx, job, claimedAt); err != nil {
+			// The job is taken over once its lease expires, so the rest of the batch goes on.
+			errs = append(errs, err)
+			continue
+		}
+
+		switch job.Status { //nolint:exhaustive // a finished job is either succeeded, pending or dead
+		case model.JobStatusSucceeded:
+			succeededCount++
+		case model.JobStatusPending:
+			retriedCount++
+		case model.JobStatusDead:
+			deadCount++
+		}
+	}
+	if len(errs) > 0 {
+		return nil, goerrors.Join(errs...)
+	}
+
+	return output.NewWorkerProcessJobs(succeededCount, retriedCount, deadCount), nil
+}
+
+// claim dequeues the next job due at claimedAt and leases it from then, or returns nil when no job is due.
+func (i *workerJobInteractor) claim(
+	ctx context.Context,
+	leaseDuration time.Duration,
+	claimedAt time.Time,
+) (*model.Job, error) {
+	var jobs model.Jobs
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		jobs, err = i.jobQueueRepository.Dequeue(ctx, repository.DequeueJobsQuery{
+			Limit:         1,
+			LeaseDuration: leaseDuration,
+			RequestTime:   claimedAt,
+		})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return jobs[0], nil
+}
+
+// process runs the job as of claimedAt and records its result at the time it finishes.
+func (i *workerJobInteractor) process(
+	ctx context.Context,
+	job *model.Job,
+	claimedAt time.Time,
+) error {
+	if job.ExceededAttempts() {
+		// The lease of the last attempt expired without the job being finished.
+		job.Fail(errors.JobLeaseExpiredErr.New().WithValue("job_id", job.ID), now.Now())
+	} else if err := i.run(ctx, job, claimedAt); err != nil {
+		job.Fail(err, now.Now())
+	} else {
+		job.Succeed(now.Now())
+	}
+	return i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		return i.jobQueueRepository.Update(ctx, job)
+	})
+}
+
+// run calls the handler of the job and turns a panic into an error, so that one broken job
+// does not stop the worker.
+func (i *workerJobInteractor) run(
+	ctx context.Context,
+	job *model.Job,
+	requestTime time.Time,
+) (err error) {
+	handler, ok := i.handlers[job.Type]
+	if !ok {
+		return errors.JobHandlerNotFoundErr.New().
+			WithValue("type", job.Type.String())
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.JobPanickedErr.New().
+				WithDetail(fmt.Sprintf("%v", r)).
+				WithValue("type", job.Type.String())
+		}
+	}()
+	return handler.Handle(ctx, job, requestTime)
+}
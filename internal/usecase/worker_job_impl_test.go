@@ -0,0 +1,438 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	mock_usecase "github.com/abyssparanoia/rapid-go/internal/usecase/mock"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWorkerJobInteractor_Process(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		batchSize     uint64
+		leaseDuration time.Duration
+	}
+
+	type want struct {
+		result         *output.WorkerProcessJobs
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase WorkerJobInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	const leaseDuration = 5 * time.Minute
+
+	// the jobs are claimed one at a time at the time they are
+	dequeueQuery := gomock.Cond(func(query repository.DequeueJobsQuery) bool {
+		return query.Limit == 1 && query.LeaseDuration == leaseDuration && !query.RequestTime.IsZero()
+	})
+
+	startedJob := func(attempts uint64, requestTime time.Time) *model.Job {
+		job := model.NewJob(
+			model.JobTypePurgeDeletedTenants,
+			model.JobPayload{PurgeDeletedTenantsJobRetentionKey: "720h"},
+			3,
+			requestTime,
+			requestTime,
+		)
+		job.Attempts = attempts - 1
+		job.Start(requestTime.Add(leaseDuration), requestTime)
+		return job
+	}
+
+	// finishedJob matches the job finished as want at any time after want,
+	// since the result is recorded at the time the job finishes rather than when the batch started.
+	finishedJob := func(want *model.Job) gomock.Matcher {
+		return gomock.Cond(func(got *model.Job) bool {
+			if got.UpdatedAt.Before(want.UpdatedAt) {
+				return false
+			}
+			shifted := &model.Job{} //nolint:exhaustruct
+			factory.CloneValue(want, shifted)
+			shifted.UpdatedAt = got.UpdatedAt
+			if shifted.FinishedAt.Valid {
+				shifted.FinishedAt = null.TimeFrom(got.UpdatedAt)
+			}
+			if shifted.Status == model.JobStatusPending {
+				// the retry is scheduled after the backoff from the time the job failed
+				if got.RunAt.Sub(got.UpdatedAt) != want.RunAt.Sub(want.UpdatedAt) {
+					return false
+				}
+				shifted.RunAt = got.RunAt
+			}
+			return reflect.DeepEqual(shifted, got)
+		})
+	}
+
+	tests := map[string]testcaseFunc{
+		"invalid argument": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return testcase{
+				args: args{
+					batchSize:     10,
+					leaseDuration: 0,
+				},
+				usecase: &workerJobInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			job := startedJob(1, testdata.RequestTime)
+
+			succeededJob := &model.Job{} //nolint:exhaustruct
+			factory.CloneValue(job, succeededJob)
+			succeededJob.Succeed(testdata.RequestTime)
+
+			mockJobQueueRepo := mock_repository.NewMockJobQueue(ctrl)
+			gomock.InOrder(
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{job}, nil),
+				mockJobQueueRepo.EXPECT().
+					Update(gomock.Any(), finishedJob(succeededJob)).
+					Return(nil),
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{}, nil),
+			)
+
+			mockHandler := mock_usecase.NewMockJobHandler(ctrl)
+			mockHandler.EXPECT().
+				Handle(gomock.Any(), job, gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					batchSize:     10,
+					leaseDuration: leaseDuration,
+				},
+				usecase: &workerJobInteractor{
+					transactable:       mock_repository.TestMockTransactable(),
+					jobQueueRepository: mockJobQueueRepo,
+					handlers: map[model.JobType]JobHandler{
+						model.JobTypePurgeDeletedTenants: mockHandler,
+					},
+				},
+				want: want{
+					result: output.NewWorkerProcessJobs(1, 0, 0),
+				},
+			}
+		},
+		"batch stops at the batch size": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			job := startedJob(1, testdata.RequestTime)
+
+			succeededJob := &model.Job{} //nolint:exhaustruct
+			factory.CloneValue(job, succeededJob)
+			succeededJob.Succeed(testdata.RequestTime)
+
+			mockJobQueueRepo := mock_repository.NewMockJobQueue(ctrl)
+			gomock.InOrder(
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{job}, nil),
+				mockJobQueueRepo.EXPECT().
+					Update(gomock.Any(), finishedJob(succeededJob)).
+					Return(nil),
+			)
+
+			mockHandler := mock_usecase.NewMockJobHandler(ctrl)
+			mockHandler.EXPECT().
+				Handle(gomock.Any(), job, gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					batchSize:     1,
+					leaseDuration: leaseDuration,
+				},
+				usecase: &workerJobInteractor{
+					transactable:       mock_repository.TestMockTransactable(),
+					jobQueueRepository: mockJobQueueRepo,
+					handlers: map[model.JobType]JobHandler{
+						model.JobTypePurgeDeletedTenants: mockHandler,
+					},
+				},
+				want: want{
+					result: output.NewWorkerProcessJobs(1, 0, 0),
+				},
+			}
+		},
+		"handler failure schedules a retry": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			job := startedJob(1, testdata.RequestTime)
+			handleErr := errors.InternalErr.New()
+
+			retriedJob := &model.Job{} //nolint:exhaustruct
+			factory.CloneValue(job, retriedJob)
+			retriedJob.Fail(handleErr, testdata.RequestTime)
+
+			mockJobQueueRepo := mock_repository.NewMockJobQueue(ctrl)
+			gomock.InOrder(
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{job}, nil),
+				mockJobQueueRepo.EXPECT().
+					Update(gomock.Any(), finishedJob(retriedJob)).
+					Return(nil),
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{}, nil),
+			)
+
+			mockHandler := mock_usecase.NewMockJobHandler(ctrl)
+			mockHandler.EXPECT().
+				Handle(gomock.Any(), job, gomock.Any()).
+				Return(handleErr)
+
+			return testcase{
+				args: args{
+					batchSize:     10,
+					leaseDuration: leaseDuration,
+				},
+				usecase: &workerJobInteractor{
+					transactable:       mock_repository.TestMockTransactable(),
+					jobQueueRepository: mockJobQueueRepo,
+					handlers: map[model.JobType]JobHandler{
+						model.JobTypePurgeDeletedTenants: mockHandler,
+					},
+				},
+				want: want{
+					result: output.NewWorkerProcessJobs(0, 1, 0),
+				},
+			}
+		},
+		"handler failure on the last attempt dead letters the job": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			job := startedJob(3, testdata.RequestTime)
+			handleErr := errors.InternalErr.New()
+
+			deadJob := &model.Job{} //nolint:exhaustruct
+			factory.CloneValue(job, deadJob)
+			deadJob.Fail(handleErr, testdata.RequestTime)
+
+			mockJobQueueRepo := mock_repository.NewMockJobQueue(ctrl)
+			gomock.InOrder(
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{job}, nil),
+				mockJobQueueRepo.EXPECT().
+					Update(gomock.Any(), finishedJob(deadJob)).
+					Return(nil),
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{}, nil),
+			)
+
+			mockHandler := mock_usecase.NewMockJobHandler(ctrl)
+			mockHandler.EXPECT().
+				Handle(gomock.Any(), job, gomock.Any()).
+				Return(handleErr)
+
+			return testcase{
+				args: args{
+					batchSize:     10,
+					leaseDuration: leaseDuration,
+				},
+				usecase: &workerJobInteractor{
+					transactable:       mock_repository.TestMockTransactable(),
+					jobQueueRepository: mockJobQueueRepo,
+					handlers: map[model.JobType]JobHandler{
+						model.JobTypePurgeDeletedTenants: mockHandler,
+					},
+				},
+				want: want{
+					result: output.NewWorkerProcessJobs(0, 0, 1),
+				},
+			}
+		},
+		"expired lease of the last attempt dead letters the job": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			job := startedJob(4, testdata.RequestTime)
+
+			deadJob := &model.Job{} //nolint:exhaustruct
+			factory.CloneValue(job, deadJob)
+			deadJob.Fail(errors.JobLeaseExpiredErr.New().WithValue("job_id", job.ID), testdata.RequestTime)
+
+			mockJobQueueRepo := mock_repository.NewMockJobQueue(ctrl)
+			gomock.InOrder(
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{job}, nil),
+				mockJobQueueRepo.EXPECT().
+					Update(gomock.Any(), finishedJob(deadJob)).
+					Return(nil),
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{}, nil),
+			)
+
+			return testcase{
+				args: args{
+					batchSize:     10,
+					leaseDuration: leaseDuration,
+				},
+				usecase: &workerJobInteractor{
+					transactable:       mock_repository.TestMockTransactable(),
+					jobQueueRepository: mockJobQueueRepo,
+					handlers: map[model.JobType]JobHandler{
+						model.JobTypePurgeDeletedTenants: mock_usecase.NewMockJobHandler(ctrl),
+					},
+				},
+				want: want{
+					result: output.NewWorkerProcessJobs(0, 0, 1),
+				},
+			}
+		},
+		"handler panic schedules a retry": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			job := startedJob(1, testdata.RequestTime)
+
+			retriedJob := &model.Job{} //nolint:exhaustruct
+			factory.CloneValue(job, retriedJob)
+			retriedJob.Fail(
+				errors.JobPanickedErr.New().
+					WithDetail("boom").
+					WithValue("type", job.Type.String()),
+				testdata.RequestTime,
+			)
+
+			mockJobQueueRepo := mock_repository.NewMockJobQueue(ctrl)
+			gomock.InOrder(
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{job}, nil),
+				mockJobQueueRepo.EXPECT().
+					Update(gomock.Any(), finishedJob(retriedJob)).
+					Return(nil),
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{}, nil),
+			)
+
+			mockHandler := mock_usecase.NewMockJobHandler(ctrl)
+			mockHandler.EXPECT().
+				Handle(gomock.Any(), job, gomock.Any()).
+				DoAndReturn(func(context.Context, *model.Job, time.Time) error {
+					panic("boom")
+				})
+
+			return testcase{
+				args: args{
+					batchSize:     10,
+					leaseDuration: leaseDuration,
+				},
+				usecase: &workerJobInteractor{
+					transactable:       mock_repository.TestMockTransactable(),
+					jobQueueRepository: mockJobQueueRepo,
+					handlers: map[model.JobType]JobHandler{
+						model.JobTypePurgeDeletedTenants: mockHandler,
+					},
+				},
+				want: want{
+					result: output.NewWorkerProcessJobs(0, 1, 0),
+				},
+			}
+		},
+		"job claimed again does not abandon the batch": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			staleJob := startedJob(1, testdata.RequestTime)
+			job := startedJob(1, testdata.RequestTime)
+
+			succeededStaleJob := &model.Job{} //nolint:exhaustruct
+			factory.CloneValue(staleJob, succeededStaleJob)
+			succeededStaleJob.Succeed(testdata.RequestTime)
+			succeededJob := &model.Job{} //nolint:exhaustruct
+			factory.CloneValue(job, succeededJob)
+			succeededJob.Succeed(testdata.RequestTime)
+
+			mockJobQueueRepo := mock_repository.NewMockJobQueue(ctrl)
+			gomock.InOrder(
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{staleJob}, nil),
+				mockJobQueueRepo.EXPECT().
+					Update(gomock.Any(), finishedJob(succeededStaleJob)).
+					Return(errors.JobLeaseExpiredErr.New()),
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{job}, nil),
+				mockJobQueueRepo.EXPECT().
+					Update(gomock.Any(), finishedJob(succeededJob)).
+					Return(nil),
+				mockJobQueueRepo.EXPECT().
+					Dequeue(gomock.Any(), dequeueQuery).
+					Return(model.Jobs{}, nil),
+			)
+
+			mockHandler := mock_usecase.NewMockJobHandler(ctrl)
+			mockHandler.EXPECT().
+				Handle(gomock.Any(), staleJob, gomock.Any()).
+				Return(nil)
+			mockHandler.EXPECT().
+				Handle(gomock.Any(), job, gomock.Any()).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					batchSize:     10,
+					leaseDuration: leaseDuration,
+				},
+				usecase: &workerJobInteractor{
+					transactable:       mock_repository.TestMockTransactable(),
+					jobQueueRepository: mockJobQueueRepo,
+					handlers: map[model.JobType]JobHandler{
+						model.JobTypePurgeDeletedTenants: mockHandler,
+					},
+				},
+				want: want{
+					expectedResult: errors.JobLeaseExpiredErr,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.Process(ctx, input.NewWorkerProcessJobs(
+				tc.args.batchSize,
+				tc.args.leaseDuration,
+			))
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.result, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
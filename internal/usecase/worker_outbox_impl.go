@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	goerrors "errors"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	"github.com/abyssparanoia/rapid-go/internal/domain/service"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+)
+
+type workerOutboxInteractor struct {
+	transactable          repository.Transactable
+	outboxEventRepository repository.OutboxEvent
+	subscribers           []service.DomainEventSubscriber
+}
+
+func NewWorkerOutboxInteractor(
+	transactable repository.Transactable,
+	outboxEventRepository repository.OutboxEvent,
+	subscribers []service.DomainEventSubscriber,
+) WorkerOutboxInteractor {
+	return &workerOutboxInteractor{
+		transactable:          transactable,
+		outboxEventRepository: outboxEventRepository,
+		subscribers:           subscribers,
+	}
+}
+
+// Relay delivers a batch of due outbox events to the subscribers.
+// The events are claimed with SKIP LOCKED in a short transaction and leased for LeaseDuration,
+// so several workers can relay concurrently and an event whose worker dies is delivered again.
+// Each event is delivered in its own transaction, so a failed subscriber rolls back only its event.
+// A failed event is retried for every subscriber, which is why subscribers must be idempotent.
+func (i *workerOutboxInteractor) Relay(
+	ctx context.Context,
+	param *input.WorkerRelayOutbox,
+) (*output.WorkerRelayOutbox, error) {
+	if err := param.Validate(); err != nil {
+		return nil, err
+	}
+
+	var outboxEvents model.OutboxEvents
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		outboxEvents, err = i.outboxEventRepository.List(ctx, repository.ListOutboxEventsQuery{
+			BaseListOptions: repository.BaseListOptions{
+				Limit:      null.Uint64From(param.BatchSize),
+				Keyset:     true,
+				ForUpdate:  true,
+				SkipLocked: true,
+			},
+			Status:            nullable.TypeFrom(model.OutboxEventStatusPending),
+			NextAttemptBefore: null.TimeFrom(param.RequestTime),
+		})
+		if err != nil {
+			return err
+		}
+		for _, outboxEvent := range outboxEvents {
+			outboxEvent.Claim(param.RequestTime.Add(param.LeaseDuration), param.RequestTime)
+			if err := i.outboxEventRepository.Update(ctx, outboxEvent); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var deliveredCount, failedCount int
+	var errs []error
+	for _, outboxEvent := range outboxEvents {
+		delivered, err := i.relay(ctx, outboxEvent, param.MaxAttempts, param.RequestTime)
+		if err != nil {
+			// The event is delivered again once its lease expires, so the rest of the batch goes on.
+			errs = append(errs, err)
+			continue
+		}
+		if delivered {
+			deliveredCount++
+		} else {
+			failedCount++
+		}
+	}
+	if len(errs) > 0 {
+		return nil, goerrors.Join(errs...)
+	}
+
+	return output.NewWorkerRelayOutbox(deliveredCount, failedCount), nil
+}
+
+// relay delivers the event and records it as delivered in one transaction, so the writes of the subscribers
+// are rolled back with the delivery when one of them fails, and the failure is then recorded in another.
+func (i *workerOutboxInteractor) relay(
+	ctx context.Context,
+	outboxEvent *model.OutboxEvent,
+	maxAttempts uint64,
+	requestTime time.Time,
+) (bool, error) {
+	deliverErr := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		if err := i.deliver(ctx, outboxEvent.Event); err != nil {
+			return err
+		}
+		// a copy is recorded, so that a failed update leaves the event as claimed
+		delivered := *outboxEvent
+		return i.outboxEventRepository.Update(ctx, delivered.Delivered(requestTime))
+	})
+	if deliverErr == nil {
+		return true, nil
+	}
+	if err := i.transactable.RWTx(ctx, func(ctx context.Context) error {
+		return i.outboxEventRepository.Update(ctx, outboxEvent.DeliveryFailed(deliverErr, maxAttempts, requestTime))
+	}); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+func (i *workerOutboxInteractor) deliver(
+	ctx context.Context,
+	event *model.DomainEvent,
+) error {
+	for _, subscriber := range i.subscribers {
+		if !subscriber.Subscribes(event.Type) {
+			continue
+		}
+		if err := subscriber.Handle(ctx, event); err != nil {
+			return errors.OutboxEventSubscriberFailedErr.Wrap(err).
+				WithValue("subscriber", subscriber.Name())
+		}
+	}
+	return nil
+}
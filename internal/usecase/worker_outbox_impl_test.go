@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/abyssparanoia/rapid-go/internal/domain/errors"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model"
+	"github.com/abyssparanoia/rapid-go/internal/domain/model/factory"
+	"github.com/abyssparanoia/rapid-go/internal/domain/repository"
+	mock_repository "github.com/abyssparanoia/rapid-go/internal/domain/repository/mock"
+	"github.com/abyssparanoia/rapid-go/internal/domain/service"
+	mock_service "github.com/abyssparanoia/rapid-go/internal/domain/service/mock"
+	"github.com/abyssparanoia/rapid-go/internal/pkg/nullable"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/input"
+	"github.com/abyssparanoia/rapid-go/internal/usecase/output"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWorkerOutboxInteractor_Relay(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		batchSize     uint64
+		maxAttempts   uint64
+		leaseDuration time.Duration
+		requestTime   time.Time
+	}
+
+	type want struct {
+		result         *output.WorkerRelayOutbox
+		expectedResult error
+	}
+
+	type testcase struct {
+		args    args
+		usecase WorkerOutboxInteractor
+		want    want
+	}
+
+	type testcaseFunc func(ctx context.Context, ctrl *gomock.Controller) testcase
+
+	listQuery := func(requestTime time.Time) repository.ListOutboxEventsQuery {
+		return repository.ListOutboxEventsQuery{
+			BaseListOptions: repository.BaseListOptions{
+				Limit:      null.Uint64From(10),
+				Keyset:     true,
+				ForUpdate:  true,
+				SkipLocked: true,
+			},
+			Status:            nullable.TypeFrom(model.OutboxEventStatusPending),
+			NextAttemptBefore: null.TimeFrom(requestTime),
+		}
+	}
+
+	tests := map[string]testcaseFunc{
+		"invalid argument": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			return testcase{
+				args: args{
+					batchSize:     0,
+					maxAttempts:   3,
+					leaseDuration: 5 * time.Minute,
+					requestTime:   time.Now(),
+				},
+				usecase: &workerOutboxInteractor{},
+				want: want{
+					expectedResult: errors.RequestInvalidArgumentErr,
+				},
+			}
+		},
+		"success": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			requestTime := testdata.RequestTime.Add(time.Minute)
+			staffDeleted := model.NewOutboxEvent(model.NewStaffDeletedEvent(testdata.Staff, testdata.RequestTime))
+			tenantCreated := model.NewOutboxEvent(model.NewTenantCreatedEvent(testdata.Tenant, testdata.RequestTime))
+
+			claimedStaffDeleted := &model.OutboxEvent{} //nolint:exhaustruct
+			factory.CloneValue(staffDeleted, claimedStaffDeleted)
+			claimedStaffDeleted.Claim(requestTime.Add(5*time.Minute), requestTime)
+			claimedTenantCreated := &model.OutboxEvent{} //nolint:exhaustruct
+			factory.CloneValue(tenantCreated, claimedTenantCreated)
+			claimedTenantCreated.Claim(requestTime.Add(5*time.Minute), requestTime)
+
+			deliveredStaffDeleted := &model.OutboxEvent{} //nolint:exhaustruct
+			factory.CloneValue(claimedStaffDeleted, deliveredStaffDeleted)
+			deliveredStaffDeleted.Delivered(requestTime)
+			deliveredTenantCreated := &model.OutboxEvent{} //nolint:exhaustruct
+			factory.CloneValue(claimedTenantCreated, deliveredTenantCreated)
+			deliveredTenantCreated.Delivered(requestTime)
+
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			gomock.InOrder(
+				mockOutboxEventRepo.EXPECT().
+					List(gomock.Any(), listQuery(requestTime)).
+					Return(model.OutboxEvents{staffDeleted, tenantCreated}, nil),
+				mockOutboxEventRepo.EXPECT().
+					Update(gomock.Any(), claimedStaffDeleted).
+					Return(nil),
+				mockOutboxEventRepo.EXPECT().
+					Update(gomock.Any(), claimedTenantCreated).
+					Return(nil),
+				mockOutboxEventRepo.EXPECT().
+					Update(gomock.Any(), deliveredStaffDeleted).
+					Return(nil),
+				mockOutboxEventRepo.EXPECT().
+					Update(gomock.Any(), deliveredTenantCreated).
+					Return(nil),
+			)
+
+			// Only events the subscriber subscribes to are handled.
+			mockSubscriber := mock_service.NewMockDomainEventSubscriber(ctrl)
+			mockSubscriber.EXPECT().
+				Subscribes(model.DomainEventTypeStaffDeleted).
+				Return(true)
+			mockSubscriber.EXPECT().
+				Subscribes(model.DomainEventTypeTenantCreated).
+				Return(false)
+			mockSubscriber.EXPECT().
+				Handle(gomock.Any(), staffDeleted.Event).
+				Return(nil)
+
+			return testcase{
+				args: args{
+					batchSize:     10,
+					maxAttempts:   3,
+					leaseDuration: 5 * time.Minute,
+					requestTime:   requestTime,
+				},
+				usecase: &workerOutboxInteractor{
+					transactable:          mock_repository.TestMockTransactable(),
+					outboxEventRepository: mockOutboxEventRepo,
+					subscribers:           []service.DomainEventSubscriber{mockSubscriber},
+				},
+				want: want{
+					result: output.NewWorkerRelayOutbox(2, 0),
+				},
+			}
+		},
+		"subscriber failure schedules a retry": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			requestTime := testdata.RequestTime.Add(time.Minute)
+			staffDeleted := model.NewOutboxEvent(model.NewStaffDeletedEvent(testdata.Staff, testdata.RequestTime))
+			handleErr := errors.InternalErr.New()
+
+			claimedStaffDeleted := &model.OutboxEvent{} //nolint:exhaustruct
+			factory.CloneValue(staffDeleted, claimedStaffDeleted)
+			claimedStaffDeleted.Claim(requestTime.Add(5*time.Minute), requestTime)
+
+			failedStaffDeleted := &model.OutboxEvent{} //nolint:exhaustruct
+			factory.CloneValue(claimedStaffDeleted, failedStaffDeleted)
+			failedStaffDeleted.DeliveryFailed(
+				errors.OutboxEventSubscriberFailedErr.Wrap(handleErr).WithValue("subscriber", "staff_authentication"),
+				3,
+				requestTime,
+			)
+
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			gomock.InOrder(
+				mockOutboxEventRepo.EXPECT().
+					List(gomock.Any(), listQuery(requestTime)).
+					Return(model.OutboxEvents{staffDeleted}, nil),
+				mockOutboxEventRepo.EXPECT().
+					Update(gomock.Any(), claimedStaffDeleted).
+					Return(nil),
+				mockOutboxEventRepo.EXPECT().
+					Update(gomock.Any(), failedStaffDeleted).
+					Return(nil),
+			)
+
+			mockSubscriber := mock_service.NewMockDomainEventSubscriber(ctrl)
+			mockSubscriber.EXPECT().
+				Subscribes(model.DomainEventTypeStaffDeleted).
+				Return(true)
+			mockSubscriber.EXPECT().
+				Handle(gomock.Any(), staffDeleted.Event).
+				Return(handleErr)
+			mockSubscriber.EXPECT().
+				Name().
+				Return("staff_authentication")
+
+			return testcase{
+				args: args{
+					batchSize:     10,
+					maxAttempts:   3,
+					leaseDuration: 5 * time.Minute,
+					requestTime:   requestTime,
+				},
+				usecase: &workerOutboxInteractor{
+					transactable:          mock_repository.TestMockTransactable(),
+					outboxEventRepository: mockOutboxEventRepo,
+					subscribers:           []service.DomainEventSubscriber{mockSubscriber},
+				},
+				want: want{
+					result: output.NewWorkerRelayOutbox(0, 1),
+				},
+			}
+		},
+
+		"failure to record an event does not abandon the batch": func(ctx context.Context, ctrl *gomock.Controller) testcase {
+			testdata := factory.NewFactory()
+			requestTime := testdata.RequestTime.Add(time.Minute)
+			staffDeleted := model.NewOutboxEvent(model.NewStaffDeletedEvent(testdata.Staff, testdata.RequestTime))
+			tenantCreated := model.NewOutboxEvent(model.NewTenantCreatedEvent(testdata.Tenant, testdata.RequestTime))
+
+			claimedStaffDeleted := &model.OutboxEvent{} //nolint:exhaustruct
+			factory.CloneValue(staffDeleted, claimedStaffDeleted)
+			claimedStaffDeleted.Claim(requestTime.Add(5*time.Minute), requestTime)
+			claimedTenantCreated := &model.OutboxEvent{} //nolint:exhaustruct
+			factory.CloneValue(tenantCreated, claimedTenantCreated)
+			claimedTenantCreated.Claim(requestTime.Add(5*time.Minute), requestTime)
+
+			deliveredStaffDeleted := &model.OutboxEvent{} //nolint:exhaustruct
+			factory.CloneValue(claimedStaffDeleted, deliveredStaffDeleted)
+			deliveredStaffDeleted.Delivered(requestTime)
+			failedStaffDeleted := &model.OutboxEvent{} //nolint:exhaustruct
+			factory.CloneValue(claimedStaffDeleted, failedStaffDeleted)
+			failedStaffDeleted.DeliveryFailed(errors.InternalErr.New(), 3, requestTime)
+			deliveredTenantCreated := &model.OutboxEvent{} //nolint:exhaustruct
+			factory.CloneValue(claimedTenantCreated, deliveredTenantCreated)
+			deliveredTenantCreated.Delivered(requestTime)
+
+			mockOutboxEventRepo := mock_repository.NewMockOutboxEvent(ctrl)
+			gomock.InOrder(
+				mockOutboxEventRepo.EXPECT().
+					List(gomock.Any(), listQuery(requestTime)).
+					Return(model.OutboxEvents{staffDeleted, tenantCreated}, nil),
+				mockOutboxEventRepo.EXPECT().
+					Update(gomock.Any(), claimedStaffDeleted).
+					Return(nil),
+				mockOutboxEventRepo.EXPECT().
+					Update(gomock.Any(), claimedTenantCreated).
+					Return(nil),
+				mockOutboxEventRepo.EXPECT().
+					Update(gomock.Any(), deliveredStaffDeleted).
+					Return(errors.InternalErr.New()),
+				mockOutboxEventRepo.EXPECT().
+					Update(gomock.Any(), failedStaffDeleted).
+					Return(errors.InternalErr.New()),
+				mockOutboxEventRepo.EXPECT().
+					Update(gomock.Any(), deliveredTenantCreated).
+					Return(nil),
+			)
+
+			return testcase{
+				args: args{
+					batchSize:     10,
+					maxAttempts:   3,
+					leaseDuration: 5 * time.Minute,
+					requestTime:   requestTime,
+				},
+				usecase: &workerOutboxInteractor{
+					transactable:          mock_repository.TestMockTransactable(),
+					outboxEventRepository: mockOutboxEventRepo,
+					subscribers:           []service.DomainEventSubscriber{},
+				},
+				want: want{
+					expectedResult: errors.InternalErr,
+				},
+			}
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tc := tc(ctx, ctrl)
+
+			got, err := tc.usecase.Relay(ctx, input.NewWorkerRelayOutbox(
+				tc.args.batchSize,
+				tc.args.maxAttempts,
+				tc.args.leaseDuration,
+				tc.args.requestTime,
+			))
+			if tc.want.expectedResult == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.want.result, got)
+			} else {
+				require.ErrorContains(t, err, tc.want.expectedResult.Error())
+			}
+		})
+	}
+}
@@ -34,13 +34,14 @@ func main() {
 		e.SpannerDatabaseID,
 	)
 
-	transactable := transactable.NewTransactable(spannerCli)
+	transactable := transactable.NewTransactable(spannerCli.Client, e.TxRetryPolicy())
 	tenantRepository := spanner_repository.NewTenant()
 	// staffRepository := spanner_repository.NewStaff()
 
 	n := now.Now()
 	tenant := model.NewTenant(
 		"tenant-name",
+		nil,
 		n,
 	)
 	tenant.ID = uuid.UUID()